@@ -0,0 +1,145 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	gcal "google.golang.org/api/calendar/v3"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// authTimeout is the time available for granting access in the browser.
+const authTimeout = 5 * time.Minute
+
+// Authorizes the Google account whose calendar is synchronized by the REST server, the refresh token printed is
+// used as GOOGLE_CALENDAR_REFRESH_TOKEN. The OAuth client must be a "Desktop app" client, those accept redirects
+// to any loopback address.
+func main() {
+	var env string
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.Parse()
+
+	if err := run(env); err != nil {
+		log.Fatalf("Couldn't authorize: %s", err)
+	}
+}
+
+func run(env string) error {
+	if err := envvar.Load(env); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "net.Listen")
+	}
+
+	oauthConf := oauth2.Config{
+		Endpoint:    google.Endpoint,
+		Scopes:      []string{gcal.CalendarEventsScope},
+		RedirectURL: "http://" + listener.Addr().String() + "/",
+	}
+
+	if oauthConf.ClientID, err = conf.Get("GOOGLE_CALENDAR_CLIENT_ID"); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "conf.Get GOOGLE_CALENDAR_CLIENT_ID")
+	}
+
+	if oauthConf.ClientSecret, err = conf.Get("GOOGLE_CALENDAR_CLIENT_SECRET"); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "conf.Get GOOGLE_CALENDAR_CLIENT_SECRET")
+	}
+
+	state, err := newState()
+	if err != nil {
+		return err
+	}
+
+	codes := make(chan string, 1)
+
+	srv := &http.Server{
+		ReadHeaderTimeout: 5 * time.Second,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("state") != state || r.URL.Query().Get("code") == "" {
+				http.Error(w, "invalid request", http.StatusBadRequest)
+
+				return
+			}
+
+			fmt.Fprintln(w, "Authorized, you can close this window.")
+
+			select {
+			case codes <- r.URL.Query().Get("code"):
+			default:
+			}
+		}),
+	}
+
+	go func() {
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("Couldn't serve: %s", err)
+		}
+	}()
+
+	defer srv.Close()
+
+	// Consent is requested every time, otherwise the refresh token is only returned the first time.
+	fmt.Printf("Open the following URL to grant access to your calendar:\n\n%s\n\n",
+		oauthConf.AuthCodeURL(state, oauth2.AccessTypeOffline, oauth2.ApprovalForce))
+
+	ctx, cancel := context.WithTimeout(context.Background(), authTimeout)
+	defer cancel()
+
+	var code string
+
+	select {
+	case <-ctx.Done():
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "access not granted in time")
+	case code = <-codes:
+	}
+
+	token, err := oauthConf.Exchange(ctx, code)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "oauthConf.Exchange")
+	}
+
+	if token.RefreshToken == "" {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "refresh token not returned")
+	}
+
+	fmt.Printf("GOOGLE_CALENDAR_REFRESH_TOKEN=%s\n", token.RefreshToken)
+
+	return nil
+}
+
+// newState returns the random value used for rejecting redirects not started by this authorization.
+func newState() (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "rand.Read")
+	}
+
+	return hex.EncodeToString(b), nil
+}
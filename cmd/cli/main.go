@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -17,17 +18,26 @@ import (
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/semconv"
 
+	"github.com/MarioCarrion/todo-api/pkg/client"
 	"github.com/MarioCarrion/todo-api/pkg/openapi3"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "smoke" {
+		if err := smoke(os.Args[2:]); err != nil {
+			log.Fatalf("Smoke test failed: %s", err)
+		}
+
+		return
+	}
+
 	initTracer()
 
 	//-
 
 	clientOA3 := http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 
-	client, err := openapi3.NewClientWithResponses("http://0.0.0.0:9234", openapi3.WithHTTPClient(&clientOA3))
+	c, err := client.New("http://0.0.0.0:9234/api/v1", client.WithHTTPClient(&clientOA3))
 	if err != nil {
 		log.Fatalf("Couldn't instantiate client: %s", err)
 	}
@@ -45,8 +55,8 @@ func main() {
 	for count < 101 {
 		priority := openapi3.PriorityLow
 
-		_, err := client.CreateTaskWithResponse(context.Background(),
-			openapi3.CreateTaskJSONRequestBody{
+		_, err := c.CreateTask(context.Background(),
+			openapi3.CreateTasksRequest{
 				Dates: &openapi3.Dates{
 					Start: newPtrTime(time.Now()),
 					Due:   newPtrTime(time.Now().Add(time.Hour * 24)),
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/pkg/client"
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+// smoke runs an end to end scenario against a running environment: a task is created, read, updated, searched
+// and deleted. Searching verifies the events emitted by each change were consumed by the indexer, the task is
+// deleted even when a step fails.
+func smoke(args []string) error {
+	var (
+		baseURL       string
+		eventsTimeout time.Duration
+	)
+
+	fs := flag.NewFlagSet("smoke", flag.ContinueOnError)
+	fs.StringVar(&baseURL, "base-url", "http://0.0.0.0:9234/api/v1", "URL of the rest-server being verified")
+	fs.DurationVar(&eventsTimeout, "events-timeout", 30*time.Second,
+		"Time to wait for events to be indexed, zero skips verifying them")
+
+	if err := fs.Parse(args); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "fs.Parse")
+	}
+
+	c, err := client.New(baseURL,
+		client.WithHTTPClient(&http.Client{Timeout: 10 * time.Second}),
+		client.WithRetry(client.RetryOptions{Attempts: smokeMaxAttempts, Backoff: time.Second, MaxBackoff: 2 * time.Second}))
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "client.New")
+	}
+
+	s := smokeScenario{
+		client:        c,
+		eventsTimeout: eventsTimeout,
+		term:          strings.ReplaceAll(uuid.NewString(), "-", ""),
+	}
+
+	return s.run(context.Background())
+}
+
+// smokeMaxAttempts is the number of times requests are sent, those are retried when rejected by the rate limiter
+// of the rest-server.
+const smokeMaxAttempts = 5
+
+type smokeScenario struct {
+	client        *client.Client
+	eventsTimeout time.Duration
+	term          string // unique value included in the descriptions for searching the task
+}
+
+//nolint: funlen, cyclop
+func (s *smokeScenario) run(ctx context.Context) error {
+	expected := smokeTask{
+		description: fmt.Sprintf("Smoke test %s", s.term),
+		priority:    openapi3.PriorityLow,
+	}
+
+	created, err := s.client.CreateTask(ctx, openapi3.CreateTasksRequest{
+		Description: &expected.description,
+		Priority:    &expected.priority,
+	})
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "create")
+	}
+
+	if created.Id == nil {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "create: task without id")
+	}
+
+	id := *created.Id
+
+	log.Printf("OK create %s", id)
+
+	deleted := false
+
+	defer func() {
+		if !deleted {
+			_ = s.client.DeleteTask(context.Background(), id)
+		}
+	}()
+
+	etag, err := s.read(ctx, id, expected)
+	if err != nil {
+		return err
+	}
+
+	if err := s.search(ctx, expected, true); err != nil {
+		return err
+	}
+
+	expected = smokeTask{
+		description: fmt.Sprintf("Smoke test %s updated", s.term),
+		priority:    openapi3.PriorityHigh,
+		isDone:      true,
+	}
+
+	if err := s.client.UpdateTask(ctx, id, etag, openapi3.UpdateTasksRequest{
+		Description: &expected.description,
+		Priority:    &expected.priority,
+		IsDone:      &expected.isDone,
+	}); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "update")
+	}
+
+	log.Printf("OK update %s", id)
+
+	if _, err := s.read(ctx, id, expected); err != nil {
+		return err
+	}
+
+	if err := s.search(ctx, expected, true); err != nil {
+		return err
+	}
+
+	if err := s.client.DeleteTask(ctx, id); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "delete")
+	}
+
+	deleted = true
+
+	log.Printf("OK delete %s", id)
+
+	var cerr *client.Error
+
+	_, _, err = s.client.ReadTask(ctx, id)
+	if !errors.As(err, &cerr) || cerr.Code() != client.ErrorCodeNotFound {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "read deleted: expected not found, got %v", err)
+	}
+
+	log.Printf("OK read deleted %s", id)
+
+	return s.search(ctx, expected, false)
+}
+
+// read verifies the task returned by the API matches the expected one, it returns its ETag when supported.
+func (s *smokeScenario) read(ctx context.Context, id string, expected smokeTask) (string, error) {
+	task, etag, err := s.client.ReadTask(ctx, id)
+	if err != nil {
+		return "", internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "read")
+	}
+
+	if !expected.matches(task) {
+		return "", internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "read: unexpected task %+v", task)
+	}
+
+	log.Printf("OK read %s", id)
+
+	return etag, nil
+}
+
+// search waits until the indexed task matches the expected one, or until it's no longer indexed when found is
+// false, this verifies the events emitted by the previous change were consumed.
+func (s *smokeScenario) search(ctx context.Context, expected smokeTask, found bool) error {
+	if s.eventsTimeout <= 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.eventsTimeout)
+	defer cancel()
+
+	var last string
+
+	for {
+		size := int64(10)
+
+		res, err := s.client.SearchTasks(ctx, openapi3.SearchTasksRequest{
+			Description: &s.term,
+			Size:        &size,
+		})
+
+		if err != nil {
+			last = err.Error()
+		} else {
+			tasks := tasksOrEmpty(res.Tasks)
+
+			last = fmt.Sprintf("%d tasks found", len(tasks))
+
+			if expected.indexed(tasks) == found {
+				log.Printf("OK search %s", s.term)
+
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "search: events not indexed after %s, last %s",
+				s.eventsTimeout, last)
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// smokeTask defines the values expected in the task being verified.
+type smokeTask struct {
+	description string
+	priority    openapi3.Priority
+	isDone      bool
+}
+
+func (t smokeTask) matches(task openapi3.Task) bool {
+	return task.Description != nil && *task.Description == t.description &&
+		task.Priority != nil && *task.Priority == t.priority &&
+		(task.IsDone != nil && *task.IsDone) == t.isDone
+}
+
+func (t smokeTask) indexed(tasks []openapi3.Task) bool {
+	for _, task := range tasks {
+		if t.matches(task) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func tasksOrEmpty(tasks *[]openapi3.Task) []openapi3.Task {
+	if tasks == nil {
+		return nil
+	}
+
+	return *tasks
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+
+	internalkafka "github.com/MarioCarrion/todo-api/internal/kafka"
+)
+
+const defaultDeadLetterLimit = 10
+
+// DeadLetterQueue defines the datastore handling dead-lettered messages.
+type DeadLetterQueue interface {
+	List(ctx context.Context, limit int) ([]internalkafka.DeadLetter, error)
+	Replay(ctx context.Context, limit int) (int, error)
+}
+
+// DeadLetter represents a message that couldn't be consumed.
+type DeadLetter struct {
+	Key               []byte    `json:"key,omitempty"`
+	Value             []byte    `json:"value"`
+	Error             string    `json:"error"`
+	Attempts          int       `json:"attempts"`
+	FailedAt          time.Time `json:"failed_at"`
+	OriginalTopic     string    `json:"original_topic"`
+	OriginalPartition int32     `json:"original_partition"`
+	OriginalOffset    int64     `json:"original_offset"`
+	Offset            int64     `json:"offset"`
+}
+
+// ListDeadLettersResponse defines the response returned when listing dead-lettered messages.
+type ListDeadLettersResponse struct {
+	Messages []DeadLetter `json:"messages"`
+}
+
+// ReplayDeadLettersResponse defines the response returned when replaying dead-lettered messages.
+type ReplayDeadLettersResponse struct {
+	Replayed int `json:"replayed"`
+}
+
+// AdminHandler exposes the endpoints used for inspecting and replaying dead-lettered messages.
+type AdminHandler struct {
+	logger *zap.Logger
+	dlq    DeadLetterQueue
+}
+
+// Register connects the handlers to the router.
+func (a *AdminHandler) Register(r *mux.Router) {
+	r.HandleFunc("/admin/dlq", a.list).Methods(http.MethodGet)
+	r.HandleFunc("/admin/dlq/replay", a.replay).Methods(http.MethodPost)
+}
+
+func (a *AdminHandler) list(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimit(w, r)
+	if !ok {
+		return
+	}
+
+	msgs, err := a.dlq.List(r.Context(), limit)
+	if err != nil {
+		a.logger.Error("Listing dead letters failed", zap.Error(err))
+		renderResponse(w, map[string]string{"error": "list failed"}, http.StatusInternalServerError)
+
+		return
+	}
+
+	res := ListDeadLettersResponse{
+		Messages: make([]DeadLetter, len(msgs)),
+	}
+
+	for i, msg := range msgs {
+		res.Messages[i] = DeadLetter(msg)
+	}
+
+	renderResponse(w, res, http.StatusOK)
+}
+
+func (a *AdminHandler) replay(w http.ResponseWriter, r *http.Request) {
+	limit, ok := parseLimit(w, r)
+	if !ok {
+		return
+	}
+
+	count, err := a.dlq.Replay(r.Context(), limit)
+	if err != nil {
+		a.logger.Error("Replaying dead letters failed", zap.Int("replayed", count), zap.Error(err))
+		renderResponse(w, map[string]string{"error": "replay failed"}, http.StatusInternalServerError)
+
+		return
+	}
+
+	a.logger.Info("Replayed dead letters", zap.Int("replayed", count))
+
+	renderResponse(w, ReplayDeadLettersResponse{Replayed: count}, http.StatusOK)
+}
+
+func parseLimit(w http.ResponseWriter, r *http.Request) (int, bool) {
+	val := r.URL.Query().Get("limit")
+	if val == "" {
+		return defaultDeadLetterLimit, true
+	}
+
+	limit, err := strconv.Atoi(val)
+	if err != nil || limit < 1 {
+		renderResponse(w, map[string]string{"error": "invalid limit"}, http.StatusBadRequest)
+
+		return 0, false
+	}
+
+	return limit, true
+}
+
+func renderResponse(w http.ResponseWriter, res interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(res)
+}
@@ -1,32 +1,38 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
+	"errors"
 	"flag"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.uber.org/zap"
 
 	"github.com/MarioCarrion/todo-api/cmd/internal"
 	internaldomain "github.com/MarioCarrion/todo-api/internal"
 	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
 	"github.com/MarioCarrion/todo-api/internal/envvar"
+	internalkafka "github.com/MarioCarrion/todo-api/internal/kafka"
 )
 
 func main() {
-	var env string
+	var env, address string
 
 	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.StringVar(&address, "address", "127.0.0.1:9236", "Admin HTTP Server Address")
 	flag.Parse()
 
-	errC, err := run(env)
+	errC, err := run(env, address)
 	if err != nil {
 		log.Fatalf("Couldn't run: %s", err)
 	}
@@ -36,7 +42,7 @@ func main() {
 	}
 }
 
-func run(env string) (<-chan error, error) {
+func run(env, address string) (<-chan error, error) {
 	logger, err := zap.NewProduction()
 	if err != nil {
 		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
@@ -53,6 +59,16 @@ func run(env string) (<-chan error, error) {
 
 	conf := envvar.New(vault)
 
+	// The admin server exposes the payloads of the dead-lettered messages, it's protected like the rest-server one.
+	adminAuth, err := internal.NewAdminAuth(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewAdminAuth")
+	}
+
+	if err := adminAuth.Validate(address); err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "adminAuth.Validate")
+	}
+
 	//-
 
 	esClient, err := internal.NewElasticSearch(conf)
@@ -65,9 +81,32 @@ func run(env string) (<-chan error, error) {
 		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewKafkaConsumer")
 	}
 
+	// Messages that can't be consumed are moved to a dead-letter topic.
+	dlq, err := internal.NewKafkaDeadLetterQueue(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewKafkaDeadLetterQueue")
+	}
+
+	maxAttempts, err := internal.NewKafkaMaxAttempts(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewKafkaMaxAttempts")
+	}
+
+	// Avro messages are supported only when the Schema Registry is configured.
+	registry, err := internal.NewSchemaRegistry(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewSchemaRegistry")
+	}
+
+	var avro *internalkafka.AvroDeserializer
+
+	if registry != nil {
+		avro = internalkafka.NewAvroDeserializer(registry)
+	}
+
 	//-
 
-	_, err = internal.NewOTExporter(conf)
+	_, err = internal.NewOTExporter(conf, nil)
 	if err != nil {
 		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.newOTExporter ")
 	}
@@ -75,13 +114,42 @@ func run(env string) (<-chan error, error) {
 	//-
 
 	srv := &Server{
-		logger: logger,
-		kafka:  kafka,
-		task:   elasticsearch.NewTask(esClient),
-		doneC:  make(chan struct{}),
-		closeC: make(chan struct{}),
+		logger:      logger,
+		kafka:       kafka,
+		decoder:     internalkafka.NewEventDecoder(avro),
+		dlq:         dlq,
+		maxAttempts: maxAttempts,
+		task:        elasticsearch.NewTask(esClient),
+		doneC:       make(chan struct{}),
+		closeC:      make(chan struct{}),
+		messages: metric.Must(global.Meter("github.com/MarioCarrion/todo-api/cmd/elasticsearch-indexer-kafka")).
+			NewInt64Counter("kafka.consumer.messages",
+				metric.WithDescription("Number of messages consumed, labeled by result: indexed or dead_lettered"),
+			),
 	}
 
+	router := mux.NewRouter()
+	router.Use(adminAuth.Middleware)
+
+	(&AdminHandler{logger: logger, dlq: dlq}).Register(router)
+
+	adminSrv := &http.Server{
+		Handler:           router,
+		Addr:              address,
+		TLSConfig:         adminAuth.TLS,
+		ReadTimeout:       1 * time.Second,
+		ReadHeaderTimeout: 1 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       1 * time.Second,
+	}
+
+	shutdown := internal.NewShutdown(logger)
+	shutdown.Register(internal.ShutdownStageAdmin, "admin-http", 5*time.Second, adminSrv.Shutdown)
+	shutdown.Register(internal.ShutdownStageConsumers, "kafka-consumer", 10*time.Second, srv.Shutdown)
+	shutdown.Register(internal.ShutdownStageConsumers, "kafka-unsubscribe", 5*time.Second, func(context.Context) error {
+		return kafka.Consumer.Unsubscribe() //nolint: wrapcheck
+	})
+
 	errC := make(chan error, 1)
 
 	ctx, stop := signal.NotifyContext(context.Background(),
@@ -94,24 +162,33 @@ func run(env string) (<-chan error, error) {
 
 		logger.Info("Shutdown signal received")
 
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-
 		defer func() {
 			_ = logger.Sync()
-			_ = kafka.Consumer.Unsubscribe()
 
 			stop()
-			cancel()
 			close(errC)
 		}()
 
-		if err := srv.Shutdown(ctxTimeout); err != nil { //nolint: contextcheck
+		if err := shutdown.Run(context.Background()); err != nil { //nolint: contextcheck
 			errC <- err
 		}
 
 		logger.Info("Shutdown completed")
 	}()
 
+	go func() {
+		logger.Info("Admin listening and serving", zap.String("address", address))
+
+		serve := adminSrv.ListenAndServe
+		if adminSrv.TLSConfig != nil {
+			serve = func() error { return adminSrv.ListenAndServeTLS("", "") }
+		}
+
+		if err := serve(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errC <- err
+		}
+	}()
+
 	go func() {
 		logger.Info("Listening and serving")
 
@@ -124,11 +201,15 @@ func run(env string) (<-chan error, error) {
 }
 
 type Server struct {
-	logger *zap.Logger
-	kafka  *internal.KafkaConsumer
-	task   *elasticsearch.Task
-	doneC  chan struct{}
-	closeC chan struct{}
+	logger      *zap.Logger
+	kafka       *internal.KafkaConsumer
+	decoder     *internalkafka.EventDecoder
+	dlq         *internalkafka.DeadLetterQueue
+	maxAttempts int
+	task        *elasticsearch.Task
+	doneC       chan struct{}
+	closeC      chan struct{}
+	messages    metric.Int64Counter
 }
 
 // ListenAndServe ...
@@ -154,35 +235,41 @@ func (s *Server) ListenAndServe() error {
 					continue
 				}
 
-				var evt struct {
-					Type  string
-					Value internaldomain.Task
-				}
+				// Invalid messages are never going to be consumed, those are dead-lettered right away.
+				evt, err := s.decoder.Decode(context.Background(), msg.Value)
+				if err != nil {
+					s.logger.Info("Dead-lettering message, invalid", zap.Error(err))
 
-				if err := json.NewDecoder(bytes.NewReader(msg.Value)).Decode(&evt); err != nil {
-					s.logger.Info("Ignoring message, invalid", zap.Error(err))
-					commit(msg)
+					if s.deadLetter(msg, err, 1) {
+						commit(msg)
+					}
 
 					continue
 				}
 
-				ok = false
-
-				switch evt.Type {
-				case "tasks.event.updated", "tasks.event.created":
-					if err := s.task.Index(context.Background(), evt.Value); err == nil {
-						ok = true
+				if attempts, err := s.consume(evt); err != nil {
+					select {
+					case <-s.closeC:
+						// Shutting down, the message is consumed again once the server restarts.
+						continue
+					default:
 					}
-				case "tasks.event.deleted":
-					if err := s.task.Delete(context.Background(), evt.Value.ID); err == nil {
-						ok = true
+
+					s.logger.Info("Dead-lettering message, consuming failed",
+						zap.String("type", evt.Type),
+						zap.Int("attempts", attempts),
+						zap.Error(err))
+
+					if s.deadLetter(msg, err, attempts) {
+						commit(msg)
 					}
-				}
 
-				if ok {
-					s.logger.Info("Consumed", zap.String("type", evt.Type))
-					commit(msg)
+					continue
 				}
+
+				s.logger.Info("Consumed", zap.String("type", evt.Type))
+				s.messages.Add(context.Background(), 1, attribute.String("result", "indexed"))
+				commit(msg)
 			}
 		}
 
@@ -194,6 +281,74 @@ func (s *Server) ListenAndServe() error {
 	return nil
 }
 
+// consume handles the event retrying transient failures using exponential backoff, up to maxAttempts times.
+func (s *Server) consume(evt internalkafka.Event) (int, error) {
+	const maxBackoff = 5 * time.Second
+
+	backoff := 100 * time.Millisecond
+
+	for attempt := 1; ; attempt++ {
+		err := s.handle(evt)
+		if err == nil {
+			return attempt, nil
+		}
+
+		var ierr *internaldomain.Error
+		if errors.As(err, &ierr) && ierr.Code() == internaldomain.ErrorCodeInvalidArgument {
+			return attempt, err // Not transient, retrying won't help.
+		}
+
+		if attempt >= s.maxAttempts {
+			return attempt, err
+		}
+
+		s.logger.Warn("Consuming failed, retrying", zap.Int("attempt", attempt), zap.Duration("backoff", backoff), zap.Error(err))
+
+		select {
+		case <-s.closeC:
+			return attempt, err
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+func (s *Server) handle(evt internalkafka.Event) error {
+	switch evt.Type {
+	case "tasks.event.updated", "tasks.event.created":
+		return s.task.Index(context.Background(), evt.Value)
+	case "tasks.event.deleted":
+		return s.task.Delete(context.Background(), evt.Value.ID)
+	case "tasks.event.completed", "tasks.event.reopened":
+		// The task is indexed when handling the "updated" event published before.
+		return nil
+	}
+
+	// Types published by newer versions are skipped, see internaldomain.SkipUnrecognizedTaskEvent.
+	internaldomain.SkipUnrecognizedTaskEvent(context.Background(), evt.Type)
+
+	s.logger.Info("Skipping message, unknown type", zap.String("type", evt.Type))
+
+	return nil
+}
+
+// deadLetter moves the message to the dead-letter queue, it returns false when that fails so the message is not
+// committed.
+func (s *Server) deadLetter(msg *kafka.Message, cause error, attempts int) bool {
+	s.messages.Add(context.Background(), 1, attribute.String("result", "dead_lettered"))
+
+	if err := s.dlq.Send(msg, cause, attempts); err != nil {
+		s.logger.Error("dead-lettering failed", zap.Error(err))
+
+		return false
+	}
+
+	return true
+}
+
 // Shutdown ...
 func (s *Server) Shutdown(ctx context.Context) error {
 	s.logger.Info("Shutting down server")
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/pubsub"
+)
+
+func main() {
+	var env string
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.Parse()
+
+	errC, err := run(env)
+	if err != nil {
+		log.Fatalf("Couldn't run: %s", err)
+	}
+
+	if err := <-errC; err != nil {
+		log.Fatalf("Error while running: %s", err)
+	}
+}
+
+func run(env string) (<-chan error, error) {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+	}
+
+	if err := envvar.Load(env); err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	esClient, err := internal.NewElasticSearch(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewElasticSearch")
+	}
+
+	client, err := internal.NewPubSub(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPubSub")
+	}
+
+	subscription, err := client.Subscription(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "client.Subscription")
+	}
+
+	//-
+
+	_, err = internal.NewOTExporter(conf, nil)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewOTExporter")
+	}
+
+	//-
+
+	srv := &Server{
+		logger:     logger,
+		subscriber: pubsub.NewSubscriber(subscription),
+		task:       elasticsearch.NewTask(esClient),
+		doneC:      make(chan struct{}),
+	}
+
+	shutdown := internal.NewShutdown(logger)
+	shutdown.Register(internal.ShutdownStageConsumers, "pubsub-subscriber", 30*time.Second, srv.Shutdown)
+	shutdown.RegisterFunc(internal.ShutdownStageDatastores, "pubsub", 5*time.Second, client.Close)
+
+	errC := make(chan error, 1)
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+
+	go func() {
+		<-ctx.Done()
+
+		logger.Info("Shutdown signal received")
+
+		defer func() {
+			_ = logger.Sync()
+
+			stop()
+			close(errC)
+		}()
+
+		if err := shutdown.Run(context.Background()); err != nil { //nolint: contextcheck
+			errC <- err
+		}
+
+		logger.Info("Shutdown completed")
+	}()
+
+	go func() {
+		logger.Info("Listening and serving")
+
+		if err := srv.ListenAndServe(ctx); err != nil {
+			errC <- err
+		}
+	}()
+
+	return errC, nil
+}
+
+type Server struct {
+	logger     *zap.Logger
+	subscriber *pubsub.Subscriber
+	task       *elasticsearch.Task
+	doneC      chan struct{}
+}
+
+// ListenAndServe consumes messages until ctx is canceled.
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	defer close(s.doneC)
+
+	err := s.subscriber.Receive(ctx, func(ctx context.Context, evt pubsub.Event) error {
+		var err error
+
+		switch evt.Type {
+		case "tasks.event.updated", "tasks.event.created":
+			err = s.task.Index(ctx, evt.Value)
+		case "tasks.event.deleted":
+			err = s.task.Delete(ctx, evt.Value.ID)
+		case "tasks.event.completed", "tasks.event.reopened":
+			// The task is indexed when handling the "updated" event published before.
+		default:
+			// Types published by newer versions are skipped, see internaldomain.SkipUnrecognizedTaskEvent.
+			internaldomain.SkipUnrecognizedTaskEvent(ctx, evt.Type)
+
+			s.logger.Info("Skipping message, unknown type", zap.String("type", evt.Type))
+
+			return nil
+		}
+
+		if err != nil {
+			s.logger.Info("Consuming failed, retrying later", zap.String("type", evt.Type), zap.Error(err))
+
+			return err
+		}
+
+		s.logger.Info("Consumed", zap.String("type", evt.Type))
+
+		return nil
+	})
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "subscriber.Receive")
+	}
+
+	s.logger.Info("No more messages to consume. Exiting.")
+
+	return nil
+}
+
+// Shutdown waits for the message being processed to complete.
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Info("Shutting down server")
+
+	select {
+	case <-ctx.Done():
+		return internaldomain.WrapErrorf(ctx.Err(), internaldomain.ErrorCodeUnknown, "context.Done")
+	case <-s.doneC:
+		return nil
+	}
+}
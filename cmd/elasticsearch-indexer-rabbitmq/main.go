@@ -69,7 +69,7 @@ func run(env string) (<-chan error, error) {
 
 	//-
 
-	_, err = internal.NewOTExporter(conf)
+	_, err = internal.NewOTExporter(conf, nil)
 	if err != nil {
 		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "newOTExporter")
 	}
@@ -83,6 +83,10 @@ func run(env string) (<-chan error, error) {
 		done:   make(chan struct{}),
 	}
 
+	shutdown := internal.NewShutdown(logger)
+	shutdown.Register(internal.ShutdownStageConsumers, "rabbitmq-consumer", 10*time.Second, srv.Shutdown)
+	shutdown.RegisterFunc(internal.ShutdownStageDatastores, "rabbitmq", 5*time.Second, rmq.Close)
+
 	errC := make(chan error, 1)
 
 	ctx, stop := signal.NotifyContext(context.Background(),
@@ -95,18 +99,14 @@ func run(env string) (<-chan error, error) {
 
 		logger.Info("Shutdown signal received")
 
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-
 		defer func() {
 			_ = logger.Sync()
 
-			rmq.Close()
 			stop()
-			cancel()
 			close(errC)
 		}()
 
-		if err := srv.Shutdown(ctxTimeout); err != nil { //nolint: contextcheck
+		if err := shutdown.Run(context.Background()); err != nil { //nolint: contextcheck
 			errC <- err
 		}
 
@@ -181,7 +181,7 @@ func (s *Server) ListenAndServe() error {
 			// XXX: We will revisit defining these topics in a better way in future episodes
 			switch msg.RoutingKey {
 			case "tasks.event.updated", "tasks.event.created":
-				task, err := decodeTask(msg.Body)
+				task, err := decodeTask(msg.ContentType, msg.Body)
 				if err != nil {
 					return
 				}
@@ -190,7 +190,7 @@ func (s *Server) ListenAndServe() error {
 					nack = true
 				}
 			case "tasks.event.deleted":
-				id, err := decodeID(msg.Body)
+				id, err := decodeID(msg.ContentType, msg.Body)
 				if err != nil {
 					return
 				}
@@ -198,8 +198,11 @@ func (s *Server) ListenAndServe() error {
 				if err := s.task.Delete(context.Background(), id); err != nil {
 					nack = true
 				}
+			case "tasks.event.completed", "tasks.event.reopened":
+				// The task is indexed when handling the "updated" event published before.
 			default:
-				nack = true
+				// Types published by newer versions are skipped, see internaldomain.SkipUnrecognizedTaskEvent.
+				internaldomain.SkipUnrecognizedTaskEvent(context.Background(), msg.RoutingKey)
 			}
 
 			if nack {
@@ -237,7 +240,22 @@ func (s *Server) Shutdown(ctx context.Context) error {
 	}
 }
 
-func decodeTask(b []byte) (internaldomain.Task, error) {
+func decodeTask(contentType string, b []byte) (internaldomain.Task, error) {
+	if contentType == internaldomain.CloudEventsContentType {
+		evt, err := internaldomain.DecodeCloudEvent(b)
+		if err != nil {
+			return internaldomain.Task{}, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "DecodeCloudEvent")
+		}
+
+		task, err := evt.DecodeTask(context.Background())
+		if err != nil {
+			return internaldomain.Task{}, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "evt.DecodeTask")
+		}
+
+		return task, nil
+	}
+
+	// gob ignores unknown fields and keeps the zero value of missing ones.
 	var res internaldomain.Task
 
 	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&res); err != nil {
@@ -247,12 +265,33 @@ func decodeTask(b []byte) (internaldomain.Task, error) {
 	return res, nil
 }
 
-func decodeID(b []byte) (string, error) {
+func decodeID(contentType string, b []byte) (string, error) {
 	var res string
 
+	if contentType == internaldomain.CloudEventsContentType {
+		if err := decodeCloudEvent(b, &res); err != nil {
+			return "", internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "decodeCloudEvent")
+		}
+
+		return res, nil
+	}
+
 	if err := gob.NewDecoder(bytes.NewReader(b)).Decode(&res); err != nil {
 		return "", internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "gob.Decode")
 	}
 
 	return res, nil
 }
+
+func decodeCloudEvent(b []byte, v interface{}) error {
+	evt, err := internaldomain.DecodeCloudEvent(b)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internaldomain.DecodeCloudEvent")
+	}
+
+	if err := evt.DecodeData(v); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "evt.DecodeData")
+	}
+
+	return nil
+}
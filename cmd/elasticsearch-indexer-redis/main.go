@@ -68,7 +68,7 @@ func run(env string) (<-chan error, error) {
 
 	//-
 
-	_, err = internal.NewOTExporter(conf)
+	_, err = internal.NewOTExporter(conf, nil)
 	if err != nil {
 		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "newOTExporter")
 	}
@@ -82,6 +82,12 @@ func run(env string) (<-chan error, error) {
 		done:   make(chan struct{}),
 	}
 
+	shutdown := internal.NewShutdown(logger)
+	shutdown.Register(internal.ShutdownStageConsumers, "redis-consumer", 10*time.Second, srv.Shutdown)
+	shutdown.Register(internal.ShutdownStageDatastores, "redis", 5*time.Second, func(context.Context) error {
+		return rdb.Close() //nolint: wrapcheck
+	})
+
 	errC := make(chan error, 1)
 
 	ctx, stop := signal.NotifyContext(context.Background(),
@@ -94,18 +100,14 @@ func run(env string) (<-chan error, error) {
 
 		logger.Info("Shutdown signal received")
 
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-
 		defer func() {
 			_ = logger.Sync()
 
-			rdb.Close()
 			stop()
-			cancel()
 			close(errC)
 		}()
 
-		if err := srv.Shutdown(ctxTimeout); err != nil { //nolint: contextcheck
+		if err := shutdown.Run(context.Background()); err != nil { //nolint: contextcheck
 			errC <- err
 		}
 
@@ -153,9 +155,8 @@ func (s *Server) ListenAndServe() error {
 			// XXX: We will revisit defining these topics in a better way in future episodes
 			switch msg.Channel {
 			case "tasks.event.updated", "tasks.event.created":
-				var task internaldomain.Task
-
-				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&task); err != nil {
+				task, err := decodeTask(msg.Payload)
+				if err != nil {
 					s.logger.Info("Ignoring message, invalid", zap.Error(err))
 
 					continue
@@ -167,7 +168,7 @@ func (s *Server) ListenAndServe() error {
 			case "tasks.event.deleted":
 				var id string
 
-				if err := json.NewDecoder(strings.NewReader(msg.Payload)).Decode(&id); err != nil {
+				if err := decodePayload(msg.Payload, &id); err != nil {
 					s.logger.Info("Ignoring message, invalid", zap.Error(err))
 
 					continue
@@ -176,6 +177,11 @@ func (s *Server) ListenAndServe() error {
 				if err := s.task.Delete(context.Background(), id); err != nil {
 					s.logger.Info("Couldn't delete task", zap.Error(err))
 				}
+			case "tasks.event.completed", "tasks.event.reopened":
+				// The task is indexed when handling the "updated" event published before.
+			default:
+				// Types published by newer versions are skipped, see internaldomain.SkipUnrecognizedTaskEvent.
+				internaldomain.SkipUnrecognizedTaskEvent(context.Background(), msg.Channel)
 			}
 		}
 
@@ -202,3 +208,40 @@ func (s *Server) Shutdown(ctx context.Context) error {
 		}
 	}
 }
+
+// decodeTask decodes task messages using either the CloudEvents envelope or the original format, the latter is
+// not versioned.
+func decodeTask(payload string) (internaldomain.Task, error) {
+	if evt, err := internaldomain.DecodeCloudEvent([]byte(payload)); err == nil {
+		task, err := evt.DecodeTask(context.Background())
+		if err != nil {
+			return internaldomain.Task{}, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "evt.DecodeTask")
+		}
+
+		return task, nil
+	}
+
+	task, err := internaldomain.DecodeTaskEventData(context.Background(), 0, []byte(payload))
+	if err != nil {
+		return internaldomain.Task{}, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "DecodeTaskEventData")
+	}
+
+	return task, nil
+}
+
+// decodePayload decodes messages using either the CloudEvents envelope or the original format.
+func decodePayload(payload string, v interface{}) error {
+	if evt, err := internaldomain.DecodeCloudEvent([]byte(payload)); err == nil {
+		if err := evt.DecodeData(v); err != nil {
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "evt.DecodeData")
+		}
+
+		return nil
+	}
+
+	if err := json.NewDecoder(strings.NewReader(payload)).Decode(v); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "json.Decode")
+	}
+
+	return nil
+}
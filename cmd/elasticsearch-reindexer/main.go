@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func main() {
+	var (
+		env  string
+		size int64
+		keep bool
+	)
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.Int64Var(&size, "size", 500, "Number of tasks indexed per batch")
+	flag.BoolVar(&keep, "keep", false, "Keep the previous indices after switching the alias")
+	flag.Parse()
+
+	if err := run(env, size, keep); err != nil {
+		log.Fatalf("Couldn't reindex: %s", err)
+	}
+}
+
+// taskLister defines the datastore the tasks are read from.
+type taskLister interface {
+	List(ctx context.Context, params internaldomain.ListParams) (internaldomain.ListResults, error)
+}
+
+func run(env string, size int64, keep bool) error {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	if err := envvar.Load(env); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	pool, err := internal.NewPostgreSQL(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPostgreSQL")
+	}
+
+	defer pool.Close()
+
+	storage, err := internal.NewTaskStorage(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewTaskStorage")
+	}
+
+	esClient, err := internal.NewElasticSearch(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewElasticSearch")
+	}
+
+	var tasks taskLister = postgresql.NewTask(pool)
+	if storage.EventSourced {
+		tasks = postgresql.NewTaskEventStore(pool, storage.SnapshotEvery)
+	}
+
+	//-
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+	defer stop()
+
+	return reindex(ctx, logger, elasticsearch.NewReindexer(esClient), tasks, size, keep)
+}
+
+// reindex copies all the tasks to a new index and switches the alias to it. Indexers keep updating the previous
+// index while copying, changes made after a task was copied are applied by replaying the events published since
+// the logged start time.
+func reindex(ctx context.Context, logger *zap.Logger, reindexer *elasticsearch.Reindexer, tasks taskLister,
+	size int64, keep bool) error {
+	started := time.Now().UTC()
+
+	index, err := reindexer.CreateIndex(ctx)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "reindexer.CreateIndex")
+	}
+
+	logger.Info("Reindexing", zap.String("index", index), zap.Time("started", started))
+
+	var (
+		cursor string
+		total  int
+	)
+
+	for {
+		page, err := tasks.List(ctx, internaldomain.ListParams{Cursor: cursor, Size: size})
+		if err != nil {
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "tasks.List")
+		}
+
+		if err := reindexer.Index(ctx, index, page.Tasks); err != nil {
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "reindexer.Index")
+		}
+
+		total += len(page.Tasks)
+
+		if cursor = page.NextCursor; cursor == "" {
+			break
+		}
+	}
+
+	previous, err := reindexer.SwitchAlias(ctx, index)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "reindexer.SwitchAlias")
+	}
+
+	logger.Info("Reindexed",
+		zap.String("index", index),
+		zap.Int("tasks", total),
+		zap.Strings("previous", previous),
+		zap.Time("started", started))
+
+	if keep {
+		return nil
+	}
+
+	if err := reindexer.DeleteIndices(ctx, previous); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "reindexer.DeleteIndices")
+	}
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package internal
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// AdminAuth defines how the admin server authenticates operators: using a static token sent as a bearer token,
+// using client certificates, or both.
+type AdminAuth struct {
+	Token string
+	TLS   *tls.Config
+}
+
+// NewAdminAuth returns the authentication used by the admin server: ADMIN_TOKEN is the static token and
+// ADMIN_TLS_CERT_FILE, ADMIN_TLS_KEY_FILE and ADMIN_TLS_CLIENT_CA_FILE enable TLS requiring client certificates
+// signed by that CA. The admin server is not protected when none of them are defined, see Validate.
+func NewAdminAuth(conf *envvar.Configuration) (AdminAuth, error) {
+	get := func(key string) (string, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		return val, nil
+	}
+
+	token, err := get("ADMIN_TOKEN")
+	if err != nil {
+		return AdminAuth{}, err
+	}
+
+	certFile, err := get("ADMIN_TLS_CERT_FILE")
+	if err != nil {
+		return AdminAuth{}, err
+	}
+
+	keyFile, err := get("ADMIN_TLS_KEY_FILE")
+	if err != nil {
+		return AdminAuth{}, err
+	}
+
+	caFile, err := get("ADMIN_TLS_CLIENT_CA_FILE")
+	if err != nil {
+		return AdminAuth{}, err
+	}
+
+	res := AdminAuth{
+		Token: token,
+	}
+
+	if certFile == "" && keyFile == "" && caFile == "" {
+		return res, nil
+	}
+
+	if certFile == "" || keyFile == "" || caFile == "" {
+		return AdminAuth{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"ADMIN_TLS_CERT_FILE, ADMIN_TLS_KEY_FILE and ADMIN_TLS_CLIENT_CA_FILE must be defined together")
+	}
+
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return AdminAuth{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "tls.LoadX509KeyPair")
+	}
+
+	ca, err := os.ReadFile(caFile)
+	if err != nil {
+		return AdminAuth{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "os.ReadFile")
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return AdminAuth{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid ADMIN_TLS_CLIENT_CA_FILE")
+	}
+
+	res.TLS = &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    pool,
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	return res, nil
+}
+
+// Enabled indicates whether operators are authenticated.
+func (a AdminAuth) Enabled() bool {
+	return a.Token != "" || a.TLS != nil
+}
+
+// Validate returns an error when operators are not authenticated and the admin server listens on an address
+// other than a loopback one, like "127.0.0.1:9235", this way it's never exposed without protection.
+func (a AdminAuth) Validate(address string) error {
+	if a.Enabled() {
+		return nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "net.SplitHostPort")
+	}
+
+	if host == "localhost" {
+		return nil
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return nil
+	}
+
+	return internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+		"admin server listening on %q must be protected, define ADMIN_TOKEN or the ADMIN_TLS settings", address)
+}
+
+// Middleware rejects the requests not including the token, when defined, using "Authorization: Bearer <token>".
+// Client certificates are verified by the TLS handshake instead.
+func (a AdminAuth) Middleware(next http.Handler) http.Handler {
+	if a.Token == "" {
+		return next
+	}
+
+	expected := []byte("Bearer " + a.Token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="admin"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// configPrefixes are the prefixes of the environment variables configuring the services.
+//nolint: gochecknoglobals
+var configPrefixes = []string{
+	"ADMIN_", "ANALYTICS_", "AWS_", "BACKFILL_", "COMPAT_", "DATABASE_", "ELASTICSEARCH_", "GITHUB_", "GOOGLE_",
+	"JAEGER_", "KAFKA_", "LOG_", "MEMCACHED_", "MESSAGE_BROKER_", "NOTIFICATIONS_", "OTEL_", "PUBSUB_", "PUSH_",
+	"QUERY_", "RABBITMQ_", "REDIS_", "REST_", "SCHEMA_", "SEARCH_", "SNS_", "SQLITE_", "SQS_", "TASKS_", "TRACES_",
+	"TRASH_", "VAULT_",
+}
+
+// configSecrets are the parts of the names of the environment variables holding secrets.
+//nolint: gochecknoglobals
+var configSecrets = []string{"PASSWORD", "TOKEN", "SECRET", "KEY", "WEBHOOK"}
+
+// redacted replaces the values of secrets.
+const redacted = "REDACTED"
+
+// DumpConfiguration returns the settings defined in environ, using the "key=value" format of os.Environ, the
+// values of secrets and the credentials included in URLs are redacted. Values read from Vault, see envvar.Get, are
+// not included; only the paths of those secrets.
+func DumpConfiguration(environ []string) map[string]string {
+	res := make(map[string]string)
+
+	for _, kv := range environ {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || !hasAnyPrefix(parts[0], configPrefixes) {
+			continue
+		}
+
+		key, val := parts[0], parts[1]
+
+		switch {
+		case strings.HasSuffix(key, "_SECURE"):
+		case containsAny(key, configSecrets):
+			if val != "" {
+				val = redacted
+			}
+		default:
+			if u, err := url.Parse(val); err == nil && u.User != nil {
+				u.User = url.User(redacted)
+				val = u.String()
+			}
+		}
+
+		res[key] = val
+	}
+
+	return res
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsAny(s string, substrs []string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	internalkafka "github.com/MarioCarrion/todo-api/internal/kafka"
+	"github.com/MarioCarrion/todo-api/internal/segment"
+)
+
+const (
+	// AnalyticsSinkNone indicates product analytics events are not tracked, this is the default.
+	AnalyticsSinkNone = ""
+
+	// AnalyticsSinkSegment indicates product analytics events are sent using the Segment HTTP Tracking API.
+	AnalyticsSinkSegment = "segment"
+
+	// AnalyticsSinkKafka indicates product analytics events are published to a Kafka topic.
+	AnalyticsSinkKafka = "kafka"
+)
+
+// NewAnalyticsSink returns the sink receiving product analytics events, configuring one is the consent of the
+// operator to track them.
+func NewAnalyticsSink(conf *envvar.Configuration) (string, error) {
+	sink, err := conf.Get("ANALYTICS_SINK")
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get ANALYTICS_SINK")
+	}
+
+	switch sink {
+	case AnalyticsSinkNone, AnalyticsSinkSegment, AnalyticsSinkKafka:
+		return sink, nil
+	}
+
+	return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid ANALYTICS_SINK: %s", sink)
+}
+
+// NewSegmentAnalytics instantiates the repository sending product analytics events using the Segment HTTP Tracking
+// API, ANALYTICS_SEGMENT_ENDPOINT defaults to Segment's.
+func NewSegmentAnalytics(conf *envvar.Configuration) (*segment.Analytics, error) {
+	endpoint, err := conf.Get("ANALYTICS_SEGMENT_ENDPOINT")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get ANALYTICS_SEGMENT_ENDPOINT")
+	}
+
+	if endpoint == "" {
+		endpoint = "https://api.segment.io"
+	}
+
+	writeKey, err := conf.Get("ANALYTICS_SEGMENT_WRITE_KEY")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get ANALYTICS_SEGMENT_WRITE_KEY")
+	}
+
+	return segment.NewAnalytics(&http.Client{Timeout: 2 * time.Second, Transport: NewHTTPTransport("segment")}, endpoint, writeKey), nil
+}
+
+// KafkaAnalytics defines the repository publishing product analytics events to Kafka and its producer.
+type KafkaAnalytics struct {
+	Analytics *internalkafka.Analytics
+	Producer  *kafka.Producer
+}
+
+// NewKafkaAnalytics instantiates the repository publishing product analytics events to the ANALYTICS_KAFKA_TOPIC
+// topic, using the same cluster as the task events.
+func NewKafkaAnalytics(conf *envvar.Configuration) (*KafkaAnalytics, error) {
+	host, _, err := newKafkaConfig(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "newKafkaConfig")
+	}
+
+	topic, err := conf.Get("ANALYTICS_KAFKA_TOPIC")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get ANALYTICS_KAFKA_TOPIC")
+	}
+
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": host,
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "kafka.NewProducer")
+	}
+
+	return &KafkaAnalytics{
+		Analytics: internalkafka.NewAnalytics(producer, topic),
+		Producer:  producer,
+	}, nil
+}
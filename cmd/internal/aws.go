@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// S3 ...
+type S3 struct {
+	Client *s3.Client
+	Bucket string
+	Prefix string
+}
+
+// NewS3 instantiates the S3 client using configuration defined in environment variables, objects are stored in
+// EXPORT_S3_BUCKET using the keys prefixed with EXPORT_S3_PREFIX.
+func NewS3(conf *envvar.Configuration) (*S3, error) {
+	cfg, err := newAWSConfig(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "newAWSConfig")
+	}
+
+	bucket, err := conf.Get("EXPORT_S3_BUCKET")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get EXPORT_S3_BUCKET")
+	}
+
+	if bucket == "" {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "EXPORT_S3_BUCKET is required")
+	}
+
+	prefix, err := conf.Get("EXPORT_S3_PREFIX")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get EXPORT_S3_PREFIX")
+	}
+
+	// Path-style addressing is used when overriding the endpoint, for example localstack doesn't resolve the
+	// bucket as a subdomain.
+	endpoint, err := conf.Get("AWS_ENDPOINT_URL")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get AWS_ENDPOINT_URL")
+	}
+
+	return &S3{
+		Client: s3.NewFromConfig(cfg, func(o *s3.Options) {
+			o.UsePathStyle = endpoint != ""
+		}),
+		Bucket: bucket,
+		Prefix: prefix,
+	}, nil
+}
+
+// SNS ...
+type SNS struct {
+	Client   *sns.Client
+	TopicARN string
+}
+
+// NewSNS instantiates the SNS client using configuration defined in environment variables.
+func NewSNS(conf *envvar.Configuration) (*SNS, error) {
+	cfg, err := newAWSConfig(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "newAWSConfig")
+	}
+
+	topicARN, err := conf.Get("SNS_TOPIC_ARN")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get SNS_TOPIC_ARN")
+	}
+
+	return &SNS{
+		Client:   sns.NewFromConfig(cfg),
+		TopicARN: topicARN,
+	}, nil
+}
+
+// SQS ...
+type SQS struct {
+	Client   *sqs.Client
+	QueueURL string
+}
+
+// NewSQS instantiates the SQS client using configuration defined in environment variables.
+func NewSQS(conf *envvar.Configuration) (*SQS, error) {
+	cfg, err := newAWSConfig(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "newAWSConfig")
+	}
+
+	queueURL, err := conf.Get("SQS_QUEUE_URL")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get SQS_QUEUE_URL")
+	}
+
+	return &SQS{
+		Client:   sqs.NewFromConfig(cfg),
+		QueueURL: queueURL,
+	}, nil
+}
+
+// newAWSConfig loads the AWS configuration using the default credentials chain, when AWS_ENDPOINT_URL is defined
+// that endpoint is used for all the services, for example when using localstack.
+func newAWSConfig(conf *envvar.Configuration) (aws.Config, error) {
+	endpoint, err := conf.Get("AWS_ENDPOINT_URL")
+	if err != nil {
+		return aws.Config{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get AWS_ENDPOINT_URL")
+	}
+
+	var opts []func(*config.LoadOptions) error
+
+	if endpoint != "" {
+		opts = append(opts, config.WithEndpointResolverWithOptions(
+			aws.EndpointResolverWithOptionsFunc(func(_, region string, _ ...interface{}) (aws.Endpoint, error) {
+				return aws.Endpoint{
+					URL:           endpoint,
+					SigningRegion: region,
+				}, nil
+			})))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return aws.Config{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "config.LoadDefaultConfig")
+	}
+
+	return cfg, nil
+}
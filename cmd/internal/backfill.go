@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/backfill"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewBackfillRunner instantiates the runner of backfills using configuration defined in environment variables:
+// batches of 500 records, processing up to 1000 records per second, are used by default.
+func NewBackfillRunner(conf *envvar.Configuration, logger *zap.Logger,
+	repo backfill.CheckpointRepository) (*backfill.Runner, error) {
+	get := func(key string, def string) (string, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		if val == "" {
+			return def, nil
+		}
+
+		return val, nil
+	}
+
+	val, err := get("BACKFILL_BATCH_SIZE", "500")
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := strconv.Atoi(val)
+	if err != nil || size <= 0 {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"invalid BACKFILL_BATCH_SIZE, must be a positive number")
+	}
+
+	if val, err = get("BACKFILL_RATE", "1000"); err != nil {
+		return nil, err
+	}
+
+	rate, err := strconv.ParseFloat(val, 64)
+	if err != nil || rate < 0 {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"invalid BACKFILL_RATE, must be the number of records per second, or 0 for no limit")
+	}
+
+	return backfill.NewRunner(logger, repo, size, rate), nil
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// GoogleCalendar defines the configuration used for synchronizing the Tasks with a Google Calendar: the changes
+// made to the events of CalendarID are listed every Interval and Conflicts indicates which change is kept when both
+// sides changed. The API has no users, so the deployment synchronizes a single account and calendar; authorizing
+// one account per user is not supported.
+type GoogleCalendar struct {
+	Events     *calendar.Google
+	CalendarID string
+	Conflicts  calendar.Policy
+	Interval   time.Duration
+}
+
+// NewGoogleCalendar instantiates the Google Calendar client using configuration defined in environment variables,
+// the only account synchronized is authorized using the OAuth 2.0 refresh token obtained with cmd/calendar-auth;
+// nil is returned when GOOGLE_CALENDAR_REFRESH_TOKEN is not defined.
+func NewGoogleCalendar(conf *envvar.Configuration) (*GoogleCalendar, error) {
+	get := func(key string) (string, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		return val, nil
+	}
+
+	refreshToken, err := get("GOOGLE_CALENDAR_REFRESH_TOKEN")
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshToken == "" {
+		return nil, nil
+	}
+
+	oauthConf := oauth2.Config{
+		Endpoint: google.Endpoint,
+		Scopes:   []string{gcal.CalendarEventsScope},
+	}
+
+	if oauthConf.ClientID, err = get("GOOGLE_CALENDAR_CLIENT_ID"); err != nil {
+		return nil, err
+	}
+
+	if oauthConf.ClientSecret, err = get("GOOGLE_CALENDAR_CLIENT_SECRET"); err != nil {
+		return nil, err
+	}
+
+	if oauthConf.ClientID == "" || oauthConf.ClientSecret == "" {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"GOOGLE_CALENDAR_CLIENT_ID and GOOGLE_CALENDAR_CLIENT_SECRET are required when GOOGLE_CALENDAR_REFRESH_TOKEN is defined")
+	}
+
+	res := GoogleCalendar{
+		CalendarID: "primary",
+		Conflicts:  calendar.PolicyLatest,
+		Interval:   time.Minute,
+	}
+
+	calendarID, err := get("GOOGLE_CALENDAR_ID")
+	if err != nil {
+		return nil, err
+	}
+
+	if calendarID != "" {
+		res.CalendarID = calendarID
+	}
+
+	conflicts, err := get("GOOGLE_CALENDAR_CONFLICTS")
+	if err != nil {
+		return nil, err
+	}
+
+	if conflicts != "" {
+		res.Conflicts = calendar.Policy(conflicts)
+
+		if err := res.Conflicts.Validate(); err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid GOOGLE_CALENDAR_CONFLICTS")
+		}
+	}
+
+	interval, err := get("GOOGLE_CALENDAR_SYNC_INTERVAL")
+	if err != nil {
+		return nil, err
+	}
+
+	if interval != "" {
+		if res.Interval, err = time.ParseDuration(interval); err != nil || res.Interval <= 0 {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+				"invalid GOOGLE_CALENDAR_SYNC_INTERVAL, must be a positive duration")
+		}
+	}
+
+	// The access tokens are refreshed as needed by the client.
+	client := oauthConf.Client(context.Background(), &oauth2.Token{RefreshToken: refreshToken})
+
+	svc, err := gcal.NewService(context.Background(), option.WithHTTPClient(client))
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "calendar.NewService")
+	}
+
+	res.Events = calendar.NewGoogle(svc)
+
+	return &res, nil
+}
@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewChangeFeedEnabled indicates whether the changes to tasks notified by PostgreSQL are listened to, it's disabled
+// by default because it holds a dedicated connection.
+func NewChangeFeedEnabled(conf *envvar.Configuration) (bool, error) {
+	val, err := conf.Get("TASKS_CHANGE_FEED")
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get TASKS_CHANGE_FEED")
+	}
+
+	if val == "" {
+		return false, nil
+	}
+
+	res, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid TASKS_CHANGE_FEED")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewCloudEventsEnabled indicates whether published messages use the CloudEvents envelope, it's disabled by
+// default to allow migrating consumers first.
+func NewCloudEventsEnabled(conf *envvar.Configuration) (bool, error) {
+	val, err := conf.Get("MESSAGE_BROKER_CLOUDEVENTS")
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get MESSAGE_BROKER_CLOUDEVENTS")
+	}
+
+	if val == "" {
+		return false, nil
+	}
+
+	res, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid MESSAGE_BROKER_CLOUDEVENTS")
+	}
+
+	return res, nil
+}
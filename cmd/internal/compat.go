@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewCompatFlags returns the flags enabling writing the data introduced by newer versions, all of them are enabled
+// by default; those must be disabled while rolling out this version next to older ones, see "/admin/compat".
+func NewCompatFlags(conf *envvar.Configuration) (internal.CompatFlags, error) {
+	val, err := conf.Get("COMPAT_WRITE_PROJECTS")
+	if err != nil {
+		return internal.CompatFlags{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get COMPAT_WRITE_PROJECTS")
+	}
+
+	if val == "" {
+		return internal.CompatFlags{WriteProjects: true}, nil
+	}
+
+	writeProjects, err := strconv.ParseBool(val)
+	if err != nil {
+		return internal.CompatFlags{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid COMPAT_WRITE_PROJECTS")
+	}
+
+	return internal.CompatFlags{WriteProjects: writeProjects}, nil
+}
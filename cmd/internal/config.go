@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// ValidateRESTServer validates the settings used by the rest-server, every missing or invalid setting is reported
+// at once instead of failing while instantiating the dependency using it.
+func ValidateRESTServer(conf *envvar.Configuration) error {
+	rules := []envvar.Rule{
+		envvar.OneOf("DATABASE_DRIVER", DatabaseDriverPostgreSQL, DatabaseDriverMySQL, DatabaseDriverSQLite),
+		envvar.Bool("DATABASE_MIGRATE"),
+		envvar.Int("DATABASE_MAX_CONNS"),
+		envvar.Int("DATABASE_MIN_CONNS"),
+		envvar.Duration("DATABASE_MAX_CONN_LIFETIME"),
+		envvar.OneOf("SEARCH_ENGINE", SearchEngineElasticsearch, SearchEngineOpenSearch, SearchEnginePostgreSQL),
+		envvar.Duration("SEARCH_RECONCILE_INTERVAL"),
+		envvar.Int("REDIS_DB"),
+		envvar.Bool("MESSAGE_BROKER_CLOUDEVENTS"),
+		envvar.Int("MESSAGE_BROKER_BUFFER_SIZE"),
+		envvar.OneOf("REST_DELETE_MISSING_STATUS", "404", "204"),
+		envvar.Bool("REST_PUT_CREATES"),
+		envvar.Bool("REST_DEBUG"),
+		envvar.Int("REST_MAX_BODY_SIZE"),
+		envvar.Duration("REST_REQUEST_TIMEOUT"),
+		envvar.Duration("REST_READ_HEADER_TIMEOUT"),
+		envvar.OneOf("ANALYTICS_SINK", AnalyticsSinkSegment, AnalyticsSinkKafka),
+		envvar.Bool("TASKS_READ_MODEL"),
+		envvar.Bool("TASKS_CHANGE_FEED"),
+		envvar.OneOf("TASKS_STORAGE", "rows", "events"),
+		envvar.OneOf("TASKS_ID_STRATEGY", IDStrategyUUIDv7, IDStrategyUUIDv4, IDStrategyULID),
+		envvar.Int("TASKS_SNAPSHOT_EVERY"),
+		envvar.Bool("COMPAT_WRITE_PROJECTS"),
+		envvar.Int("TRASH_RETENTION_DAYS"),
+		envvar.Duration("GOOGLE_CALENDAR_SYNC_INTERVAL"),
+		envvar.OneOf("GOOGLE_CALENDAR_CONFLICTS",
+			string(calendar.PolicyLatest), string(calendar.PolicyTasks), string(calendar.PolicyCalendar)),
+		envvar.Duration("GITHUB_IMPORT_INTERVAL"),
+		envvar.Bool("GITHUB_CLOSE_ISSUES"),
+		envvar.Int("GITHUB_APP_ID"),
+		envvar.Int("GITHUB_APP_INSTALLATION_ID"),
+		envvar.Bool("OTEL_EXPORTER_OTLP_INSECURE"),
+		envvar.Duration("OTEL_METRIC_EXPORT_INTERVAL"),
+	}
+
+	// The settings of the database depend on the driver, PostgreSQL is the default.
+	driver, _ := conf.Get("DATABASE_DRIVER")
+
+	switch driver {
+	case DatabaseDriverSQLite:
+		rules = append(rules, envvar.Required("SQLITE_PATH"))
+	case "", DatabaseDriverPostgreSQL, DatabaseDriverMySQL:
+		rules = append(rules,
+			envvar.Required("DATABASE_HOST"),
+			envvar.Required("DATABASE_PORT"),
+			envvar.Int("DATABASE_PORT"),
+			envvar.Required("DATABASE_NAME"),
+		)
+
+		// The username is generated by Vault when using dynamic credentials.
+		if role, _ := conf.Get("DATABASE_VAULT_ROLE"); role == "" || driver == DatabaseDriverMySQL {
+			rules = append(rules, envvar.Required("DATABASE_USERNAME"))
+		}
+	}
+
+	if err := conf.Validate(rules...); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "conf.Validate")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+const (
+	// DatabaseDriverPostgreSQL indicates tasks are stored in PostgreSQL, this is the default.
+	DatabaseDriverPostgreSQL = "postgres"
+
+	// DatabaseDriverSQLite indicates tasks are stored in a SQLite database file.
+	DatabaseDriverSQLite = "sqlite"
+
+	// DatabaseDriverMySQL indicates tasks are stored in MySQL or MariaDB.
+	DatabaseDriverMySQL = "mysql"
+)
+
+// NewDatabaseDriver returns the database used for storing tasks.
+func NewDatabaseDriver(conf *envvar.Configuration) (string, error) {
+	driver, err := conf.Get("DATABASE_DRIVER")
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get DATABASE_DRIVER")
+	}
+
+	switch driver {
+	case "", DatabaseDriverPostgreSQL:
+		return DatabaseDriverPostgreSQL, nil
+	case DatabaseDriverSQLite, DatabaseDriverMySQL:
+		return driver, nil
+	}
+
+	return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid DATABASE_DRIVER: %s", driver)
+}
@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"time"
+)
+
+// DiagnosticsWriteTimeout is the time available for writing diagnostics responses, CPU profiles and execution
+// traces are collected for the number of seconds requested before responding so it limits how long those can be.
+const DiagnosticsWriteTimeout = 2 * time.Minute
+
+// NewDiagnosticsServer instantiates the server exposing the runtime profiles, using net/http/pprof, and the runtime
+// variables, using expvar. It's meant to be listening on an address only reachable by operators, profiles can be
+// requested live using "go tool pprof http://<address>/debug/pprof/profile?seconds=30" for example.
+func NewDiagnosticsServer(address string) *http.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+
+	return &http.Server{
+		Handler:           mux,
+		Addr:              address,
+		ReadTimeout:       1 * time.Second,
+		ReadHeaderTimeout: 1 * time.Second,
+		WriteTimeout:      DiagnosticsWriteTimeout,
+		IdleTimeout:       1 * time.Second,
+	}
+}
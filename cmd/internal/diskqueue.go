@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/diskqueue"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewDiskQueue instantiates the on-disk queue used for buffering messages when the message broker is
+// unavailable, nil is returned when MESSAGE_BROKER_BUFFER_DIR is not defined.
+func NewDiskQueue(conf *envvar.Configuration, logger *zap.Logger, orig diskqueue.TaskMessageBroker) (*diskqueue.Task, error) {
+	dir, err := conf.Get("MESSAGE_BROKER_BUFFER_DIR")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get MESSAGE_BROKER_BUFFER_DIR")
+	}
+
+	if dir == "" {
+		return nil, nil
+	}
+
+	size := 10_000
+
+	val, err := conf.Get("MESSAGE_BROKER_BUFFER_SIZE")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get MESSAGE_BROKER_BUFFER_SIZE")
+	}
+
+	if val != "" {
+		if size, err = strconv.Atoi(val); err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid MESSAGE_BROKER_BUFFER_SIZE")
+		}
+	}
+
+	queue, err := diskqueue.NewTask(logger, orig, dir, size, 5*time.Second)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "diskqueue.NewTask")
+	}
+
+	return queue, nil
+}
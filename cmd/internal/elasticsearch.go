@@ -1,27 +1,63 @@
 package internal
 
 import (
+	"context"
+	"io"
+	"io/ioutil"
+
 	esv7 "github.com/elastic/go-elasticsearch/v7"
+	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/opensearch-project/opensearch-go"
 
 	"github.com/MarioCarrion/todo-api/internal"
 	"github.com/MarioCarrion/todo-api/internal/envvar"
 )
 
 // NewElasticSearch instantiates the ElasticSearch client using configuration defined in environment variables.
-func NewElasticSearch(conf *envvar.Configuration) (es *esv7.Client, err error) {
-	es, err = esv7.NewDefaultClient()
+func NewElasticSearch(conf *envvar.Configuration) (esv7api.Transport, error) {
+	es, err := NewElasticSearchClient(conf)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "elasticsearch.Open")
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "NewElasticSearchClient")
 	}
 
-	res, err := es.Info()
+	res, err := esv7api.InfoRequest{}.Do(context.Background(), es)
 	if err != nil {
 		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "es.Info")
 	}
+	defer res.Body.Close()
+
+	if res.IsError() {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnknown, "es.Info %d", res.StatusCode)
+	}
+
+	io.Copy(ioutil.Discard, res.Body) //nolint: errcheck
 
-	defer func() {
-		err = res.Body.Close()
-	}()
+	return es, nil
+}
+
+// NewElasticSearchClient instantiates the ElasticSearch client using configuration defined in environment variables,
+// unlike NewElasticSearch it does not verify the cluster is reachable. An OpenSearch client is instantiated instead
+// when SEARCH_ENGINE is "opensearch", the go-elasticsearch client refuses to connect to OpenSearch clusters but
+// both support the same requests.
+func NewElasticSearchClient(conf *envvar.Configuration) (esv7api.Transport, error) {
+	engine, err := NewSearchEngine(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "NewSearchEngine")
+	}
+
+	if engine == SearchEngineOpenSearch {
+		client, err := opensearch.NewClient(opensearch.Config{Transport: NewHTTPTransport("opensearch")})
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "opensearch.NewClient")
+		}
+
+		return client, nil
+	}
+
+	es, err := esv7.NewClient(esv7.Config{Transport: NewHTTPTransport("elasticsearch")})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "elasticsearch.Open")
+	}
 
 	return es, nil
 }
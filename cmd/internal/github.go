@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/github"
+)
+
+// GitHub defines the configuration used for importing the open issues of Repositories as Tasks every Interval,
+// LabelPriorities maps the labels of the issues to the priority of their Tasks; CloseIssues indicates whether the
+// issues are closed once their Tasks are completed.
+type GitHub struct {
+	Client          *github.Client
+	Repositories    []string
+	Interval        time.Duration
+	LabelPriorities map[string]internal.Priority
+	CloseIssues     bool
+}
+
+// NewGitHub instantiates the GitHub client using configuration defined in environment variables, it authenticates
+// using GITHUB_TOKEN or as the installation of a GitHub App; nil is returned when GITHUB_REPOSITORIES is not
+// defined.
+//nolint: funlen, cyclop
+func NewGitHub(conf *envvar.Configuration) (*GitHub, error) {
+	get := func(key string) (string, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		return val, nil
+	}
+
+	repositories, err := get("GITHUB_REPOSITORIES")
+	if err != nil {
+		return nil, err
+	}
+
+	if repositories == "" {
+		return nil, nil
+	}
+
+	res := GitHub{
+		Interval:        5 * time.Minute,
+		LabelPriorities: map[string]internal.Priority{},
+	}
+
+	for _, repository := range strings.Split(repositories, ",") {
+		repository = strings.TrimSpace(repository)
+
+		if err := internal.ValidateRepository(repository); err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid GITHUB_REPOSITORIES")
+		}
+
+		res.Repositories = append(res.Repositories, repository)
+	}
+
+	baseURL, err := get("GITHUB_API_URL")
+	if err != nil {
+		return nil, err
+	}
+
+	if baseURL == "" {
+		baseURL = github.DefaultURL
+	}
+
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	creds, err := newGitHubCredentials(get, baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := get("GITHUB_IMPORT_INTERVAL")
+	if err != nil {
+		return nil, err
+	}
+
+	if interval != "" {
+		if res.Interval, err = time.ParseDuration(interval); err != nil || res.Interval <= 0 {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+				"invalid GITHUB_IMPORT_INTERVAL, must be a positive duration")
+		}
+	}
+
+	closeIssues, err := get("GITHUB_CLOSE_ISSUES")
+	if err != nil {
+		return nil, err
+	}
+
+	if closeIssues != "" {
+		if res.CloseIssues, err = strconv.ParseBool(closeIssues); err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid GITHUB_CLOSE_ISSUES")
+		}
+	}
+
+	labels, err := get("GITHUB_LABEL_PRIORITIES")
+	if err != nil {
+		return nil, err
+	}
+
+	priorities := map[string]internal.Priority{
+		"low":    internal.PriorityLow,
+		"medium": internal.PriorityMedium,
+		"high":   internal.PriorityHigh,
+	}
+
+	if labels != "" {
+		for _, label := range strings.Split(labels, ",") {
+			parts := strings.SplitN(strings.TrimSpace(label), "=", 2)
+			if len(parts) != 2 {
+				return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+					"invalid GITHUB_LABEL_PRIORITIES, %q must be label=priority", label)
+			}
+
+			priority, ok := priorities[parts[1]]
+			if !ok {
+				return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+					"invalid GITHUB_LABEL_PRIORITIES, %q must use low, medium or high", label)
+			}
+
+			res.LabelPriorities[parts[0]] = priority
+		}
+	}
+
+	res.Client = github.NewClient(&http.Client{Timeout: 30 * time.Second}, baseURL, creds)
+
+	return &res, nil
+}
+
+// newGitHubCredentials returns the personal access token when defined, otherwise the GitHub App installation.
+func newGitHubCredentials(get func(string) (string, error), baseURL string) (github.Credentials, error) {
+	token, err := get("GITHUB_TOKEN")
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		return github.PersonalToken(token), nil
+	}
+
+	var vals [3]string
+
+	for i, key := range []string{"GITHUB_APP_ID", "GITHUB_APP_INSTALLATION_ID", "GITHUB_APP_PRIVATE_KEY"} {
+		if vals[i], err = get(key); err != nil {
+			return nil, err
+		}
+	}
+
+	if vals[0] == "" || vals[1] == "" || vals[2] == "" {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"GITHUB_TOKEN or GITHUB_APP_ID, GITHUB_APP_INSTALLATION_ID and GITHUB_APP_PRIVATE_KEY are required")
+	}
+
+	appID, err := strconv.ParseInt(vals[0], 10, 64)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid GITHUB_APP_ID")
+	}
+
+	installationID, err := strconv.ParseInt(vals[1], 10, 64)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid GITHUB_APP_INSTALLATION_ID")
+	}
+
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(vals[2]))
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid GITHUB_APP_PRIVATE_KEY")
+	}
+
+	return github.NewApp(&http.Client{Timeout: 30 * time.Second}, baseURL, appID, installationID, key), nil
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/unit"
+)
+
+//nolint: gochecknoglobals
+var clientDuration = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/cmd/internal")).NewFloat64ValueRecorder(
+	"http.client.duration",
+	metric.WithDescription("Time in milliseconds spent sending requests, labeled by client, method and status"),
+	metric.WithUnit(unit.Milliseconds),
+)
+
+// NewHTTPTransport instantiates the transport used by HTTP clients calling dependencies, it records the time spent
+// sending requests using client as label. Failed requests use "error" as status.
+func NewHTTPTransport(client string) http.RoundTripper {
+	return &metricsTransport{
+		client: client,
+		next:   http.DefaultTransport,
+	}
+}
+
+type metricsTransport struct {
+	client string
+	next   http.RoundTripper
+}
+
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+
+	res, err := t.next.RoundTrip(req)
+
+	status := "error"
+	if err == nil {
+		status = strconv.Itoa(res.StatusCode)
+	}
+
+	clientDuration.Record(req.Context(), float64(time.Since(start))/float64(time.Millisecond),
+		attribute.String("client", t.client),
+		attribute.String("http.method", req.Method),
+		attribute.String("http.status_code", status),
+	)
+
+	return res, err //nolint: wrapcheck
+}
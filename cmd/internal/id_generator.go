@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+const (
+	// IDStrategyUUIDv7 indicates new tasks use time-sortable UUIDs, this is the default.
+	IDStrategyUUIDv7 = "uuidv7"
+
+	// IDStrategyUUIDv4 indicates new tasks use random UUIDs.
+	IDStrategyUUIDv4 = "uuidv4"
+
+	// IDStrategyULID indicates new tasks use ULIDs.
+	IDStrategyULID = "ulid"
+
+	// IDStrategyDatabase indicates the ids of new tasks are assigned by the database.
+	IDStrategyDatabase = "database"
+)
+
+// NewIDGenerator returns the generator of the ids assigned to new tasks, it's nil when those are assigned by the
+// database.
+func NewIDGenerator(conf *envvar.Configuration) (internal.IDGenerator, error) {
+	strategy, err := conf.Get("TASKS_ID_STRATEGY")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get TASKS_ID_STRATEGY")
+	}
+
+	switch strategy {
+	case "", IDStrategyUUIDv7:
+		return internal.UUIDv7Generator{}, nil
+	case IDStrategyUUIDv4:
+		return internal.UUIDv4Generator{}, nil
+	case IDStrategyULID:
+		return internal.NewULIDGenerator(), nil
+	case IDStrategyDatabase:
+		return nil, nil
+	}
+
+	return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid TASKS_ID_STRATEGY: %s", strategy)
+}
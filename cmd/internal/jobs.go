@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Job defines a function running in the background until the service shuts down.
+type Job struct {
+	Name string
+	Run  func(context.Context)
+}
+
+// RunningJob defines a job that is still running.
+type RunningJob struct {
+	Name    string
+	Started time.Time
+}
+
+// Jobs runs jobs in the background and tracks which ones are still running.
+type Jobs struct {
+	mu      sync.Mutex
+	running map[string]RunningJob
+}
+
+// NewJobs instantiates the Jobs runner.
+func NewJobs() *Jobs {
+	return &Jobs{
+		running: make(map[string]RunningJob),
+	}
+}
+
+// Go runs the job in a new goroutine, it's tracked as running until it returns.
+func (j *Jobs) Go(ctx context.Context, job Job) {
+	j.mu.Lock()
+	j.running[job.Name] = RunningJob{Name: job.Name, Started: time.Now()}
+	j.mu.Unlock()
+
+	j.run(ctx, job)
+}
+
+// TryGo runs the job like Go unless a job with the same name is still running, it indicates whether it was started.
+func (j *Jobs) TryGo(ctx context.Context, job Job) bool {
+	j.mu.Lock()
+
+	if _, ok := j.running[job.Name]; ok {
+		j.mu.Unlock()
+
+		return false
+	}
+
+	j.running[job.Name] = RunningJob{Name: job.Name, Started: time.Now()}
+	j.mu.Unlock()
+
+	j.run(ctx, job)
+
+	return true
+}
+
+func (j *Jobs) run(ctx context.Context, job Job) {
+	go func() {
+		defer func() {
+			j.mu.Lock()
+			delete(j.running, job.Name)
+			j.mu.Unlock()
+		}()
+
+		job.Run(ctx)
+	}()
+}
+
+// Running returns the jobs still running, sorted by name.
+func (j *Jobs) Running() []RunningJob {
+	j.mu.Lock()
+
+	res := make([]RunningJob, 0, len(j.running))
+
+	for _, job := range j.running {
+		res = append(res, job)
+	}
+
+	j.mu.Unlock()
+
+	sort.Slice(res, func(a, b int) bool { return res[a].Name < res[b].Name })
+
+	return res
+}
@@ -1,10 +1,13 @@
 package internal
 
 import (
+	"strconv"
+
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 
 	"github.com/MarioCarrion/todo-api/internal"
 	"github.com/MarioCarrion/todo-api/internal/envvar"
+	internalkafka "github.com/MarioCarrion/todo-api/internal/kafka"
 )
 
 type KafkaProducer struct {
@@ -36,6 +39,7 @@ func NewKafkaProducer(conf *envvar.Configuration) (*KafkaProducer, error) {
 
 type KafkaConsumer struct {
 	Consumer *kafka.Consumer
+	Topic    string
 }
 
 // NewKafkaConsumer instantiates the Kafka consumer using configuration defined in environment variables.
@@ -63,9 +67,76 @@ func NewKafkaConsumer(conf *envvar.Configuration, groupID string) (*KafkaConsume
 
 	return &KafkaConsumer{
 		Consumer: client,
+		Topic:    topic,
+	}, nil
+}
+
+// NewKafkaReader instantiates a Kafka consumer using configuration defined in environment variables, unlike
+// NewKafkaConsumer it's not subscribed to the topic and it does not commit offsets, partitions are meant to be
+// assigned explicitly for reading ranges of messages.
+func NewKafkaReader(conf *envvar.Configuration, groupID string) (*KafkaConsumer, error) {
+	host, topic, err := newKafkaConfig(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "kafka.newKafkaConfig")
+	}
+
+	config := kafka.ConfigMap{
+		"bootstrap.servers":  host,
+		"group.id":           groupID,
+		"enable.auto.commit": false,
+	}
+
+	client, err := kafka.NewConsumer(&config)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "kafka.NewConsumer")
+	}
+
+	return &KafkaConsumer{
+		Consumer: client,
+		Topic:    topic,
 	}, nil
 }
 
+// NewKafkaDeadLetterQueue instantiates the dead-letter queue used for messages that couldn't be consumed, using
+// configuration defined in environment variables.
+func NewKafkaDeadLetterQueue(conf *envvar.Configuration) (*internalkafka.DeadLetterQueue, error) {
+	host, topic, err := newKafkaConfig(conf)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "kafka.newKafkaConfig")
+	}
+
+	config := kafka.ConfigMap{
+		"bootstrap.servers": host,
+	}
+
+	producer, err := kafka.NewProducer(&config)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "kafka.NewProducer")
+	}
+
+	return internalkafka.NewDeadLetterQueue(producer, config, topic), nil
+}
+
+// NewKafkaMaxAttempts returns the number of times consuming a message is attempted before moving it to the
+// dead-letter queue, defined in KAFKA_MAX_ATTEMPTS, defaults to 5.
+func NewKafkaMaxAttempts(conf *envvar.Configuration) (int, error) {
+	val, err := conf.Get("KAFKA_MAX_ATTEMPTS")
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get KAFKA_MAX_ATTEMPTS")
+	}
+
+	if val == "" {
+		return 5, nil
+	}
+
+	res, err := strconv.Atoi(val)
+	if err != nil || res < 1 {
+		return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid KAFKA_MAX_ATTEMPTS")
+	}
+
+	return res, nil
+}
+
 func newKafkaConfig(conf *envvar.Configuration) (host, topic string, err error) {
 	host, err = conf.Get("KAFKA_HOST")
 	if err != nil {
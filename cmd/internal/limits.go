@@ -0,0 +1,51 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewQueryLimits instantiates the limits used for querying records using configuration defined in environment
+// variables, default values are used when those are not defined.
+func NewQueryLimits(conf *envvar.Configuration) (internal.QueryLimits, error) {
+	get := func(key string, def int64) (int64, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		if val == "" {
+			return def, nil
+		}
+
+		res, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			return 0, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid %s", key)
+		}
+
+		return res, nil
+	}
+
+	maxPageSize, err := get("QUERY_MAX_PAGE_SIZE", 100)
+	if err != nil {
+		return internal.QueryLimits{}, err
+	}
+
+	maxSearchTerms, err := get("SEARCH_MAX_TERMS", 32)
+	if err != nil {
+		return internal.QueryLimits{}, err
+	}
+
+	maxWildcardTerms, err := get("SEARCH_MAX_WILDCARD_TERMS", 2)
+	if err != nil {
+		return internal.QueryLimits{}, err
+	}
+
+	return internal.QueryLimits{
+		MaxPageSize:      maxPageSize,
+		MaxSearchTerms:   int(maxSearchTerms),
+		MaxWildcardTerms: int(maxWildcardTerms),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	migratemysql "github.com/golang-migrate/migrate/v4/database/mysql"
+	migratepostgres "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/httpfs"
+	"github.com/jackc/pgx/v4/stdlib"
+
+	"github.com/MarioCarrion/todo-api/db"
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewDatabaseMigrateEnabled indicates whether the embedded migrations are applied when the service starts, it's
+// disabled by default because running them as a separate step is preferred when deploying multiple services.
+func NewDatabaseMigrateEnabled(conf *envvar.Configuration) (bool, error) {
+	val, err := conf.Get("DATABASE_MIGRATE")
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get DATABASE_MIGRATE")
+	}
+
+	if val == "" {
+		return false, nil
+	}
+
+	res, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid DATABASE_MIGRATE")
+	}
+
+	return res, nil
+}
+
+// MigrateDatabase applies the pending embedded migrations of the configured driver, SQLite is skipped because
+// its schema is created when opening the database. The migrations run using a dedicated connection holding an
+// advisory lock, so instances starting at the same time wait for each other instead of migrating concurrently.
+func MigrateDatabase(conf *envvar.Configuration, driver string) error {
+	var (
+		instance database.Driver
+		name     string
+		path     string
+	)
+
+	switch driver {
+	case DatabaseDriverSQLite:
+		return nil
+	case DatabaseDriverMySQL:
+		mysqlDB, err := NewMySQL(conf)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "NewMySQL")
+		}
+
+		if instance, err = migratemysql.WithInstance(mysqlDB, &migratemysql.Config{}); err != nil {
+			_ = mysqlDB.Close()
+
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "migratemysql.WithInstance")
+		}
+
+		name, path = "mysql", "mysql/migrations"
+	default:
+		pool, err := NewPostgreSQL(conf)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "NewPostgreSQL")
+		}
+
+		pgDB := stdlib.OpenDB(*pool.Config().ConnConfig)
+
+		pool.Close()
+
+		if instance, err = migratepostgres.WithInstance(pgDB, &migratepostgres.Config{}); err != nil {
+			_ = pgDB.Close()
+
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "migratepostgres.WithInstance")
+		}
+
+		name, path = "postgres", "migrations"
+	}
+
+	source, err := httpfs.New(http.FS(db.Migrations), path)
+	if err != nil {
+		_ = instance.Close()
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "httpfs.New")
+	}
+
+	m, err := migrate.NewWithInstance("httpfs", source, name, instance)
+	if err != nil {
+		_ = source.Close()
+		_ = instance.Close()
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "migrate.NewWithInstance")
+	}
+
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "m.Up")
+	}
+
+	return nil
+}
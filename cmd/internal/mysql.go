@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewMySQL instantiates the MySQL database using the same environment variables used by PostgreSQL, the
+// DATABASE_SSLMODE one is ignored.
+func NewMySQL(conf *envvar.Configuration) (*sql.DB, error) {
+	get := func(v string) (string, error) {
+		res, err := conf.Get(v)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", v)
+		}
+
+		return res, nil
+	}
+
+	values := make(map[string]string)
+
+	for _, v := range []string{"DATABASE_HOST", "DATABASE_PORT", "DATABASE_USERNAME", "DATABASE_PASSWORD", "DATABASE_NAME"} {
+		res, err := get(v)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "get")
+		}
+
+		values[v] = res
+	}
+
+	cfg := mysql.NewConfig()
+	cfg.Net = "tcp"
+	cfg.Addr = fmt.Sprintf("%s:%s", values["DATABASE_HOST"], values["DATABASE_PORT"])
+	cfg.User = values["DATABASE_USERNAME"]
+	cfg.Passwd = values["DATABASE_PASSWORD"]
+	cfg.DBName = values["DATABASE_NAME"]
+	cfg.ParseTime = true
+	cfg.Params = map[string]string{"time_zone": "'+00:00'"}
+
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "sql.Open")
+	}
+
+	if err := db.PingContext(context.Background()); err != nil {
+		_ = db.Close()
+
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "db.Ping")
+	}
+
+	return db, nil
+}
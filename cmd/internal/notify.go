@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/notify"
+)
+
+// NewNotifier instantiates the Notifier delivering the changes to tasks to the Slack and Microsoft Teams webhooks
+// defined in NOTIFICATIONS_WEBHOOKS, see notify.ParseRoutes; nil is returned when it's not defined.
+func NewNotifier(conf *envvar.Configuration, logger *zap.Logger) (*notify.Notifier, error) {
+	val, err := conf.Get("NOTIFICATIONS_WEBHOOKS")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get NOTIFICATIONS_WEBHOOKS")
+	}
+
+	routes, err := notify.ParseRoutes(val)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid NOTIFICATIONS_WEBHOOKS")
+	}
+
+	if len(routes) == 0 {
+		return nil, nil
+	}
+
+	return notify.New(logger, &http.Client{Timeout: 5 * time.Second}, routes, 1_000), nil
+}
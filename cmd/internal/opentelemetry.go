@@ -1,15 +1,23 @@
 package internal
 
 import (
+	"context"
+	"net/http"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/contrib/instrumentation/runtime"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/metric/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
 	"go.opentelemetry.io/otel/exporters/trace/jaeger"
 	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/propagation"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/semconv"
@@ -18,19 +26,15 @@ import (
 	"github.com/MarioCarrion/todo-api/internal/envvar"
 )
 
-// NewOTExporter instantiates the OpenTelemetry exporters using configuration defined in environment variables.
-func NewOTExporter(conf *envvar.Configuration) (*prometheus.Exporter, error) {
-	if err := runtime.Start(runtime.WithMinimumReadMemStatsInterval(time.Second)); err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "runtime.Start")
-	}
-
-	promExporter, err := prometheus.NewExportPipeline(prometheus.Config{})
+// NewOTExporter instantiates the OpenTelemetry exporters using configuration defined in environment variables,
+// the returned handler serves the metrics to be scraped by Prometheus; see NewMetricsExporter. Traces are sampled
+// using sampler, all of them when nil.
+func NewOTExporter(conf *envvar.Configuration, sampler sdktrace.Sampler) (http.Handler, error) {
+	metrics, err := NewMetricsExporter(conf)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "prometheus.NewExportPipeline")
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "NewMetricsExporter")
 	}
 
-	global.SetMeterProvider(promExporter.MeterProvider())
-
 	//-
 
 	jaegerEndpoint, _ := conf.Get("JAEGER_ENDPOINT")
@@ -42,8 +46,12 @@ func NewOTExporter(conf *envvar.Configuration) (*prometheus.Exporter, error) {
 		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "jaeger.NewRawExporter")
 	}
 
+	if sampler == nil {
+		sampler = sdktrace.AlwaysSample()
+	}
+
 	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithSampler(sampler),
 		sdktrace.WithSyncer(jaegerExporter),
 		sdktrace.WithResource(resource.NewWithAttributes(attribute.KeyValue{
 			Key:   semconv.ServiceNameKey,
@@ -54,5 +62,79 @@ func NewOTExporter(conf *envvar.Configuration) (*prometheus.Exporter, error) {
 	otel.SetTracerProvider(tp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
 
+	return metrics, nil
+}
+
+// NewMetricsExporter instantiates the OpenTelemetry metrics exporter using configuration defined in environment
+// variables. Metrics are pushed to the collector using OTLP when OTEL_EXPORTER_OTLP_ENDPOINT is set, in that case
+// the returned handler responds with 404; otherwise metrics are scraped by Prometheus using the returned handler.
+func NewMetricsExporter(conf *envvar.Configuration) (http.Handler, error) {
+	endpoint, _ := conf.Get("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if endpoint == "" {
+		return NewPrometheusExporter()
+	}
+
+	if err := runtime.Start(runtime.WithMinimumReadMemStatsInterval(time.Second)); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "runtime.Start")
+	}
+
+	opts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(endpoint)}
+
+	if val, _ := conf.Get("OTEL_EXPORTER_OTLP_INSECURE"); val != "" {
+		insecure, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "strconv.ParseBool")
+		}
+
+		if insecure {
+			opts = append(opts, otlpgrpc.WithInsecure())
+		}
+	}
+
+	period := 10 * time.Second
+
+	if val, _ := conf.Get("OTEL_METRIC_EXPORT_INTERVAL"); val != "" {
+		interval, err := time.ParseDuration(val)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "time.ParseDuration")
+		}
+
+		period = interval
+	}
+
+	// The connection is established in the background, the exporter retries when the collector is not available.
+	exporter, err := otlp.NewExporter(context.Background(), otlpgrpc.NewDriver(opts...))
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "otlp.NewExporter")
+	}
+
+	pusher := controller.New(
+		processor.New(simple.NewWithHistogramDistribution(), exporter),
+		controller.WithExporter(exporter),
+		controller.WithCollectPeriod(period),
+	)
+
+	if err = pusher.Start(context.Background()); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "pusher.Start")
+	}
+
+	global.SetMeterProvider(pusher.MeterProvider())
+
+	return http.NotFoundHandler(), nil
+}
+
+// NewPrometheusExporter instantiates the OpenTelemetry metrics exporter, traces are not exported.
+func NewPrometheusExporter() (*prometheus.Exporter, error) {
+	if err := runtime.Start(runtime.WithMinimumReadMemStatsInterval(time.Second)); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "runtime.Start")
+	}
+
+	promExporter, err := prometheus.NewExportPipeline(prometheus.Config{})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "prometheus.NewExportPipeline")
+	}
+
+	global.SetMeterProvider(promExporter.MeterProvider())
+
 	return promExporter, nil
 }
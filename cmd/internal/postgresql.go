@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v4/pgxpool"
 
@@ -13,10 +15,18 @@ import (
 
 	"github.com/MarioCarrion/todo-api/internal"
 	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
 )
 
-// NewPostgreSQL instantiates the PostgreSQL database using configuration defined in environment variables.
+// NewPostgreSQL instantiates the PostgreSQL database using configuration defined in environment variables, the
+// statistics of the connection pool are exported as metrics.
 func NewPostgreSQL(conf *envvar.Configuration) (*pgxpool.Pool, error) {
+	return NewPostgreSQLWithCredentials(conf, nil)
+}
+
+// NewPostgreSQLWithCredentials instantiates the PostgreSQL database like NewPostgreSQL does, when creds is not nil
+// the credentials generated by Vault are used instead of DATABASE_USERNAME and DATABASE_PASSWORD.
+func NewPostgreSQLWithCredentials(conf *envvar.Configuration, creds *VaultDatabaseCredentials) (*pgxpool.Pool, error) {
 	get := func(v string) string {
 		res, err := conf.Get(v)
 		if err != nil {
@@ -47,14 +57,97 @@ func NewPostgreSQL(conf *envvar.Configuration) (*pgxpool.Pool, error) {
 
 	dsn.RawQuery = q.Encode()
 
-	pool, err := pgxpool.Connect(context.Background(), dsn.String())
+	config, err := pgxpool.ParseConfig(dsn.String())
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "pgxpool.ParseConfig")
+	}
+
+	if err := configurePool(conf, config); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "configurePool")
+	}
+
+	if creds != nil {
+		creds.Configure(config)
+	}
+
+	pool, err := pgxpool.ConnectConfig(context.Background(), config)
 	if err != nil {
-		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "pgxpool.Connect")
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "pgxpool.ConnectConfig")
 	}
 
 	if err := pool.Ping(context.Background()); err != nil {
 		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "db.Ping")
 	}
 
+	postgresql.ObservePool(pool)
+
 	return pool, nil
 }
+
+// configurePool sets the size and the lifetime of the connections of the pool, the pgx defaults are used for the
+// values that are not defined.
+func configurePool(conf *envvar.Configuration, config *pgxpool.Config) error {
+	for _, v := range []struct {
+		name string
+		set  func(string) error
+	}{
+		{
+			"DATABASE_MAX_CONNS",
+			func(val string) error {
+				n, err := strconv.ParseInt(val, 10, 32)
+				if err != nil || n <= 0 {
+					return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "must be a positive number")
+				}
+
+				config.MaxConns = int32(n)
+
+				return nil
+			},
+		},
+		{
+			"DATABASE_MIN_CONNS",
+			func(val string) error {
+				n, err := strconv.ParseInt(val, 10, 32)
+				if err != nil || n < 0 {
+					return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "must be zero or a positive number")
+				}
+
+				config.MinConns = int32(n)
+
+				return nil
+			},
+		},
+		{
+			"DATABASE_MAX_CONN_LIFETIME",
+			func(val string) error {
+				d, err := time.ParseDuration(val)
+				if err != nil || d <= 0 {
+					return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "must be a positive duration")
+				}
+
+				config.MaxConnLifetime = d
+
+				return nil
+			},
+		},
+	} {
+		val, err := conf.Get(v.name)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", v.name)
+		}
+
+		if val == "" {
+			continue
+		}
+
+		if err := v.set(val); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid %s", v.name)
+		}
+	}
+
+	if config.MinConns > config.MaxConns {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "DATABASE_MIN_CONNS is greater than DATABASE_MAX_CONNS")
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// PubSub ...
+type PubSub struct {
+	Client *pubsub.Client
+	Topic  *pubsub.Topic
+}
+
+// NewPubSub instantiates the Google Pub/Sub client using configuration defined in environment variables, the
+// emulator is used when PUBSUB_EMULATOR_HOST is defined.
+func NewPubSub(conf *envvar.Configuration) (*PubSub, error) {
+	projectID, err := conf.Get("PUBSUB_PROJECT_ID")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get PUBSUB_PROJECT_ID")
+	}
+
+	topicID, err := conf.Get("PUBSUB_TOPIC")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get PUBSUB_TOPIC")
+	}
+
+	if topicID == "" {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "PUBSUB_TOPIC is required")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	client, err := pubsub.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "pubsub.NewClient")
+	}
+
+	return &PubSub{
+		Client: client,
+		Topic:  client.Topic(topicID),
+	}, nil
+}
+
+// Subscription returns the subscription defined in PUBSUB_SUBSCRIPTION, it's created when it does not exist
+// with message ordering enabled.
+func (p *PubSub) Subscription(conf *envvar.Configuration) (*pubsub.Subscription, error) {
+	id, err := conf.Get("PUBSUB_SUBSCRIPTION")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get PUBSUB_SUBSCRIPTION")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	sub := p.Client.Subscription(id)
+
+	ok, err := sub.Exists(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "sub.Exists")
+	}
+
+	if ok {
+		return sub, nil
+	}
+
+	sub, err = p.Client.CreateSubscription(ctx, id, pubsub.SubscriptionConfig{
+		Topic:                 p.Topic,
+		EnableMessageOrdering: true,
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.CreateSubscription")
+	}
+
+	return sub, nil
+}
+
+// Close ...
+func (p *PubSub) Close() {
+	p.Topic.Stop()
+	_ = p.Client.Close()
+}
@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/push"
+)
+
+// WebPush defines the configuration used for delivering reminders using the Web Push protocol: Tasks are reminded
+// Lead before their due date and checked every Interval.
+type WebPush struct {
+	VAPID    push.VAPID
+	Lead     time.Duration
+	Interval time.Duration
+}
+
+// NewWebPush instantiates the Web Push configuration using configuration defined in environment variables, nil is
+// returned when PUSH_VAPID_PRIVATE_KEY is not defined.
+func NewWebPush(conf *envvar.Configuration) (*WebPush, error) {
+	get := func(key string) (string, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		return val, nil
+	}
+
+	privateKey, err := get("PUSH_VAPID_PRIVATE_KEY")
+	if err != nil {
+		return nil, err
+	}
+
+	if privateKey == "" {
+		return nil, nil
+	}
+
+	res := WebPush{
+		VAPID:    push.VAPID{PrivateKey: privateKey},
+		Lead:     time.Hour,
+		Interval: time.Minute,
+	}
+
+	if res.VAPID.PublicKey, err = get("PUSH_VAPID_PUBLIC_KEY"); err != nil {
+		return nil, err
+	}
+
+	if res.VAPID.Subscriber, err = get("PUSH_VAPID_SUBSCRIBER"); err != nil {
+		return nil, err
+	}
+
+	if res.VAPID.PublicKey == "" || res.VAPID.Subscriber == "" {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"PUSH_VAPID_PUBLIC_KEY and PUSH_VAPID_SUBSCRIBER are required when PUSH_VAPID_PRIVATE_KEY is defined")
+	}
+
+	for key, dst := range map[string]*time.Duration{
+		"PUSH_REMINDER_LEAD":     &res.Lead,
+		"PUSH_REMINDER_INTERVAL": &res.Interval,
+	} {
+		val, err := get(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if val == "" {
+			continue
+		}
+
+		if *dst, err = time.ParseDuration(val); err != nil || *dst <= 0 {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid %s, must be a positive duration", key)
+		}
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,28 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewReadModelEnabled indicates whether reading tasks uses the read model projected by the task-projector, it's
+// disabled by default because that requires Kafka as message broker.
+func NewReadModelEnabled(conf *envvar.Configuration) (bool, error) {
+	val, err := conf.Get("TASKS_READ_MODEL")
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get TASKS_READ_MODEL")
+	}
+
+	if val == "" {
+		return false, nil
+	}
+
+	res, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid TASKS_READ_MODEL")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,29 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewReconcileInterval returns how often the indexed tasks are reconciled with the ones in PostgreSQL, zero when
+// it's disabled, which is the default.
+func NewReconcileInterval(conf *envvar.Configuration) (time.Duration, error) {
+	val, err := conf.Get("SEARCH_RECONCILE_INTERVAL")
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get SEARCH_RECONCILE_INTERVAL")
+	}
+
+	if val == "" {
+		return 0, nil
+	}
+
+	res, err := time.ParseDuration(val)
+	if err != nil || res < 0 {
+		return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"invalid SEARCH_RECONCILE_INTERVAL, must be a positive duration")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,189 @@
+package internal
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+// NewRESTSemantics instantiates the semantics of the methods used for modifying records using configuration
+// defined in environment variables.
+func NewRESTSemantics(conf *envvar.Configuration) (rest.Semantics, error) {
+	var res rest.Semantics
+
+	status, err := conf.Get("REST_DELETE_MISSING_STATUS")
+	if err != nil {
+		return rest.Semantics{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_DELETE_MISSING_STATUS")
+	}
+
+	switch status {
+	case "", strconv.Itoa(http.StatusNotFound):
+		res.DeleteMissingStatus = http.StatusNotFound
+	case strconv.Itoa(http.StatusNoContent):
+		res.DeleteMissingStatus = http.StatusNoContent
+	default:
+		return rest.Semantics{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"invalid REST_DELETE_MISSING_STATUS, must be either 404 or 204")
+	}
+
+	creates, err := conf.Get("REST_PUT_CREATES")
+	if err != nil {
+		return rest.Semantics{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_PUT_CREATES")
+	}
+
+	if creates != "" {
+		if res.PutCreates, err = strconv.ParseBool(creates); err != nil {
+			return rest.Semantics{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid REST_PUT_CREATES")
+		}
+	}
+
+	return res, nil
+}
+
+// NewRESTDebug indicates whether debug information, like the time consumed by each dependency, is returned in
+// response headers; it's disabled by default.
+func NewRESTDebug(conf *envvar.Configuration) (bool, error) {
+	val, err := conf.Get("REST_DEBUG")
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_DEBUG")
+	}
+
+	if val == "" {
+		return false, nil
+	}
+
+	res, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid REST_DEBUG")
+	}
+
+	return res, nil
+}
+
+// NewRESTDefaultTimeZone returns the time zone used when clients don't request one, it's UTC by default.
+func NewRESTDefaultTimeZone(conf *envvar.Configuration) (*time.Location, error) {
+	val, err := conf.Get("REST_DEFAULT_TIME_ZONE")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_DEFAULT_TIME_ZONE")
+	}
+
+	res, err := time.LoadLocation(val)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid REST_DEFAULT_TIME_ZONE")
+	}
+
+	return res, nil
+}
+
+// unversionedDeprecation is when the routes not including the version, the ones existing before "/api/v1" was
+// introduced, were deprecated.
+//nolint: gochecknoglobals
+var unversionedDeprecation = time.Date(2026, time.October, 17, 0, 0, 0, 0, time.UTC)
+
+// NewRESTUnversioned returns the deprecated version of the API used by the routes not including the version, those
+// are aliases of "/api/v1". REST_UNVERSIONED_SUNSET, using the "2006-01-02" format, indicates when they will be
+// removed.
+func NewRESTUnversioned(conf *envvar.Configuration) (rest.APIVersion, error) {
+	res := rest.APIVersion{
+		Deprecation: unversionedDeprecation,
+		Successor:   "v1",
+	}
+
+	val, err := conf.Get("REST_UNVERSIONED_SUNSET")
+	if err != nil {
+		return rest.APIVersion{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_UNVERSIONED_SUNSET")
+	}
+
+	if val == "" {
+		return res, nil
+	}
+
+	if res.Sunset, err = time.Parse("2006-01-02", val); err != nil {
+		return rest.APIVersion{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument,
+			"invalid REST_UNVERSIONED_SUNSET")
+	}
+
+	return res, nil
+}
+
+// NewRESTLimits returns the limits applied to requests: REST_MAX_BODY_SIZE, in bytes, defaults to 1 MiB;
+// REST_REQUEST_TIMEOUT and REST_READ_HEADER_TIMEOUT default to one second; REST_ROUTE_TIMEOUTS overrides the
+// request timeout per route using comma separated path templates and durations, for example
+// "/search/tasks=3s,/tasks:batchUpdate=5s"; the templates must match the registered routes, without the version.
+func NewRESTLimits(conf *envvar.Configuration) (rest.RequestLimits, error) {
+	getDuration := func(key string) (time.Duration, error) {
+		val, err := conf.Get(key)
+		if err != nil {
+			return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", key)
+		}
+
+		if val == "" {
+			return time.Second, nil
+		}
+
+		res, err := time.ParseDuration(val)
+		if err != nil || res <= 0 {
+			return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid %s, must be a positive duration", key)
+		}
+
+		return res, nil
+	}
+
+	res := rest.RequestLimits{MaxBodySize: 1 << 20}
+
+	var err error
+
+	if res.Timeout, err = getDuration("REST_REQUEST_TIMEOUT"); err != nil {
+		return rest.RequestLimits{}, err
+	}
+
+	if res.ReadHeaderTimeout, err = getDuration("REST_READ_HEADER_TIMEOUT"); err != nil {
+		return rest.RequestLimits{}, err
+	}
+
+	size, err := conf.Get("REST_MAX_BODY_SIZE")
+	if err != nil {
+		return rest.RequestLimits{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_MAX_BODY_SIZE")
+	}
+
+	if size != "" {
+		if res.MaxBodySize, err = strconv.ParseInt(size, 10, 64); err != nil || res.MaxBodySize <= 0 {
+			return rest.RequestLimits{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+				"invalid REST_MAX_BODY_SIZE, must be a positive number of bytes")
+		}
+	}
+
+	routes, err := conf.Get("REST_ROUTE_TIMEOUTS")
+	if err != nil {
+		return rest.RequestLimits{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get REST_ROUTE_TIMEOUTS")
+	}
+
+	if routes == "" {
+		return res, nil
+	}
+
+	res.RouteTimeouts = make(map[string]time.Duration)
+
+	for _, route := range strings.Split(routes, ",") {
+		parts := strings.SplitN(strings.TrimSpace(route), "=", 2)
+		if len(parts) != 2 {
+			return rest.RequestLimits{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+				"invalid REST_ROUTE_TIMEOUTS, %q must be path=duration", route)
+		}
+
+		timeout, err := time.ParseDuration(parts[1])
+		if err != nil || timeout <= 0 {
+			return rest.RequestLimits{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+				"invalid REST_ROUTE_TIMEOUTS, %q must use a positive duration", route)
+		}
+
+		res.RouteTimeouts[parts[0]] = timeout
+	}
+
+	return res, nil
+}
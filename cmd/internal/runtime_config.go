@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"strconv"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// RuntimeSettings defines the settings that can be changed while running, see RuntimeConfig.
+type RuntimeSettings struct {
+	LogLevel           zapcore.Level
+	TracesSamplerRatio float64 // Fraction of the traces started by this service that are sampled, from 0 to 1.
+}
+
+// NewRuntimeSettings returns the runtime settings using the values returned by get, LOG_LEVEL defaults to "info"
+// and TRACES_SAMPLER_RATIO to 1, all traces are sampled.
+func NewRuntimeSettings(get func(key string) (string, error)) (RuntimeSettings, error) {
+	res := RuntimeSettings{
+		LogLevel:           zapcore.InfoLevel,
+		TracesSamplerRatio: 1,
+	}
+
+	level, err := get("LOG_LEVEL")
+	if err != nil {
+		return RuntimeSettings{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "get LOG_LEVEL")
+	}
+
+	if level != "" {
+		if err := res.LogLevel.Set(level); err != nil {
+			return RuntimeSettings{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid LOG_LEVEL: %s", level)
+		}
+	}
+
+	ratio, err := get("TRACES_SAMPLER_RATIO")
+	if err != nil {
+		return RuntimeSettings{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "get TRACES_SAMPLER_RATIO")
+	}
+
+	if ratio != "" {
+		if res.TracesSamplerRatio, err = strconv.ParseFloat(ratio, 64); err != nil ||
+			res.TracesSamplerRatio < 0 || res.TracesSamplerRatio > 1 {
+			return RuntimeSettings{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+				"invalid TRACES_SAMPLER_RATIO, must be between 0 and 1: %s", ratio)
+		}
+	}
+
+	return res, nil
+}
+
+// ReadRuntimeSettings reads the runtime settings again, from the environment variables file, env, and the
+// configuration file, config, when defined; those are read directly because ENV keeps the values loaded at startup.
+// Settings not defined in any of them use conf, like at startup.
+func ReadRuntimeSettings(conf *envvar.Configuration, env, config string) (RuntimeSettings, error) {
+	values := make(map[string]string)
+
+	if config != "" {
+		settings, err := envvar.ReadFile(config)
+		if err != nil {
+			return RuntimeSettings{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "envvar.ReadFile")
+		}
+
+		for key, val := range settings {
+			values[key] = val
+		}
+	}
+
+	// The environment variables file overrides the configuration file, like at startup.
+	if env != "" {
+		settings, err := envvar.Read(env)
+		if err != nil {
+			return RuntimeSettings{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "envvar.Read")
+		}
+
+		for key, val := range settings {
+			values[key] = val
+		}
+	}
+
+	return NewRuntimeSettings(func(key string) (string, error) {
+		if val, ok := values[key]; ok {
+			return val, nil
+		}
+
+		return conf.Get(key) //nolint: wrapcheck
+	})
+}
+
+// RuntimeConfig holds the runtime settings in use, those are replaced all at once so readers always get a
+// consistent snapshot of them. It's safe for concurrent use.
+type RuntimeConfig struct {
+	level    zap.AtomicLevel
+	snapshot atomic.Value // runtimeSnapshot
+}
+
+type runtimeSnapshot struct {
+	settings RuntimeSettings
+	sampler  sdktrace.Sampler
+}
+
+// NewRuntimeConfig instantiates the runtime configuration using settings, level is the one used by the logger.
+func NewRuntimeConfig(level zap.AtomicLevel, settings RuntimeSettings) *RuntimeConfig {
+	res := RuntimeConfig{
+		level: level,
+	}
+
+	res.Update(settings)
+
+	return &res
+}
+
+// Settings returns the settings in use.
+func (c *RuntimeConfig) Settings() RuntimeSettings {
+	return c.load().settings
+}
+
+// Update replaces the settings in use, the new ones are applied right away.
+func (c *RuntimeConfig) Update(settings RuntimeSettings) {
+	c.snapshot.Store(runtimeSnapshot{
+		settings: settings,
+		sampler:  sdktrace.ParentBased(sdktrace.TraceIDRatioBased(settings.TracesSamplerRatio)),
+	})
+
+	c.level.SetLevel(settings.LogLevel)
+}
+
+// Sampler returns the sampler deciding which traces are sampled using the current TracesSamplerRatio, the decision
+// of the parent span is honored when there's one.
+func (c *RuntimeConfig) Sampler() sdktrace.Sampler {
+	return runtimeSampler{config: c}
+}
+
+func (c *RuntimeConfig) load() runtimeSnapshot {
+	return c.snapshot.Load().(runtimeSnapshot) //nolint: forcetypeassert
+}
+
+type runtimeSampler struct {
+	config *RuntimeConfig
+}
+
+func (s runtimeSampler) ShouldSample(params sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	return s.config.load().sampler.ShouldSample(params)
+}
+
+func (s runtimeSampler) Description() string {
+	return "RuntimeSampler{" + s.config.load().sampler.Description() + "}"
+}
@@ -0,0 +1,25 @@
+package internal
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/kafka"
+)
+
+// NewSchemaRegistry instantiates the Schema Registry client using configuration defined in environment variables,
+// nil is returned when SCHEMA_REGISTRY_URL is not defined, in that case messages are serialized using JSON.
+func NewSchemaRegistry(conf *envvar.Configuration) (*kafka.SchemaRegistry, error) {
+	baseURL, err := conf.Get("SCHEMA_REGISTRY_URL")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get SCHEMA_REGISTRY_URL")
+	}
+
+	if baseURL == "" {
+		return nil, nil
+	}
+
+	return kafka.NewSchemaRegistry(&http.Client{Timeout: 10 * time.Second}, baseURL), nil
+}
@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+const (
+	// SearchEngineElasticsearch indicates tasks are searched using Elasticsearch, this is the default.
+	SearchEngineElasticsearch = "elasticsearch"
+
+	// SearchEngineOpenSearch indicates tasks are searched using OpenSearch, using the same index as Elasticsearch.
+	SearchEngineOpenSearch = "opensearch"
+
+	// SearchEnginePostgreSQL indicates tasks are searched using the full-text search of PostgreSQL.
+	SearchEnginePostgreSQL = "postgres"
+)
+
+// NewSearchEngine returns the engine used for searching tasks.
+func NewSearchEngine(conf *envvar.Configuration) (string, error) {
+	engine, err := conf.Get("SEARCH_ENGINE")
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get SEARCH_ENGINE")
+	}
+
+	switch engine {
+	case "", SearchEngineElasticsearch:
+		return SearchEngineElasticsearch, nil
+	case SearchEngineOpenSearch, SearchEnginePostgreSQL:
+		return engine, nil
+	}
+
+	return "", internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid SEARCH_ENGINE: %s", engine)
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// ShutdownStage defines the order used for running shutdown hooks, hooks in earlier stages run first.
+type ShutdownStage int
+
+const (
+	// ShutdownStageHTTP drains the HTTP servers so no new work is accepted.
+	ShutdownStageHTTP ShutdownStage = iota
+
+	// ShutdownStageConsumers stops consuming messages.
+	ShutdownStageConsumers
+
+	// ShutdownStageJobs waits for background jobs to complete.
+	ShutdownStageJobs
+
+	// ShutdownStageOutbox flushes pending messages to be published.
+	ShutdownStageOutbox
+
+	// ShutdownStageDatastores closes the connections to datastores.
+	ShutdownStageDatastores
+
+	// ShutdownStageAdmin closes the admin HTTP servers, those keep serving while the other stages run.
+	ShutdownStageAdmin
+)
+
+// String returns the name of the stage used for logging.
+func (s ShutdownStage) String() string {
+	switch s {
+	case ShutdownStageHTTP:
+		return "http"
+	case ShutdownStageConsumers:
+		return "consumers"
+	case ShutdownStageJobs:
+		return "jobs"
+	case ShutdownStageOutbox:
+		return "outbox"
+	case ShutdownStageDatastores:
+		return "datastores"
+	case ShutdownStageAdmin:
+		return "admin"
+	}
+
+	return "unknown"
+}
+
+type shutdownHook struct {
+	stage   ShutdownStage
+	name    string
+	timeout time.Duration
+	fn      func(context.Context) error
+}
+
+// Shutdown is a registry of hooks to run when shutting down, subsystems register their cleanup when they are
+// instantiated instead of relying on the order of deferred calls.
+type Shutdown struct {
+	logger *zap.Logger
+	mutex  sync.Mutex
+	hooks  []shutdownHook
+}
+
+// NewShutdown instantiates the Shutdown registry.
+func NewShutdown(logger *zap.Logger) *Shutdown {
+	return &Shutdown{
+		logger: logger,
+	}
+}
+
+// Register adds a hook to the stage, hooks in the same stage run in the order they were registered. The context
+// passed to fn is canceled after timeout, when fn does not return by then the next hook runs anyway.
+func (s *Shutdown) Register(stage ShutdownStage, name string, timeout time.Duration, fn func(context.Context) error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.hooks = append(s.hooks, shutdownHook{
+		stage:   stage,
+		name:    name,
+		timeout: timeout,
+		fn:      fn,
+	})
+}
+
+// RegisterFunc adds a hook that does not support cancellation nor returns errors, like most Close methods.
+func (s *Shutdown) RegisterFunc(stage ShutdownStage, name string, timeout time.Duration, fn func()) {
+	s.Register(stage, name, timeout, func(context.Context) error {
+		fn()
+
+		return nil
+	})
+}
+
+// Run runs all the hooks in order, failing hooks don't prevent the next ones from running, the first error
+// is returned.
+func (s *Shutdown) Run(ctx context.Context) error {
+	s.mutex.Lock()
+
+	hooks := make([]shutdownHook, len(s.hooks))
+	copy(hooks, s.hooks)
+
+	s.mutex.Unlock()
+
+	sort.SliceStable(hooks, func(i, j int) bool { return hooks[i].stage < hooks[j].stage })
+
+	var res error
+
+	for _, hook := range hooks {
+		if err := s.run(ctx, hook); err != nil && res == nil {
+			res = err
+		}
+	}
+
+	return res
+}
+
+func (s *Shutdown) run(ctx context.Context, hook shutdownHook) error {
+	fields := []zap.Field{
+		zap.Stringer("stage", hook.stage),
+		zap.String("hook", hook.name),
+	}
+
+	s.logger.Info("Running shutdown hook", fields...)
+
+	ctx, cancel := context.WithTimeout(ctx, hook.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errC := make(chan error, 1)
+
+	go func() {
+		errC <- hook.fn(ctx)
+	}()
+
+	var err error
+
+	select {
+	case err = <-errC:
+	case <-ctx.Done():
+		err = internal.WrapErrorf(ctx.Err(), internal.ErrorCodeUnknown, "shutdown hook %s timed out", hook.name)
+	}
+
+	fields = append(fields, zap.Duration("duration", time.Since(start)))
+
+	if err != nil {
+		s.logger.Error("Shutdown hook failed", append(fields, zap.Error(err))...)
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "shutdown hook %s", hook.name)
+	}
+
+	s.logger.Info("Shutdown hook completed", fields...)
+
+	return nil
+}
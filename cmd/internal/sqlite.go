@@ -0,0 +1,41 @@
+package internal
+
+import (
+	"context"
+	"database/sql"
+
+	// Initialize "sqlite".
+	_ "modernc.org/sqlite"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/sqlite"
+)
+
+// NewSQLite opens the SQLite database file, creating it and its tables when missing.
+func NewSQLite(conf *envvar.Configuration) (*sql.DB, error) {
+	path, err := conf.Get("SQLITE_PATH")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get SQLITE_PATH")
+	}
+
+	if path == "" {
+		path = "todo.db"
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "sql.Open")
+	}
+
+	// SQLite allows one writer at a time, using a single connection avoids "database is locked" errors.
+	db.SetMaxOpenConns(1)
+
+	if err := sqlite.Migrate(context.Background(), db); err != nil {
+		_ = db.Close()
+
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "sqlite.Migrate")
+	}
+
+	return db, nil
+}
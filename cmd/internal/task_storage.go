@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// TaskStorage defines how tasks are persisted.
+type TaskStorage struct {
+	// EventSourced indicates tasks are stored as an append-only stream of events instead of rows.
+	EventSourced bool
+	// SnapshotEvery indicates the number of events appended to a task before saving a snapshot of its state.
+	SnapshotEvery int64
+}
+
+// NewTaskStorage returns the configured way of persisting tasks, rows are used by default.
+func NewTaskStorage(conf *envvar.Configuration) (TaskStorage, error) {
+	get := func(v string) (string, error) {
+		res, err := conf.Get(v)
+		if err != nil {
+			return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get %s", v)
+		}
+
+		return res, nil
+	}
+
+	storage, err := get("TASKS_STORAGE")
+	if err != nil {
+		return TaskStorage{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "get")
+	}
+
+	res := TaskStorage{SnapshotEvery: 50}
+
+	switch storage {
+	case "", "rows":
+	case "events":
+		res.EventSourced = true
+	default:
+		return TaskStorage{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid TASKS_STORAGE: %s", storage)
+	}
+
+	every, err := get("TASKS_SNAPSHOT_EVERY")
+	if err != nil {
+		return TaskStorage{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "get")
+	}
+
+	if every != "" {
+		if res.SnapshotEvery, err = strconv.ParseInt(every, 10, 64); err != nil || res.SnapshotEvery <= 0 {
+			return TaskStorage{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid TASKS_SNAPSHOT_EVERY: %s", every)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,30 @@
+package internal
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+// NewTrashRetention returns how long deleted tasks are kept in the trash before those are purged, 30 days by
+// default; zero when they are kept forever.
+func NewTrashRetention(conf *envvar.Configuration) (time.Duration, error) {
+	val, err := conf.Get("TRASH_RETENTION_DAYS")
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get TRASH_RETENTION_DAYS")
+	}
+
+	if val == "" {
+		return 30 * 24 * time.Hour, nil
+	}
+
+	days, err := strconv.Atoi(val)
+	if err != nil || days < 0 {
+		return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"invalid TRASH_RETENTION_DAYS, must be a positive number of days")
+	}
+
+	return time.Duration(days) * 24 * time.Hour, nil
+}
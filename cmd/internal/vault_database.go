@@ -0,0 +1,179 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/envvar/vault"
+)
+
+// vaultDatabaseRetry is the time to wait before trying again when renewing or rotating the credentials fails.
+const vaultDatabaseRetry = 5 * time.Second
+
+// VaultDatabaseCredentials keeps the short-lived database credentials generated by the Vault database secrets
+// engine, their lease is renewed in the background and new credentials are generated when it can't be renewed
+// anymore. Connections established using previous credentials are closed once released, so queries in progress
+// complete before rotating them.
+type VaultDatabaseCredentials struct {
+	provider *vault.Provider
+	logger   *zap.Logger
+	mount    string
+	role     string
+
+	mu      sync.RWMutex
+	current vault.DatabaseCredentials
+}
+
+// NewVaultDatabaseCredentials generates the database credentials using the role defined by DATABASE_VAULT_ROLE,
+// the secrets engine is mounted at VAULT_DATABASE_MOUNT, "database" by default. It returns nil when
+// DATABASE_VAULT_ROLE is not defined, static credentials are used in that case.
+func NewVaultDatabaseCredentials(conf *envvar.Configuration, logger *zap.Logger) (*VaultDatabaseCredentials, error) {
+	role, err := conf.Get("DATABASE_VAULT_ROLE")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get DATABASE_VAULT_ROLE")
+	}
+
+	if role == "" {
+		return nil, nil //nolint: nilnil
+	}
+
+	mount, err := conf.Get("VAULT_DATABASE_MOUNT")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "conf.Get VAULT_DATABASE_MOUNT")
+	}
+
+	if mount == "" {
+		mount = "database"
+	}
+
+	provider, err := NewVaultProvider()
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "NewVaultProvider")
+	}
+
+	res := VaultDatabaseCredentials{
+		provider: provider,
+		logger:   logger,
+		mount:    mount,
+		role:     role,
+	}
+
+	if err := res.rotate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "rotate")
+	}
+
+	return &res, nil
+}
+
+// Configure makes the pool use the current credentials for new connections, and close the connections established
+// using previous ones instead of reusing them. The lifetime of the connections is limited to half the lease so idle
+// connections using previous credentials are closed before those expire.
+func (c *VaultDatabaseCredentials) Configure(config *pgxpool.Config) {
+	if lease := c.credentials().Lease; lease.Duration > 0 && config.MaxConnLifetime > lease.Duration/2 {
+		config.MaxConnLifetime = lease.Duration / 2
+	}
+
+	config.BeforeConnect = func(_ context.Context, connConfig *pgx.ConnConfig) error {
+		creds := c.credentials()
+
+		connConfig.User = creds.Username
+		connConfig.Password = creds.Password
+
+		return nil
+	}
+
+	// Each lease uses its own username, so it identifies the credentials used by the connection.
+	isCurrent := func(conn *pgx.Conn) bool {
+		return conn.Config().User == c.credentials().Username
+	}
+
+	config.BeforeAcquire = func(_ context.Context, conn *pgx.Conn) bool {
+		return isCurrent(conn)
+	}
+
+	config.AfterRelease = isCurrent
+}
+
+// Run renews the lease of the credentials until ctx is done, new credentials are generated when the lease reaches
+// its maximum time to live.
+func (c *VaultDatabaseCredentials) Run(ctx context.Context) {
+	for {
+		lease := c.credentials().Lease
+		if lease.Duration <= 0 {
+			return // Credentials never expire.
+		}
+
+		// Renewing when two thirds of the lease elapsed leaves time for retrying when Vault is not available.
+		wait := lease.Duration * 2 / 3
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(wait):
+			}
+
+			err := c.renew(lease)
+			if err == nil {
+				break
+			}
+
+			c.logger.Warn("Renewing database credentials failed, retrying", zap.Error(err))
+
+			wait = vaultDatabaseRetry
+		}
+	}
+}
+
+// renew extends the lease, when it can't be extended for as long as before new credentials are generated.
+func (c *VaultDatabaseCredentials) renew(lease vault.Lease) error {
+	if lease.Renewable {
+		renewed, err := c.provider.RenewLease(lease.ID, lease.Duration)
+		if err == nil && renewed.Duration >= lease.Duration {
+			c.mu.Lock()
+			c.current.Lease = renewed
+			c.mu.Unlock()
+
+			return nil
+		}
+
+		if err != nil {
+			c.logger.Info("Renewing database credentials lease failed, rotating them", zap.Error(err))
+		}
+	}
+
+	if err := c.rotate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "rotate")
+	}
+
+	c.logger.Info("Database credentials rotated")
+
+	return nil
+}
+
+func (c *VaultDatabaseCredentials) rotate() error {
+	creds, err := c.provider.DatabaseCredentials(c.mount, c.role)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "provider.DatabaseCredentials")
+	}
+
+	c.mu.Lock()
+	c.current = creds
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *VaultDatabaseCredentials) credentials() vault.DatabaseCredentials {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.current
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/parquet"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/s3"
+)
+
+func main() {
+	var (
+		env  string
+		date string
+		size int
+	)
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.StringVar(&date, "date", time.Now().UTC().AddDate(0, 0, -1).Format(parquet.DateLayout), "Date to export, YYYY-MM-DD")
+	flag.IntVar(&size, "size", 1000, "Number of rows read per batch")
+	flag.Parse()
+
+	if err := run(env, date, int32(size)); err != nil {
+		log.Fatalf("Couldn't export: %s", err)
+	}
+}
+
+func run(env, date string, size int32) error {
+	day, err := time.Parse(parquet.DateLayout, date)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "time.Parse")
+	}
+
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	if err := envvar.Load(env); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	pool, err := internal.NewPostgreSQL(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPostgreSQL")
+	}
+
+	defer pool.Close()
+
+	storage, err := internal.NewTaskStorage(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewTaskStorage")
+	}
+
+	s3Client, err := internal.NewS3(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewS3")
+	}
+
+	// Events only exist when tasks are event sourced.
+	var (
+		tasks  parquet.TaskSource = postgresql.NewTask(pool)
+		events parquet.TaskEventSource
+	)
+
+	if storage.EventSourced {
+		store := postgresql.NewTaskEventStore(pool, storage.SnapshotEvery)
+		tasks, events = store, store
+	}
+
+	exporter := parquet.NewExporter(tasks, events, s3.NewObjectStore(s3Client.Client, s3Client.Bucket, s3Client.Prefix), size)
+
+	//-
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+	defer stop()
+
+	res, err := exporter.Export(ctx, day)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "Export")
+	}
+
+	logger.Info("Exported",
+		zap.String("date", date),
+		zap.Int64("tasks", res.Tasks),
+		zap.Int64("events", res.Events),
+		zap.Strings("keys", res.Keys))
+
+	return nil
+}
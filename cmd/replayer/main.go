@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	internalkafka "github.com/MarioCarrion/todo-api/internal/kafka"
+)
+
+const timeoutMS = 5000
+
+// Range defines the messages to replay, limits are inclusive and ignored when negative or zero.
+type Range struct {
+	FromOffset int64
+	ToOffset   int64
+	FromTime   time.Time
+	ToTime     time.Time
+}
+
+func main() {
+	var (
+		env              string
+		fromTime, toTime string
+		dryRun           bool
+		r                Range
+	)
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.Int64Var(&r.FromOffset, "from-offset", -1, "First offset to replay, applies to all partitions")
+	flag.Int64Var(&r.ToOffset, "to-offset", -1, "Last offset to replay, applies to all partitions")
+	flag.StringVar(&fromTime, "from-time", "", "Replay messages published at or after this time, RFC3339")
+	flag.StringVar(&toTime, "to-time", "", "Replay messages published at or before this time, RFC3339")
+	flag.BoolVar(&dryRun, "dry-run", false, "Decode the messages without indexing them")
+	flag.Parse()
+
+	var err error
+
+	if fromTime != "" {
+		if r.FromTime, err = time.Parse(time.RFC3339, fromTime); err != nil {
+			log.Fatalf("Invalid from-time: %s", err)
+		}
+	}
+
+	if toTime != "" {
+		if r.ToTime, err = time.Parse(time.RFC3339, toTime); err != nil {
+			log.Fatalf("Invalid to-time: %s", err)
+		}
+	}
+
+	if err := run(env, r, dryRun); err != nil {
+		log.Fatalf("Couldn't replay: %s", err)
+	}
+}
+
+func run(env string, r Range, dryRun bool) error {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	if err := envvar.Load(env); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	esClient, err := internal.NewElasticSearch(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewElasticSearch")
+	}
+
+	reader, err := internal.NewKafkaReader(conf, "elasticsearch-replayer")
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewKafkaReader")
+	}
+
+	defer reader.Consumer.Close()
+
+	registry, err := internal.NewSchemaRegistry(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewSchemaRegistry")
+	}
+
+	var avro *internalkafka.AvroDeserializer
+
+	if registry != nil {
+		avro = internalkafka.NewAvroDeserializer(registry)
+	}
+
+	//-
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+	defer stop()
+
+	replayer := &Replayer{
+		logger:   logger,
+		consumer: reader.Consumer,
+		topic:    reader.Topic,
+		decoder:  internalkafka.NewEventDecoder(avro),
+		task:     elasticsearch.NewTask(esClient),
+		dryRun:   dryRun,
+	}
+
+	return replayer.Replay(ctx, r)
+}
+
+// Replayer re-applies task events to the Elasticsearch index.
+type Replayer struct {
+	logger   *zap.Logger
+	consumer *kafka.Consumer
+	topic    string
+	decoder  *internalkafka.EventDecoder
+	task     *elasticsearch.Task
+	dryRun   bool
+}
+
+// Replay consumes the messages in the range, in order per partition, and re-applies them.
+func (r *Replayer) Replay(ctx context.Context, rng Range) error {
+	partitions, ends, err := r.assignments(rng)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "assignments")
+	}
+
+	if len(partitions) == 0 {
+		r.logger.Info("No messages to replay")
+
+		return nil
+	}
+
+	if err := r.consumer.Assign(partitions); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "consumer.Assign")
+	}
+
+	var replayed, failed int
+
+	for len(ends) > 0 && ctx.Err() == nil {
+		msg, err := r.consumer.ReadMessage(timeoutMS * time.Millisecond)
+		if err != nil {
+			var kerr kafka.Error
+			if errors.As(err, &kerr) && kerr.Code() == kafka.ErrTimedOut {
+				break // No more messages available.
+			}
+
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "consumer.ReadMessage")
+		}
+
+		partition := msg.TopicPartition.Partition
+
+		end, ok := ends[partition]
+		if !ok {
+			continue
+		}
+
+		if int64(msg.TopicPartition.Offset) >= end || (!rng.ToTime.IsZero() && msg.Timestamp.After(rng.ToTime)) {
+			delete(ends, partition)
+
+			continue
+		}
+
+		if err := r.apply(ctx, msg); err != nil {
+			r.logger.Error("Replaying failed",
+				zap.Int32("partition", partition),
+				zap.String("offset", msg.TopicPartition.Offset.String()),
+				zap.Error(err))
+
+			failed++
+
+			continue
+		}
+
+		replayed++
+	}
+
+	r.logger.Info("Replay completed", zap.Int("replayed", replayed), zap.Int("failed", failed), zap.Bool("dry_run", r.dryRun))
+
+	if failed > 0 {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeUnknown, "%d messages failed", failed)
+	}
+
+	return nil
+}
+
+// assignments returns the partitions to read, starting at the first offset in the range, and the offset where
+// reading each partition stops, exclusive.
+func (r *Replayer) assignments(rng Range) ([]kafka.TopicPartition, map[int32]int64, error) {
+	metadata, err := r.consumer.GetMetadata(&r.topic, false, timeoutMS)
+	if err != nil {
+		return nil, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "consumer.GetMetadata")
+	}
+
+	var partitions []kafka.TopicPartition
+
+	ends := make(map[int32]int64)
+
+	for _, p := range metadata.Topics[r.topic].Partitions {
+		low, high, err := r.consumer.QueryWatermarkOffsets(r.topic, p.ID, timeoutMS)
+		if err != nil {
+			return nil, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "consumer.QueryWatermarkOffsets")
+		}
+
+		start, end := low, high
+
+		if rng.FromOffset >= 0 && rng.FromOffset > start {
+			start = rng.FromOffset
+		}
+
+		if rng.ToOffset >= 0 && rng.ToOffset+1 < end {
+			end = rng.ToOffset + 1
+		}
+
+		if !rng.FromTime.IsZero() {
+			offset, err := r.offsetForTime(p.ID, rng.FromTime)
+			if err != nil {
+				return nil, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "offsetForTime")
+			}
+
+			if offset < 0 { // No messages published after that time.
+				offset = high
+			}
+
+			if offset > start {
+				start = offset
+			}
+		}
+
+		if start >= end {
+			continue
+		}
+
+		partitions = append(partitions, kafka.TopicPartition{
+			Topic:     &r.topic,
+			Partition: p.ID,
+			Offset:    kafka.Offset(start),
+		})
+
+		ends[p.ID] = end
+	}
+
+	return partitions, ends, nil
+}
+
+func (r *Replayer) offsetForTime(partition int32, t time.Time) (int64, error) {
+	res, err := r.consumer.OffsetsForTimes([]kafka.TopicPartition{
+		{
+			Topic:     &r.topic,
+			Partition: partition,
+			Offset:    kafka.Offset(t.UnixMilli()),
+		},
+	}, timeoutMS)
+	if err != nil {
+		return 0, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "consumer.OffsetsForTimes")
+	}
+
+	return int64(res[0].Offset), nil
+}
+
+func (r *Replayer) apply(ctx context.Context, msg *kafka.Message) error {
+	evt, err := r.decoder.Decode(ctx, msg.Value)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "decoder.Decode")
+	}
+
+	if r.dryRun {
+		r.logger.Info("Decoded", zap.String("type", evt.Type), zap.String("id", evt.Value.ID))
+
+		return nil
+	}
+
+	switch evt.Type {
+	case "tasks.event.updated", "tasks.event.created":
+		return r.task.Index(ctx, evt.Value) //nolint: wrapcheck
+	case "tasks.event.deleted":
+		return r.task.Delete(ctx, evt.Value.ID) //nolint: wrapcheck
+	case "tasks.event.completed", "tasks.event.reopened":
+		// The task is indexed when handling the "updated" event published before.
+		return nil
+	}
+
+	// Types published by newer versions are skipped, see internaldomain.SkipUnrecognizedTaskEvent.
+	internaldomain.SkipUnrecognizedTaskEvent(ctx, evt.Type)
+
+	r.logger.Info("Skipping message, unknown type", zap.String("type", evt.Type))
+
+	return nil
+}
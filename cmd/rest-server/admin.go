@@ -0,0 +1,490 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/backfill"
+	"github.com/MarioCarrion/todo-api/internal/compat"
+	"github.com/MarioCarrion/todo-api/internal/diskqueue"
+	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+// InFlightRequest represents a request being handled.
+type InFlightRequest struct {
+	Method     string    `json:"method"`
+	Route      string    `json:"route"`
+	RequestID  string    `json:"request_id,omitempty"`
+	TraceID    string    `json:"trace_id,omitempty"`
+	Started    time.Time `json:"started"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+// BackgroundJob represents a job running in the background.
+type BackgroundJob struct {
+	Name       string    `json:"name"`
+	Started    time.Time `json:"started"`
+	DurationMS int64     `json:"duration_ms"`
+}
+
+// InFlightResponse defines the response returned when listing the work in progress.
+type InFlightResponse struct {
+	Requests []InFlightRequest `json:"requests"`
+	Jobs     []BackgroundJob   `json:"jobs"`
+}
+
+// Fault represents the behavior simulated for a dependency in development mode.
+type Fault struct {
+	Dependency string  `json:"dependency"`
+	LatencyMS  int64   `json:"latency_ms"`
+	ErrorRate  float64 `json:"error_rate"`
+}
+
+// FaultsResponse defines the response returned when listing the simulated faults.
+type FaultsResponse struct {
+	Faults []Fault `json:"faults"`
+}
+
+// SetFaultRequest defines the request used for simulating a fault.
+type SetFaultRequest struct {
+	LatencyMS int64   `json:"latency_ms"`
+	ErrorRate float64 `json:"error_rate"`
+}
+
+// Backfill represents the progress of a backfill.
+type Backfill struct {
+	Name      string    `json:"name"`
+	Running   bool      `json:"running"`
+	Done      bool      `json:"done"`
+	Processed int64     `json:"processed"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// BackfillsResponse defines the response returned when listing the backfills.
+type BackfillsResponse struct {
+	Backfills []Backfill `json:"backfills"`
+}
+
+// CompatInstance represents an instance serving traffic.
+type CompatInstance struct {
+	ID         string    `json:"id"`
+	Version    int       `json:"version"`
+	MinVersion int       `json:"min_version"`
+	StartedAt  time.Time `json:"started_at"`
+	SeenAt     time.Time `json:"seen_at"`
+	Current    bool      `json:"current"`
+}
+
+// CompatFlag represents a flag enabling writing the data introduced by a version, Safe indicates every instance
+// serving traffic reads that data.
+type CompatFlag struct {
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+	Enabled bool   `json:"enabled"`
+	Safe    bool   `json:"safe"`
+}
+
+// CompatResponse defines the response returned when reporting the versions serving traffic.
+type CompatResponse struct {
+	Version              int              `json:"version"`
+	MinServingVersion    int              `json:"min_serving_version"`
+	MinCompatibleVersion int              `json:"min_compatible_version"`
+	Compatible           bool             `json:"compatible"`
+	Instances            []CompatInstance `json:"instances"`
+	Flags                []CompatFlag     `json:"flags"`
+}
+
+// SetFlagRequest defines the request used for enabling or disabling a flag.
+type SetFlagRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// FlagsResponse defines the response returned when listing the flags.
+type FlagsResponse struct {
+	Flags []CompatFlag `json:"flags"`
+}
+
+// ConfigResponse defines the response returned when dumping the configuration.
+type ConfigResponse struct {
+	Settings map[string]string `json:"settings"`
+}
+
+// AdminHandler exposes the endpoints used by operators for inspecting and operating the server: running backfills,
+// reconciling the search index, flushing the cache, replaying buffered messages, changing flags and, in development mode, simulating
+// faults of the dependencies. Operations running in the background stop when ctx is done.
+type AdminHandler struct {
+	ctx        context.Context //nolint: containedctx
+	inFlight   *rest.InFlight
+	jobs       *internal.Jobs
+	faults     *memory.Faults
+	backfills  *backfill.Runner
+	registry   *compat.Registry
+	flags      *internaldomain.CompatFlagSet
+	reconciler *elasticsearch.Reconciler
+	cache      *memcache.Client
+	queue      *diskqueue.Task
+}
+
+// Register connects the handlers to the router.
+func (a *AdminHandler) Register(r *mux.Router) {
+	r.HandleFunc("/admin/inflight", a.list).Methods(http.MethodGet)
+	r.HandleFunc("/admin/compat", a.compat).Methods(http.MethodGet)
+	r.HandleFunc("/admin/config", a.config).Methods(http.MethodGet)
+	r.HandleFunc("/admin/flags", a.listFlags).Methods(http.MethodGet)
+	r.HandleFunc("/admin/flags/{name}", a.setFlag).Methods(http.MethodPut)
+
+	if a.reconciler != nil {
+		r.HandleFunc("/admin/reconcile", a.reconcile).Methods(http.MethodPost)
+	}
+
+	if a.cache != nil {
+		r.HandleFunc("/admin/cache/flush", a.flushCache).Methods(http.MethodPost)
+	}
+
+	if a.queue != nil {
+		r.HandleFunc("/admin/diskqueue/replay", a.replay).Methods(http.MethodPost)
+	}
+
+	if a.faults != nil {
+		r.HandleFunc("/admin/faults", a.listFaults).Methods(http.MethodGet)
+		r.HandleFunc("/admin/faults/{dependency}", a.setFault).Methods(http.MethodPut)
+		r.HandleFunc("/admin/faults/{dependency}", a.resetFault).Methods(http.MethodDelete)
+	}
+
+	if a.backfills != nil {
+		r.HandleFunc("/admin/backfills", a.listBackfills).Methods(http.MethodGet)
+		r.HandleFunc("/admin/backfills/{name}", a.startBackfill).Methods(http.MethodPost)
+		r.HandleFunc("/admin/backfills/{name}", a.cancelBackfill).Methods(http.MethodDelete)
+	}
+}
+
+// list returns the requests being handled and the jobs running in the background, the oldest requests first.
+func (a *AdminHandler) list(w http.ResponseWriter, _ *http.Request) {
+	now := time.Now()
+
+	requests := a.inFlight.Requests()
+	jobs := a.jobs.Running()
+
+	res := InFlightResponse{
+		Requests: make([]InFlightRequest, len(requests)),
+		Jobs:     make([]BackgroundJob, len(jobs)),
+	}
+
+	for i, req := range requests {
+		res.Requests[i] = InFlightRequest{
+			Method:     req.Method,
+			Route:      req.Route,
+			RequestID:  req.RequestID,
+			TraceID:    req.TraceID,
+			Started:    req.Started,
+			DurationMS: now.Sub(req.Started).Milliseconds(),
+		}
+	}
+
+	for i, job := range jobs {
+		res.Jobs[i] = BackgroundJob{
+			Name:       job.Name,
+			Started:    job.Started,
+			DurationMS: now.Sub(job.Started).Milliseconds(),
+		}
+	}
+
+	renderResponse(w, res, http.StatusOK)
+}
+
+// compat returns the versions of the instances serving traffic: the oldest one, the oldest one all of them can run
+// alongside of, and which flags can be safely enabled.
+func (a *AdminHandler) compat(w http.ResponseWriter, r *http.Request) {
+	status, err := a.registry.Status(r.Context())
+	if err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, http.StatusInternalServerError)
+
+		return
+	}
+
+	current := a.registry.Instance()
+
+	res := CompatResponse{
+		Version:              current.Version,
+		MinServingVersion:    status.MinServingVersion,
+		MinCompatibleVersion: status.MinCompatibleVersion,
+		Compatible:           status.Compatible(),
+		Instances:            make([]CompatInstance, len(status.Instances)),
+	}
+
+	for i, instance := range status.Instances {
+		res.Instances[i] = CompatInstance{
+			ID:         instance.ID,
+			Version:    instance.Version,
+			MinVersion: instance.MinVersion,
+			StartedAt:  instance.StartedAt,
+			SeenAt:     instance.SeenAt,
+			Current:    instance.ID == current.ID,
+		}
+	}
+
+	for _, flag := range a.flags.Flags().List() {
+		res.Flags = append(res.Flags, CompatFlag{
+			Name:    flag.Name,
+			Version: flag.Version,
+			Enabled: flag.Enabled,
+			Safe:    status.Safe(flag),
+		})
+	}
+
+	renderResponse(w, res, http.StatusOK)
+}
+
+// config returns the settings of the server, the values of secrets are redacted.
+func (a *AdminHandler) config(w http.ResponseWriter, _ *http.Request) {
+	renderResponse(w, ConfigResponse{Settings: internal.DumpConfiguration(os.Environ())}, http.StatusOK)
+}
+
+// listFlags returns the flags in use by this instance.
+func (a *AdminHandler) listFlags(w http.ResponseWriter, _ *http.Request) {
+	var res FlagsResponse
+
+	for _, flag := range a.flags.Flags().List() {
+		res.Flags = append(res.Flags, CompatFlag{
+			Name:    flag.Name,
+			Version: flag.Version,
+			Enabled: flag.Enabled,
+		})
+	}
+
+	renderResponse(w, res, http.StatusOK)
+}
+
+// setFlag enables or disables the flag in this instance until it restarts, enabling flags is rejected unless it's
+// safe for every instance serving traffic, see compat.
+func (a *AdminHandler) setFlag(w http.ResponseWriter, r *http.Request) {
+	var req SetFlagRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderResponse(w, map[string]string{"error": "invalid request"}, http.StatusBadRequest)
+
+		return
+	}
+
+	name := mux.Vars(r)["name"]
+
+	if req.Enabled {
+		status, err := a.registry.Status(r.Context())
+		if err != nil {
+			renderResponse(w, map[string]string{"error": err.Error()}, http.StatusInternalServerError)
+
+			return
+		}
+
+		for _, flag := range a.flags.Flags().List() {
+			if flag.Name == name && !status.Safe(flag) {
+				renderResponse(w, map[string]string{"error": "older versions are serving traffic"}, http.StatusConflict)
+
+				return
+			}
+		}
+	}
+
+	if err := a.flags.Set(name, req.Enabled); err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, adminErrorStatus(err))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reconcile reconciles the indexed tasks with the datastore in the background, the result is logged. It fixes the
+// drifted tasks in the current index, recreating the index is done by the elasticsearch-reindexer command.
+func (a *AdminHandler) reconcile(w http.ResponseWriter, _ *http.Request) {
+	a.startJob(w, internal.Job{Name: "admin-reconcile", Run: a.reconciler.RunOnce})
+}
+
+// flushCache invalidates all the cached tasks.
+func (a *AdminHandler) flushCache(w http.ResponseWriter, _ *http.Request) {
+	if err := a.cache.FlushAll(); err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// replay publishes the messages buffered on disk in the background, instead of waiting for the next attempt.
+func (a *AdminHandler) replay(w http.ResponseWriter, _ *http.Request) {
+	a.startJob(w, internal.Job{Name: "admin-diskqueue-replay", Run: func(ctx context.Context) {
+		_ = a.queue.Replay(ctx) // Messages failing are kept for the next attempt.
+	}})
+}
+
+// startJob runs the job in the background, unless it's already running.
+func (a *AdminHandler) startJob(w http.ResponseWriter, job internal.Job) {
+	if !a.jobs.TryGo(a.ctx, job) {
+		renderResponse(w, map[string]string{"error": "already running"}, http.StatusConflict)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// listFaults returns the faults simulated for each dependency, including the ones behaving normally.
+func (a *AdminHandler) listFaults(w http.ResponseWriter, _ *http.Request) {
+	deps := a.faults.Dependencies()
+
+	res := FaultsResponse{
+		Faults: make([]Fault, len(deps)),
+	}
+
+	for i, dep := range deps {
+		res.Faults[i] = newFault(dep, a.faults.Get(dep))
+	}
+
+	renderResponse(w, res, http.StatusOK)
+}
+
+// setFault simulates the fault for the dependency, replacing the previous one.
+func (a *AdminHandler) setFault(w http.ResponseWriter, r *http.Request) {
+	var req SetFaultRequest
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		renderResponse(w, map[string]string{"error": "invalid request"}, http.StatusBadRequest)
+
+		return
+	}
+
+	dep := internaldomain.Dependency(mux.Vars(r)["dependency"])
+
+	fault := memory.Fault{
+		Latency:   time.Duration(req.LatencyMS) * time.Millisecond,
+		ErrorRate: req.ErrorRate,
+	}
+
+	if err := a.faults.Set(dep, fault); err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, http.StatusBadRequest)
+
+		return
+	}
+
+	renderResponse(w, newFault(dep, fault), http.StatusOK)
+}
+
+// resetFault makes the dependency behave normally again.
+func (a *AdminHandler) resetFault(w http.ResponseWriter, r *http.Request) {
+	if err := a.faults.Set(internaldomain.Dependency(mux.Vars(r)["dependency"]), memory.Fault{}); err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, http.StatusBadRequest)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listBackfills returns the progress of the backfills, including the ones never started.
+func (a *AdminHandler) listBackfills(w http.ResponseWriter, r *http.Request) {
+	statuses, err := a.backfills.Status(r.Context())
+	if err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, http.StatusInternalServerError)
+
+		return
+	}
+
+	res := BackfillsResponse{
+		Backfills: make([]Backfill, len(statuses)),
+	}
+
+	for i, status := range statuses {
+		res.Backfills[i] = Backfill{
+			Name:      status.Name,
+			Running:   status.Running,
+			Done:      status.Done,
+			Processed: status.Processed,
+			UpdatedAt: status.UpdatedAt,
+			Error:     status.Error,
+		}
+	}
+
+	renderResponse(w, res, http.StatusOK)
+}
+
+// startBackfill runs the backfill in the background, resuming after its last checkpoint.
+func (a *AdminHandler) startBackfill(w http.ResponseWriter, r *http.Request) {
+	if err := a.backfills.Start(mux.Vars(r)["name"]); err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, adminErrorStatus(err))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// cancelBackfill stops the running backfill after the batch being processed.
+func (a *AdminHandler) cancelBackfill(w http.ResponseWriter, r *http.Request) {
+	if err := a.backfills.Cancel(mux.Vars(r)["name"]); err != nil {
+		renderResponse(w, map[string]string{"error": err.Error()}, adminErrorStatus(err))
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func adminErrorStatus(err error) int {
+	var ierr *internaldomain.Error
+	if errors.As(err, &ierr) {
+		switch ierr.Code() {
+		case internaldomain.ErrorCodeNotFound:
+			return http.StatusNotFound
+		case internaldomain.ErrorCodeConflict:
+			return http.StatusConflict
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+func newFault(dep internaldomain.Dependency, fault memory.Fault) Fault {
+	return Fault{
+		Dependency: string(dep),
+		LatencyMS:  fault.Latency.Milliseconds(),
+		ErrorRate:  fault.ErrorRate,
+	}
+}
+
+func renderResponse(w http.ResponseWriter, res interface{}, status int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// newAdminServer instantiates the admin server using handler, requests are authenticated using auth.
+func newAdminServer(address string, handler *AdminHandler, auth internal.AdminAuth) *http.Server {
+	router := mux.NewRouter()
+	router.Use(auth.Middleware)
+
+	handler.Register(router)
+
+	return &http.Server{
+		Handler:           router,
+		Addr:              address,
+		TLSConfig:         auth.TLS,
+		ReadTimeout:       1 * time.Second,
+		ReadHeaderTimeout: 1 * time.Second,
+		WriteTimeout:      1 * time.Second,
+		IdleTimeout:       1 * time.Second,
+	}
+}
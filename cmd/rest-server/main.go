@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"database/sql"
 	"embed"
 	"errors"
 	"flag"
@@ -12,11 +13,12 @@ import (
 	"os/signal"
 	"syscall"
 	"time"
+	_ "time/tzdata" // Required for loading the Time-Zone header in the scratch image.
 
 	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/didip/tollbooth/v6"
 	"github.com/didip/tollbooth/v6/limiter"
-	esv7 "github.com/elastic/go-elasticsearch/v7"
+	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
 	rv8 "github.com/go-redis/redis/v8"
 	"github.com/gorilla/mux"
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -26,26 +28,52 @@ import (
 	// "github.com/MarioCarrion/todo-api/internal/kafka" .
 	"github.com/MarioCarrion/todo-api/cmd/internal"
 	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/backfill"
+	"github.com/MarioCarrion/todo-api/internal/breaker"
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+	"github.com/MarioCarrion/todo-api/internal/compat"
+	"github.com/MarioCarrion/todo-api/internal/diskqueue"
 	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
 	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/github"
 	"github.com/MarioCarrion/todo-api/internal/memcached"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/mysql"
+	"github.com/MarioCarrion/todo-api/internal/notify"
 	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/push"
 	"github.com/MarioCarrion/todo-api/internal/redis"
 	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/retention"
 	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/sqlite"
 )
 
 //go:embed static
 var content embed.FS
 
+// compatHeartbeat is the interval used for saving the heartbeats reporting the version of this instance.
+const compatHeartbeat = 10 * time.Second
+
+// trashPurgeInterval is the interval used for purging the deleted tasks kept in the trash past their retention.
+const trashPurgeInterval = time.Hour
+
 func main() {
-	var env, address string
+	var (
+		env, config, address, adminAddress, diagnosticsAddress string
+		dev                                                    bool
+	)
 
 	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.StringVar(&config, "config", "", "Configuration filename, YAML or JSON; environment variables override it")
 	flag.StringVar(&address, "address", ":9234", "HTTP Server Address")
+	flag.StringVar(&adminAddress, "admin-address", "127.0.0.1:9235", "Admin HTTP Server Address")
+	flag.StringVar(&diagnosticsAddress, "diagnostics-address", "",
+		"Diagnostics HTTP Server Address, exposes pprof and expvar when set; it must not be reachable publicly")
+	flag.BoolVar(&dev, "dev", false, "Development mode, tasks are kept in memory and no external dependency is used")
 	flag.Parse()
 
-	errC, err := run(env, address)
+	errC, err := run(env, config, address, adminAddress, diagnosticsAddress, dev)
 	if err != nil {
 		log.Fatalf("Couldn't run: %s", err)
 	}
@@ -55,14 +83,26 @@ func main() {
 	}
 }
 
-func run(env, address string) (<-chan error, error) {
-	logger, err := zap.NewProduction()
+func run(env, config, address, adminAddress, diagnosticsAddress string, dev bool) (<-chan error, error) {
+	logConf := zap.NewProductionConfig()
+
+	logger, err := logConf.Build()
 	if err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "logConf.Build")
 	}
 
-	if err := envvar.Load(env); err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	// The environment variables file is optional in development mode, default values are used instead.
+	if !dev || env != "" {
+		if err := envvar.Load(env); err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+		}
+	}
+
+	// Loaded after the environment variables file so those override the configuration file as well.
+	if config != "" {
+		if err := envvar.LoadFile(config); err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.LoadFile")
+		}
 	}
 
 	vault, err := internal.NewVaultProvider()
@@ -72,43 +112,102 @@ func run(env, address string) (<-chan error, error) {
 
 	conf := envvar.New(vault)
 
+	runtimeSettings, err := internal.NewRuntimeSettings(conf.Get)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRuntimeSettings")
+	}
+
+	// Runtime settings are read again from the files when receiving SIGHUP, see reloadRuntimeConfig.
+	runtimeConf := internal.NewRuntimeConfig(logConf.Level, runtimeSettings)
+
+	shutdown := internal.NewShutdown(logger)
+
 	//-
 
-	pool, err := internal.NewPostgreSQL(conf)
+	var (
+		srvConf    serverConfig
+		background []internal.Job
+	)
+
+	if dev {
+		if srvConf, err = newDevDependencies(); err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "newDevDependencies")
+		}
+
+		logger.Info("Running in development mode, tasks are kept in memory")
+	} else {
+		if err := internal.ValidateRESTServer(conf); err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.ValidateRESTServer")
+		}
+
+		if srvConf, background, err = newDependencies(conf, logger, shutdown, runtimeConf); err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "newDependencies")
+		}
+	}
+
+	queryLimits, err := internal.NewQueryLimits(conf)
 	if err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPostgreSQL")
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewQueryLimits")
 	}
 
-	esClient, err := internal.NewElasticSearch(conf)
+	ids, err := internal.NewIDGenerator(conf)
 	if err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewElasticSearch")
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewIDGenerator")
 	}
 
-	memcached, err := internal.NewMemcached(conf)
+	// Only PostgreSQL storing tasks as rows assigns the ids, the other datastores generate random UUIDs.
+	if ids == nil && (srvConf.DB == nil || srvConf.Storage.EventSourced) {
+		return nil, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+			"ids assigned by the database require PostgreSQL storing tasks as rows")
+	}
+
+	semantics, err := internal.NewRESTSemantics(conf)
 	if err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewMemcached")
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRESTSemantics")
 	}
 
-	// rmq, err := internal.NewRabbitMQ(conf)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("internal.NewRabbitMQ %w", err)
-	// }
+	debug, err := internal.NewRESTDebug(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRESTDebug")
+	}
 
-	// kafka, err := internal.NewKafkaProducer(conf)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("internal.NewKafka %w", err)
-	// }
+	timeZone, err := internal.NewRESTDefaultTimeZone(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRESTDefaultTimeZone")
+	}
 
-	rdb, err := internal.NewRedis(conf)
+	flags, err := internal.NewCompatFlags(conf)
 	if err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRedis")
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewCompatFlags")
 	}
 
-	//-
+	limits, err := internal.NewRESTLimits(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRESTLimits")
+	}
 
-	promExporter, err := internal.NewOTExporter(conf)
+	unversioned, err := internal.NewRESTUnversioned(conf)
 	if err != nil {
-		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewOTExporter")
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRESTUnversioned")
+	}
+
+	adminAuth, err := internal.NewAdminAuth(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewAdminAuth")
+	}
+
+	if err := adminAuth.Validate(adminAddress); err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "adminAuth.Validate")
+	}
+
+	trashRetention, err := internal.NewTrashRetention(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewTrashRetention")
+	}
+
+	webPush, err := internal.NewWebPush(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewWebPush")
 	}
 
 	logging := func(h http.Handler) http.Handler {
@@ -116,30 +215,96 @@ func run(env, address string) (<-chan error, error) {
 			logger.Info(r.Method,
 				zap.Time("time", time.Now()),
 				zap.String("url", r.URL.String()),
+				zap.String("request_id", internaldomain.RequestIDFromContext(r.Context())),
 			)
 
 			h.ServeHTTP(w, r)
+
+			if budget := internaldomain.BudgetFromContext(r.Context()); budget != nil {
+				logger.Info("Budget", budgetFields(r, budget)...)
+			}
 		})
 	}
 
+	inFlight := rest.NewInFlight()
+
 	//-
 
-	srv, err := newServer(serverConfig{
-		Address:       address,
-		DB:            pool,
-		ElasticSearch: esClient,
-		Metrics:       promExporter,
-		Middlewares:   []mux.MiddlewareFunc{otelmux.Middleware("todo-api-server"), logging},
-		Redis:         rdb,
-		Logger:        logger,
-		Memcached:     memcached,
-		// RabbitMQ:      rmq,
-		// Kafka:         kafka,
-	})
+	srvConf.Address = address
+	srvConf.Middlewares = []mux.MiddlewareFunc{
+		otelmux.Middleware("todo-api-server"),
+		rest.Metrics,
+		rest.RequestID,
+		rest.Negotiate,
+		rest.Recover(logger),
+		inFlight.Middleware,
+		rest.Limits(limits),
+		rest.Budget(limits.Timeout, debug || dev),
+		rest.Profiles,
+		rest.Humanize,
+		rest.DefaultTimeZone(timeZone),
+		rest.AnalyticsConsent,
+		logging,
+	}
+	srvConf.Logger = logger
+	srvConf.QueryLimits = queryLimits
+	srvConf.RequestLimits = limits
+	srvConf.Unversioned = unversioned
+	srvConf.IDs = ids
+	srvConf.Semantics = semantics
+	srvConf.Compat = internaldomain.NewCompatFlagSet(flags)
+	srvConf.Trash = newTaskTrashRepository(srvConf)
+	srvConf.WebPush = webPush
+	srvConf.Subscriptions = newPushSubscriptionRepository(srvConf)
+
+	srv, err := newServer(srvConf)
 	if err != nil {
 		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "newServer")
 	}
 
+	shutdown.Register(internal.ShutdownStageHTTP, "http", 5*time.Second, func(ctx context.Context) error {
+		srv.SetKeepAlivesEnabled(false)
+
+		return srv.Shutdown(ctx) //nolint: wrapcheck
+	})
+
+	// The heartbeats of this instance are removed once it stops serving traffic.
+	registry := compat.NewRegistry(logger, newInstanceRepository(srvConf), compatHeartbeat)
+
+	background = append(background, internal.Job{Name: "compat-heartbeat", Run: registry.Run})
+
+	shutdown.Register(internal.ShutdownStageHTTP, "compat", 5*time.Second, registry.Shutdown)
+
+	if trashRetention > 0 {
+		purger := retention.NewPurger(logger, srvConf.Trash, trashRetention, trashPurgeInterval)
+
+		background = append(background, internal.Job{Name: "trash-purge", Run: purger.Run})
+	}
+
+	if webPush != nil {
+		_, read, _ := newRepositories(srvConf)
+
+		reminder := push.NewReminder(logger, read, srvConf.Subscriptions,
+			push.NewPusher(&http.Client{Timeout: 10 * time.Second}, webPush.VAPID), webPush.Lead, webPush.Interval)
+
+		background = append(background, internal.Job{Name: "push-reminders", Run: reminder.Run})
+	}
+
+	if srvConf.CalendarSync != nil {
+		puller := calendar.NewPuller(logger, srvConf.CalendarSync, newTaskService(srvConf), srvConf.Calendar.Interval)
+
+		background = append(background, internal.Job{Name: "calendar-pull", Run: puller.Run})
+	}
+
+	if srvConf.GitHub != nil {
+		poller := github.NewPoller(logger, newIssueImportService(srvConf, newTaskService(srvConf)),
+			srvConf.GitHub.Repositories, srvConf.GitHub.Interval)
+
+		background = append(background, internal.Job{Name: "github-import", Run: poller.Run})
+	}
+
+	jobs := internal.NewJobs()
+
 	errC := make(chan error, 1)
 
 	ctx, stop := signal.NotifyContext(context.Background(),
@@ -147,27 +312,49 @@ func run(env, address string) (<-chan error, error) {
 		syscall.SIGTERM,
 		syscall.SIGQUIT)
 
+	// The admin server keeps serving while the other stages run, so draining can be inspected.
+	adminSrv := newAdminServer(adminAddress, &AdminHandler{
+		ctx:        ctx,
+		inFlight:   inFlight,
+		jobs:       jobs,
+		faults:     srvConf.Faults,
+		backfills:  srvConf.Backfills,
+		registry:   registry,
+		flags:      srvConf.Compat,
+		reconciler: srvConf.Reconciler,
+		cache:      srvConf.Memcached,
+		queue:      srvConf.DiskQueue,
+	}, adminAuth)
+
+	shutdown.Register(internal.ShutdownStageAdmin, "admin-http", 5*time.Second, adminSrv.Shutdown)
+
+	var diagnosticsSrv *http.Server
+
+	if diagnosticsAddress != "" {
+		diagnosticsSrv = internal.NewDiagnosticsServer(diagnosticsAddress)
+
+		shutdown.Register(internal.ShutdownStageAdmin, "diagnostics-http", 5*time.Second, diagnosticsSrv.Shutdown)
+	}
+
+	for _, job := range background {
+		jobs.Go(ctx, job)
+	}
+
+	go reloadRuntimeConfig(ctx, logger, runtimeConf, conf, env, config)
+
 	go func() {
 		<-ctx.Done()
 
 		logger.Info("Shutdown signal received")
 
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-
 		defer func() {
 			_ = logger.Sync()
 
-			pool.Close()
-			// rmq.Close()
-			rdb.Close()
 			stop()
-			cancel()
 			close(errC)
 		}()
 
-		srv.SetKeepAlivesEnabled(false)
-
-		if err := srv.Shutdown(ctxTimeout); err != nil { //nolint: contextcheck
+		if err := shutdown.Run(context.Background()); err != nil { //nolint: contextcheck
 			errC <- err
 		}
 
@@ -184,13 +371,475 @@ func run(env, address string) (<-chan error, error) {
 		}
 	}()
 
+	go func() {
+		logger.Info("Admin listening and serving", zap.String("address", adminAddress))
+
+		serve := adminSrv.ListenAndServe
+		if adminSrv.TLSConfig != nil {
+			serve = func() error { return adminSrv.ListenAndServeTLS("", "") }
+		}
+
+		if err := serve(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errC <- err
+		}
+	}()
+
+	if diagnosticsSrv != nil {
+		go func() {
+			logger.Info("Diagnostics listening and serving", zap.String("address", diagnosticsAddress))
+
+			if err := diagnosticsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				errC <- err
+			}
+		}()
+	}
+
 	return errC, nil
 }
 
+// reloadRuntimeConfig reads the runtime settings again every time SIGHUP is received until ctx is done, invalid
+// settings are logged and the ones in use are kept.
+func reloadRuntimeConfig(ctx context.Context, logger *zap.Logger, runtimeConf *internal.RuntimeConfig,
+	conf *envvar.Configuration, env, config string) {
+	hupC := make(chan os.Signal, 1)
+
+	signal.Notify(hupC, syscall.SIGHUP)
+	defer signal.Stop(hupC)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hupC:
+		}
+
+		settings, err := internal.ReadRuntimeSettings(conf, env, config)
+		if err != nil {
+			logger.Error("Reloading runtime settings failed, keeping the ones in use", zap.Error(err))
+
+			continue
+		}
+
+		runtimeConf.Update(settings)
+
+		logger.Info("Runtime settings reloaded",
+			zap.Stringer("log_level", settings.LogLevel),
+			zap.Float64("traces_sampler_ratio", settings.TracesSamplerRatio))
+	}
+}
+
+// budgetFields returns the time consumed by each dependency while handling the request, as log fields.
+func budgetFields(r *http.Request, budget *internaldomain.Budget) []zap.Field {
+	res := []zap.Field{
+		zap.String("request_id", internaldomain.RequestIDFromContext(r.Context())),
+		zap.Duration("elapsed", budget.Elapsed()),
+	}
+
+	for _, entry := range budget.Entries() {
+		res = append(res, zap.Duration("budget."+string(entry.Dependency), entry.Duration))
+
+		if budget.Limit() > 0 {
+			res = append(res, zap.Float64("budget."+string(entry.Dependency)+".percent", budget.Percent(entry.Duration)))
+		}
+	}
+
+	return res
+}
+
+// newDevDependencies instantiates the dependencies used in development mode: tasks are kept in memory, searched
+// in memory and their events are dropped; only metrics are exported.
+func newDevDependencies() (serverConfig, error) {
+	promExporter, err := internal.NewPrometheusExporter()
+	if err != nil {
+		return serverConfig{}, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPrometheusExporter")
+	}
+
+	store := memory.NewTask()
+	faults := memory.NewFaults()
+
+	// The dependencies replaced by the memory store can be simulated to be slow or failing using the admin server.
+	return serverConfig{
+		Memory:        store,
+		Faults:        faults,
+		SearchHealth:  memory.NewFaultyTask(store, faults),
+		Metrics:       promExporter,
+		MessageBroker: memory.NewFaultyDiscard(faults),
+	}, nil
+}
+
+// newDependencies instantiates the external dependencies using configuration defined in environment variables,
+// the returned jobs must run in the background until the server shuts down.
+// nolint: funlen, cyclop
+func newDependencies(conf *envvar.Configuration, logger *zap.Logger,
+	shutdown *internal.Shutdown, runtimeConf *internal.RuntimeConfig) (serverConfig, []internal.Job, error) {
+	driver, err := internal.NewDatabaseDriver(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewDatabaseDriver")
+	}
+
+	var (
+		pool     *pgxpool.Pool
+		sqliteDB *sql.DB
+		mysqlDB  *sql.DB
+		dbCreds  *internal.VaultDatabaseCredentials
+	)
+
+	switch driver {
+	case internal.DatabaseDriverSQLite:
+		if sqliteDB, err = internal.NewSQLite(conf); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewSQLite")
+		}
+
+		shutdown.Register(internal.ShutdownStageDatastores, "sqlite", 5*time.Second, func(context.Context) error {
+			return sqliteDB.Close() //nolint: wrapcheck
+		})
+	case internal.DatabaseDriverMySQL:
+		if mysqlDB, err = internal.NewMySQL(conf); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewMySQL")
+		}
+
+		shutdown.Register(internal.ShutdownStageDatastores, "mysql", 5*time.Second, func(context.Context) error {
+			return mysqlDB.Close() //nolint: wrapcheck
+		})
+	default:
+		// Short-lived credentials are generated by Vault when DATABASE_VAULT_ROLE is defined.
+		if dbCreds, err = internal.NewVaultDatabaseCredentials(conf, logger); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultDatabaseCredentials")
+		}
+
+		if pool, err = internal.NewPostgreSQLWithCredentials(conf, dbCreds); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPostgreSQLWithCredentials")
+		}
+
+		shutdown.RegisterFunc(internal.ShutdownStageDatastores, "postgresql", 5*time.Second, pool.Close)
+	}
+
+	migrateDB, err := internal.NewDatabaseMigrateEnabled(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewDatabaseMigrateEnabled")
+	}
+
+	if migrateDB {
+		if err := internal.MigrateDatabase(conf, driver); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.MigrateDatabase")
+		}
+	}
+
+	searchEngine, err := internal.NewSearchEngine(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewSearchEngine")
+	}
+
+	var (
+		esClient esv7api.Transport
+		esHealth *elasticsearch.Health
+	)
+
+	// Elasticsearch, or OpenSearch, is a soft dependency, search is disabled until the cluster is reachable.
+	if searchEngine != internal.SearchEnginePostgreSQL {
+		if esClient, err = internal.NewElasticSearchClient(conf); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewElasticSearchClient")
+		}
+
+		esHealth = elasticsearch.NewHealth(logger, esClient, 10*time.Second)
+	}
+
+	memcached, err := internal.NewMemcached(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewMemcached")
+	}
+
+	// rmq, err := internal.NewRabbitMQ(conf)
+	// if err != nil {
+	// 	return nil, fmt.Errorf("internal.NewRabbitMQ %w", err)
+	// }
+
+	// shutdown.RegisterFunc(internal.ShutdownStageDatastores, "rabbitmq", 5*time.Second, rmq.Close)
+
+	// kafka, err := internal.NewKafkaProducer(conf)
+	// if err != nil {
+	// 	return nil, fmt.Errorf("internal.NewKafka %w", err)
+	// }
+
+	rdb, err := internal.NewRedis(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewRedis")
+	}
+
+	shutdown.Register(internal.ShutdownStageDatastores, "redis", 5*time.Second, func(context.Context) error {
+		return rdb.Close() //nolint: wrapcheck
+	})
+
+	cloudEvents, err := internal.NewCloudEventsEnabled(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewCloudEventsEnabled")
+	}
+
+	readModel, err := internal.NewReadModelEnabled(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewReadModelEnabled")
+	}
+
+	storage, err := internal.NewTaskStorage(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewTaskStorage")
+	}
+
+	if searchEngine == internal.SearchEnginePostgreSQL && (driver != internal.DatabaseDriverPostgreSQL || storage.EventSourced) {
+		return serverConfig{}, nil, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+			"searching using PostgreSQL requires PostgreSQL storing tasks as rows")
+	}
+
+	if driver != internal.DatabaseDriverPostgreSQL && (readModel || storage.EventSourced) {
+		return serverConfig{}, nil, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+			"the read model and the event store require PostgreSQL")
+	}
+
+	changeFeed, err := internal.NewChangeFeedEnabled(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewChangeFeedEnabled")
+	}
+
+	if changeFeed && (driver != internal.DatabaseDriverPostgreSQL || storage.EventSourced) {
+		return serverConfig{}, nil, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+			"the change feed requires PostgreSQL storing tasks as rows")
+	}
+
+	reconcileInterval, err := internal.NewReconcileInterval(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewReconcileInterval")
+	}
+
+	if reconcileInterval > 0 && (driver != internal.DatabaseDriverPostgreSQL || esClient == nil) {
+		return serverConfig{}, nil, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+			"reconciling the indexed tasks requires PostgreSQL and Elasticsearch")
+	}
+
+	//-
+
+	metrics, err := internal.NewOTExporter(conf, runtimeConf.Sampler())
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewOTExporter")
+	}
+
+	// msgBroker, err := rabbitmq.NewTask(rmq.Channel, cloudEvents)
+	// if err != nil {
+	// 	return nil, fmt.Errorf("rabbitmq.NewTask %w", err)
+	// }
+
+	// var avro *kafka.AvroSerializer
+
+	// registry, err := internal.NewSchemaRegistry(conf)
+	// if err != nil {
+	// 	return nil, fmt.Errorf("internal.NewSchemaRegistry %w", err)
+	// }
+
+	// if registry != nil {
+	// 	if avro, err = kafka.NewAvroSerializer(context.Background(), registry, kafka.Topic); err != nil {
+	// 		return nil, fmt.Errorf("kafka.NewAvroSerializer %w", err)
+	// 	}
+	// }
+
+	// msgBroker := kafka.NewTask(kafka.Producer, kafka.Topic, cloudEvents, avro)
+
+	// snsClient, err := internal.NewSNS(conf)
+	// if err != nil {
+	// 	return nil, fmt.Errorf("internal.NewSNS %w", err)
+	// }
+
+	// msgBroker := sns.NewTask(snsClient.Client, snsClient.TopicARN, cloudEvents)
+
+	// ps, err := internal.NewPubSub(conf)
+	// if err != nil {
+	// 	return nil, fmt.Errorf("internal.NewPubSub %w", err)
+	// }
+
+	// msgBroker := pubsub.NewTask(ps.Topic, cloudEvents)
+
+	// Publishing fails right away while Redis is unavailable, instead of waiting for each message to time out.
+	var msgBroker service.TaskMessageBrokerRepository = breaker.NewTask(redis.NewTask(rdb, cloudEvents),
+		breaker.New(logger, "message-broker", internaldomain.DependencyRedis, 30*time.Second))
+
+	// Events are buffered on disk while the message broker is unavailable, when configured.
+	queue, err := internal.NewDiskQueue(conf, logger, msgBroker)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewDiskQueue")
+	}
+
+	if queue != nil {
+		msgBroker = queue
+
+		// Last attempt to publish the buffered events, those are kept on disk otherwise.
+		shutdown.Register(internal.ShutdownStageOutbox, "diskqueue", 5*time.Second, queue.Replay)
+	}
+
+	// Changes are notified once accepted by the message broker, or buffered.
+	notifier, err := internal.NewNotifier(conf, logger)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewNotifier")
+	}
+
+	if notifier != nil {
+		msgBroker = notify.NewTask(msgBroker, notifier)
+	}
+
+	// Tasks with due dates are mirrored in Google Calendar, the changes made to the events are pulled by run.
+	googleCalendar, err := internal.NewGoogleCalendar(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewGoogleCalendar")
+	}
+
+	var calendarSync *calendar.Sync
+
+	if googleCalendar != nil {
+		if pool == nil {
+			return serverConfig{}, nil, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+				"synchronizing Google Calendar requires PostgreSQL")
+		}
+
+		calendarSync = calendar.NewSync(logger, googleCalendar.Events, postgresql.NewCalendarLink(pool),
+			googleCalendar.CalendarID, googleCalendar.Conflicts, 1_000)
+
+		msgBroker = calendar.NewTask(msgBroker, calendarSync)
+	}
+
+	// Open issues are imported as tasks by run, and closed once their tasks are completed when configured.
+	gitHub, err := internal.NewGitHub(conf)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewGitHub")
+	}
+
+	var (
+		issueLinks  issueLinkRepository
+		issueCloser *github.Closer
+	)
+
+	if gitHub != nil {
+		issueLinks = newIssueLinkRepository(pool)
+
+		if gitHub.CloseIssues {
+			issueCloser = github.NewCloser(logger, gitHub.Client, issueLinks, 1_000)
+
+			msgBroker = github.NewTask(msgBroker, issueCloser)
+		}
+	}
+
+	analytics, err := newAnalytics(conf, shutdown)
+	if err != nil {
+		return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "newAnalytics")
+	}
+
+	//-
+
+	var (
+		background   []internal.Job
+		searchHealth rest.Availability
+	)
+
+	if dbCreds != nil {
+		background = append(background, internal.Job{Name: "vault-database-credentials", Run: dbCreds.Run})
+	}
+
+	if esHealth != nil {
+		searchHealth = esHealth
+
+		background = append(background, internal.Job{Name: "elasticsearch-health", Run: esHealth.Run})
+	} else {
+		searchHealth = postgresql.NewTaskSearch(pool)
+	}
+
+	if queue != nil {
+		background = append(background, internal.Job{Name: "diskqueue", Run: queue.Run})
+	}
+
+	if notifier != nil {
+		background = append(background, internal.Job{Name: "notifications", Run: notifier.Run})
+	}
+
+	if calendarSync != nil {
+		background = append(background, internal.Job{Name: "calendar-sync", Run: calendarSync.Run})
+	}
+
+	if issueCloser != nil {
+		background = append(background, internal.Job{Name: "github-close", Run: issueCloser.Run})
+	}
+
+	// Reconciling is also started using the admin server, even when it doesn't run periodically.
+	var reconciler *elasticsearch.Reconciler
+
+	if driver == internal.DatabaseDriverPostgreSQL && esClient != nil {
+		var source elasticsearch.TaskSource = postgresql.NewTask(pool)
+		if storage.EventSourced {
+			source = postgresql.NewTaskEventStore(pool, storage.SnapshotEvery)
+		}
+
+		reconciler = elasticsearch.NewReconciler(logger, esClient, source, reconcileInterval)
+
+		if reconcileInterval > 0 {
+			background = append(background, internal.Job{Name: "elasticsearch-reconciler", Run: reconciler.Run})
+		}
+	}
+
+	// Backfills are started and canceled using the admin server.
+	var backfills *backfill.Runner
+
+	if pool != nil {
+		if backfills, err = internal.NewBackfillRunner(conf, logger, postgresql.NewBackfillCheckpoint(pool)); err != nil {
+			return serverConfig{}, nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewBackfillRunner")
+		}
+
+		backfills.Register(backfill.Backfill{
+			Name: "task-events",
+			Step: postgresql.NewTaskEventStore(pool, storage.SnapshotEvery).BackfillStep,
+		})
+
+		shutdown.Register(internal.ShutdownStageJobs, "backfills", 10*time.Second, backfills.Shutdown)
+	}
+
+	// Changes are notified by the "tasks_changed" trigger and fanned out to the subscribers in this instance.
+	var changes *internaldomain.TaskChangeFeed
+
+	if changeFeed {
+		changes = internaldomain.NewTaskChangeFeed()
+
+		listener := postgresql.NewTaskListener(logger, pool, changes)
+
+		background = append(background, internal.Job{Name: "postgresql-listener", Run: listener.Run})
+	}
+
+	return serverConfig{
+		DB:            pool,
+		SQLite:        sqliteDB,
+		MySQL:         mysqlDB,
+		ElasticSearch: esClient,
+		SearchHealth:  searchHealth,
+		Metrics:       metrics,
+		Redis:         rdb,
+		Memcached:     memcached,
+		MessageBroker: msgBroker,
+		Analytics:     analytics,
+		ReadModel:     readModel,
+		Storage:       storage,
+		Changes:       changes,
+		Backfills:     backfills,
+		Reconciler:    reconciler,
+		DiskQueue:     queue,
+		Calendar:      googleCalendar,
+		CalendarSync:  calendarSync,
+		GitHub:        gitHub,
+		IssueLinks:    issueLinks,
+		// RabbitMQ:      rmq,
+		// Kafka:         kafka,
+	}, background, nil
+}
+
 type serverConfig struct {
 	Address       string
 	DB            *pgxpool.Pool
-	ElasticSearch *esv7.Client
+	SQLite        *sql.DB
+	MySQL         *sql.DB
+	ElasticSearch esv7api.Transport
+	SearchHealth  rest.Availability
 	Kafka         *internal.KafkaProducer
 	RabbitMQ      *internal.RabbitMQ
 	Redis         *rv8.Client
@@ -198,6 +847,43 @@ type serverConfig struct {
 	Metrics       http.Handler
 	Middlewares   []mux.MiddlewareFunc
 	Logger        *zap.Logger
+	QueryLimits   internaldomain.QueryLimits
+	RequestLimits rest.RequestLimits
+	Unversioned   rest.APIVersion
+	IDs           internaldomain.IDGenerator
+	MessageBroker service.TaskMessageBrokerRepository
+	Analytics     service.AnalyticsRepository
+	Semantics     rest.Semantics
+	ReadModel     bool
+	Storage       internal.TaskStorage
+	Changes       *internaldomain.TaskChangeFeed
+	Memory        *memory.Task
+	Faults        *memory.Faults
+	Backfills     *backfill.Runner
+	Reconciler    *elasticsearch.Reconciler
+	DiskQueue     *diskqueue.Task
+	Compat        *internaldomain.CompatFlagSet
+	Trash         service.TaskTrashRepository
+	WebPush       *internal.WebPush
+	Subscriptions pushSubscriptionRepository
+	Calendar      *internal.GoogleCalendar
+	CalendarSync  *calendar.Sync
+	GitHub        *internal.GitHub
+	IssueLinks    issueLinkRepository
+}
+
+// pushSubscriptionRepository defines the datastore keeping the push subscriptions, used by the handlers
+// registering them and by the reminders delivered to them.
+type pushSubscriptionRepository interface {
+	service.PushSubscriptionRepository
+	push.SubscriptionRepository
+}
+
+// issueLinkRepository defines the datastore keeping the links between issues and tasks, used by the service
+// importing issues and by the closer closing them.
+type issueLinkRepository interface {
+	service.IssueLinkRepository
+	github.LinkRepository
 }
 
 func newServer(conf serverConfig) (*http.Server, error) {
@@ -209,33 +895,68 @@ func newServer(conf serverConfig) (*http.Server, error) {
 
 	//-
 
-	repo := postgresql.NewTask(conf.DB)
-	mrepo := memcached.NewTask(conf.Memcached, repo, conf.Logger)
+	svc := newTaskService(conf)
 
-	search := elasticsearch.NewTask(conf.ElasticSearch)
-	msearch := memcached.NewSearchableTask(conf.Memcached, search)
+	rest.RegisterOpenAPI(router)
 
-	// XXX mclient := memcached.NewSearchableTask(conf.Memcached, search, conf.Logger)
-	// msgBroker, err := rabbitmq.NewTask(conf.RabbitMQ.Channel)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("rabbitmq.NewTask %w", err)
-	// }
+	tasks := rest.NewTaskHandler(svc, conf.SearchHealth, conf.Semantics)
+	projectsHandler := rest.NewProjectHandler(service.NewProject(newProjectRepository(conf), svc))
+
+	var pushHandler *rest.PushHandler
 
-	// msgBroker := kafka.NewTask(conf.Kafka.Producer, conf.Kafka.Topic)
+	// Browsers subscribe to the reminders using the VAPID public key.
+	if conf.WebPush != nil {
+		pushHandler = rest.NewPushHandler(service.NewPushSubscription(conf.Subscriptions),
+			conf.WebPush.VAPID.PublicKey)
+	}
+
+	var issueHandler *rest.IssueHandler
 
-	msgBroker := redis.NewTask(conf.Redis)
+	// Issues are imported on demand, in addition to the periodic imports.
+	if conf.GitHub != nil {
+		issueHandler = rest.NewIssueHandler(newIssueImportService(conf, svc))
+	}
 
-	svc := service.NewTask(conf.Logger, mrepo, msearch, msgBroker)
+	var ws *rest.WebSocketHandler
 
-	rest.RegisterOpenAPI(router)
-	rest.NewTaskHandler(svc).Register(router)
+	// The WebSocket API notifies the changes received from the change feed.
+	if conf.Changes != nil {
+		ws = rest.NewWebSocketHandler(svc, conf.Changes)
+	}
+
+	// Breaking changes are introduced in a new version, "/api/v2" for example, registered side by side with
+	// "/api/v1"; the routes not including the version are deprecated aliases of "/api/v1".
+	for _, version := range []rest.APIVersion{{Name: "v1"}, conf.Unversioned} {
+		v := rest.RegisterVersion(router, version)
+
+		tasks.Register(v)
+		projectsHandler.Register(v)
+
+		if pushHandler != nil {
+			pushHandler.Register(v)
+		}
+
+		if issueHandler != nil {
+			issueHandler.Register(v)
+		}
+
+		if ws != nil {
+			ws.Register(v)
+		}
+	}
 
 	//-
 
 	fsys, _ := fs.Sub(content, "static")
 	router.PathPrefix("/static/").Handler(http.StripPrefix("/static/", http.FileServer(http.FS(fsys))))
 
-	// r.Handle("/metrics", conf.Metrics)
+	router.Handle("/metrics", conf.Metrics)
+
+	rest.RegisterAllow(router)
+
+	if err := conf.RequestLimits.ValidateRoutes(router); err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "RequestLimits.ValidateRoutes")
+	}
 
 	//-
 
@@ -245,12 +966,225 @@ func newServer(conf serverConfig) (*http.Server, error) {
 
 	//-
 
-	return &http.Server{
+	srv := &http.Server{
 		Handler:           lmtmw,
 		Addr:              conf.Address,
-		ReadTimeout:       1 * time.Second,
-		ReadHeaderTimeout: 1 * time.Second,
-		WriteTimeout:      1 * time.Second,
+		ReadTimeout:       conf.RequestLimits.ReadHeaderTimeout + conf.RequestLimits.MaxTimeout(),
+		ReadHeaderTimeout: conf.RequestLimits.ReadHeaderTimeout,
+		WriteTimeout:      conf.RequestLimits.WriteTimeout(),
 		IdleTimeout:       1 * time.Second,
-	}, nil
+	}
+
+	// Upgraded connections are not closed by the server when shutting down.
+	if ws != nil {
+		srv.RegisterOnShutdown(ws.Close)
+	}
+
+	return srv, nil
+}
+
+// newTaskService instantiates the service used for managing tasks, used by the handlers and by the jobs applying
+// changes made elsewhere.
+func newTaskService(conf serverConfig) *service.Task {
+	repo, read, search := newRepositories(conf)
+
+	return service.NewTask(service.TaskConfig{
+		Logger:        conf.Logger,
+		Repo:          repo,
+		Read:          read,
+		Search:        search,
+		MessageBroker: conf.MessageBroker,
+		UnitOfWork:    newUnitOfWork(conf),
+		Limits:        conf.QueryLimits,
+		IDs:           conf.IDs,
+		Versions:      newTaskVersions(conf),
+		Analytics:     conf.Analytics,
+		Projects:      newProjectRepository(conf),
+		Flags:         conf.Compat,
+		Dependencies:  newTaskDependencyRepository(conf),
+		Trash:         conf.Trash,
+	})
+}
+
+// newIssueImportService instantiates the service used for importing the issues of the configured repositories.
+func newIssueImportService(conf serverConfig, tasks *service.Task) *service.IssueImport {
+	return service.NewIssueImport(conf.GitHub.Client, conf.IssueLinks, tasks, conf.GitHub.Repositories,
+		conf.GitHub.LabelPriorities)
+}
+
+// newAnalytics returns the sink receiving product analytics events, nil when not configured.
+func newAnalytics(conf *envvar.Configuration, shutdown *internal.Shutdown) (service.AnalyticsRepository, error) {
+	sink, err := internal.NewAnalyticsSink(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewAnalyticsSink")
+	}
+
+	switch sink {
+	case internal.AnalyticsSinkSegment:
+		analytics, err := internal.NewSegmentAnalytics(conf)
+		if err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewSegmentAnalytics")
+		}
+
+		return analytics, nil
+	case internal.AnalyticsSinkKafka:
+		analytics, err := internal.NewKafkaAnalytics(conf)
+		if err != nil {
+			return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewKafkaAnalytics")
+		}
+
+		shutdown.RegisterFunc(internal.ShutdownStageOutbox, "kafka-analytics", 5*time.Second, func() {
+			analytics.Producer.Flush(5000)
+			analytics.Producer.Close()
+		})
+
+		return analytics.Analytics, nil
+	}
+
+	return nil, nil
+}
+
+// newUnitOfWork returns the datastore used for running repository calls in a single transaction, nil when it's not
+// supported; the event store already appends events in their own transactions.
+func newUnitOfWork(conf serverConfig) service.UnitOfWork {
+	if conf.DB == nil || conf.Storage.EventSourced {
+		return nil
+	}
+
+	return postgresql.NewUnitOfWork(conf.DB)
+}
+
+// newTaskVersions returns the datastore used for reading previous versions of tasks, nil when it's not supported;
+// only PostgreSQL storing tasks as rows keeps them.
+func newTaskVersions(conf serverConfig) service.TaskVersionRepository {
+	if conf.DB == nil || conf.Storage.EventSourced {
+		return nil
+	}
+
+	return postgresql.NewTask(conf.DB)
+}
+
+// newInstanceRepository returns the repository used for keeping the heartbeats of the instances, only PostgreSQL
+// shares them with other instances.
+func newInstanceRepository(conf serverConfig) compat.InstanceRepository {
+	if conf.DB == nil {
+		return memory.NewInstance()
+	}
+
+	return postgresql.NewInstance(conf.DB)
+}
+
+// newProjectRepository returns the repository used for storing projects, those are kept in the same datastore as
+// tasks.
+func newProjectRepository(conf serverConfig) service.ProjectRepository {
+	switch {
+	case conf.Memory != nil:
+		return memory.NewProject()
+	case conf.SQLite != nil:
+		return sqlite.NewProject(conf.SQLite)
+	case conf.MySQL != nil:
+		return mysql.NewProject(conf.MySQL)
+	default:
+		return postgresql.NewProject(conf.DB)
+	}
+}
+
+// newPushSubscriptionRepository returns the repository used for keeping the push subscriptions, only PostgreSQL
+// shares them with other instances.
+func newPushSubscriptionRepository(conf serverConfig) pushSubscriptionRepository {
+	if conf.DB == nil {
+		return memory.NewPushSubscription()
+	}
+
+	return postgresql.NewPushSubscription(conf.DB)
+}
+
+// newIssueLinkRepository returns the repository used for keeping the links between issues and tasks, only
+// PostgreSQL shares them with other instances so each issue is imported once.
+func newIssueLinkRepository(pool *pgxpool.Pool) issueLinkRepository {
+	if pool == nil {
+		return memory.NewIssueLink()
+	}
+
+	return postgresql.NewIssueLink(pool)
+}
+
+// newTaskDependencyRepository returns the repository used for storing the dependencies between tasks, those are
+// kept in the same datastore as tasks.
+func newTaskDependencyRepository(conf serverConfig) service.TaskDependencyRepository {
+	switch {
+	case conf.Memory != nil:
+		return memory.NewTaskDependency()
+	case conf.SQLite != nil:
+		return sqlite.NewTaskDependency(conf.SQLite)
+	case conf.MySQL != nil:
+		return mysql.NewTaskDependency(conf.MySQL)
+	default:
+		return postgresql.NewTaskDependency(conf.DB)
+	}
+}
+
+// newTaskTrashRepository returns the repository used for keeping the deleted tasks, those are kept in the same
+// datastore as tasks.
+func newTaskTrashRepository(conf serverConfig) service.TaskTrashRepository {
+	switch {
+	case conf.Memory != nil:
+		return memory.NewTaskTrash()
+	case conf.SQLite != nil:
+		return sqlite.NewTaskTrash(conf.SQLite)
+	case conf.MySQL != nil:
+		return mysql.NewTaskTrash(conf.MySQL)
+	default:
+		return postgresql.NewTaskTrash(conf.DB)
+	}
+}
+
+// newRepositories returns the repositories used for modifying, reading and searching tasks.
+func newRepositories(conf serverConfig) (service.TaskRepository, service.TaskReadRepository, service.TaskSearchRepository) {
+	// Caching is not needed when tasks are already kept in memory.
+	if conf.Memory != nil {
+		if conf.Faults != nil {
+			task := memory.NewFaultyTask(conf.Memory, conf.Faults)
+
+			return task, task, task
+		}
+
+		return conf.Memory, conf.Memory, conf.Memory
+	}
+
+	var repo memcached.TaskStore
+
+	switch {
+	case conf.SQLite != nil:
+		repo = sqlite.NewTask(conf.SQLite)
+	case conf.MySQL != nil:
+		repo = mysql.NewTask(conf.MySQL)
+	case conf.Storage.EventSourced:
+		repo = postgresql.NewTaskEventStore(conf.DB, conf.Storage.SnapshotEvery)
+	default:
+		repo = postgresql.NewTask(conf.DB)
+	}
+
+	mrepo := memcached.NewTask(conf.Memcached, repo, conf.Logger)
+
+	// Searching PostgreSQL is not cached, results include the latest changes like reading tasks does.
+	var msearch service.TaskSearchRepository
+
+	if conf.ElasticSearch != nil {
+		search := elasticsearch.NewTask(conf.ElasticSearch)
+		// msearch := memcached.NewSearchableTask(conf.Memcached, search)
+		msearch = redis.NewSearchableTask(conf.Logger, conf.Redis, search, 5*time.Second, 30*time.Second)
+	} else {
+		msearch = postgresql.NewTaskSearch(conf.DB)
+	}
+
+	// XXX mclient := memcached.NewSearchableTask(conf.Memcached, search, conf.Logger)
+
+	// Reads use the read model projected from the task events when enabled, those are eventually consistent.
+	var read service.TaskReadRepository = mrepo
+	if conf.ReadModel {
+		read = postgresql.NewTaskReadModel(conf.DB)
+	}
+
+	return mrepo, read, msearch
 }
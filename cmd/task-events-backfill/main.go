@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/backfill"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func main() {
+	var env string
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.Parse()
+
+	if err := run(env); err != nil {
+		log.Fatalf("Couldn't backfill: %s", err)
+	}
+}
+
+func run(env string) error {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+	}
+
+	defer func() {
+		_ = logger.Sync()
+	}()
+
+	if err := envvar.Load(env); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	pool, err := internal.NewPostgreSQL(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPostgreSQL")
+	}
+
+	defer pool.Close()
+
+	storage, err := internal.NewTaskStorage(conf)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewTaskStorage")
+	}
+
+	runner, err := internal.NewBackfillRunner(conf, logger, postgresql.NewBackfillCheckpoint(pool))
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewBackfillRunner")
+	}
+
+	runner.Register(backfill.Backfill{
+		Name: "task-events",
+		Step: postgresql.NewTaskEventStore(pool, storage.SnapshotEvery).BackfillStep,
+	})
+
+	//-
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+	defer stop()
+
+	// Progress is checkpointed, running it again after an interruption resumes after the last processed batch.
+	if err := runner.Run(ctx, "task-events"); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "runner.Run")
+	}
+
+	return nil
+}
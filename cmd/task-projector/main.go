@@ -0,0 +1,262 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/cmd/internal"
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	internalkafka "github.com/MarioCarrion/todo-api/internal/kafka"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func main() {
+	var env string
+
+	flag.StringVar(&env, "env", "", "Environment Variables filename")
+	flag.Parse()
+
+	errC, err := run(env)
+	if err != nil {
+		log.Fatalf("Couldn't run: %s", err)
+	}
+
+	if err := <-errC; err != nil {
+		log.Fatalf("Error while running: %s", err)
+	}
+}
+
+func run(env string) (<-chan error, error) {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "zap.NewProduction")
+	}
+
+	if err := envvar.Load(env); err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "envvar.Load")
+	}
+
+	vault, err := internal.NewVaultProvider()
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewVaultProvider")
+	}
+
+	conf := envvar.New(vault)
+
+	//-
+
+	pool, err := internal.NewPostgreSQL(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewPostgreSQL")
+	}
+
+	kafka, err := internal.NewKafkaConsumer(conf, "task-projector")
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewKafkaConsumer")
+	}
+
+	registry, err := internal.NewSchemaRegistry(conf)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewSchemaRegistry")
+	}
+
+	var avro *internalkafka.AvroDeserializer
+
+	if registry != nil {
+		avro = internalkafka.NewAvroDeserializer(registry)
+	}
+
+	//-
+
+	_, err = internal.NewOTExporter(conf, nil)
+	if err != nil {
+		return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "internal.NewOTExporter")
+	}
+
+	//-
+
+	srv := &Server{
+		logger:    logger,
+		kafka:     kafka,
+		decoder:   internalkafka.NewEventDecoder(avro),
+		readModel: postgresql.NewTaskReadModel(pool),
+		doneC:     make(chan struct{}),
+		closeC:    make(chan struct{}),
+	}
+
+	shutdown := internal.NewShutdown(logger)
+	shutdown.Register(internal.ShutdownStageConsumers, "kafka-consumer", 10*time.Second, srv.Shutdown)
+	shutdown.Register(internal.ShutdownStageConsumers, "kafka-unsubscribe", 5*time.Second, func(context.Context) error {
+		return kafka.Consumer.Unsubscribe() //nolint: wrapcheck
+	})
+	shutdown.RegisterFunc(internal.ShutdownStageDatastores, "postgresql", 5*time.Second, pool.Close)
+
+	errC := make(chan error, 1)
+
+	ctx, stop := signal.NotifyContext(context.Background(),
+		os.Interrupt,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+
+	go func() {
+		<-ctx.Done()
+
+		logger.Info("Shutdown signal received")
+
+		defer func() {
+			_ = logger.Sync()
+
+			stop()
+			close(errC)
+		}()
+
+		if err := shutdown.Run(context.Background()); err != nil { //nolint: contextcheck
+			errC <- err
+		}
+
+		logger.Info("Shutdown completed")
+	}()
+
+	go func() {
+		logger.Info("Listening and serving")
+
+		if err := srv.ListenAndServe(); err != nil {
+			errC <- err
+		}
+	}()
+
+	return errC, nil
+}
+
+// Server projects the task events into the read model.
+type Server struct {
+	logger    *zap.Logger
+	kafka     *internal.KafkaConsumer
+	decoder   *internalkafka.EventDecoder
+	readModel *postgresql.TaskReadModel
+	doneC     chan struct{}
+	closeC    chan struct{}
+}
+
+// ListenAndServe ...
+func (s *Server) ListenAndServe() error {
+	commit := func(msg *kafka.Message) {
+		if _, err := s.kafka.Consumer.CommitMessage(msg); err != nil {
+			s.logger.Error("commit failed", zap.Error(err))
+		}
+	}
+
+	go func() {
+		run := true
+
+		for run {
+			select {
+			case <-s.closeC:
+				run = false
+
+				break
+			default:
+				msg, ok := s.kafka.Consumer.Poll(150).(*kafka.Message)
+				if !ok {
+					continue
+				}
+
+				evt, err := s.decoder.Decode(context.Background(), msg.Value)
+				if err != nil {
+					s.logger.Info("Ignoring message, invalid", zap.Error(err))
+					commit(msg)
+
+					continue
+				}
+
+				// Events must be projected in order, failures are retried until those succeed.
+				if !s.project(evt) {
+					continue // Shutting down, the message is projected again once the server restarts.
+				}
+
+				s.logger.Info("Projected", zap.String("type", evt.Type))
+				commit(msg)
+			}
+		}
+
+		s.logger.Info("No more messages to consume. Exiting.")
+
+		s.doneC <- struct{}{}
+	}()
+
+	return nil
+}
+
+// project applies the event to the read model retrying failures using exponential backoff, it returns false when
+// the server is shutting down before the event was projected.
+func (s *Server) project(evt internalkafka.Event) bool {
+	const maxBackoff = 30 * time.Second
+
+	backoff := 100 * time.Millisecond
+
+	for {
+		var err error
+
+		switch evt.Type {
+		case "tasks.event.updated", "tasks.event.created":
+			err = s.readModel.Save(context.Background(), evt.Value)
+		case "tasks.event.deleted":
+			err = s.readModel.Delete(context.Background(), evt.Value.ID)
+		case "tasks.event.completed", "tasks.event.reopened":
+			// The read model is saved when handling the "updated" event published before.
+		default:
+			internaldomain.SkipUnrecognizedTaskEvent(context.Background(), evt.Type)
+
+			s.logger.Info("Ignoring message, unknown type", zap.String("type", evt.Type))
+
+			return true
+		}
+
+		if err == nil {
+			return true
+		}
+
+		var ierr *internaldomain.Error
+		if errors.As(err, &ierr) && ierr.Code() == internaldomain.ErrorCodeInvalidArgument {
+			s.logger.Info("Ignoring message, invalid", zap.Error(err))
+
+			return true
+		}
+
+		s.logger.Warn("Projecting failed, retrying", zap.Duration("backoff", backoff), zap.Error(err))
+
+		select {
+		case <-s.closeC:
+			return false
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// Shutdown ...
+func (s *Server) Shutdown(ctx context.Context) error {
+	s.logger.Info("Shutting down server")
+
+	close(s.closeC)
+
+	select {
+	case <-ctx.Done():
+		return internaldomain.WrapErrorf(ctx.Err(), internaldomain.ErrorCodeUnknown, "context.Done")
+	case <-s.doneC:
+		return nil
+	}
+}
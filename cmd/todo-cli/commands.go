@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"strings"
+	"time"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+// exportPageSize is the number of tasks requested per page when exporting.
+const exportPageSize = 100
+
+func create(ctx context.Context, app *app, args []string) error {
+	var (
+		req                 openapi3.CreateTasksRequest
+		priority, due, proj string
+	)
+
+	fs := flag.NewFlagSet("create", flag.ContinueOnError)
+	fs.StringVar(&priority, "priority", "", "Priority: none, low, medium or high")
+	fs.StringVar(&due, "due", "", "Due date, RFC3339")
+	fs.StringVar(&proj, "project", "", "Id of the project")
+
+	if err := fs.Parse(args); err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	description := strings.Join(fs.Args(), " ")
+	if description == "" {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument, "usage: create [flags] <description>")
+	}
+
+	req.Description = &description
+
+	if priority != "" {
+		p := openapi3.Priority(priority)
+		req.Priority = &p
+	}
+
+	if due != "" {
+		t, err := time.Parse(time.RFC3339, due)
+		if err != nil {
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "invalid due")
+		}
+
+		req.Dates = &openapi3.Dates{Due: &t}
+	}
+
+	if proj != "" {
+		req.ProjectId = &proj
+	}
+
+	task, err := app.client.CreateTask(ctx, req)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "create")
+	}
+
+	return app.printer.Task(task)
+}
+
+func list(ctx context.Context, app *app, args []string) error {
+	var (
+		params       openapi3.ListTaskParams
+		cursor, proj string
+		size         int64
+		overdue      bool
+	)
+
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.Int64Var(&size, "size", 20, "Number of tasks per page")
+	fs.StringVar(&cursor, "cursor", "", "Cursor of the page, returned when listing the previous one")
+	fs.StringVar(&proj, "project", "", "Only list the tasks of this project")
+	fs.BoolVar(&overdue, "overdue", false, "Only list undone tasks whose due date passed")
+
+	if err := fs.Parse(args); err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	params.Size = &size
+
+	if cursor != "" {
+		params.Cursor = &cursor
+	}
+
+	if proj != "" {
+		params.ProjectId = &proj
+	}
+
+	if overdue {
+		params.Overdue = &overdue
+	}
+
+	res, err := app.client.ListTasks(ctx, params)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "list")
+	}
+
+	var next string
+	if res.NextCursor != nil {
+		next = *res.NextCursor
+	}
+
+	return app.printer.Tasks(tasksOrEmpty(res.Tasks), next)
+}
+
+func complete(ctx context.Context, app *app, args []string) error {
+	if len(args) != 1 {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument, "usage: complete <id>")
+	}
+
+	task, err := app.client.CompleteTask(ctx, args[0])
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "complete")
+	}
+
+	return app.printer.Task(task)
+}
+
+func search(ctx context.Context, app *app, args []string) error {
+	var (
+		req  openapi3.SearchTasksRequest
+		size int64
+		done string
+	)
+
+	fs := flag.NewFlagSet("search", flag.ContinueOnError)
+	fs.Int64Var(&size, "size", 20, "Maximum number of tasks")
+	fs.StringVar(&done, "done", "", "Only match done, true, or undone, false, tasks")
+
+	if err := fs.Parse(args); err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	description := strings.Join(fs.Args(), " ")
+	if description == "" {
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument, "usage: search [flags] <terms>")
+	}
+
+	req.Description = &description
+	req.Size = &size
+
+	switch done {
+	case "":
+	case "true", "false":
+		isDone := done == "true"
+		req.IsDone = &isDone
+	default:
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument, "invalid done, must be true or false")
+	}
+
+	res, err := app.client.SearchTasks(ctx, req)
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "search")
+	}
+
+	return app.printer.Tasks(tasksOrEmpty(res.Tasks), "")
+}
+
+// export writes all the tasks, one page at a time, as JSON lines or CSV; the output mode is not used because the
+// tasks are streamed instead of printed at once.
+func export(ctx context.Context, app *app, args []string) error {
+	var format string
+
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	fs.StringVar(&format, "format", "jsonl", "Format: jsonl or csv")
+
+	if err := fs.Parse(args); err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	var write func(openapi3.Task) error
+
+	switch format {
+	case "jsonl":
+		enc := json.NewEncoder(app.printer.w)
+
+		write = func(task openapi3.Task) error { return enc.Encode(task) } //nolint: wrapcheck
+	case "csv":
+		w := csv.NewWriter(app.printer.w)
+		defer w.Flush()
+
+		if err := w.Write(taskColumns); err != nil {
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "w.Write")
+		}
+
+		write = func(task openapi3.Task) error { return w.Write(taskRow(task)) } //nolint: wrapcheck
+	default:
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+			"invalid format %q, must be either jsonl or csv", format)
+	}
+
+	size := int64(exportPageSize)
+	params := openapi3.ListTaskParams{Size: &size}
+
+	for {
+		res, err := app.client.ListTasks(ctx, params)
+		if err != nil {
+			return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "list")
+		}
+
+		for _, task := range tasksOrEmpty(res.Tasks) {
+			if err := write(task); err != nil {
+				return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "write")
+			}
+		}
+
+		if res.NextCursor == nil || *res.NextCursor == "" {
+			return nil
+		}
+
+		params.Cursor = res.NextCursor
+	}
+}
+
+func tasksOrEmpty(tasks *[]openapi3.Task) []openapi3.Task {
+	if tasks == nil {
+		return nil
+	}
+
+	return *tasks
+}
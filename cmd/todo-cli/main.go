@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/pkg/client"
+)
+
+const (
+	defaultAddress = "http://127.0.0.1:9234/api/v1"
+
+	addressKey = "TODO_API_ADDRESS"
+	tokenKey   = "TODO_API_TOKEN"
+)
+
+const usage = `Usage: todo-cli [flags] <command> [command flags]
+
+Commands:
+  create    Creates a task
+  list      Lists tasks, one page at a time
+  complete  Marks a task as done
+  search    Searches indexed tasks
+  export    Writes all the tasks as JSON lines or CSV
+
+Flags:
+`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		}
+
+		os.Exit(1)
+	}
+}
+
+// commands are the supported commands indexed by name.
+//nolint: gochecknoglobals
+var commands = map[string]func(ctx context.Context, app *app, args []string) error{
+	"create":   create,
+	"list":     list,
+	"complete": complete,
+	"search":   search,
+	"export":   export,
+}
+
+// app is the state shared by all the commands.
+type app struct {
+	client  *client.Client
+	printer printer
+}
+
+func run(args []string) error {
+	var (
+		config, address, token, output string
+		timeout                        time.Duration
+	)
+
+	fs := flag.NewFlagSet("todo-cli", flag.ContinueOnError)
+	fs.StringVar(&config, "config", defaultConfig(),
+		"Configuration file, YAML or JSON, defining "+addressKey+" and "+tokenKey+"; ignored when missing")
+	fs.StringVar(&address, "address", "", "URL of the API including the version, "+addressKey+" by default")
+	fs.StringVar(&token, "token", "", "Bearer token sent to the API, "+tokenKey+" by default")
+	fs.StringVar(&output, "output", "table", "Output mode: table or json")
+	fs.DurationVar(&timeout, "timeout", 30*time.Second, "Time available for running the command")
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	cmd, ok := commands[fs.Arg(0)]
+	if !ok {
+		fs.Usage()
+
+		return internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument, "unknown command %q", fs.Arg(0))
+	}
+
+	settings, err := loadSettings(config)
+	if err != nil {
+		return err
+	}
+
+	if address == "" {
+		address = settings[addressKey]
+	}
+
+	if token == "" {
+		token = settings[tokenKey]
+	}
+
+	p, err := newPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(address,
+		client.WithHTTPClient(&http.Client{Transport: bearerTransport{token: token, next: http.DefaultTransport}}))
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "client.New")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return cmd(ctx, &app{client: c, printer: p}, fs.Args()[1:])
+}
+
+// defaultConfig returns the path of the configuration file used by default, "~/.config/todo-cli/config.yaml".
+func defaultConfig() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "todo-cli", "config.yaml")
+}
+
+// loadSettings returns the address and token, the environment variables override the configuration file.
+func loadSettings(config string) (map[string]string, error) {
+	if config != "" {
+		if _, err := os.Stat(config); err == nil {
+			if err := envvar.LoadFile(config); err != nil {
+				return nil, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "envvar.LoadFile")
+			}
+		}
+	}
+
+	res := map[string]string{
+		addressKey: os.Getenv(addressKey),
+		tokenKey:   os.Getenv(tokenKey),
+	}
+
+	if res[addressKey] == "" {
+		res[addressKey] = defaultAddress
+	}
+
+	return res, nil
+}
+
+// bearerTransport authenticates the requests using the token, when defined.
+type bearerTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.token == "" {
+		return t.next.RoundTrip(req) //nolint: wrapcheck
+	}
+
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	return t.next.RoundTrip(req) //nolint: wrapcheck
+}
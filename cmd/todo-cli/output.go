@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+// printer writes the results of the commands using the output mode: a table meant for people or JSON meant for
+// other programs.
+type printer struct {
+	w    io.Writer
+	json bool
+}
+
+func newPrinter(output string) (printer, error) {
+	switch output {
+	case "table":
+		return printer{w: os.Stdout}, nil
+	case "json":
+		return printer{w: os.Stdout, json: true}, nil
+	}
+
+	return printer{}, internaldomain.NewErrorf(internaldomain.ErrorCodeInvalidArgument,
+		"invalid output %q, must be either table or json", output)
+}
+
+// Task writes the task.
+func (p printer) Task(task openapi3.Task) error {
+	if p.json {
+		return p.encode(openapi3.ReadTasksResponse{Task: &task})
+	}
+
+	return p.table([]openapi3.Task{task}, "")
+}
+
+// Tasks writes the tasks, next is the cursor of the next page when there are more results.
+func (p printer) Tasks(tasks []openapi3.Task, next string) error {
+	if p.json {
+		res := openapi3.ListTasksResponse{Tasks: &tasks}
+		if next != "" {
+			res.NextCursor = &next
+		}
+
+		return p.encode(res)
+	}
+
+	return p.table(tasks, next)
+}
+
+func (p printer) encode(val interface{}) error {
+	enc := json.NewEncoder(p.w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(val); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "json.Encode")
+	}
+
+	return nil
+}
+
+func (p printer) table(tasks []openapi3.Task, next string) error {
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ID\tDESCRIPTION\tPRIORITY\tDONE\tDUE")
+
+	for _, task := range tasks {
+		row := taskRow(task)
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", row[0], row[1], row[2], row[3], row[4])
+	}
+
+	if err := tw.Flush(); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "tw.Flush")
+	}
+
+	if next != "" {
+		fmt.Fprintf(p.w, "\nMore results: --cursor %s\n", next)
+	}
+
+	return nil
+}
+
+// taskColumns are the columns of the rows returned by taskRow.
+//nolint: gochecknoglobals
+var taskColumns = []string{"id", "description", "priority", "is_done", "due"}
+
+// taskRow returns the values of the task printed in tables and exported to CSV, see taskColumns.
+func taskRow(task openapi3.Task) []string {
+	var (
+		id, description, priority, due string
+		done                           bool
+	)
+
+	if task.Id != nil {
+		id = *task.Id
+	}
+
+	if task.Description != nil {
+		description = *task.Description
+	}
+
+	if task.Priority != nil {
+		priority = string(*task.Priority)
+	}
+
+	if task.IsDone != nil {
+		done = *task.IsDone
+	}
+
+	if task.Dates != nil && task.Dates.Due != nil && !task.Dates.Due.IsZero() {
+		due = task.Dates.Due.Format(time.RFC3339)
+	}
+
+	return []string{id, description, priority, strconv.FormatBool(done), due}
+}
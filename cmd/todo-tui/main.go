@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/pkg/client"
+)
+
+func main() {
+	var (
+		address, token  string
+		snooze, refresh time.Duration
+	)
+
+	flag.StringVar(&address, "address", envOr("TODO_API_ADDRESS", "http://127.0.0.1:9234/api/v1"),
+		"URL of the API including the version")
+	flag.StringVar(&token, "token", os.Getenv("TODO_API_TOKEN"), "Bearer token sent to the API")
+	flag.DurationVar(&snooze, "snooze", 24*time.Hour, "Time the due date is postponed when snoozing a task")
+	flag.DurationVar(&refresh, "refresh", 5*time.Second, "How often tasks are reloaded when changes are not streamed")
+	flag.Parse()
+
+	if err := run(address, token, snooze, refresh); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(address, token string, snooze, refresh time.Duration) error {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+
+	c, err := client.New(address, client.WithHTTPClient(&http.Client{Transport: headerTransport{header: header}}))
+	if err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "client.New")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	msgs := make(chan interface{})
+
+	go stream(ctx, address, header, msgs)
+
+	if err := tea.NewProgram(newModel(ctx, c, msgs, snooze, refresh), tea.WithAltScreen()).Start(); err != nil {
+		return internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnknown, "tea.Start")
+	}
+
+	return nil
+}
+
+// headerTransport adds the headers, like the bearer token, to the requests.
+type headerTransport struct {
+	header http.Header
+}
+
+func (t headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+
+	for key, vals := range t.header {
+		req.Header[key] = vals
+	}
+
+	return http.DefaultTransport.RoundTrip(req) //nolint: wrapcheck
+}
+
+func envOr(key, def string) string {
+	if val := os.Getenv(key); val != "" {
+		return val
+	}
+
+	return def
+}
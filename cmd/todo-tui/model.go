@@ -0,0 +1,343 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/pkg/client"
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+const (
+	// pageSize is the maximum number of tasks loaded, the first ones listed.
+	pageSize = 50
+
+	// requestTimeout is the time available for each request sent to the API.
+	requestTimeout = 10 * time.Second
+)
+
+// tasksMsg replaces the displayed tasks.
+type tasksMsg []openapi3.Task
+
+// taskMsg replaces, or adds, the displayed task.
+type taskMsg openapi3.Task
+
+// deletedMsg removes the displayed task.
+type deletedMsg string
+
+// errMsg notifies a command failed.
+type errMsg struct {
+	action string
+	err    error
+}
+
+// tickMsg triggers reloading the tasks while the changes are not received.
+type tickMsg time.Time
+
+// model is the state of the dashboard: the tasks are kept up to date using the changes received from the stream,
+// or polled every refresh when it's not available.
+type model struct {
+	ctx     context.Context //nolint: containedctx
+	client  *client.Client
+	msgs    <-chan interface{}
+	snooze  time.Duration
+	refresh time.Duration
+
+	tasks  []openapi3.Task
+	cursor int
+	live   bool
+	status string
+}
+
+func newModel(ctx context.Context, c *client.Client, msgs <-chan interface{}, snooze, refresh time.Duration) model {
+	return model{
+		ctx:     ctx,
+		client:  c,
+		msgs:    msgs,
+		snooze:  snooze,
+		refresh: refresh,
+		status:  "connecting...",
+	}
+}
+
+func (m model) Init() tea.Cmd {
+	return tea.Batch(m.load(), m.wait(), m.tick())
+}
+
+//nolint: cyclop
+func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		return m.key(msg)
+	case tasksMsg:
+		m.tasks = msg
+		m.sort()
+	case taskMsg:
+		m.upsert(openapi3.Task(msg))
+	case deletedMsg:
+		m.remove(string(msg))
+	case changeMsg:
+		return m, tea.Batch(m.change(msg), m.wait())
+	case streamStatusMsg:
+		m.live = msg.live
+		m.status = "live"
+
+		if msg.err != nil {
+			m.status = fmt.Sprintf("polling every %s, stream unavailable: %s", m.refresh, msg.err)
+		}
+
+		// Changes missed while disconnected are picked up by reloading the tasks.
+		return m, tea.Batch(m.load(), m.wait())
+	case tickMsg:
+		if m.live {
+			return m, m.tick()
+		}
+
+		return m, tea.Batch(m.load(), m.tick())
+	case errMsg:
+		m.status = fmt.Sprintf("%s failed: %s", msg.action, msg.err)
+	}
+
+	return m, nil
+}
+
+func (m model) key(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+c", "q":
+		return m, tea.Quit
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.tasks)-1 {
+			m.cursor++
+		}
+	case "r":
+		return m, m.load()
+	case "c":
+		if task, ok := m.selected(); ok {
+			return m, m.complete(task)
+		}
+	case "s":
+		if task, ok := m.selected(); ok {
+			return m, m.snoozeTask(task)
+		}
+	}
+
+	return m, nil
+}
+
+func (m model) View() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "ToDo dashboard, %d tasks\n\n", len(m.tasks))
+
+	for i, task := range m.tasks {
+		pointer := " "
+		if i == m.cursor {
+			pointer = ">"
+		}
+
+		done := "[ ]"
+		if task.IsDone != nil && *task.IsDone {
+			done = "[x]"
+		}
+
+		fmt.Fprintf(&b, "%s %s %-6s %-20s %s\n", pointer, done, priority(task), due(task), value(task.Description))
+	}
+
+	fmt.Fprintf(&b, "\n%s\n", m.status)
+	fmt.Fprintf(&b, "j/k: move  c: complete  s: snooze %s  r: reload  q: quit\n", m.snooze)
+
+	return b.String()
+}
+
+// wait returns the next message received from the stream.
+func (m model) wait() tea.Cmd {
+	return func() tea.Msg {
+		select {
+		case <-m.ctx.Done():
+			return nil
+		case msg := <-m.msgs:
+			return msg
+		}
+	}
+}
+
+func (m model) tick() tea.Cmd {
+	return tea.Tick(m.refresh, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+func (m model) load() tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, requestTimeout)
+		defer cancel()
+
+		size := int64(pageSize)
+
+		res, err := m.client.ListTasks(ctx, openapi3.ListTaskParams{Size: &size})
+		if err != nil {
+			return errMsg{action: "load", err: err}
+		}
+
+		if res.Tasks == nil {
+			return tasksMsg(nil)
+		}
+
+		return tasksMsg(*res.Tasks)
+	}
+}
+
+// change reads the task again unless it was deleted, the changes only include its id.
+func (m model) change(msg changeMsg) tea.Cmd {
+	if msg.Kind == string(internaldomain.TaskChangeDeleted) {
+		return func() tea.Msg { return deletedMsg(msg.ID) }
+	}
+
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, requestTimeout)
+		defer cancel()
+
+		task, _, err := m.client.ReadTask(ctx, msg.ID)
+		if err != nil {
+			return errMsg{action: "read", err: err}
+		}
+
+		return taskMsg(task)
+	}
+}
+
+func (m model) complete(task openapi3.Task) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, requestTimeout)
+		defer cancel()
+
+		res, err := m.client.CompleteTask(ctx, value(task.Id))
+		if err != nil {
+			return errMsg{action: "complete", err: err}
+		}
+
+		return taskMsg(res)
+	}
+}
+
+// snoozeTask postpones the due date of the task, it's only updated when it did not change since it was read.
+func (m model) snoozeTask(task openapi3.Task) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(m.ctx, requestTimeout)
+		defer cancel()
+
+		id := value(task.Id)
+
+		current, etag, err := m.client.ReadTask(ctx, id)
+		if err != nil {
+			return errMsg{action: "snooze", err: err}
+		}
+
+		dates := openapi3.Dates{}
+		if current.Dates != nil {
+			dates = *current.Dates
+		}
+
+		due := time.Now()
+		if dates.Due != nil && dates.Due.After(due) {
+			due = *dates.Due
+		}
+
+		due = due.Add(m.snooze)
+		dates.Due = &due
+
+		if err := m.client.UpdateTask(ctx, id, etag, openapi3.UpdateTasksRequest{
+			Dates:       &dates,
+			Description: current.Description,
+			IsDone:      current.IsDone,
+			Priority:    current.Priority,
+			ProjectId:   current.ProjectId,
+		}); err != nil {
+			return errMsg{action: "snooze", err: err}
+		}
+
+		current.Dates = &dates
+
+		return taskMsg(current)
+	}
+}
+
+func (m model) selected() (openapi3.Task, bool) {
+	if m.cursor >= len(m.tasks) {
+		return openapi3.Task{}, false
+	}
+
+	return m.tasks[m.cursor], true
+}
+
+func (m *model) upsert(task openapi3.Task) {
+	for i, t := range m.tasks {
+		if value(t.Id) == value(task.Id) {
+			m.tasks[i] = task
+
+			return
+		}
+	}
+
+	m.tasks = append(m.tasks, task)
+	m.sort()
+}
+
+func (m *model) remove(id string) {
+	for i, t := range m.tasks {
+		if value(t.Id) == id {
+			m.tasks = append(m.tasks[:i], m.tasks[i+1:]...)
+
+			break
+		}
+	}
+
+	if m.cursor >= len(m.tasks) && m.cursor > 0 {
+		m.cursor = len(m.tasks) - 1
+	}
+}
+
+// sort orders the tasks by creation time, like they are listed.
+func (m *model) sort() {
+	sort.SliceStable(m.tasks, func(i, j int) bool {
+		a, b := m.tasks[i].CreatedAt, m.tasks[j].CreatedAt
+
+		return a != nil && (b == nil || a.Before(*b))
+	})
+
+	if m.cursor >= len(m.tasks) {
+		m.cursor = 0
+	}
+}
+
+func priority(task openapi3.Task) string {
+	if task.Priority == nil {
+		return ""
+	}
+
+	return string(*task.Priority)
+}
+
+func due(task openapi3.Task) string {
+	if task.Dates == nil || task.Dates.Due == nil || task.Dates.Due.IsZero() {
+		return "-"
+	}
+
+	return task.Dates.Due.Local().Format("2006-01-02 15:04")
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
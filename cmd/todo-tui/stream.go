@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	internaldomain "github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+const (
+	// streamMinBackoff and streamMaxBackoff bound the time waited before reconnecting.
+	streamMinBackoff = time.Second
+	streamMaxBackoff = 30 * time.Second
+)
+
+// changeMsg notifies a task changed.
+type changeMsg rest.TaskChange
+
+// streamStatusMsg notifies whether the changes are being received, err explains why when they are not.
+type streamStatusMsg struct {
+	live bool
+	err  error
+}
+
+// stream receives the changes to all the tasks using the WebSocket API, it reconnects until ctx is done. Servers
+// without a change feed don't serve the WebSocket API, the dashboard polls the tasks instead.
+func stream(ctx context.Context, address string, header http.Header, msgs chan<- interface{}) {
+	wsURL, err := webSocketURL(address)
+	if err != nil {
+		send(ctx, msgs, streamStatusMsg{err: err})
+
+		return
+	}
+
+	backoff := streamMinBackoff
+
+	for {
+		connected, err := streamOnce(ctx, wsURL, header, msgs)
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		if connected {
+			backoff = streamMinBackoff
+		}
+
+		send(ctx, msgs, streamStatusMsg{err: err})
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > streamMaxBackoff {
+			backoff = streamMaxBackoff
+		}
+	}
+}
+
+// streamOnce receives the changes until the connection fails, it indicates whether it was connected.
+func streamOnce(ctx context.Context, wsURL string, header http.Header, msgs chan<- interface{}) (bool, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, wsURL, header)
+	if err != nil {
+		return false, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnavailable, "websocket.Dial")
+	}
+
+	defer conn.Close()
+
+	// Reading is unblocked by closing the connection.
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	if err := conn.WriteJSON(rest.WebSocketMessage{Type: rest.WebSocketSubscribe}); err != nil {
+		return true, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnavailable, "conn.WriteJSON")
+	}
+
+	send(ctx, msgs, streamStatusMsg{live: true})
+
+	for {
+		var msg rest.WebSocketMessage
+
+		if err := conn.ReadJSON(&msg); err != nil {
+			return true, internaldomain.WrapErrorf(err, internaldomain.ErrorCodeUnavailable, "conn.ReadJSON")
+		}
+
+		if msg.Type == rest.WebSocketChange && msg.Change != nil {
+			send(ctx, msgs, changeMsg(*msg.Change))
+		}
+	}
+}
+
+// webSocketURL returns the URL of the WebSocket API served under the same version as the REST API in address.
+func webSocketURL(address string) (string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", internaldomain.WrapErrorf(err, internaldomain.ErrorCodeInvalidArgument, "url.Parse")
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	default:
+		u.Scheme = "ws"
+	}
+
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/ws"
+
+	return u.String(), nil
+}
+
+func send(ctx context.Context, msgs chan<- interface{}, msg interface{}) {
+	select {
+	case <-ctx.Done():
+	case msgs <- msg:
+	}
+}
@@ -0,0 +1,8 @@
+// Package db embeds the SQL migrations so services can apply them when starting.
+package db
+
+import "embed"
+
+// Migrations contains the PostgreSQL migrations, in "migrations", and the MySQL ones, in "mysql/migrations".
+//go:embed migrations/*.sql mysql/migrations/*.sql
+var Migrations embed.FS //nolint: gochecknoglobals
@@ -0,0 +1,4 @@
+DROP INDEX tasks_created_at_id_idx;
+
+ALTER TABLE tasks
+  DROP COLUMN created_at;
@@ -0,0 +1,4 @@
+ALTER TABLE tasks
+  ADD COLUMN created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc');
+
+CREATE INDEX tasks_created_at_id_idx ON tasks (created_at, id);
@@ -0,0 +1 @@
+DROP INDEX tasks_urgency_idx;
@@ -0,0 +1,9 @@
+CREATE INDEX tasks_urgency_idx ON tasks (
+  done,
+  (COALESCE(due_date, '9999-12-31'::TIMESTAMP) - CASE priority
+    WHEN 'high'   THEN INTERVAL '3 days'
+    WHEN 'medium' THEN INTERVAL '1 day'
+    ELSE INTERVAL '0'
+  END),
+  id
+);
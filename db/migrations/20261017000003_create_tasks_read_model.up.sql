@@ -0,0 +1,14 @@
+CREATE TABLE tasks_read_model (
+  id          UUID PRIMARY KEY,
+  description TEXT NOT NULL,
+  priority    priority DEFAULT 'none' NOT NULL,
+  start_date  TIMESTAMP WITHOUT TIME ZONE,
+  due_date    TIMESTAMP WITHOUT TIME ZONE,
+  done        BOOLEAN NOT NULL DEFAULT FALSE,
+  urgency_at  TIMESTAMP WITHOUT TIME ZONE NOT NULL,
+  created_at  TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX tasks_read_model_created_at_id_idx ON tasks_read_model (created_at, id);
+
+CREATE INDEX tasks_read_model_urgency_idx ON tasks_read_model (done, urgency_at, id);
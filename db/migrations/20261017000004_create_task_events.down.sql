@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS task_snapshots;
+DROP TABLE IF EXISTS task_events;
+DROP TABLE IF EXISTS task_streams;
@@ -0,0 +1,24 @@
+CREATE TABLE task_streams (
+  id         UUID PRIMARY KEY,
+  version    BIGINT NOT NULL,
+  deleted    BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX task_streams_created_at_id_idx ON task_streams (created_at, id);
+
+CREATE TABLE task_events (
+  task_id    UUID NOT NULL REFERENCES task_streams (id),
+  version    BIGINT NOT NULL,
+  type       VARCHAR NOT NULL,
+  data       JSONB NOT NULL,
+  created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc'),
+  PRIMARY KEY (task_id, version)
+);
+
+CREATE TABLE task_snapshots (
+  task_id    UUID PRIMARY KEY REFERENCES task_streams (id),
+  version    BIGINT NOT NULL,
+  data       JSONB NOT NULL,
+  created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc')
+);
@@ -0,0 +1,3 @@
+DROP TRIGGER tasks_changed ON tasks;
+
+DROP FUNCTION notify_tasks_changed;
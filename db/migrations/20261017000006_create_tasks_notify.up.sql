@@ -0,0 +1,23 @@
+CREATE FUNCTION notify_tasks_changed() RETURNS TRIGGER AS $$
+DECLARE
+  task RECORD;
+BEGIN
+  IF TG_OP = 'DELETE' THEN
+    task := OLD;
+  ELSE
+    task := NEW;
+  END IF;
+
+  PERFORM pg_notify('tasks_changed', json_build_object(
+    'kind',    CASE TG_OP WHEN 'INSERT' THEN 'created' WHEN 'UPDATE' THEN 'updated' ELSE 'deleted' END,
+    'id',      task.id,
+    'version', task.version
+  )::TEXT);
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_changed
+  AFTER INSERT OR UPDATE OR DELETE ON tasks
+  FOR EACH ROW EXECUTE FUNCTION notify_tasks_changed();
@@ -0,0 +1,4 @@
+DROP INDEX tasks_description_search_idx;
+
+ALTER TABLE tasks
+  DROP COLUMN description_search;
@@ -0,0 +1,4 @@
+ALTER TABLE tasks
+  ADD COLUMN description_search TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', description)) STORED;
+
+CREATE INDEX tasks_description_search_idx ON tasks USING GIN (description_search);
@@ -0,0 +1 @@
+DROP FUNCTION estimate_records;
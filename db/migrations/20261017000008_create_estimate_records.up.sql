@@ -0,0 +1,5 @@
+-- Number of records in the table according to the statistics of the planner, those are updated by "ANALYZE" and
+-- autovacuum; it's meant for tables too big to be counted.
+CREATE FUNCTION estimate_records(table_name TEXT) RETURNS BIGINT AS $$
+  SELECT GREATEST(reltuples, 0)::BIGINT FROM pg_class WHERE oid = table_name::REGCLASS;
+$$ LANGUAGE SQL STABLE;
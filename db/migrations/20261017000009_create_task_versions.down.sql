@@ -0,0 +1,5 @@
+DROP TRIGGER tasks_versioned ON tasks;
+
+DROP FUNCTION save_task_version;
+
+DROP TABLE task_versions;
@@ -0,0 +1,28 @@
+CREATE TABLE task_versions (
+  id          UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+  version     BIGINT NOT NULL,
+  description VARCHAR NOT NULL,
+  priority    priority NOT NULL,
+  start_date  TIMESTAMP WITHOUT TIME ZONE,
+  due_date    TIMESTAMP WITHOUT TIME ZONE,
+  done        BOOLEAN NOT NULL,
+  PRIMARY KEY (id, version)
+);
+
+-- Keeps the previous versions of the updated tasks, those are used as the base when resolving conflicting updates;
+-- only the latest 10 are kept.
+CREATE FUNCTION save_task_version() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO task_versions (id, version, description, priority, start_date, due_date, done)
+  VALUES (OLD.id, OLD.version, OLD.description, OLD.priority, OLD.start_date, OLD.due_date, OLD.done)
+  ON CONFLICT DO NOTHING;
+
+  DELETE FROM task_versions WHERE id = OLD.id AND version <= OLD.version - 10;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER tasks_versioned
+  AFTER UPDATE ON tasks
+  FOR EACH ROW WHEN (OLD.version <> NEW.version) EXECUTE FUNCTION save_task_version();
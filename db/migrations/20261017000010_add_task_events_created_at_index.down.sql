@@ -0,0 +1 @@
+DROP INDEX task_events_created_at_idx;
@@ -0,0 +1 @@
+CREATE INDEX task_events_created_at_idx ON task_events (created_at, task_id, version);
@@ -0,0 +1,38 @@
+CREATE OR REPLACE FUNCTION save_task_version() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO task_versions (id, version, description, priority, start_date, due_date, done)
+  VALUES (OLD.id, OLD.version, OLD.description, OLD.priority, OLD.start_date, OLD.due_date, OLD.done)
+  ON CONFLICT DO NOTHING;
+
+  DELETE FROM task_versions WHERE id = OLD.id AND version <= OLD.version - 10;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE task_versions
+  ALTER COLUMN start_date TYPE TIMESTAMP WITHOUT TIME ZONE USING start_date AT TIME ZONE 'UTC',
+  ALTER COLUMN due_date   TYPE TIMESTAMP WITHOUT TIME ZONE USING due_date AT TIME ZONE 'UTC',
+  DROP COLUMN time_zone;
+
+ALTER TABLE tasks_read_model
+  ALTER COLUMN start_date TYPE TIMESTAMP WITHOUT TIME ZONE USING start_date AT TIME ZONE 'UTC',
+  ALTER COLUMN due_date   TYPE TIMESTAMP WITHOUT TIME ZONE USING due_date AT TIME ZONE 'UTC',
+  DROP COLUMN time_zone;
+
+DROP INDEX tasks_urgency_idx;
+
+ALTER TABLE tasks
+  ALTER COLUMN start_date TYPE TIMESTAMP WITHOUT TIME ZONE USING start_date AT TIME ZONE 'UTC',
+  ALTER COLUMN due_date   TYPE TIMESTAMP WITHOUT TIME ZONE USING due_date AT TIME ZONE 'UTC',
+  DROP COLUMN time_zone;
+
+CREATE INDEX tasks_urgency_idx ON tasks (
+  done,
+  (COALESCE(due_date, '9999-12-31'::TIMESTAMP) - CASE priority
+    WHEN 'high'   THEN INTERVAL '3 days'
+    WHEN 'medium' THEN INTERVAL '1 day'
+    ELSE INTERVAL '0'
+  END),
+  id
+);
@@ -0,0 +1,41 @@
+-- Dates are stored as instants, the existing ones were stored in UTC, and the time zone they are rendered in is
+-- stored separately because instants don't include one.
+DROP INDEX tasks_urgency_idx;
+
+ALTER TABLE tasks
+  ALTER COLUMN start_date TYPE TIMESTAMP WITH TIME ZONE USING start_date AT TIME ZONE 'UTC',
+  ALTER COLUMN due_date   TYPE TIMESTAMP WITH TIME ZONE USING due_date AT TIME ZONE 'UTC',
+  ADD COLUMN time_zone TEXT NOT NULL DEFAULT '';
+
+-- Urgency is calculated in UTC, subtracting days from instants depends on the time zone of the session.
+CREATE INDEX tasks_urgency_idx ON tasks (
+  done,
+  (COALESCE(due_date AT TIME ZONE 'UTC', '9999-12-31'::TIMESTAMP) - CASE priority
+    WHEN 'high'   THEN INTERVAL '3 days'
+    WHEN 'medium' THEN INTERVAL '1 day'
+    ELSE INTERVAL '0'
+  END),
+  id
+);
+
+ALTER TABLE tasks_read_model
+  ALTER COLUMN start_date TYPE TIMESTAMP WITH TIME ZONE USING start_date AT TIME ZONE 'UTC',
+  ALTER COLUMN due_date   TYPE TIMESTAMP WITH TIME ZONE USING due_date AT TIME ZONE 'UTC',
+  ADD COLUMN time_zone TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE task_versions
+  ALTER COLUMN start_date TYPE TIMESTAMP WITH TIME ZONE USING start_date AT TIME ZONE 'UTC',
+  ALTER COLUMN due_date   TYPE TIMESTAMP WITH TIME ZONE USING due_date AT TIME ZONE 'UTC',
+  ADD COLUMN time_zone TEXT NOT NULL DEFAULT '';
+
+CREATE OR REPLACE FUNCTION save_task_version() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO task_versions (id, version, description, priority, start_date, due_date, time_zone, done)
+  VALUES (OLD.id, OLD.version, OLD.description, OLD.priority, OLD.start_date, OLD.due_date, OLD.time_zone, OLD.done)
+  ON CONFLICT DO NOTHING;
+
+  DELETE FROM task_versions WHERE id = OLD.id AND version <= OLD.version - 10;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,8 @@
+-- Progress of the backfills run in batches, "cursor" is opaque and indicates the last processed record.
+CREATE TABLE backfill_checkpoints (
+  name       TEXT PRIMARY KEY,
+  cursor     TEXT NOT NULL DEFAULT '',
+  processed  BIGINT NOT NULL DEFAULT 0,
+  done       BOOLEAN NOT NULL DEFAULT FALSE,
+  updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
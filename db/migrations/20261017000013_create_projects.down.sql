@@ -0,0 +1,19 @@
+CREATE OR REPLACE FUNCTION save_task_version() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO task_versions (id, version, description, priority, start_date, due_date, time_zone, done)
+  VALUES (OLD.id, OLD.version, OLD.description, OLD.priority, OLD.start_date, OLD.due_date, OLD.time_zone, OLD.done)
+  ON CONFLICT DO NOTHING;
+
+  DELETE FROM task_versions WHERE id = OLD.id AND version <= OLD.version - 10;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE task_versions DROP COLUMN project_id;
+
+ALTER TABLE tasks_read_model DROP COLUMN project_id;
+
+ALTER TABLE tasks DROP COLUMN project_id;
+
+DROP TABLE projects;
@@ -0,0 +1,32 @@
+CREATE TABLE projects (
+  id         UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+  name       TEXT NOT NULL,
+  created_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc')
+);
+
+CREATE INDEX projects_created_at_id_idx ON projects (created_at, id);
+
+-- Project ids are not constrained, like in the other datastores, the service validates them and deletes or
+-- unassigns the tasks of the deleted projects publishing the events for them, see service.Project.
+ALTER TABLE tasks ADD COLUMN project_id UUID;
+
+CREATE INDEX tasks_project_id_idx ON tasks (project_id);
+
+ALTER TABLE tasks_read_model ADD COLUMN project_id UUID;
+
+CREATE INDEX tasks_read_model_project_id_idx ON tasks_read_model (project_id);
+
+ALTER TABLE task_versions ADD COLUMN project_id UUID;
+
+CREATE OR REPLACE FUNCTION save_task_version() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO task_versions (id, version, description, priority, start_date, due_date, time_zone, project_id, done)
+  VALUES (OLD.id, OLD.version, OLD.description, OLD.priority, OLD.start_date, OLD.due_date, OLD.time_zone,
+    OLD.project_id, OLD.done)
+  ON CONFLICT DO NOTHING;
+
+  DELETE FROM task_versions WHERE id = OLD.id AND version <= OLD.version - 10;
+
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
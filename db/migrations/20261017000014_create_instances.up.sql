@@ -0,0 +1,11 @@
+-- Heartbeats of the instances serving traffic, used for knowing the versions running alongside each other during
+-- rolling deploys; instances not seen recently are considered gone.
+CREATE TABLE instances (
+  id          UUID PRIMARY KEY,
+  version     INTEGER NOT NULL,
+  min_version INTEGER NOT NULL,
+  started_at  TIMESTAMPTZ NOT NULL,
+  seen_at     TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX instances_seen_at_idx ON instances (seen_at);
@@ -0,0 +1,9 @@
+-- Task ids are not constrained, like in the other datastores, dependencies on missing tasks are ignored by the
+-- service, see service.Task.
+CREATE TABLE task_dependencies (
+  blocked_id UUID NOT NULL,
+  blocker_id UUID NOT NULL,
+  PRIMARY KEY (blocked_id, blocker_id)
+);
+
+CREATE INDEX task_dependencies_blocker_id_idx ON task_dependencies (blocker_id);
@@ -0,0 +1,13 @@
+CREATE TABLE tasks_trash (
+  id          UUID PRIMARY KEY,
+  description TEXT NOT NULL,
+  priority    priority DEFAULT 'none'::priority NOT NULL,
+  start_date  TIMESTAMP WITH TIME ZONE,
+  due_date    TIMESTAMP WITH TIME ZONE,
+  time_zone   TEXT NOT NULL DEFAULT '',
+  project_id  UUID,
+  done        BOOLEAN NOT NULL DEFAULT FALSE,
+  deleted_at  TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX tasks_trash_deleted_at_id_idx ON tasks_trash (deleted_at, id);
@@ -0,0 +1,3 @@
+ALTER TABLE tasks_read_model DROP COLUMN completed_at;
+
+ALTER TABLE tasks DROP COLUMN completed_at;
@@ -0,0 +1,4 @@
+-- Tasks completed before this migration keep no completion time.
+ALTER TABLE tasks ADD COLUMN completed_at TIMESTAMPTZ;
+
+ALTER TABLE tasks_read_model ADD COLUMN completed_at TIMESTAMPTZ;
@@ -0,0 +1,7 @@
+DROP INDEX tasks_read_model_updated_at_idx;
+
+ALTER TABLE tasks_read_model DROP COLUMN updated_at;
+
+DROP INDEX tasks_updated_at_idx;
+
+ALTER TABLE tasks DROP COLUMN updated_at;
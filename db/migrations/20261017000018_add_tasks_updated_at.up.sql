@@ -0,0 +1,14 @@
+-- Tasks stored before this migration were last updated when created, as far as it's known.
+ALTER TABLE tasks
+  ADD COLUMN updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc');
+
+UPDATE tasks SET updated_at = created_at;
+
+CREATE INDEX tasks_updated_at_idx ON tasks (updated_at);
+
+ALTER TABLE tasks_read_model
+  ADD COLUMN updated_at TIMESTAMP WITHOUT TIME ZONE NOT NULL DEFAULT (NOW() AT TIME ZONE 'utc');
+
+UPDATE tasks_read_model SET updated_at = created_at;
+
+CREATE INDEX tasks_read_model_updated_at_idx ON tasks_read_model (updated_at);
@@ -0,0 +1,15 @@
+-- Browsers subscribed to receive notifications using the Web Push protocol, id is derived from the endpoint.
+CREATE TABLE push_subscriptions (
+  id         UUID PRIMARY KEY,
+  endpoint   TEXT NOT NULL,
+  p256dh     TEXT NOT NULL,
+  auth       TEXT NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- Reminders already delivered, claimed by the instance delivering them; tasks whose due date changes are
+-- reminded again.
+CREATE TABLE push_reminders (
+  task_id UUID PRIMARY KEY,
+  due     TIMESTAMP WITH TIME ZONE NOT NULL
+);
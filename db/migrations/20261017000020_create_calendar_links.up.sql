@@ -0,0 +1,12 @@
+-- Events mirroring tasks in the calendar, fingerprint identifies the values last synchronized.
+CREATE TABLE calendar_links (
+  task_id     UUID PRIMARY KEY,
+  event_id    TEXT NOT NULL UNIQUE,
+  fingerprint TEXT NOT NULL
+);
+
+-- Tokens used for listing the changes made to each calendar since the last synchronization.
+CREATE TABLE calendar_sync_tokens (
+  calendar_id TEXT PRIMARY KEY,
+  token       TEXT NOT NULL
+);
@@ -0,0 +1,8 @@
+-- GitHub issues imported as tasks, task_id is NULL while the issue is being imported.
+CREATE TABLE issue_links (
+  repository TEXT    NOT NULL,
+  number     INTEGER NOT NULL,
+  task_id    UUID    UNIQUE,
+  closed     BOOLEAN NOT NULL DEFAULT FALSE,
+  PRIMARY KEY (repository, number)
+);
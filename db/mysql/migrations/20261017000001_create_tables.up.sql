@@ -0,0 +1,18 @@
+CREATE TABLE tasks (
+  id          CHAR(36) PRIMARY KEY,
+  description TEXT NOT NULL,
+  priority    ENUM('none', 'low', 'medium', 'high') NOT NULL DEFAULT 'none',
+  start_date  DATETIME(6) NULL,
+  due_date    DATETIME(6) NULL,
+  done        BOOLEAN NOT NULL DEFAULT FALSE,
+  created_at  DATETIME(6) NOT NULL,
+  urgency_at  DATETIME(6) AS (
+    COALESCE(due_date, CAST('9999-12-31 00:00:00' AS DATETIME(6))) - INTERVAL (CASE priority
+      WHEN 'high'   THEN 3
+      WHEN 'medium' THEN 1
+      ELSE 0
+    END) DAY
+  ) STORED NOT NULL,
+  INDEX tasks_created_at_id_idx (created_at, id),
+  INDEX tasks_urgency_idx (done, urgency_at, id)
+);
@@ -0,0 +1 @@
+ALTER TABLE tasks ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT '' AFTER due_date;
@@ -0,0 +1,10 @@
+CREATE TABLE projects (
+  id         CHAR(36) PRIMARY KEY,
+  name       TEXT NOT NULL,
+  created_at DATETIME(6) NOT NULL,
+  INDEX projects_created_at_id_idx (created_at, id)
+);
+
+ALTER TABLE tasks
+  ADD COLUMN project_id CHAR(36) NOT NULL DEFAULT '' AFTER time_zone,
+  ADD INDEX tasks_project_id_idx (project_id);
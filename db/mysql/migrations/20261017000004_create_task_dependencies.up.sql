@@ -0,0 +1,6 @@
+CREATE TABLE task_dependencies (
+  blocked_id CHAR(36) NOT NULL,
+  blocker_id CHAR(36) NOT NULL,
+  PRIMARY KEY (blocked_id, blocker_id),
+  INDEX task_dependencies_blocker_id_idx (blocker_id)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE tasks_trash (
+  id          CHAR(36) PRIMARY KEY,
+  description TEXT NOT NULL,
+  priority    ENUM('none', 'low', 'medium', 'high') NOT NULL DEFAULT 'none',
+  start_date  DATETIME(6) NULL,
+  due_date    DATETIME(6) NULL,
+  time_zone   VARCHAR(64) NOT NULL DEFAULT '',
+  project_id  CHAR(36) NOT NULL DEFAULT '',
+  done        BOOLEAN NOT NULL DEFAULT FALSE,
+  deleted_at  DATETIME(6) NOT NULL,
+  INDEX tasks_trash_deleted_at_id_idx (deleted_at, id)
+);
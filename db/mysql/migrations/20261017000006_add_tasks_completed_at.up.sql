@@ -0,0 +1,2 @@
+-- Tasks completed before this migration keep no completion time.
+ALTER TABLE tasks ADD COLUMN completed_at DATETIME(6) NULL AFTER done;
@@ -0,0 +1 @@
+ALTER TABLE tasks DROP INDEX tasks_updated_at_idx, DROP COLUMN updated_at;
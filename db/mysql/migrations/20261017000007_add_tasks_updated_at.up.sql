@@ -0,0 +1,6 @@
+-- Tasks stored before this migration were last updated when created, as far as it's known.
+ALTER TABLE tasks ADD COLUMN updated_at DATETIME(6) NULL AFTER created_at;
+
+UPDATE tasks SET updated_at = created_at;
+
+ALTER TABLE tasks MODIFY updated_at DATETIME(6) NOT NULL, ADD INDEX tasks_updated_at_idx (updated_at);
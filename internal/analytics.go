@@ -0,0 +1,108 @@
+package internal
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// AnalyticsEventTaskCreated indicates a Task was created.
+	AnalyticsEventTaskCreated = "task_created"
+
+	// AnalyticsEventSearchPerformed indicates Tasks were searched.
+	AnalyticsEventSearchPerformed = "search_performed"
+)
+
+// AnalyticsEvent represents a product analytics event using the format of the Segment Track API, see
+// https://segment.com/docs/connections/spec/track/ for details, so it can be sent to any compatible sink.
+// Events are scrubbed by design: properties are limited to the ones defined by the constructors, values entered by
+// users, like descriptions or search terms, and ids are never included. Users are not identified, each event uses
+// its own anonymous id.
+//nolint: tagliatelle
+type AnalyticsEvent struct {
+	Type        string                 `json:"type"`
+	Event       string                 `json:"event"`
+	MessageID   string                 `json:"messageId"`
+	AnonymousID string                 `json:"anonymousId"`
+	Timestamp   time.Time              `json:"timestamp"`
+	Properties  map[string]interface{} `json:"properties"`
+}
+
+func newAnalyticsEvent(event string, properties map[string]interface{}) AnalyticsEvent {
+	id := uuid.NewString()
+
+	return AnalyticsEvent{
+		Type:        "track",
+		Event:       event,
+		MessageID:   id,
+		AnonymousID: id,
+		Timestamp:   time.Now().UTC(),
+		Properties:  properties,
+	}
+}
+
+// NewTaskCreatedEvent returns the event indicating the Task was created, cloned indicates whether it was created
+// by cloning another Task.
+func NewTaskCreatedEvent(task Task, cloned bool) AnalyticsEvent {
+	return newAnalyticsEvent(AnalyticsEventTaskCreated, map[string]interface{}{
+		"priority":           priorityName(task.Priority),
+		"has_start_date":     !task.Dates.Start.IsZero(),
+		"has_due_date":       !task.Dates.Due.IsZero(),
+		"has_project":        task.ProjectID != "",
+		"description_length": utf8.RuneCountInString(task.Description),
+		"cloned":             cloned,
+	})
+}
+
+// NewSearchPerformedEvent returns the event indicating Tasks were searched using args, it includes which filters and
+// options were used, the number of results and the latency of the search.
+func NewSearchPerformedEvent(args SearchParams, res SearchResults, latency time.Duration) AnalyticsEvent {
+	return newAnalyticsEvent(AnalyticsEventSearchPerformed, map[string]interface{}{
+		"by_description": args.Description != nil,
+		"by_priority":    args.Priority != nil,
+		"by_done":        args.IsDone != nil,
+		"by_due":         args.Due != nil,
+		"by_project":     args.ProjectID != nil,
+		"sort":           string(args.Sort),
+		"fuzzy":          args.Fuzziness.Fuzzy(),
+		"highlight":      args.Highlight != nil,
+		"facets":         args.Facets,
+		"next_page":      args.Cursor != "" || args.From > 0,
+		"result_count":   len(res.Tasks),
+		"total":          res.Total,
+		"latency_ms":     latency.Milliseconds(),
+	})
+}
+
+func priorityName(p Priority) string {
+	switch p {
+	case PriorityNone:
+		return "none"
+	case PriorityLow:
+		return "low"
+	case PriorityMedium:
+		return "medium"
+	case PriorityHigh:
+		return "high"
+	}
+
+	return "invalid"
+}
+
+type analyticsConsentKey struct{}
+
+// NewContextWithAnalyticsConsent returns a new context that carries whether the client consents to product analytics.
+func NewContextWithAnalyticsConsent(ctx context.Context, consent bool) context.Context {
+	return context.WithValue(ctx, analyticsConsentKey{}, consent)
+}
+
+// AnalyticsConsentFromContext indicates whether the client consents to product analytics, consent must be stored
+// explicitly in ctx so events are never tracked by default.
+func AnalyticsConsentFromContext(ctx context.Context) bool {
+	consent, _ := ctx.Value(analyticsConsentKey{}).(bool)
+
+	return consent
+}
@@ -0,0 +1,86 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestNewTaskCreatedEvent(t *testing.T) {
+	t.Parallel()
+
+	event := internal.NewTaskCreatedEvent(internal.Task{
+		ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+		Description: "Renew the car insurance",
+		Priority:    internal.PriorityHigh,
+		Dates:       internal.Dates{Due: time.Now()},
+	}, false)
+
+	expected := map[string]interface{}{
+		"priority":           "high",
+		"has_start_date":     false,
+		"has_due_date":       true,
+		"has_project":        false,
+		"description_length": 23,
+		"cloned":             false,
+	}
+
+	if !cmp.Equal(expected, event.Properties) {
+		t.Fatalf("expected properties do not match: %s", cmp.Diff(expected, event.Properties))
+	}
+
+	if event.Type != "track" || event.Event != internal.AnalyticsEventTaskCreated {
+		t.Fatalf("expected track task_created, got %s %s", event.Type, event.Event)
+	}
+
+	if event.MessageID == "" || event.AnonymousID != event.MessageID {
+		t.Fatalf("expected anonymous id to be the message id, got %q and %q", event.AnonymousID, event.MessageID)
+	}
+}
+
+func TestNewSearchPerformedEvent(t *testing.T) {
+	t.Parallel()
+
+	description := "milk"
+
+	event := internal.NewSearchPerformedEvent(
+		internal.SearchParams{Description: &description, Size: 10, Cursor: "next", Sort: internal.SortUrgency},
+		internal.SearchResults{Tasks: []internal.Task{{Description: "Buy milk"}}, Total: 3},
+		250*time.Millisecond)
+
+	expected := map[string]interface{}{
+		"by_description": true,
+		"by_priority":    false,
+		"by_done":        false,
+		"by_due":         false,
+		"by_project":     false,
+		"sort":           "urgency",
+		"fuzzy":          false,
+		"highlight":      false,
+		"facets":         false,
+		"next_page":      true,
+		"result_count":   1,
+		"total":          int64(3),
+		"latency_ms":     int64(250),
+	}
+
+	if !cmp.Equal(expected, event.Properties) {
+		t.Fatalf("expected properties do not match: %s", cmp.Diff(expected, event.Properties))
+	}
+}
+
+func TestAnalyticsConsentFromContext(t *testing.T) {
+	t.Parallel()
+
+	if internal.AnalyticsConsentFromContext(context.Background()) {
+		t.Fatalf("expected no consent by default")
+	}
+
+	if !internal.AnalyticsConsentFromContext(internal.NewContextWithAnalyticsConsent(context.Background(), true)) {
+		t.Fatalf("expected consent")
+	}
+}
@@ -0,0 +1,15 @@
+package internal
+
+import (
+	"time"
+)
+
+// BackfillCheckpoint is the progress of a backfill: Cursor indicates the last processed record, so interrupted
+// backfills resume after it, and Processed the number of records processed so far.
+type BackfillCheckpoint struct {
+	Name      string
+	Cursor    string
+	Processed int64
+	Done      bool
+	UpdatedAt time.Time
+}
@@ -0,0 +1,274 @@
+// Package backfill implements a runner for data migrations that process millions of records, like populating new
+// columns, in batches: batches are throttled to avoid load spikes and the progress is checkpointed after each one so
+// backfills can be canceled and resumed.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Step processes the batch of up to size records following the one indicated by cursor, empty for the first batch;
+// it returns the cursor of the last processed record, empty when there are no records left, and the number of
+// processed records. Steps must be idempotent because a batch is processed again when its checkpoint is not saved.
+type Step func(ctx context.Context, cursor string, size int) (string, int, error)
+
+// Backfill defines a data migration processed in batches, Name identifies its checkpoint.
+type Backfill struct {
+	Name string
+	Step Step
+}
+
+// CheckpointRepository defines the datastore keeping the checkpoints.
+type CheckpointRepository interface {
+	Find(ctx context.Context, name string) (internal.BackfillCheckpoint, error)
+	Save(ctx context.Context, checkpoint internal.BackfillCheckpoint) error
+}
+
+// Status indicates the progress of a registered backfill, Error is the reason the last run failed.
+type Status struct {
+	internal.BackfillCheckpoint
+	Running bool
+	Error   string
+}
+
+// Runner runs the registered backfills, batches of all of them share the same rate limit. It's safe for concurrent
+// use.
+type Runner struct {
+	logger  *zap.Logger
+	repo    CheckpointRepository
+	size    int
+	limiter *rate.Limiter
+
+	mu        sync.Mutex
+	wg        sync.WaitGroup
+	backfills map[string]Backfill
+	running   map[string]context.CancelFunc
+	errs      map[string]string
+}
+
+// NewRunner instantiates the Runner, batches include size records and up to limit records are processed per second;
+// batches are not throttled when limit is not positive.
+func NewRunner(logger *zap.Logger, repo CheckpointRepository, size int, limit float64) *Runner {
+	l := rate.Limit(limit)
+	if limit <= 0 {
+		l = rate.Inf
+	}
+
+	return &Runner{
+		logger:    logger,
+		repo:      repo,
+		size:      size,
+		limiter:   rate.NewLimiter(l, size),
+		backfills: make(map[string]Backfill),
+		running:   make(map[string]context.CancelFunc),
+		errs:      make(map[string]string),
+	}
+}
+
+// Register adds the backfill so it can be started by name.
+func (r *Runner) Register(b Backfill) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.backfills[b.Name] = b
+}
+
+// Start runs the backfill in the background until it completes, it's canceled or the Runner shuts down; it resumes
+// after the last checkpoint.
+func (r *Runner) Start(name string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	b, err := r.acquire(name, cancel)
+	if err != nil {
+		cancel()
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "acquire")
+	}
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		if err := r.run(ctx, b); err != nil {
+			r.logger.Warn("couldn't backfill", zap.String("name", name), zap.Error(err))
+		}
+	}()
+
+	return nil
+}
+
+// Run runs the backfill until it completes or ctx is canceled, it resumes after the last checkpoint.
+func (r *Runner) Run(ctx context.Context, name string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	b, err := r.acquire(name, cancel)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "acquire")
+	}
+
+	return r.run(ctx, b)
+}
+
+// Cancel stops the running backfill after the batch being processed, starting it again resumes after it.
+func (r *Runner) Cancel(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cancel, ok := r.running[name]
+	if !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "backfill not running")
+	}
+
+	cancel()
+
+	return nil
+}
+
+// Shutdown cancels the running backfills and waits for them to stop, or until ctx is done.
+func (r *Runner) Shutdown(ctx context.Context) error {
+	r.mu.Lock()
+
+	for _, cancel := range r.running {
+		cancel()
+	}
+
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return internal.WrapErrorf(ctx.Err(), internal.ErrorCodeUnknown, "wait backfills")
+	}
+}
+
+// Status returns the status of the registered backfills, sorted by name.
+func (r *Runner) Status(ctx context.Context) ([]Status, error) {
+	r.mu.Lock()
+
+	res := make([]Status, 0, len(r.backfills))
+
+	for name := range r.backfills {
+		_, running := r.running[name]
+
+		res = append(res, Status{
+			BackfillCheckpoint: internal.BackfillCheckpoint{Name: name},
+			Running:            running,
+			Error:              r.errs[name],
+		})
+	}
+
+	r.mu.Unlock()
+
+	sort.Slice(res, func(a, b int) bool { return res[a].Name < res[b].Name })
+
+	for i := range res {
+		checkpoint, err := r.checkpoint(ctx, res[i].Name)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "checkpoint")
+		}
+
+		res[i].BackfillCheckpoint = checkpoint
+	}
+
+	return res, nil
+}
+
+// acquire marks the backfill as running, only one instance of each backfill runs at the same time.
+func (r *Runner) acquire(name string, cancel context.CancelFunc) (Backfill, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.backfills[name]
+	if !ok {
+		return Backfill{}, internal.NewErrorf(internal.ErrorCodeNotFound, "backfill not found")
+	}
+
+	if _, ok := r.running[name]; ok {
+		return Backfill{}, internal.NewErrorf(internal.ErrorCodeConflict, "backfill already running")
+	}
+
+	r.running[name] = cancel
+	delete(r.errs, name)
+
+	return b, nil
+}
+
+func (r *Runner) run(ctx context.Context, b Backfill) (err error) {
+	defer func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		delete(r.running, b.Name)
+
+		if err != nil && !errors.Is(err, context.Canceled) {
+			r.errs[b.Name] = err.Error()
+		}
+	}()
+
+	checkpoint, err := r.checkpoint(ctx, b.Name)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "checkpoint")
+	}
+
+	for !checkpoint.Done {
+		if err := r.limiter.WaitN(ctx, r.size); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				err = ctxErr
+			}
+
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "limiter.WaitN")
+		}
+
+		cursor, n, err := b.Step(ctx, checkpoint.Cursor, r.size)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "step")
+		}
+
+		checkpoint.Cursor = cursor
+		checkpoint.Processed += int64(n)
+		checkpoint.Done = cursor == ""
+		checkpoint.UpdatedAt = time.Now().UTC()
+
+		if err := r.repo.Save(ctx, checkpoint); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Save")
+		}
+	}
+
+	r.logger.Info("Backfilled", zap.String("name", b.Name), zap.Int64("processed", checkpoint.Processed))
+
+	return nil
+}
+
+// checkpoint returns the last checkpoint of the backfill, backfills without checkpoint start from the beginning.
+func (r *Runner) checkpoint(ctx context.Context, name string) (internal.BackfillCheckpoint, error) {
+	res, err := r.repo.Find(ctx, name)
+	if err != nil {
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound {
+			return internal.BackfillCheckpoint{Name: name}, nil
+		}
+
+		return internal.BackfillCheckpoint{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	return res, nil
+}
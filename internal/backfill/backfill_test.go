@@ -0,0 +1,189 @@
+package backfill_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/backfill"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+)
+
+// records simulates a table with n records, the cursor is the index of the last processed one.
+type records struct {
+	mu        sync.Mutex
+	n         int
+	processed []int
+	failAt    int
+}
+
+func (r *records) Step(_ context.Context, cursor string, size int) (string, int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	from := 0
+
+	if cursor != "" {
+		last, err := strconv.Atoi(cursor)
+		if err != nil {
+			return "", 0, err
+		}
+
+		from = last + 1
+	}
+
+	if r.failAt > 0 && from >= r.failAt {
+		r.failAt = 0
+
+		return "", 0, errors.New("failed")
+	}
+
+	to := from + size
+	if to > r.n {
+		to = r.n
+	}
+
+	for i := from; i < to; i++ {
+		r.processed = append(r.processed, i)
+	}
+
+	if to == r.n {
+		return "", to - from, nil
+	}
+
+	return strconv.Itoa(to - 1), to - from, nil
+}
+
+func TestRunner_Run(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewBackfillCheckpoint()
+	recs := &records{n: 25, failAt: 10}
+
+	runner := backfill.NewRunner(zap.NewNop(), repo, 5, 0)
+	runner.Register(backfill.Backfill{Name: "records", Step: recs.Step})
+
+	if err := runner.Run(context.Background(), "records"); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	statuses, err := runner.Status(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(statuses) != 1 || statuses[0].Processed != 10 || statuses[0].Done || statuses[0].Error == "" {
+		t.Fatalf("expected failed backfill after 10 records, got %+v", statuses)
+	}
+
+	// Running it again resumes after the last checkpoint.
+	if err := runner.Run(context.Background(), "records"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	checkpoint, err := repo.Find(context.Background(), "records")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !checkpoint.Done || checkpoint.Processed != 25 {
+		t.Fatalf("expected completed backfill, got %+v", checkpoint)
+	}
+
+	if len(recs.processed) != 25 {
+		t.Fatalf("expected each record processed once, got %v", recs.processed)
+	}
+
+	// Completed backfills are not run again.
+	if err := runner.Run(context.Background(), "records"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(recs.processed) != 25 {
+		t.Fatalf("expected no records processed, got %v", recs.processed)
+	}
+}
+
+func TestRunner_Rate(t *testing.T) {
+	t.Parallel()
+
+	recs := &records{n: 30}
+
+	runner := backfill.NewRunner(zap.NewNop(), memory.NewBackfillCheckpoint(), 10, 100)
+	runner.Register(backfill.Backfill{Name: "records", Step: recs.Step})
+
+	start := time.Now()
+
+	if err := runner.Run(context.Background(), "records"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// The first batch is not throttled, each one of the other two waits 100ms.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Fatalf("expected throttled batches, took %s", elapsed)
+	}
+}
+
+func TestRunner_StartCancel(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+
+	step := func(ctx context.Context, _ string, _ int) (string, int, error) {
+		close(started)
+
+		<-ctx.Done()
+
+		return "", 0, ctx.Err()
+	}
+
+	runner := backfill.NewRunner(zap.NewNop(), memory.NewBackfillCheckpoint(), 5, 0)
+	runner.Register(backfill.Backfill{Name: "blocked", Step: step})
+
+	assertCode := func(err error, code internal.ErrorCode) {
+		t.Helper()
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != code {
+			t.Fatalf("expected error code %d, got %v", code, err)
+		}
+	}
+
+	assertCode(runner.Start("missing"), internal.ErrorCodeNotFound)
+	assertCode(runner.Cancel("blocked"), internal.ErrorCodeNotFound)
+
+	if err := runner.Start("blocked"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	<-started
+
+	assertCode(runner.Start("blocked"), internal.ErrorCodeConflict)
+
+	if err := runner.Cancel("blocked"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	if err := runner.Shutdown(ctx); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	statuses, err := runner.Status(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// Canceling is not an error.
+	if len(statuses) != 1 || statuses[0].Running || statuses[0].Error != "" {
+		t.Fatalf("expected stopped backfill, got %+v", statuses)
+	}
+}
@@ -0,0 +1,78 @@
+// Package breaker sheds the load sent to failing dependencies using circuit breakers, calls are rejected right away
+// while the circuit is open instead of waiting for the dependency to time out.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mercari/go-circuitbreaker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// ErrOpen indicates the call was rejected because the circuit is open.
+var ErrOpen = errors.New("circuit breaker open")
+
+//nolint: gochecknoglobals
+var (
+	rejectedCalls = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/breaker")).NewInt64Counter(
+		"circuit_breaker.rejected",
+		metric.WithDescription("Number of calls rejected while the circuit is open, labeled by breaker"),
+	)
+
+	stateChanges = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/breaker")).NewInt64Counter(
+		"circuit_breaker.state_changes",
+		metric.WithDescription("Number of times the circuit changed its state, labeled by breaker and new state"),
+	)
+)
+
+// Breaker is a circuit breaker: after failing 3 consecutive times the circuit opens and calls are rejected for
+// openTimeout, after that calls are allowed again one at a time until one succeeds.
+type Breaker struct {
+	name       string
+	dependency internal.Dependency
+	cb         *circuitbreaker.CircuitBreaker
+}
+
+// New instantiates the Breaker, name identifies it in logs and metrics and dependency is the one guarded by it,
+// DependencyNone when it's not known.
+func New(logger *zap.Logger, name string, dependency internal.Dependency, openTimeout time.Duration) *Breaker {
+	return &Breaker{
+		name:       name,
+		dependency: dependency,
+		cb: circuitbreaker.New(
+			circuitbreaker.WithOpenTimeout(openTimeout),
+			circuitbreaker.WithTripFunc(circuitbreaker.NewTripFuncConsecutiveFailures(3)),
+			circuitbreaker.WithOnStateChangeHookFn(func(oldState, newState circuitbreaker.State) {
+				logger.Info("Circuit breaker state changed",
+					zap.String("breaker", name),
+					zap.String("old", string(oldState)),
+					zap.String("new", string(newState)),
+				)
+
+				stateChanges.Add(context.Background(), 1,
+					attribute.String("breaker", name),
+					attribute.String("state", string(newState)),
+				)
+			}),
+		),
+	}
+}
+
+// Do calls fn when the circuit is not open, otherwise an ErrOpen error using ErrorCodeUnavailable is returned. The
+// error returned by fn is returned as is.
+func (b *Breaker) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !b.cb.Ready() {
+		rejectedCalls.Add(ctx, 1, attribute.String("breaker", b.name))
+
+		return internal.WrapDependencyErrorf(ErrOpen, b.dependency, internal.ErrorCodeUnavailable, "%s not available", b.name)
+	}
+
+	return b.cb.Done(ctx, fn(ctx)) //nolint: wrapcheck
+}
@@ -0,0 +1,63 @@
+package breaker_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/breaker"
+)
+
+func TestBreaker_Do(t *testing.T) {
+	t.Parallel()
+
+	b := breaker.New(zap.NewNop(), "test", internal.DependencyRedis, 50*time.Millisecond)
+
+	var calls int
+
+	failing := func(context.Context) error {
+		calls++
+
+		return errors.New("failed")
+	}
+
+	// Consecutive failures open the circuit.
+
+	for i := 0; i < 3; i++ {
+		if err := b.Do(context.Background(), failing); err == nil || errors.Is(err, breaker.ErrOpen) {
+			t.Fatalf("expected the original error, got %v", err)
+		}
+	}
+
+	// Circuit is open: calls are rejected without calling the dependency.
+
+	err := b.Do(context.Background(), failing)
+	if !errors.Is(err, breaker.ErrOpen) {
+		t.Fatalf("expected %v error, got %v", breaker.ErrOpen, err)
+	}
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeUnavailable || ierr.Dependency() != internal.DependencyRedis {
+		t.Fatalf("expected unavailable error caused by %s, got %v", internal.DependencyRedis, err)
+	}
+
+	if calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", calls)
+	}
+
+	// Open timeout elapsed: a successful call closes the circuit.
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := b.Do(context.Background(), func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := b.Do(context.Background(), func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
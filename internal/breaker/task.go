@@ -0,0 +1,65 @@
+package breaker
+
+import (
+	"context"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskMessageBroker defines the message broker used for publishing Task messages.
+type TaskMessageBroker interface {
+	Created(ctx context.Context, task internal.Task) error
+	Deleted(ctx context.Context, id string) error
+	Updated(ctx context.Context, task internal.Task) error
+	Completed(ctx context.Context, task internal.Task) error
+	Reopened(ctx context.Context, task internal.Task) error
+}
+
+// Task publishes Task messages using the original message broker guarded by a circuit breaker.
+type Task struct {
+	orig    TaskMessageBroker
+	breaker *Breaker
+}
+
+// NewTask instantiates the Task message broker.
+func NewTask(orig TaskMessageBroker, breaker *Breaker) *Task {
+	return &Task{
+		orig:    orig,
+		breaker: breaker,
+	}
+}
+
+// Created publishes a message indicating a task was created.
+func (t *Task) Created(ctx context.Context, task internal.Task) error {
+	return t.breaker.Do(ctx, func(ctx context.Context) error {
+		return t.orig.Created(ctx, task) //nolint: wrapcheck
+	})
+}
+
+// Deleted publishes a message indicating a task was deleted.
+func (t *Task) Deleted(ctx context.Context, id string) error {
+	return t.breaker.Do(ctx, func(ctx context.Context) error {
+		return t.orig.Deleted(ctx, id) //nolint: wrapcheck
+	})
+}
+
+// Updated publishes a message indicating a task was updated.
+func (t *Task) Updated(ctx context.Context, task internal.Task) error {
+	return t.breaker.Do(ctx, func(ctx context.Context) error {
+		return t.orig.Updated(ctx, task) //nolint: wrapcheck
+	})
+}
+
+// Completed publishes a message indicating a task was completed.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.breaker.Do(ctx, func(ctx context.Context) error {
+		return t.orig.Completed(ctx, task) //nolint: wrapcheck
+	})
+}
+
+// Reopened publishes a message indicating a completed task was reopened.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.breaker.Do(ctx, func(ctx context.Context) error {
+		return t.orig.Reopened(ctx, task) //nolint: wrapcheck
+	})
+}
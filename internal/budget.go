@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/unit"
+)
+
+//nolint: gochecknoglobals
+var dependencyDuration = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal")).NewFloat64ValueRecorder(
+	"dependency.duration",
+	metric.WithDescription("Time in milliseconds spent calling dependencies, labeled by dependency"),
+	metric.WithUnit(unit.Milliseconds),
+)
+
+type budgetKey struct{}
+
+// Budget tracks how much of the time available for handling a request was consumed by each dependency, it's safe
+// for concurrent use.
+type Budget struct {
+	mu    sync.Mutex
+	start time.Time
+	limit time.Duration
+	spent map[Dependency]time.Duration
+}
+
+// BudgetEntry defines the time consumed by a dependency.
+type BudgetEntry struct {
+	Dependency Dependency
+	Duration   time.Duration
+}
+
+// NewContextWithBudget returns a new context that carries a budget started now, limit is the time available for
+// handling the request; the deadline of ctx is used instead when it's sooner.
+func NewContextWithBudget(ctx context.Context, limit time.Duration) (context.Context, *Budget) {
+	start := time.Now()
+
+	if deadline, ok := ctx.Deadline(); ok && (limit <= 0 || deadline.Sub(start) < limit) {
+		limit = deadline.Sub(start)
+	}
+
+	b := Budget{
+		start: start,
+		limit: limit,
+		spent: make(map[Dependency]time.Duration),
+	}
+
+	return context.WithValue(ctx, budgetKey{}, &b), &b
+}
+
+// BudgetFromContext returns the budget stored in ctx, nil if none.
+func BudgetFromContext(ctx context.Context) *Budget {
+	b, _ := ctx.Value(budgetKey{}).(*Budget)
+
+	return b
+}
+
+// TrackDependency starts measuring the time spent calling dependency, the returned function stops measuring it,
+// records it as a metric and adds it to the budget stored in ctx, if any.
+func TrackDependency(ctx context.Context, dependency Dependency) func() {
+	b := BudgetFromContext(ctx)
+	start := time.Now()
+
+	return func() {
+		elapsed := time.Since(start)
+
+		dependencyDuration.Record(ctx, float64(elapsed)/float64(time.Millisecond),
+			attribute.String("dependency", string(dependency)))
+
+		if b != nil {
+			b.Add(dependency, elapsed)
+		}
+	}
+}
+
+// Add adds the time consumed by the dependency.
+func (b *Budget) Add(dependency Dependency, d time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.spent[dependency] += d
+}
+
+// Elapsed returns the time since the budget started.
+func (b *Budget) Elapsed() time.Duration {
+	return time.Since(b.start)
+}
+
+// Limit returns the time available for handling the request, zero if unknown.
+func (b *Budget) Limit() time.Duration {
+	return b.limit
+}
+
+// Percent returns the percentage of the limit represented by d, zero if the limit is unknown.
+func (b *Budget) Percent(d time.Duration) float64 {
+	if b.limit <= 0 {
+		return 0
+	}
+
+	return float64(d) / float64(b.limit) * 100
+}
+
+// Entries returns the time consumed by each dependency, sorted by dependency.
+func (b *Budget) Entries() []BudgetEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := make([]BudgetEntry, 0, len(b.spent))
+
+	for dependency, d := range b.spent {
+		res = append(res, BudgetEntry{Dependency: dependency, Duration: d})
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].Dependency < res[j].Dependency })
+
+	return res
+}
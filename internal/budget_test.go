@@ -0,0 +1,73 @@
+package internal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx, budget := internal.NewContextWithBudget(context.Background(), time.Second)
+
+	if internal.BudgetFromContext(ctx) != budget {
+		t.Fatalf("expected budget in context")
+	}
+
+	budget.Add(internal.DependencyPostgreSQL, 10*time.Millisecond)
+	budget.Add(internal.DependencyElasticsearch, 80*time.Millisecond)
+	budget.Add(internal.DependencyPostgreSQL, 2*time.Millisecond)
+
+	internal.TrackDependency(ctx, internal.DependencyKafka)()
+
+	actual := budget.Entries()
+
+	if len(actual) != 3 || actual[1].Dependency != internal.DependencyKafka {
+		t.Fatalf("expected kafka to be tracked, got %v", actual)
+	}
+
+	actual[1].Duration = 0
+
+	expected := []internal.BudgetEntry{
+		{Dependency: internal.DependencyElasticsearch, Duration: 80 * time.Millisecond},
+		{Dependency: internal.DependencyKafka},
+		{Dependency: internal.DependencyPostgreSQL, Duration: 12 * time.Millisecond},
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+
+	if percent := budget.Percent(80 * time.Millisecond); percent != 8 {
+		t.Fatalf("expected 8 percent, got %f", percent)
+	}
+}
+
+func TestNewContextWithBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, budget := internal.NewContextWithBudget(ctx, time.Second)
+
+	if limit := budget.Limit(); limit > 100*time.Millisecond || limit <= 0 {
+		t.Fatalf("expected the deadline to be used as limit, got %s", limit)
+	}
+}
+
+func TestTrackDependency(t *testing.T) {
+	t.Parallel()
+
+	// Contexts without budget are ignored.
+	internal.TrackDependency(context.Background(), internal.DependencyPostgreSQL)()
+
+	if internal.BudgetFromContext(context.Background()) != nil {
+		t.Fatalf("expected no budget")
+	}
+}
@@ -0,0 +1,135 @@
+// Package calendar synchronizes the Tasks with due dates and the events of a Google Calendar both ways: Tasks are
+// mirrored as events, and the changes made to those events are applied back to the Tasks. Links between Tasks and
+// events are stored so updates and deletions propagate either way.
+package calendar
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//nolint: gochecknoglobals
+var (
+	pushed = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/calendar")).NewInt64Counter(
+		"calendar.pushed",
+		metric.WithDescription("Number of changes to tasks applied to the calendar"),
+	)
+
+	pulled = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/calendar")).NewInt64Counter(
+		"calendar.pulled",
+		metric.WithDescription("Number of changes to events applied to the tasks"),
+	)
+
+	conflicts = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/calendar")).NewInt64Counter(
+		"calendar.conflicts",
+		metric.WithDescription("Number of tasks and events changed on both sides since they were synchronized"),
+	)
+
+	dropped = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/calendar")).NewInt64Counter(
+		"calendar.dropped",
+		metric.WithDescription("Number of changes to tasks not synchronized because the queue was full"),
+	)
+)
+
+// Policy indicates which change is kept when a Task and its event changed since they were last synchronized.
+type Policy string
+
+const (
+	// PolicyLatest keeps the change made last.
+	PolicyLatest Policy = "latest"
+
+	// PolicyTasks keeps the change made to the Task.
+	PolicyTasks Policy = "tasks"
+
+	// PolicyCalendar keeps the change made to the event.
+	PolicyCalendar Policy = "calendar"
+)
+
+// Validate indicates whether the policy is supported.
+func (p Policy) Validate() error {
+	switch p {
+	case PolicyLatest, PolicyTasks, PolicyCalendar:
+		return nil
+	}
+
+	return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown policy %q", p)
+}
+
+// taskWins indicates whether the change made to the Task at taskUpdated is kept over the one made to the event at
+// eventUpdated. Tasks not including the time of their last change lose when keeping the latest one.
+func (p Policy) taskWins(taskUpdated, eventUpdated time.Time) bool {
+	switch p {
+	case PolicyTasks:
+		return true
+	case PolicyCalendar:
+		return false
+	}
+
+	return !taskUpdated.IsZero() && taskUpdated.After(eventUpdated)
+}
+
+// Event is a calendar event mirroring a Task, it takes place at the due date of the Task.
+type Event struct {
+	ID        string
+	TaskID    string // Empty when the event was not created by Sync.
+	Summary   string
+	Due       time.Time
+	TimeZone  string
+	Cancelled bool      // Events deleted from the calendar are only included when listing changes.
+	Updated   time.Time // Time of the last change made to the event.
+}
+
+// EventsClient defines the calendar API used for synchronizing the events, see Google.
+type EventsClient interface {
+	Insert(ctx context.Context, calendarID string, event Event) (Event, error)
+	Update(ctx context.Context, calendarID string, event Event) (Event, error)
+	Delete(ctx context.Context, calendarID, eventID string) error
+	Get(ctx context.Context, calendarID, eventID string) (Event, error)
+	// Changes returns the events changed since syncToken was returned, and the token used for listing the
+	// following ones. All the events are returned when syncToken is empty or expired.
+	Changes(ctx context.Context, calendarID, syncToken string) ([]Event, string, error)
+}
+
+// LinkRepository defines the datastore keeping the links between Tasks and events, and the token used for listing
+// the changes made to the calendar.
+type LinkRepository interface {
+	DeleteLink(ctx context.Context, taskID string) error
+	FindLinkByEvent(ctx context.Context, eventID string) (internal.CalendarLink, error)
+	FindLinkByTask(ctx context.Context, taskID string) (internal.CalendarLink, error)
+	SaveLink(ctx context.Context, link internal.CalendarLink) error
+	SaveSyncToken(ctx context.Context, calendarID, token string) error
+	SyncToken(ctx context.Context, calendarID string) (string, error)
+}
+
+// mirrored indicates whether the Task is mirrored in the calendar: undone Tasks with a due date.
+func mirrored(task internal.Task) bool {
+	return !task.IsDone && !task.Dates.Due.IsZero()
+}
+
+// taskFingerprint returns the fingerprint of the Task, empty when it's not mirrored.
+func taskFingerprint(task internal.Task) string {
+	if !mirrored(task) {
+		return ""
+	}
+
+	return fingerprint(task.Description, task.Dates.Due)
+}
+
+// eventFingerprint returns the fingerprint of the event, it matches the one of the Task it mirrors.
+func eventFingerprint(event Event) string {
+	return fingerprint(event.Summary, event.Due)
+}
+
+// fingerprint identifies the values synchronized, due dates are compared using seconds like the calendar does.
+func fingerprint(description string, due time.Time) string {
+	sum := sha256.Sum256([]byte(description + "\x00" + due.UTC().Truncate(time.Second).Format(time.RFC3339)))
+
+	return hex.EncodeToString(sum[:16])
+}
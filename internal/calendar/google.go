@@ -0,0 +1,202 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/googleapi"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// taskIDProperty is the private extended property of the events keeping the ID of the Task they mirror.
+	taskIDProperty = "todo_task_id"
+
+	// changesPageSize is the number of changed events listed at once.
+	changesPageSize = 250
+
+	statusCancelled = "cancelled"
+)
+
+// Google is the EventsClient using the Google Calendar API. Events take place at the due date of their Task and
+// last no time, the ID of the Task is kept in a private extended property.
+type Google struct {
+	svc *gcal.Service
+}
+
+// NewGoogle instantiates the Google client, svc must be authorized to manage the events of the calendar.
+func NewGoogle(svc *gcal.Service) *Google {
+	return &Google{
+		svc: svc,
+	}
+}
+
+// Insert creates the event.
+func (g *Google) Insert(ctx context.Context, calendarID string, event Event) (Event, error) {
+	res, err := g.svc.Events.Insert(calendarID, toGoogle(event)).Context(ctx).Do()
+	if err != nil {
+		return Event{}, wrapErrorf(err, "Events.Insert")
+	}
+
+	return fromGoogle(res), nil
+}
+
+// Update changes the summary and the dates of the event, the rest of its values are kept.
+func (g *Google) Update(ctx context.Context, calendarID string, event Event) (Event, error) {
+	res, err := g.svc.Events.Patch(calendarID, event.ID, toGoogle(event)).Context(ctx).Do()
+	if err != nil {
+		return Event{}, wrapErrorf(err, "Events.Patch")
+	}
+
+	return fromGoogle(res), nil
+}
+
+// Delete deletes the event.
+func (g *Google) Delete(ctx context.Context, calendarID, eventID string) error {
+	if err := g.svc.Events.Delete(calendarID, eventID).Context(ctx).Do(); err != nil {
+		return wrapErrorf(err, "Events.Delete")
+	}
+
+	return nil
+}
+
+// Get returns the event, deleted events are returned as cancelled.
+func (g *Google) Get(ctx context.Context, calendarID, eventID string) (Event, error) {
+	res, err := g.svc.Events.Get(calendarID, eventID).Context(ctx).Do()
+	if err != nil {
+		return Event{}, wrapErrorf(err, "Events.Get")
+	}
+
+	return fromGoogle(res), nil
+}
+
+// Changes returns the events changed since syncToken was returned, including the deleted ones. Expired tokens are
+// replaced by listing all the events again.
+func (g *Google) Changes(ctx context.Context, calendarID, syncToken string) ([]Event, string, error) {
+	var (
+		res       []Event
+		pageToken string
+	)
+
+	for {
+		call := g.svc.Events.List(calendarID).
+			Context(ctx).
+			MaxResults(changesPageSize).
+			ShowDeleted(true).
+			PageToken(pageToken)
+
+		if syncToken != "" {
+			call = call.SyncToken(syncToken)
+		}
+
+		events, err := call.Do()
+		if err != nil {
+			if syncToken != "" && statusCode(err) == http.StatusGone {
+				return g.Changes(ctx, calendarID, "")
+			}
+
+			return nil, "", wrapErrorf(err, "Events.List")
+		}
+
+		for _, event := range events.Items {
+			res = append(res, fromGoogle(event))
+		}
+
+		if events.NextPageToken == "" {
+			return res, events.NextSyncToken, nil
+		}
+
+		pageToken = events.NextPageToken
+	}
+}
+
+func toGoogle(event Event) *gcal.Event {
+	at := &gcal.EventDateTime{
+		DateTime: event.Due.Format(time.RFC3339),
+		TimeZone: event.TimeZone,
+	}
+
+	res := gcal.Event{
+		Summary: event.Summary,
+		Start:   at,
+		End:     at,
+	}
+
+	if event.TaskID != "" {
+		res.ExtendedProperties = &gcal.EventExtendedProperties{
+			Private: map[string]string{taskIDProperty: event.TaskID},
+		}
+	}
+
+	return &res
+}
+
+func fromGoogle(event *gcal.Event) Event {
+	res := Event{
+		ID:        event.Id,
+		Summary:   event.Summary,
+		Cancelled: event.Status == statusCancelled,
+	}
+
+	if event.ExtendedProperties != nil {
+		res.TaskID = event.ExtendedProperties.Private[taskIDProperty]
+	}
+
+	if event.Start != nil {
+		res.Due, res.TimeZone = parseDateTime(event.Start)
+	}
+
+	res.Updated, _ = time.Parse(time.RFC3339, event.Updated)
+
+	return res
+}
+
+// parseDateTime returns the time and the time zone of the start of an event, all-day events start at midnight in
+// their time zone.
+func parseDateTime(val *gcal.EventDateTime) (time.Time, string) {
+	if val.DateTime != "" {
+		res, _ := time.Parse(time.RFC3339, val.DateTime)
+
+		return res, val.TimeZone
+	}
+
+	loc := time.UTC
+
+	if val.TimeZone != "" {
+		if l, err := time.LoadLocation(val.TimeZone); err == nil {
+			loc = l
+		}
+	}
+
+	res, _ := time.ParseInLocation("2006-01-02", val.Date, loc)
+
+	return res, val.TimeZone
+}
+
+func statusCode(err error) int {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return gerr.Code
+	}
+
+	return 0
+}
+
+// wrapErrorf wraps the errors returned by the API, events not found or already deleted use
+// internal.ErrorCodeNotFound.
+func wrapErrorf(err error, format string, a ...interface{}) error {
+	code := internal.ErrorCodeUnknown
+
+	switch statusCode(err) {
+	case http.StatusNotFound, http.StatusGone:
+		code = internal.ErrorCodeNotFound
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusServiceUnavailable:
+		code = internal.ErrorCodeUnavailable
+	}
+
+	return internal.WrapErrorf(err, code, format, a...)
+}
@@ -0,0 +1,152 @@
+package calendar_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	gcal "google.golang.org/api/calendar/v3"
+	"google.golang.org/api/option"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+)
+
+func TestGoogle_Insert(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2026, time.October, 20, 9, 30, 0, 0, time.UTC)
+
+	var received gcal.Event
+
+	client := newGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/calendars/primary/events" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("expected no error, got %s", err)
+		}
+
+		received.Id = "event1"
+		received.Updated = "2026-10-17T10:00:00Z"
+
+		_ = json.NewEncoder(w).Encode(received)
+	})
+
+	actual, err := client.Insert(context.Background(), "primary", calendar.Event{
+		TaskID:   "1c0a4b5e-5d2f-4f7a-9d4f-0b1f8a3c2e11",
+		Summary:  "write report",
+		Due:      due,
+		TimeZone: "America/New_York",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if received.Start.DateTime != "2026-10-20T09:30:00Z" || received.End.DateTime != received.Start.DateTime ||
+		received.Start.TimeZone != "America/New_York" {
+		t.Fatalf("expected event taking place at the due date, got %+v", received.Start)
+	}
+
+	expected := calendar.Event{
+		ID:       "event1",
+		TaskID:   "1c0a4b5e-5d2f-4f7a-9d4f-0b1f8a3c2e11",
+		Summary:  "write report",
+		Due:      due,
+		TimeZone: "America/New_York",
+		Updated:  time.Date(2026, time.October, 17, 10, 0, 0, 0, time.UTC),
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestGoogle_Changes(t *testing.T) {
+	t.Parallel()
+
+	client := newGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var res gcal.Events
+
+		switch {
+		case query.Get("syncToken") == "expired":
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"error":{"code":410,"message":"Sync token is no longer valid"}}`))
+
+			return
+		case query.Get("pageToken") == "":
+			res = gcal.Events{
+				Items: []*gcal.Event{
+					{Id: "event1", Start: &gcal.EventDateTime{Date: "2026-10-20", TimeZone: "UTC"}},
+				},
+				NextPageToken: "page2",
+			}
+		default:
+			res = gcal.Events{
+				Items:         []*gcal.Event{{Id: "event2", Status: "cancelled"}},
+				NextSyncToken: "token2",
+			}
+		}
+
+		_ = json.NewEncoder(w).Encode(res)
+	})
+
+	// Expired tokens list all the events again.
+	events, token, err := client.Changes(context.Background(), "primary", "expired")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := []calendar.Event{
+		{ID: "event1", Due: time.Date(2026, time.October, 20, 0, 0, 0, 0, time.UTC), TimeZone: "UTC"},
+		{ID: "event2", Cancelled: true},
+	}
+
+	if !cmp.Equal(expected, events) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, events))
+	}
+
+	if token != "token2" {
+		t.Fatalf("expected token2, got %q", token)
+	}
+}
+
+func TestGoogle_Get_NotFound(t *testing.T) {
+	t.Parallel()
+
+	client := newGoogle(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Not Found"}}`))
+	})
+
+	_, err := client.Get(context.Background(), "primary", "missing")
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
+
+func newGoogle(t *testing.T, handler http.HandlerFunc) *calendar.Google {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	svc, err := gcal.NewService(context.Background(),
+		option.WithHTTPClient(srv.Client()),
+		option.WithEndpoint(srv.URL+"/"))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	return calendar.NewGoogle(svc)
+}
@@ -0,0 +1,194 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskService defines the service used for applying the changes made to the events.
+type TaskService interface {
+	Delete(ctx context.Context, id string) error
+	Task(ctx context.Context, id string) (internal.Task, error)
+	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates,
+		projectID string, isDone bool) error
+}
+
+// Puller applies the changes made to the events mirroring Tasks, listed using the sync token of the calendar:
+// changing the summary or the start of an event updates the description or due date of its Task, and deleting the
+// event deletes the Task.
+type Puller struct {
+	logger   *zap.Logger
+	sync     *Sync
+	tasks    TaskService
+	interval time.Duration
+}
+
+// NewPuller instantiates the Puller, the changes are listed every interval.
+func NewPuller(logger *zap.Logger, sync *Sync, tasks TaskService, interval time.Duration) *Puller {
+	return &Puller{
+		logger:   logger,
+		sync:     sync,
+		tasks:    tasks,
+		interval: interval,
+	}
+}
+
+// Run applies the changes until ctx is canceled, failures are logged and retried in the next interval.
+func (p *Puller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Pull(ctx); err != nil {
+			p.logger.Warn("Couldn't synchronize calendar to tasks", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Pull applies the changes made since the last time, it returns the number of Tasks changed. The sync token is
+// only saved once all the changes are applied, applying them again is harmless.
+func (p *Puller) Pull(ctx context.Context) (int, error) {
+	token, err := p.sync.links.SyncToken(ctx, p.sync.calendarID)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.SyncToken")
+	}
+
+	events, next, err := p.sync.events.Changes(ctx, p.sync.calendarID, token)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "events.Changes")
+	}
+
+	var changed int
+
+	for _, event := range events {
+		applied, err := p.apply(ctx, event)
+		if err != nil {
+			return changed, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "apply %s", event.ID)
+		}
+
+		if applied {
+			changed++
+
+			pulled.Add(ctx, 1)
+		}
+	}
+
+	if err := p.sync.links.SaveSyncToken(ctx, p.sync.calendarID, next); err != nil {
+		return changed, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.SaveSyncToken")
+	}
+
+	return changed, nil
+}
+
+// apply applies the change made to the event to its Task, events not mirroring Tasks are ignored. When the Task
+// changed as well since they were synchronized, the policy indicates which change is kept.
+func (p *Puller) apply(ctx context.Context, event Event) (bool, error) {
+	link, err := p.sync.links.FindLinkByEvent(ctx, event.ID)
+	if err != nil {
+		if isNotFound(err) {
+			return false, nil
+		}
+
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.FindLinkByEvent")
+	}
+
+	if event.Cancelled {
+		if err := p.sync.links.DeleteLink(ctx, link.TaskID); err != nil && !isNotFound(err) {
+			return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.DeleteLink")
+		}
+
+		if err := p.tasks.Delete(ctx, link.TaskID); err != nil && !isNotFound(err) {
+			return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Delete")
+		}
+
+		return true, nil
+	}
+
+	fp := eventFingerprint(event)
+
+	// Already synchronized, for example the change was made by Sync; events without a start are not supported.
+	if fp == link.Fingerprint || event.Due.IsZero() {
+		return false, nil
+	}
+
+	task, err := p.tasks.Task(ctx, link.TaskID)
+	if err != nil {
+		if isNotFound(err) {
+			return false, p.sync.unlink(ctx, link)
+		}
+
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Task")
+	}
+
+	// Tasks completed, or without due date, are not mirrored anymore; the event is deleted instead.
+	if !mirrored(task) {
+		p.sync.Notify(ctx, Change{Task: task})
+
+		return false, nil
+	}
+
+	if taskFingerprint(task) != link.Fingerprint {
+		conflicts.Add(ctx, 1)
+
+		// The Task is synchronized again, so its change replaces the one made to the event.
+		if p.sync.policy.taskWins(task.UpdatedAt, event.Updated) {
+			p.sync.Notify(ctx, Change{Task: task})
+
+			return false, nil
+		}
+	}
+
+	// Saved first so the update is not pushed back to the calendar, restored when updating fails.
+	if err := p.sync.links.SaveLink(ctx, internal.CalendarLink{
+		TaskID:      link.TaskID,
+		EventID:     link.EventID,
+		Fingerprint: fp,
+	}); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.SaveLink")
+	}
+
+	if err := p.update(ctx, task, event); err != nil {
+		if lerr := p.sync.links.SaveLink(ctx, link); lerr != nil {
+			p.logger.Warn("Couldn't restore calendar link", zap.String("id", link.TaskID), zap.Error(lerr))
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// update changes the description and due date of the Task, as long as it did not change since it was read.
+func (p *Puller) update(ctx context.Context, task internal.Task, event Event) error {
+	description := event.Summary
+	if description == "" {
+		description = task.Description
+	}
+
+	dates := task.Dates
+	dates.Due = event.Due
+
+	if !dates.Start.IsZero() && dates.Start.After(dates.Due) {
+		dates.Start = time.Time{}
+	}
+
+	if task.Version > 0 {
+		ctx = internal.NewContextWithExpectedVersion(ctx, task.Version)
+	}
+
+	if err := p.tasks.Update(ctx, task.ID, description, task.Priority, dates, task.ProjectID, false); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Update")
+	}
+
+	return nil
+}
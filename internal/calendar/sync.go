@@ -0,0 +1,178 @@
+package calendar
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Change is a change made to a Task, deleted Tasks only include their ID.
+type Change struct {
+	Deleted bool
+	Task    internal.Task
+}
+
+// Sync mirrors the Tasks in the calendar in the background, so the requests changing Tasks don't wait for the
+// calendar; see Puller for applying the changes made to the events.
+type Sync struct {
+	logger     *zap.Logger
+	events     EventsClient
+	links      LinkRepository
+	calendarID string
+	policy     Policy
+	queue      chan Change
+}
+
+// NewSync instantiates the Sync, queueSize indicates the maximum number of changes waiting to be synchronized;
+// changes are dropped when the queue is full.
+func NewSync(logger *zap.Logger, events EventsClient, links LinkRepository, calendarID string, policy Policy,
+	queueSize int) *Sync {
+	return &Sync{
+		logger:     logger,
+		events:     events,
+		links:      links,
+		calendarID: calendarID,
+		policy:     policy,
+		queue:      make(chan Change, queueSize),
+	}
+}
+
+// Notify queues the change to be synchronized by Run.
+func (s *Sync) Notify(ctx context.Context, change Change) {
+	select {
+	case s.queue <- change:
+	default:
+		dropped.Add(ctx, 1)
+
+		s.logger.Warn("Calendar change dropped, queue is full", zap.String("id", change.Task.ID))
+	}
+}
+
+// Run synchronizes the queued changes until ctx is cancelled, failures are logged.
+func (s *Sync) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case change := <-s.queue:
+			if err := s.Push(ctx, change); err != nil {
+				s.logger.Warn("Couldn't synchronize task to calendar", zap.String("id", change.Task.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// Push applies the change to the event mirroring the Task: the event is created when the Task gets a due date,
+// updated when its description or due date change, and deleted when the Task is deleted, completed or loses its due
+// date. Changes made to the event since the last synchronization are kept when the policy says so, those are
+// applied to the Task by Puller instead.
+func (s *Sync) Push(ctx context.Context, change Change) error {
+	link, err := s.links.FindLinkByTask(ctx, change.Task.ID)
+	if err != nil && !isNotFound(err) {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.FindLinkByTask")
+	}
+
+	linked := err == nil
+
+	if change.Deleted || !mirrored(change.Task) {
+		if !linked {
+			return nil
+		}
+
+		return s.unlink(ctx, link)
+	}
+
+	fp := taskFingerprint(change.Task)
+
+	if !linked {
+		event, err := s.events.Insert(ctx, s.calendarID, newEvent(change.Task))
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "events.Insert")
+		}
+
+		return s.link(ctx, internal.CalendarLink{TaskID: change.Task.ID, EventID: event.ID, Fingerprint: fp})
+	}
+
+	// Already synchronized, for example the change was made by Puller.
+	if link.Fingerprint == fp {
+		return nil
+	}
+
+	current, err := s.events.Get(ctx, s.calendarID, link.EventID)
+	if err != nil {
+		// Events deleted from the calendar delete their Task, see Puller.
+		if isNotFound(err) {
+			return nil
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "events.Get")
+	}
+
+	if current.Cancelled {
+		return nil
+	}
+
+	if eventFingerprint(current) != link.Fingerprint {
+		conflicts.Add(ctx, 1)
+
+		if !s.policy.taskWins(change.Task.UpdatedAt, current.Updated) {
+			return nil
+		}
+	}
+
+	event := newEvent(change.Task)
+	event.ID = link.EventID
+
+	if _, err := s.events.Update(ctx, s.calendarID, event); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "events.Update")
+	}
+
+	link.Fingerprint = fp
+
+	return s.link(ctx, link)
+}
+
+// link saves the link after synchronizing the Task.
+func (s *Sync) link(ctx context.Context, link internal.CalendarLink) error {
+	if err := s.links.SaveLink(ctx, link); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.SaveLink")
+	}
+
+	pushed.Add(ctx, 1)
+
+	return nil
+}
+
+// unlink deletes the event and its link, the link is deleted first so the deletion is not pulled back.
+func (s *Sync) unlink(ctx context.Context, link internal.CalendarLink) error {
+	if err := s.links.DeleteLink(ctx, link.TaskID); err != nil && !isNotFound(err) {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.DeleteLink")
+	}
+
+	if err := s.events.Delete(ctx, s.calendarID, link.EventID); err != nil && !isNotFound(err) {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "events.Delete")
+	}
+
+	pushed.Add(ctx, 1)
+
+	return nil
+}
+
+// newEvent returns the event mirroring the Task.
+func newEvent(task internal.Task) Event {
+	return Event{
+		TaskID:   task.ID,
+		Summary:  task.Description,
+		Due:      task.Dates.Due,
+		TimeZone: task.Dates.TimeZone,
+	}
+}
+
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	return errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound
+}
@@ -0,0 +1,446 @@
+package calendar_test
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+)
+
+const calendarID = "primary"
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+
+	tasks := taskService{memory.NewTask()}
+	events := newFakeEvents()
+
+	mirror := calendar.NewSync(zap.NewNop(), events, memory.NewCalendarLink(), calendarID, calendar.PolicyLatest, 10)
+	puller := calendar.NewPuller(zap.NewNop(), mirror, tasks, time.Minute)
+
+	due := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+
+	task := createTask(t, tasks, "write report", due)
+
+	// Tasks with due date are mirrored.
+
+	push(t, mirror, calendar.Change{Task: task})
+
+	event := events.only(t)
+	if event.TaskID != task.ID || event.Summary != "write report" || !event.Due.Equal(due) {
+		t.Fatalf("expected event mirroring the task, got %+v", event)
+	}
+
+	// Changes already synchronized are ignored.
+
+	push(t, mirror, calendar.Change{Task: task})
+
+	if events.updates != 0 {
+		t.Fatalf("expected no updates, got %d", events.updates)
+	}
+
+	task.Description = "write final report"
+
+	push(t, mirror, calendar.Change{Task: task})
+
+	if event = events.only(t); event.Summary != "write final report" {
+		t.Fatalf("expected event summary updated, got %q", event.Summary)
+	}
+
+	// Changes made to the event are applied to the task, once; those are not pushed back.
+
+	assertPulled(t, puller, 0)
+
+	events.change(event.ID, "review report", due.Add(time.Hour))
+
+	assertPulled(t, puller, 1)
+	assertPulled(t, puller, 0)
+
+	task = findTask(t, tasks, task.ID)
+	if task.Description != "review report" || !task.Dates.Due.Equal(due.Add(time.Hour)) {
+		t.Fatalf("expected task updated, got %+v", task)
+	}
+
+	updates := events.updates
+
+	push(t, mirror, calendar.Change{Task: task})
+
+	if events.updates != updates {
+		t.Fatalf("expected no updates, got %d", events.updates-updates)
+	}
+
+	// Completed tasks are not mirrored anymore.
+
+	task.IsDone = true
+
+	push(t, mirror, calendar.Change{Task: task})
+
+	if len(events.active()) != 0 {
+		t.Fatalf("expected event deleted, got %v", events.active())
+	}
+
+	// Deleting events deletes their task.
+
+	task = createTask(t, tasks, "call back", due)
+
+	push(t, mirror, calendar.Change{Task: task})
+
+	events.cancel(events.only(t).ID)
+
+	assertPulled(t, puller, 1)
+
+	if _, err := tasks.Task(context.Background(), task.ID); err == nil {
+		t.Fatalf("expected task deleted")
+	}
+}
+
+func TestPuller_Conflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		policy         calendar.Policy
+		taskLast       bool
+		expectedPulled int
+		expectedDesc   string
+	}{
+		{
+			"latest: task changed last",
+			calendar.PolicyLatest,
+			true,
+			0,
+			"task change",
+		},
+		{
+			"latest: event changed last",
+			calendar.PolicyLatest,
+			false,
+			1,
+			"event change",
+		},
+		{
+			"tasks",
+			calendar.PolicyTasks,
+			false,
+			0,
+			"task change",
+		},
+		{
+			"calendar",
+			calendar.PolicyCalendar,
+			true,
+			1,
+			"event change",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tasks := taskService{memory.NewTask()}
+			events := newFakeEvents()
+
+			mirror := calendar.NewSync(zap.NewNop(), events, memory.NewCalendarLink(), calendarID, tt.policy, 10)
+			puller := calendar.NewPuller(zap.NewNop(), mirror, tasks, time.Minute)
+
+			due := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+
+			task := createTask(t, tasks, "original", due)
+
+			push(t, mirror, calendar.Change{Task: task})
+
+			assertPulled(t, puller, 0)
+
+			// Both sides change before synchronizing again.
+
+			changeEvent := func() { events.change(events.only(t).ID, "event change", due) }
+
+			changeTask := func() {
+				if err := tasks.Update(context.Background(), task.ID, "task change", task.Priority, task.Dates, "",
+					false); err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+			}
+
+			first, last := changeTask, changeEvent
+			if tt.taskLast {
+				first, last = changeEvent, changeTask
+			}
+
+			first()
+			time.Sleep(time.Millisecond)
+			last()
+
+			assertPulled(t, puller, tt.expectedPulled)
+
+			// Changes kept in the task are synchronized again by Sync.
+			if tt.expectedPulled == 0 {
+				push(t, mirror, calendar.Change{Task: findTask(t, tasks, task.ID)})
+			}
+
+			if actual := findTask(t, tasks, task.ID).Description; actual != tt.expectedDesc {
+				t.Fatalf("expected task description %q, got %q", tt.expectedDesc, actual)
+			}
+
+			if actual := events.only(t).Summary; actual != tt.expectedDesc {
+				t.Fatalf("expected event summary %q, got %q", tt.expectedDesc, actual)
+			}
+		})
+	}
+}
+
+func TestTask(t *testing.T) {
+	t.Parallel()
+
+	events := newFakeEvents()
+	mirror := calendar.NewSync(zap.NewNop(), events, memory.NewCalendarLink(), calendarID, calendar.PolicyLatest, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go mirror.Run(ctx)
+
+	broker := calendar.NewTask(discard{}, mirror)
+
+	task := internal.Task{
+		ID:          "1c0a4b5e-5d2f-4f7a-9d4f-0b1f8a3c2e11",
+		Description: "mirrored",
+		Dates:       internal.Dates{Due: time.Now().Add(time.Hour)},
+	}
+
+	if err := broker.Created(context.Background(), task); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	waitFor(t, func() bool { return len(events.active()) == 1 })
+
+	if err := broker.Deleted(context.Background(), task.ID); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	waitFor(t, func() bool { return len(events.active()) == 0 })
+}
+
+//-
+
+// taskService uses the memory store as the service.
+type taskService struct {
+	store *memory.Task
+}
+
+func (s taskService) Delete(ctx context.Context, id string) error {
+	return s.store.Delete(ctx, id) //nolint: wrapcheck
+}
+
+func (s taskService) Task(ctx context.Context, id string) (internal.Task, error) {
+	return s.store.Find(ctx, id) //nolint: wrapcheck
+}
+
+//nolint: lll
+func (s taskService) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	return s.store.Update(ctx, id, description, priority, dates, projectID, isDone) //nolint: wrapcheck
+}
+
+type discard struct{}
+
+func (discard) Created(context.Context, internal.Task) error   { return nil }
+func (discard) Deleted(context.Context, string) error          { return nil }
+func (discard) Updated(context.Context, internal.Task) error   { return nil }
+func (discard) Completed(context.Context, internal.Task) error { return nil }
+func (discard) Reopened(context.Context, internal.Task) error  { return nil }
+
+// fakeEvents keeps the events in memory, the sync tokens are the number of changes made so far.
+type fakeEvents struct {
+	mu      sync.Mutex
+	events  map[string]calendar.Event
+	changes []string
+	updates int
+}
+
+func newFakeEvents() *fakeEvents {
+	return &fakeEvents{events: make(map[string]calendar.Event)}
+}
+
+func (f *fakeEvents) Insert(_ context.Context, _ string, event calendar.Event) (calendar.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	event.ID = "event" + strconv.Itoa(len(f.events))
+
+	f.save(event)
+
+	return event, nil
+}
+
+func (f *fakeEvents) Update(_ context.Context, _ string, event calendar.Event) (calendar.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.events[event.ID]; !ok {
+		return calendar.Event{}, internal.NewErrorf(internal.ErrorCodeNotFound, "not found")
+	}
+
+	f.updates++
+	f.save(event)
+
+	return event, nil
+}
+
+func (f *fakeEvents) Delete(_ context.Context, _, eventID string) error {
+	f.cancel(eventID)
+
+	return nil
+}
+
+func (f *fakeEvents) Get(_ context.Context, _, eventID string) (calendar.Event, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	event, ok := f.events[eventID]
+	if !ok {
+		return calendar.Event{}, internal.NewErrorf(internal.ErrorCodeNotFound, "not found")
+	}
+
+	return event, nil
+}
+
+func (f *fakeEvents) Changes(_ context.Context, _, syncToken string) ([]calendar.Event, string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	from, _ := strconv.Atoi(syncToken)
+
+	var res []calendar.Event
+
+	for _, id := range f.changes[from:] {
+		res = append(res, f.events[id])
+	}
+
+	return res, strconv.Itoa(len(f.changes)), nil
+}
+
+// change changes the event like a person using the calendar.
+func (f *fakeEvents) change(id, summary string, due time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	event := f.events[id]
+	event.Summary = summary
+	event.Due = due
+
+	f.save(event)
+}
+
+func (f *fakeEvents) cancel(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	event := f.events[id]
+	event.Cancelled = true
+
+	f.save(event)
+}
+
+func (f *fakeEvents) save(event calendar.Event) {
+	event.Updated = time.Now().UTC()
+
+	f.events[event.ID] = event
+	f.changes = append(f.changes, event.ID)
+}
+
+func (f *fakeEvents) active() []calendar.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var res []calendar.Event
+
+	for _, event := range f.events {
+		if !event.Cancelled {
+			res = append(res, event)
+		}
+	}
+
+	return res
+}
+
+func (f *fakeEvents) only(t *testing.T) calendar.Event {
+	t.Helper()
+
+	res := f.active()
+	if len(res) != 1 {
+		t.Fatalf("expected one event, got %v", res)
+	}
+
+	return res[0]
+}
+
+func createTask(t *testing.T, tasks taskService, description string, due time.Time) internal.Task {
+	t.Helper()
+
+	task, err := tasks.store.Create(context.Background(), internal.CreateParams{
+		Description: description,
+		Priority:    internal.PriorityMedium,
+		Dates:       internal.Dates{Due: due},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	return task
+}
+
+func findTask(t *testing.T, tasks taskService, id string) internal.Task {
+	t.Helper()
+
+	task, err := tasks.Task(context.Background(), id)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	return task
+}
+
+func push(t *testing.T, mirror *calendar.Sync, change calendar.Change) {
+	t.Helper()
+
+	if err := mirror.Push(context.Background(), change); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+}
+
+func assertPulled(t *testing.T, puller *calendar.Puller, expected int) {
+	t.Helper()
+
+	actual, err := puller.Pull(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if actual != expected {
+		t.Fatalf("expected %d changes pulled, got %d", expected, actual)
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("condition not met")
+}
@@ -0,0 +1,10 @@
+package internal
+
+// CalendarLink links a Task to the event mirroring it in a calendar. Fingerprint identifies the description and
+// due date last synchronized, so changes made on either side are detected and the ones already synchronized are
+// ignored.
+type CalendarLink struct {
+	TaskID      string
+	EventID     string
+	Fingerprint string
+}
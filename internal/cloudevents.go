@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// CloudEventsContentType is the content type used for messages using the CloudEvents structured mode.
+	CloudEventsContentType = "application/cloudevents+json"
+
+	cloudEventsSpecVersion = "1.0"
+)
+
+// CloudEvent represents an event using the CloudEvents 1.0 JSON format, see https://cloudevents.io/ for details.
+// DataVersion is an extension attribute indicating the version of data, see TaskEventVersion.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	DataVersion     int             `json:"dataversion,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewCloudEvent returns a new CloudEvent with a unique id, data is encoded as JSON.
+func NewCloudEvent(source, eventType string, data interface{}) (CloudEvent, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return CloudEvent{}, WrapErrorf(err, ErrorCodeUnknown, "json.Marshal")
+	}
+
+	return CloudEvent{
+		SpecVersion:     cloudEventsSpecVersion,
+		ID:              uuid.NewString(),
+		Source:          source,
+		Type:            eventType,
+		Time:            time.Now().UTC(),
+		DataContentType: "application/json",
+		DataVersion:     TaskEventVersion,
+		Data:            b,
+	}, nil
+}
+
+// DecodeCloudEvent decodes b as a CloudEvent, an error is returned when b is not using that format.
+func DecodeCloudEvent(b []byte) (CloudEvent, error) {
+	var res CloudEvent
+
+	if err := json.Unmarshal(b, &res); err != nil {
+		return CloudEvent{}, WrapErrorf(err, ErrorCodeInvalidArgument, "json.Unmarshal")
+	}
+
+	if res.SpecVersion != cloudEventsSpecVersion || res.Type == "" {
+		return CloudEvent{}, NewErrorf(ErrorCodeInvalidArgument, "not a cloud event")
+	}
+
+	return res, nil
+}
+
+// DecodeData decodes the data of the event into v.
+func (c CloudEvent) DecodeData(v interface{}) error {
+	if err := json.Unmarshal(c.Data, v); err != nil {
+		return WrapErrorf(err, ErrorCodeInvalidArgument, "json.Unmarshal")
+	}
+
+	return nil
+}
+
+// DecodeTask decodes the data of the event as a Task, see DecodeTaskEventData.
+func (c CloudEvent) DecodeTask(ctx context.Context) (Task, error) {
+	return DecodeTaskEventData(ctx, c.DataVersion, c.Data)
+}
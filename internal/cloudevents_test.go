@@ -0,0 +1,92 @@
+package internal_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestCloudEvent(t *testing.T) {
+	t.Parallel()
+
+	expected := internal.Task{
+		ID:          "1-2-3",
+		Description: "event",
+		Priority:    internal.PriorityHigh,
+	}
+
+	evt, err := internal.NewCloudEvent("/source", "tasks.event.created", expected)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	b, err := json.Marshal(evt)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	actualEvt, err := internal.DecodeCloudEvent(b)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if actualEvt.ID == "" || actualEvt.Type != "tasks.event.created" || actualEvt.Source != "/source" {
+		t.Fatalf("expected valid event, got %#v", actualEvt)
+	}
+
+	if actualEvt.DataVersion != internal.TaskEventVersion {
+		t.Fatalf("expected data version %d, got %d", internal.TaskEventVersion, actualEvt.DataVersion)
+	}
+
+	actual, err := actualEvt.DecodeTask(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestDecodeCloudEvent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		withErr bool
+	}{
+		{
+			"OK",
+			`{"specversion":"1.0","id":"1","source":"/s","type":"t","data":"id"}`,
+			false,
+		},
+		{
+			"ERR: original format",
+			`{"Type":"tasks.event.created","Value":{}}`,
+			true,
+		},
+		{
+			"ERR: invalid JSON",
+			`"1-2-3"x`,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := internal.DecodeCloudEvent([]byte(tt.input))
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package internal
+
+import (
+	"sync"
+	"time"
+)
+
+// CompatVersion is the version of the data written by this build: database columns, snapshots and events. It must
+// be increased when older builds can't read the data written by this one without losing part of it.
+//
+//   - 1: original columns.
+//   - 2: dates stored as instants with an explicit time zone.
+//   - 3: tasks assigned to projects.
+//   - 4: completion times of tasks.
+//   - 5: update times of tasks.
+const CompatVersion = 5
+
+// MinCompatVersion is the oldest version that can keep serving traffic while this build is deployed, older
+// instances must be replaced before rolling out this build.
+const MinCompatVersion = 2
+
+// Instance represents a running instance of the server, those save heartbeats so the versions serving traffic
+// during rolling deploys are known.
+type Instance struct {
+	ID         string
+	Version    int
+	MinVersion int
+	StartedAt  time.Time
+	SeenAt     time.Time
+}
+
+// CompatFlags enables writing the data introduced by newer versions, while disabled those instances keep writing
+// only the data older versions read. Flags are meant to be enabled once every instance serving traffic uses the
+// version indicated by CompatFlag.Version or a newer one.
+type CompatFlags struct {
+	// WriteProjects allows assigning tasks to projects; older versions don't know about projects and drop them
+	// from the tasks they update.
+	WriteProjects bool
+}
+
+// CompatFlag describes a flag: Version is the oldest version able to read the data written when it's enabled.
+type CompatFlag struct {
+	Name    string
+	Version int
+	Enabled bool
+}
+
+// List returns the flags.
+func (f CompatFlags) List() []CompatFlag {
+	return []CompatFlag{
+		{Name: "write_projects", Version: 3, Enabled: f.WriteProjects},
+	}
+}
+
+// CompatFlagSet holds the flags in use by this instance, those can be changed while running. It's safe for
+// concurrent use.
+type CompatFlagSet struct {
+	mu    sync.RWMutex
+	flags CompatFlags
+}
+
+// NewCompatFlagSet instantiates the set using flags.
+func NewCompatFlagSet(flags CompatFlags) *CompatFlagSet {
+	return &CompatFlagSet{
+		flags: flags,
+	}
+}
+
+// Flags returns the flags in use.
+func (s *CompatFlagSet) Flags() CompatFlags {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.flags
+}
+
+// Set enables or disables the flag named name, see CompatFlag.Name.
+func (s *CompatFlagSet) Set(name string, enabled bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch name {
+	case "write_projects":
+		s.flags.WriteProjects = enabled
+	default:
+		return NewErrorf(ErrorCodeNotFound, "unknown flag: %s", name)
+	}
+
+	return nil
+}
+
+// CompatStatus summarizes the versions of the instances serving traffic.
+type CompatStatus struct {
+	Instances []Instance
+
+	// MinServingVersion is the oldest version serving traffic.
+	MinServingVersion int
+
+	// MinCompatibleVersion is the oldest version all the instances serving traffic can run alongside of.
+	MinCompatibleVersion int
+}
+
+// NewCompatStatus returns the status of the instances.
+func NewCompatStatus(instances []Instance) CompatStatus {
+	res := CompatStatus{
+		Instances: instances,
+	}
+
+	for i, instance := range instances {
+		if i == 0 || instance.Version < res.MinServingVersion {
+			res.MinServingVersion = instance.Version
+		}
+
+		if instance.MinVersion > res.MinCompatibleVersion {
+			res.MinCompatibleVersion = instance.MinVersion
+		}
+	}
+
+	return res
+}
+
+// Compatible indicates whether the versions serving traffic can run alongside each other.
+func (s CompatStatus) Compatible() bool {
+	return s.MinServingVersion >= s.MinCompatibleVersion
+}
+
+// Safe indicates whether the flag can be enabled, that is every instance serving traffic reads the data written
+// when it's enabled.
+func (s CompatStatus) Safe(flag CompatFlag) bool {
+	return len(s.Instances) > 0 && s.MinServingVersion >= flag.Version
+}
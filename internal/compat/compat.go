@@ -0,0 +1,120 @@
+// Package compat tracks the versions of the instances serving traffic during rolling deploys: each instance saves a
+// heartbeat periodically and the ones not seen recently are considered gone, so the data introduced by newer
+// versions is written only once every instance can read it.
+package compat
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// missedHeartbeats is the number of heartbeats an instance can miss before it's considered gone.
+const missedHeartbeats = 3
+
+// InstanceRepository defines the datastore keeping the heartbeats of the instances.
+type InstanceRepository interface {
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, seenAfter time.Time) ([]internal.Instance, error)
+	Save(ctx context.Context, instance internal.Instance) error
+}
+
+// Registry saves the heartbeats of this instance and reports the versions of all the instances serving traffic.
+type Registry struct {
+	logger   *zap.Logger
+	repo     InstanceRepository
+	interval time.Duration
+	instance internal.Instance
+}
+
+// NewRegistry instantiates the Registry of this instance, heartbeats are saved every interval.
+func NewRegistry(logger *zap.Logger, repo InstanceRepository, interval time.Duration) *Registry {
+	return &Registry{
+		logger:   logger,
+		repo:     repo,
+		interval: interval,
+		instance: internal.Instance{
+			ID:         uuid.NewString(),
+			Version:    internal.CompatVersion,
+			MinVersion: internal.MinCompatVersion,
+			StartedAt:  time.Now().UTC(),
+		},
+	}
+}
+
+// Instance returns this instance.
+func (r *Registry) Instance() internal.Instance {
+	return r.instance
+}
+
+// Run saves the heartbeats until ctx is canceled, failures are logged and retried in the next heartbeat.
+func (r *Registry) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.heartbeat(ctx); err != nil {
+			r.logger.Warn("Couldn't save heartbeat", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown removes this instance, it must be called once it stops serving traffic.
+func (r *Registry) Shutdown(ctx context.Context) error {
+	if err := r.repo.Delete(ctx, r.instance.ID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Delete")
+	}
+
+	return nil
+}
+
+// Status returns the versions of the instances serving traffic, the oldest instances first; this instance is always
+// included even if its heartbeat was not saved yet.
+func (r *Registry) Status(ctx context.Context) (internal.CompatStatus, error) {
+	instances, err := r.repo.List(ctx, time.Now().Add(-missedHeartbeats*r.interval))
+	if err != nil {
+		return internal.CompatStatus{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.List")
+	}
+
+	found := false
+
+	for _, instance := range instances {
+		if instance.ID == r.instance.ID {
+			found = true
+
+			break
+		}
+	}
+
+	if !found {
+		instances = append(instances, r.instance)
+	}
+
+	sort.SliceStable(instances, func(i, j int) bool {
+		return instances[i].StartedAt.Before(instances[j].StartedAt)
+	})
+
+	return internal.NewCompatStatus(instances), nil
+}
+
+func (r *Registry) heartbeat(ctx context.Context) error {
+	instance := r.instance
+	instance.SeenAt = time.Now().UTC()
+
+	if err := r.repo.Save(ctx, instance); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Save")
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package compat_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/compat"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewInstance()
+
+	// Instance running the previous version, started before the registry.
+	old := internal.Instance{
+		ID:         "44633fe3-b039-4fb3-a35f-a57fe3c906c7",
+		Version:    internal.CompatVersion - 1,
+		MinVersion: internal.MinCompatVersion - 1,
+		StartedAt:  time.Now().Add(-time.Hour),
+		SeenAt:     time.Now(),
+	}
+
+	if err := repo.Save(context.Background(), old); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	registry := compat.NewRegistry(zap.NewNop(), repo, time.Hour)
+
+	// This instance is included before its first heartbeat.
+	status, err := registry.Status(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(status.Instances) != 2 || status.Instances[0].ID != old.ID {
+		t.Fatalf("expected instances do not match: %+v", status.Instances)
+	}
+
+	if status.MinServingVersion != old.Version || status.MinCompatibleVersion != internal.MinCompatVersion {
+		t.Fatalf("expected versions do not match: %+v", status)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Saves the first heartbeat and returns because ctx is canceled.
+	registry.Run(ctx)
+
+	if err := registry.Shutdown(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	instances, _ := repo.List(context.Background(), time.Time{})
+	if len(instances) != 1 || instances[0].ID != old.ID {
+		t.Fatalf("expected instances do not match: %+v", instances)
+	}
+}
@@ -0,0 +1,108 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestNewCompatStatus(t *testing.T) {
+	t.Parallel()
+
+	flag := internal.CompatFlag{Name: "write_projects", Version: 3}
+
+	tests := []struct {
+		name               string
+		input              []internal.Instance
+		expectedServing    int
+		expectedCompatible int
+		compatible         bool
+		safe               bool
+	}{
+		{
+			"OK: same version",
+			[]internal.Instance{
+				{Version: 3, MinVersion: 2},
+				{Version: 3, MinVersion: 2},
+			},
+			3,
+			2,
+			true,
+			true,
+		},
+		{
+			"OK: rolling deploy",
+			[]internal.Instance{
+				{Version: 2, MinVersion: 1},
+				{Version: 3, MinVersion: 2},
+			},
+			2,
+			2,
+			true,
+			false,
+		},
+		{
+			"OK: incompatible",
+			[]internal.Instance{
+				{Version: 1, MinVersion: 1},
+				{Version: 3, MinVersion: 2},
+			},
+			1,
+			2,
+			false,
+			false,
+		},
+		{
+			"OK: no instances",
+			nil,
+			0,
+			0,
+			true,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := internal.NewCompatStatus(tt.input)
+
+			if actual.MinServingVersion != tt.expectedServing || actual.MinCompatibleVersion != tt.expectedCompatible {
+				t.Fatalf("expected versions do not match: %+v", actual)
+			}
+
+			if actual.Compatible() != tt.compatible {
+				t.Fatalf("expected compatible %t, got %t", tt.compatible, actual.Compatible())
+			}
+
+			if actual.Safe(flag) != tt.safe {
+				t.Fatalf("expected safe %t, got %t", tt.safe, actual.Safe(flag))
+			}
+		})
+	}
+}
+
+func TestCompatFlagSet_Set(t *testing.T) {
+	t.Parallel()
+
+	set := internal.NewCompatFlagSet(internal.CompatFlags{WriteProjects: true})
+
+	if err := set.Set("write_projects", false); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if set.Flags().WriteProjects {
+		t.Fatalf("expected flag disabled")
+	}
+
+	err := set.Set("unknown", true)
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
@@ -0,0 +1,264 @@
+// Package diskqueue buffers messages in a bounded on-disk queue when the message broker is unavailable, those
+// are replayed in order once the broker recovers.
+package diskqueue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	eventCreated   = "created"
+	eventDeleted   = "deleted"
+	eventUpdated   = "updated"
+	eventCompleted = "completed"
+	eventReopened  = "reopened"
+
+	fileExt = ".json"
+)
+
+// TaskMessageBroker defines the message broker used for publishing Task messages.
+type TaskMessageBroker interface {
+	Created(ctx context.Context, task internal.Task) error
+	Deleted(ctx context.Context, id string) error
+	Updated(ctx context.Context, task internal.Task) error
+	Completed(ctx context.Context, task internal.Task) error
+	Reopened(ctx context.Context, task internal.Task) error
+}
+
+// Task publishes Task messages using the original message broker, when that fails messages are buffered on disk
+// instead of returning an error, to keep the order all messages are buffered until the queue is replayed.
+type Task struct {
+	orig     TaskMessageBroker
+	logger   *zap.Logger
+	dir      string
+	maxSize  int
+	interval time.Duration
+
+	mu      sync.Mutex
+	entries []string
+	seq     uint64
+}
+
+type entry struct {
+	Type string        `json:"type"`
+	Task internal.Task `json:"task"`
+}
+
+// NewTask instantiates the Task buffer, messages previously buffered in dir are loaded to be replayed. maxSize
+// indicates the maximum number of messages to buffer, when reached publishing fails.
+func NewTask(logger *zap.Logger, orig TaskMessageBroker, dir string, maxSize int, interval time.Duration) (*Task, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.MkdirAll")
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.ReadDir")
+	}
+
+	var entries []string
+
+	for _, file := range files {
+		if !file.IsDir() && strings.HasSuffix(file.Name(), fileExt) {
+			entries = append(entries, file.Name())
+		}
+	}
+
+	sort.Strings(entries)
+
+	res := &Task{
+		orig:     orig,
+		logger:   logger,
+		dir:      dir,
+		maxSize:  maxSize,
+		interval: interval,
+		entries:  entries,
+	}
+
+	meter := global.Meter("github.com/MarioCarrion/todo-api/internal/diskqueue")
+
+	metric.Must(meter).NewInt64ValueObserver("message_buffer.depth",
+		func(_ context.Context, result metric.Int64ObserverResult) {
+			depth, _ := res.stats()
+			result.Observe(int64(depth))
+		},
+		metric.WithDescription("Number of messages waiting to be published"),
+	)
+
+	metric.Must(meter).NewFloat64ValueObserver("message_buffer.age",
+		func(_ context.Context, result metric.Float64ObserverResult) {
+			_, age := res.stats()
+			result.Observe(age.Seconds())
+		},
+		metric.WithDescription("Age in seconds of the oldest message waiting to be published"),
+	)
+
+	return res, nil
+}
+
+// Created publishes a message indicating a task was created.
+func (t *Task) Created(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, entry{Type: eventCreated, Task: task})
+}
+
+// Deleted publishes a message indicating a task was deleted.
+func (t *Task) Deleted(ctx context.Context, id string) error {
+	return t.publish(ctx, entry{Type: eventDeleted, Task: internal.Task{ID: id}})
+}
+
+// Updated publishes a message indicating a task was updated.
+func (t *Task) Updated(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, entry{Type: eventUpdated, Task: task})
+}
+
+// Completed publishes a message indicating a task was completed.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, entry{Type: eventCompleted, Task: task})
+}
+
+// Reopened publishes a message indicating a completed task was reopened.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, entry{Type: eventReopened, Task: task})
+}
+
+// Run replays the buffered messages periodically until ctx is cancelled.
+func (t *Task) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.Replay(ctx); err != nil {
+				t.logger.Info("message broker still unavailable", zap.Error(err))
+			}
+		}
+	}
+}
+
+// Replay publishes the buffered messages in order, it stops at the first message that fails.
+func (t *Task) Replay(ctx context.Context) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for len(t.entries) > 0 {
+		name := t.entries[0]
+		path := filepath.Join(t.dir, name)
+
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.ReadFile")
+		}
+
+		var evt entry
+
+		if err := json.Unmarshal(b, &evt); err != nil {
+			t.logger.Warn("discarding invalid buffered message", zap.String("name", name), zap.Error(err))
+		} else if err := t.send(ctx, evt); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnavailable, "send")
+		}
+
+		if err := os.Remove(path); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.Remove")
+		}
+
+		t.entries = t.entries[1:]
+	}
+
+	return nil
+}
+
+func (t *Task) publish(ctx context.Context, evt entry) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.entries) == 0 {
+		err := t.send(ctx, evt)
+		if err == nil {
+			return nil
+		}
+
+		t.logger.Warn("buffering message, broker unavailable", zap.Error(err))
+	}
+
+	if t.maxSize > 0 && len(t.entries) >= t.maxSize {
+		return internal.NewErrorf(internal.ErrorCodeUnavailable, "message buffer is full")
+	}
+
+	b, err := json.Marshal(evt)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	t.seq++
+
+	// Names are sortable by creation time, the sequence prevents collisions.
+	name := fmt.Sprintf("%020d-%010d%s", time.Now().UnixNano(), t.seq, fileExt)
+
+	if err := os.WriteFile(filepath.Join(t.dir, name), b, 0o600); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.WriteFile")
+	}
+
+	t.entries = append(t.entries, name)
+
+	return nil
+}
+
+func (t *Task) send(ctx context.Context, evt entry) error {
+	var err error
+
+	switch evt.Type {
+	case eventCreated:
+		err = t.orig.Created(ctx, evt.Task)
+	case eventDeleted:
+		err = t.orig.Deleted(ctx, evt.Task.ID)
+	case eventUpdated:
+		err = t.orig.Updated(ctx, evt.Task)
+	case eventCompleted:
+		err = t.orig.Completed(ctx, evt.Task)
+	case eventReopened:
+		err = t.orig.Reopened(ctx, evt.Task)
+	default:
+		return internal.NewErrorf(internal.ErrorCodeUnknown, "unknown message type %s", evt.Type)
+	}
+
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.%s", evt.Type)
+	}
+
+	return nil
+}
+
+// stats returns the number of buffered messages and the age of the oldest one.
+func (t *Task) stats() (int, time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.entries) == 0 {
+		return 0, 0
+	}
+
+	nanos, err := strconv.ParseInt(strings.SplitN(t.entries[0], "-", 2)[0], 10, 64)
+	if err != nil {
+		return len(t.entries), 0
+	}
+
+	return len(t.entries), time.Since(time.Unix(0, nanos))
+}
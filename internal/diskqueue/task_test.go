@@ -0,0 +1,131 @@
+package diskqueue_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/diskqueue"
+)
+
+func TestTask(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	broker := &fakeBroker{err: errors.New("unavailable")}
+
+	queue, err := diskqueue.NewTask(zap.NewNop(), broker, dir, 3, time.Second)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// Broker is unavailable: messages are buffered.
+
+	if err := queue.Created(context.Background(), internal.Task{ID: "1"}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := queue.Updated(context.Background(), internal.Task{ID: "1", IsDone: true}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := queue.Deleted(context.Background(), "1"); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// Buffer is full.
+
+	err = queue.Created(context.Background(), internal.Task{ID: "2"})
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeUnavailable {
+		t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+	}
+
+	if err := queue.Replay(context.Background()); err == nil {
+		t.Fatalf("expected error, got no value")
+	}
+
+	// Broker recovers: messages buffered by a previous process are replayed in order.
+
+	broker.setErr(nil)
+
+	queue, err = diskqueue.NewTask(zap.NewNop(), broker, dir, 3, time.Second)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := queue.Replay(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := queue.Created(context.Background(), internal.Task{ID: "3"}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := queue.Completed(context.Background(), internal.Task{ID: "3", IsDone: true}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := queue.Reopened(context.Background(), internal.Task{ID: "3"}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := []string{"created 1", "updated 1", "deleted 1", "created 3", "completed 3", "reopened 3"}
+
+	if !cmp.Equal(expected, broker.published) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, broker.published))
+	}
+}
+
+type fakeBroker struct {
+	mu        sync.Mutex
+	err       error
+	published []string
+}
+
+func (f *fakeBroker) Created(_ context.Context, task internal.Task) error {
+	return f.publish("created " + task.ID)
+}
+
+func (f *fakeBroker) Deleted(_ context.Context, id string) error {
+	return f.publish("deleted " + id)
+}
+
+func (f *fakeBroker) Updated(_ context.Context, task internal.Task) error {
+	return f.publish("updated " + task.ID)
+}
+
+func (f *fakeBroker) Completed(_ context.Context, task internal.Task) error {
+	return f.publish("completed " + task.ID)
+}
+
+func (f *fakeBroker) Reopened(_ context.Context, task internal.Task) error {
+	return f.publish("reopened " + task.ID)
+}
+
+func (f *fakeBroker) setErr(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.err = err
+}
+
+func (f *fakeBroker) publish(msg string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+
+	f.published = append(f.published, msg)
+
+	return nil
+}
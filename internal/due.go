@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"time"
+)
+
+// DueStatus indicates when an undone Task is due relative to now, days are calendar days in the time zone of now:
+// Overdue when the due date passed, DueToday when it's due during the current day and DueInDays the number of days
+// until the due date, negative when it passed. Done Tasks and the ones without due date use the zero value.
+type DueStatus struct {
+	Overdue   bool
+	DueToday  bool
+	DueInDays *int
+}
+
+// NewDueStatus returns the DueStatus of the Task, it's the only place the indicators are computed so they match
+// the ones selected by DueFilter.
+func NewDueStatus(task Task, now time.Time) DueStatus {
+	if task.IsDone || task.Dates.Due.IsZero() {
+		return DueStatus{}
+	}
+
+	days := daysBetween(now, task.Dates.Due.In(now.Location()))
+
+	return DueStatus{
+		Overdue:   task.Dates.Due.Before(now),
+		DueToday:  days == 0,
+		DueInDays: &days,
+	}
+}
+
+// DueFilter selects the undone Tasks using the indicators in DueStatus, the set fields are combined: for example
+// Overdue and Today select the Tasks due earlier today.
+type DueFilter struct {
+	Overdue bool
+	Today   bool
+	InDays  *int
+}
+
+// IsZero indicates whether no Tasks are filtered.
+func (f DueFilter) IsZero() bool {
+	return !f.Overdue && !f.Today && f.InDays == nil
+}
+
+// Range returns the due dates of the Tasks selected relative to now, using the time zone of now.
+func (f DueFilter) Range(now time.Time) DueRange {
+	today := startOfDay(now)
+
+	var res DueRange
+
+	if f.Overdue {
+		res = res.intersect(DueRange{To: now})
+	}
+
+	if f.Today {
+		res = res.intersect(DueRange{From: today, To: today.AddDate(0, 0, 1)})
+	}
+
+	if f.InDays != nil {
+		from := today.AddDate(0, 0, *f.InDays)
+		res = res.intersect(DueRange{From: from, To: from.AddDate(0, 0, 1)})
+	}
+
+	return res
+}
+
+// DueRange selects the undone Tasks due from From, inclusive, until To, exclusive; a zero value means unbounded.
+// Tasks without due date are never selected.
+type DueRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Match indicates whether the Task is selected.
+func (r DueRange) Match(task Task) bool {
+	due := task.Dates.Due
+
+	if task.IsDone || due.IsZero() {
+		return false
+	}
+
+	return (r.From.IsZero() || !due.Before(r.From)) && (r.To.IsZero() || due.Before(r.To))
+}
+
+func (r DueRange) intersect(other DueRange) DueRange {
+	if r.From.IsZero() || other.From.After(r.From) {
+		r.From = other.From
+	}
+
+	if r.To.IsZero() || (!other.To.IsZero() && other.To.Before(r.To)) {
+		r.To = other.To
+	}
+
+	return r
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// daysBetween returns the number of calendar days from "from" to "to", both are expected in the same location.
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+
+	return int(b.Sub(a).Hours() / 24)
+}
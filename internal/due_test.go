@@ -0,0 +1,186 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestNewDueStatus(t *testing.T) {
+	t.Parallel()
+
+	newInt := func(i int) *int {
+		return &i
+	}
+
+	// 23:00 in UTC is already the next day in Tokyo.
+	now := time.Date(2026, 10, 17, 23, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	tests := []struct {
+		name   string
+		task   internal.Task
+		now    time.Time
+		output internal.DueStatus
+	}{
+		{
+			"OK: overdue today",
+			internal.Task{Dates: internal.Dates{Due: now.Add(-time.Hour)}},
+			now,
+			internal.DueStatus{Overdue: true, DueToday: true, DueInDays: newInt(0)},
+		},
+		{
+			"OK: overdue yesterday",
+			internal.Task{Dates: internal.Dates{Due: now.AddDate(0, 0, -1)}},
+			now,
+			internal.DueStatus{Overdue: true, DueInDays: newInt(-1)},
+		},
+		{
+			"OK: due tomorrow",
+			internal.Task{Dates: internal.Dates{Due: now.Add(2 * time.Hour)}},
+			now,
+			internal.DueStatus{DueInDays: newInt(1)},
+		},
+		{
+			"OK: due today in time zone",
+			internal.Task{Dates: internal.Dates{Due: now.Add(2 * time.Hour)}},
+			now.In(tokyo),
+			internal.DueStatus{DueToday: true, DueInDays: newInt(0)},
+		},
+		{
+			"OK: done",
+			internal.Task{IsDone: true, Dates: internal.Dates{Due: now.Add(-time.Hour)}},
+			now,
+			internal.DueStatus{},
+		},
+		{
+			"OK: no due date",
+			internal.Task{},
+			now,
+			internal.DueStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := internal.NewDueStatus(tt.task, tt.now); !cmp.Equal(tt.output, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output, actual))
+			}
+		})
+	}
+}
+
+func TestDueFilter_Range(t *testing.T) {
+	t.Parallel()
+
+	newInt := func(i int) *int {
+		return &i
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, newYork)
+	today := time.Date(2026, 10, 17, 0, 0, 0, 0, newYork)
+
+	tests := []struct {
+		name   string
+		input  internal.DueFilter
+		now    time.Time
+		output internal.DueRange
+	}{
+		{
+			"OK: overdue",
+			internal.DueFilter{Overdue: true},
+			now,
+			internal.DueRange{To: now},
+		},
+		{
+			"OK: today",
+			internal.DueFilter{Today: true},
+			now,
+			internal.DueRange{From: today, To: today.AddDate(0, 0, 1)},
+		},
+		{
+			"OK: overdue today",
+			internal.DueFilter{Overdue: true, Today: true},
+			now,
+			internal.DueRange{From: today, To: now},
+		},
+		{
+			"OK: in days",
+			internal.DueFilter{InDays: newInt(2)},
+			now,
+			internal.DueRange{From: today.AddDate(0, 0, 2), To: today.AddDate(0, 0, 3)},
+		},
+		{
+			// Daylight saving time ends on 2026-11-01, the day lasts 25 hours.
+			"OK: in days with daylight saving time",
+			internal.DueFilter{InDays: newInt(15)},
+			now,
+			internal.DueRange{
+				From: time.Date(2026, 11, 1, 0, 0, 0, 0, newYork),
+				To:   time.Date(2026, 11, 1, 0, 0, 0, 0, newYork).Add(25 * time.Hour),
+			},
+		},
+		{
+			"OK: in days and today",
+			internal.DueFilter{Today: true, InDays: newInt(1)},
+			now,
+			internal.DueRange{From: today.AddDate(0, 0, 1), To: today.AddDate(0, 0, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := tt.input.Range(tt.now)
+
+			if !actual.From.Equal(tt.output.From) || !actual.To.Equal(tt.output.To) {
+				t.Fatalf("expected %s - %s, got %s - %s", tt.output.From, tt.output.To, actual.From, actual.To)
+			}
+		})
+	}
+}
+
+func TestDueRange_Match(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	r := internal.DueRange{From: now.Add(-time.Hour), To: now}
+
+	tests := []struct {
+		name   string
+		task   internal.Task
+		output bool
+	}{
+		{"OK: from inclusive", internal.Task{Dates: internal.Dates{Due: now.Add(-time.Hour)}}, true},
+		{"OK: to exclusive", internal.Task{Dates: internal.Dates{Due: now}}, false},
+		{"OK: done", internal.Task{IsDone: true, Dates: internal.Dates{Due: now.Add(-time.Minute)}}, false},
+		{"OK: no due date", internal.Task{}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := r.Match(tt.task); actual != tt.output {
+				t.Fatalf("expected %t, got %t", tt.output, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package elasticsearch
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"sync/atomic"
+	"time"
+
+	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
+	"go.uber.org/zap"
+)
+
+// Health keeps track of the connectivity to Elasticsearch, it is meant to be used for enabling or disabling
+// features depending on it.
+type Health struct {
+	client    esv7api.Transport
+	logger    *zap.Logger
+	interval  time.Duration
+	available int32
+}
+
+// NewHealth instantiates the Health monitor, the initial state is unavailable until the first check succeeds.
+func NewHealth(logger *zap.Logger, client esv7api.Transport, interval time.Duration) *Health {
+	return &Health{
+		client:   client,
+		logger:   logger,
+		interval: interval,
+	}
+}
+
+// Available indicates whether Elasticsearch was reachable during the last check.
+func (h *Health) Available() bool {
+	return atomic.LoadInt32(&h.available) == 1
+}
+
+// Run checks the connectivity periodically until the context is canceled.
+func (h *Health) Run(ctx context.Context) {
+	h.check(ctx)
+
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.check(ctx)
+		}
+	}
+}
+
+func (h *Health) check(ctx context.Context) {
+	var available int32
+
+	resp, err := esv7api.PingRequest{}.Do(ctx, h.client)
+	if err == nil {
+		if !resp.IsError() {
+			available = 1
+		}
+
+		io.Copy(ioutil.Discard, resp.Body) //nolint: errcheck
+		resp.Body.Close()
+	}
+
+	if old := atomic.SwapInt32(&h.available, available); old != available {
+		h.logger.Info("elasticsearch availability changed",
+			zap.Bool("available", available == 1),
+			zap.Error(err),
+		)
+	}
+}
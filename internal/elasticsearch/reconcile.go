@@ -0,0 +1,330 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// reconcileBatchSize is the number of tasks compared per request.
+const reconcileBatchSize = 500
+
+// TaskSource defines the datastore considered the source of truth when reconciling the indexed tasks.
+type TaskSource interface {
+	Find(ctx context.Context, id string) (internal.Task, error)
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
+}
+
+// Drift indicates the number of indexed tasks fixed by reconciling: Missing were not indexed, Stale were indexed
+// using outdated values and Orphaned were indexed after being deleted.
+type Drift struct {
+	Missing  int64
+	Stale    int64
+	Orphaned int64
+}
+
+// Reconciler periodically compares the indexed tasks with the ones in the datastore and fixes the differences,
+// caused for example by events that were never published or consumed.
+type Reconciler struct {
+	client   esv7api.Transport
+	index    string
+	tasks    TaskSource
+	logger   *zap.Logger
+	interval time.Duration
+	drift    metric.Int64Counter
+}
+
+// NewReconciler instantiates the Reconciler.
+func NewReconciler(logger *zap.Logger, client esv7api.Transport, tasks TaskSource, interval time.Duration) *Reconciler {
+	meter := global.Meter("github.com/MarioCarrion/todo-api/internal/elasticsearch")
+
+	return &Reconciler{
+		client:   client,
+		index:    TasksAlias,
+		tasks:    tasks,
+		logger:   logger,
+		interval: interval,
+		drift: metric.Must(meter).NewInt64Counter("search_reconcile.drift",
+			metric.WithDescription("Number of indexed tasks fixed by reconciling, labeled by kind: missing, stale or orphaned"),
+		),
+	}
+}
+
+// Run reconciles the indexed tasks periodically until the context is canceled.
+func (r *Reconciler) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.RunOnce(ctx)
+		}
+	}
+}
+
+// RunOnce reconciles the indexed tasks once, the result is logged.
+func (r *Reconciler) RunOnce(ctx context.Context) {
+	drift, err := r.Reconcile(ctx)
+	if err != nil {
+		r.logger.Warn("couldn't reconcile indexed tasks", zap.Error(err))
+
+		return
+	}
+
+	r.logger.Info("reconciled indexed tasks",
+		zap.Int64("missing", drift.Missing),
+		zap.Int64("stale", drift.Stale),
+		zap.Int64("orphaned", drift.Orphaned),
+	)
+}
+
+// Reconcile indexes the tasks missing or differing from the datastore and deletes the indexed tasks that don't
+// exist anymore. Tasks are read again before being fixed to avoid overwriting changes indexed in the meantime.
+func (r *Reconciler) Reconcile(ctx context.Context) (Drift, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Reconciler.Reconcile")
+	defer span.End()
+
+	var (
+		drift  Drift
+		cursor string
+	)
+
+	seen := make(map[string]struct{})
+
+	for {
+		page, err := r.tasks.List(ctx, internal.ListParams{Cursor: cursor, Size: reconcileBatchSize})
+		if err != nil {
+			return drift, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.List")
+		}
+
+		ids := make([]string, len(page.Tasks))
+
+		for i, task := range page.Tasks {
+			ids[i] = task.ID
+			seen[task.ID] = struct{}{}
+		}
+
+		indexed, err := r.indexed(ctx, ids)
+		if err != nil {
+			return drift, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "indexed")
+		}
+
+		for _, task := range page.Tasks {
+			doc, found := indexed[task.ID]
+			if found && doc == newIndexedTask(task) {
+				continue
+			}
+
+			if err := r.reindex(ctx, task.ID); err != nil {
+				return drift, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "reindex")
+			}
+
+			if found {
+				drift.Stale++
+			} else {
+				drift.Missing++
+			}
+		}
+
+		if cursor = page.NextCursor; cursor == "" {
+			break
+		}
+	}
+
+	var after string
+
+	for {
+		ids, err := r.indexedIDs(ctx, after)
+		if err != nil {
+			return drift, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "indexedIDs")
+		}
+
+		for _, id := range ids {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+
+			deleted, err := r.deleteOrphan(ctx, id)
+			if err != nil {
+				return drift, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deleteOrphan")
+			}
+
+			if deleted {
+				drift.Orphaned++
+			}
+		}
+
+		if len(ids) < reconcileBatchSize {
+			break
+		}
+
+		after = ids[len(ids)-1]
+	}
+
+	r.drift.Add(ctx, drift.Missing, attribute.String("kind", "missing"))
+	r.drift.Add(ctx, drift.Stale, attribute.String("kind", "stale"))
+	r.drift.Add(ctx, drift.Orphaned, attribute.String("kind", "orphaned"))
+
+	return drift, nil
+}
+
+// reindex indexes the current values of the task.
+func (r *Reconciler) reindex(ctx context.Context, id string) error {
+	task, err := r.tasks.Find(ctx, id)
+	if err != nil {
+		// Deleted in the meantime, the orphaned document, if any, is deleted by the indexer.
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound {
+			return nil
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Find")
+	}
+
+	if err := (&Task{client: r.client, index: r.index}).Index(ctx, task); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "Index")
+	}
+
+	return nil
+}
+
+// deleteOrphan deletes the indexed task when it doesn't exist in the datastore, it may have been created after
+// listing the tasks.
+func (r *Reconciler) deleteOrphan(ctx context.Context, id string) (bool, error) {
+	_, err := r.tasks.Find(ctx, id)
+	if err == nil {
+		return false, nil
+	}
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Find")
+	}
+
+	if err := (&Task{client: r.client, index: r.index}).Delete(ctx, id); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "Delete")
+	}
+
+	return true, nil
+}
+
+// indexed returns the indexed tasks, by ID, matching the IDs.
+func (r *Reconciler) indexed(ctx context.Context, ids []string) (map[string]indexedTask, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{"ids": ids}); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	}
+
+	req := esv7api.MgetRequest{
+		Index: r.index,
+		Body:  &buf,
+	}
+
+	resp, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "MgetRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return nil, newErrorf(internal.ErrorCodeUnknown, "MgetRequest.Do %d", resp.StatusCode)
+	}
+
+	//nolint: tagliatelle
+	var docs struct {
+		Docs []struct {
+			ID     string      `json:"_id"`
+			Found  bool        `json:"found"`
+			Source indexedTask `json:"_source"`
+		} `json:"docs"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&docs); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
+	}
+
+	res := make(map[string]indexedTask, len(docs.Docs))
+
+	for _, doc := range docs.Docs {
+		if doc.Found {
+			res[doc.ID] = doc.Source
+		}
+	}
+
+	return res, nil
+}
+
+// indexedIDs returns up to reconcileBatchSize IDs of indexed tasks, sorted, following the after ID.
+func (r *Reconciler) indexedIDs(ctx context.Context, after string) ([]string, error) {
+	query := map[string]interface{}{
+		"size":    reconcileBatchSize,
+		"_source": false,
+		"sort":    []interface{}{map[string]interface{}{"id": "asc"}},
+		"query":   map[string]interface{}{"match_all": map[string]interface{}{}},
+	}
+
+	if after != "" {
+		query["search_after"] = []interface{}{after}
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	}
+
+	req := esv7api.SearchRequest{
+		Index: []string{r.index},
+		Body:  &buf,
+	}
+
+	resp, err := req.Do(ctx, r.client)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "SearchRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return nil, newErrorf(internal.ErrorCodeUnknown, "SearchRequest.Do %d", resp.StatusCode)
+	}
+
+	//nolint: tagliatelle
+	var hits struct {
+		Hits struct {
+			Hits []struct {
+				ID string `json:"_id"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&hits); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
+	}
+
+	res := make([]string, len(hits.Hits.Hits))
+
+	for i, hit := range hits.Hits.Hits {
+		res[i] = hit.ID
+	}
+
+	return res, nil
+}
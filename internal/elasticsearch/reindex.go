@@ -0,0 +1,251 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TasksAlias is the alias used for indexing and searching tasks, it points to the index created by the last
+// reindexing.
+const TasksAlias = "tasks"
+
+// tasksMapping defines the mappings of the indexed tasks, changing them requires reindexing.
+const tasksMapping = `{
+  "mappings": {
+    "properties": {
+      "id":           { "type": "keyword" },
+      "description":  {
+        "type": "text",
+        "fields": {
+          "suggest": { "type": "search_as_you_type" }
+        }
+      },
+      "priority":     { "type": "byte" },
+      "is_done":      { "type": "boolean" },
+      "date_start":   { "type": "long" },
+      "date_due":     { "type": "long" },
+      "time_zone":    { "type": "keyword" },
+      "project_id":   { "type": "keyword" },
+      "completed_at": { "type": "long" },
+      "created_at":   { "type": "long" },
+      "updated_at":   { "type": "long" }
+    }
+  }
+}`
+
+// Reindexer creates new indices with the current mappings and switches the alias to them, searching keeps using
+// the previous index until the new one is complete.
+type Reindexer struct {
+	client esv7api.Transport
+	alias  string
+}
+
+// NewReindexer instantiates the Reindexer.
+func NewReindexer(client esv7api.Transport) *Reindexer {
+	return &Reindexer{
+		client: client,
+		alias:  TasksAlias,
+	}
+}
+
+// CreateIndex creates a new index using the current mappings, its name is the alias followed by the creation time.
+func (r *Reindexer) CreateIndex(ctx context.Context) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Reindexer.CreateIndex")
+	defer span.End()
+
+	index := r.alias + "_" + time.Now().UTC().Format("20060102150405")
+
+	req := esv7api.IndicesCreateRequest{
+		Index: index,
+		Body:  strings.NewReader(tasksMapping),
+	}
+
+	if err := r.do(ctx, req, "IndicesCreateRequest.Do"); err != nil {
+		return "", err
+	}
+
+	return index, nil
+}
+
+// Index indexes the tasks in the index using one bulk request.
+func (r *Reindexer) Index(ctx context.Context, index string, tasks []internal.Task) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Reindexer.Index")
+	defer span.End()
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+
+	for _, task := range tasks {
+		meta := map[string]interface{}{
+			"index": map[string]interface{}{"_id": task.ID},
+		}
+
+		if err := enc.Encode(meta); err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+		}
+
+		if err := enc.Encode(newIndexedTask(task)); err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+		}
+	}
+
+	req := esv7api.BulkRequest{
+		Index: index,
+		Body:  &buf,
+	}
+
+	resp, err := req.Do(ctx, r.client)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "BulkRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return newErrorf(internal.ErrorCodeUnknown, "BulkRequest.Do %d", resp.StatusCode)
+	}
+
+	// Failures of individual documents are reported in the body, the request itself succeeds.
+	var res struct {
+		Errors bool `json:"errors"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
+	}
+
+	if res.Errors {
+		return newErrorf(internal.ErrorCodeUnknown, "BulkRequest.Do failed indexing some tasks")
+	}
+
+	return nil
+}
+
+// SwitchAlias points the alias to the index, removing it from the indices it pointed to before in the same atomic
+// operation, and returns the names of those. An existing index named like the alias, created before aliases were
+// used, is deleted as part of the same operation.
+func (r *Reindexer) SwitchAlias(ctx context.Context, index string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Reindexer.SwitchAlias")
+	defer span.End()
+
+	// Searches must find all the indexed tasks as soon as the alias is switched.
+	if err := r.do(ctx, esv7api.IndicesRefreshRequest{Index: []string{index}}, "IndicesRefreshRequest.Do"); err != nil {
+		return nil, err
+	}
+
+	previous, legacy, err := r.aliased(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "aliased")
+	}
+
+	actions := []interface{}{
+		map[string]interface{}{"add": map[string]interface{}{"index": index, "alias": r.alias}},
+	}
+
+	for _, name := range previous {
+		actions = append(actions, map[string]interface{}{"remove": map[string]interface{}{"index": name, "alias": r.alias}})
+	}
+
+	if legacy {
+		actions = append(actions, map[string]interface{}{"remove_index": map[string]interface{}{"index": r.alias}})
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.NewEncoder(&buf).Encode(map[string]interface{}{"actions": actions}); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	}
+
+	if err := r.do(ctx, esv7api.IndicesUpdateAliasesRequest{Body: &buf}, "IndicesUpdateAliasesRequest.Do"); err != nil {
+		return nil, err
+	}
+
+	return previous, nil
+}
+
+// DeleteIndices deletes the indices, like the ones returned by SwitchAlias once they are not needed anymore.
+func (r *Reindexer) DeleteIndices(ctx context.Context, indices []string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Reindexer.DeleteIndices")
+	defer span.End()
+
+	if len(indices) == 0 {
+		return nil
+	}
+
+	return r.do(ctx, esv7api.IndicesDeleteRequest{Index: indices}, "IndicesDeleteRequest.Do")
+}
+
+// aliased returns the indices the alias points to, or whether an index named like the alias exists instead.
+func (r *Reindexer) aliased(ctx context.Context) ([]string, bool, error) {
+	resp, err := esv7api.IndicesGetAliasRequest{Name: []string{r.alias}}.Do(ctx, r.client)
+	if err != nil {
+		return nil, false, wrapErrorf(err, internal.ErrorCodeUnknown, "IndicesGetAliasRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		io.Copy(ioutil.Discard, resp.Body) //nolint: errcheck
+
+		exists, err := esv7api.IndicesExistsRequest{Index: []string{r.alias}}.Do(ctx, r.client)
+		if err != nil {
+			return nil, false, wrapErrorf(err, internal.ErrorCodeUnknown, "IndicesExistsRequest.Do")
+		}
+		defer exists.Body.Close()
+
+		return nil, exists.StatusCode == http.StatusOK, nil
+	}
+
+	if resp.IsError() {
+		return nil, false, newErrorf(internal.ErrorCodeUnknown, "IndicesGetAliasRequest.Do %d", resp.StatusCode)
+	}
+
+	var res map[string]json.RawMessage
+
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, false, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
+	}
+
+	indices := make([]string, 0, len(res))
+
+	for name := range res {
+		indices = append(indices, name)
+	}
+
+	return indices, false, nil
+}
+
+type request interface {
+	Do(ctx context.Context, transport esv7api.Transport) (*esv7api.Response, error)
+}
+
+// do sends the request discarding the body of the response.
+func (r *Reindexer) do(ctx context.Context, req request, name string) error {
+	resp, err := req.Do(ctx, r.client)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, name)
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return newErrorf(internal.ErrorCodeUnknown, "%s %d", name, resp.StatusCode)
+	}
+
+	io.Copy(ioutil.Discard, resp.Body) //nolint: errcheck
+
+	return nil
+}
@@ -3,21 +3,26 @@ package elasticsearch
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"io"
 	"io/ioutil"
 	"time"
 
-	esv7 "github.com/elastic/go-elasticsearch/v7"
 	esv7api "github.com/elastic/go-elasticsearch/v7/esapi"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/MarioCarrion/todo-api/internal"
 )
 
+// undated is the value indexed for the dates not defined, it's before the epoch so those are excluded by the due
+// dates filters.
+//nolint: gochecknoglobals
+var undated = time.Time{}.UnixNano()
+
 // Task represents the repository used for interacting with Task records.
 type Task struct {
-	client *esv7.Client
+	client esv7api.Transport
 	index  string
 }
 
@@ -30,13 +35,43 @@ type indexedTask struct {
 	IsDone      bool              `json:"is_done"`
 	DateStart   int64             `json:"date_start"`
 	DateDue     int64             `json:"date_due"`
+	TimeZone    string            `json:"time_zone,omitempty"`
+	ProjectID   string            `json:"project_id,omitempty"`
+	CompletedAt int64             `json:"completed_at"`
+	CreatedAt   int64             `json:"created_at"`
+	UpdatedAt   int64             `json:"updated_at"`
+}
+
+func newIndexedTask(task internal.Task) indexedTask {
+	return indexedTask{
+		ID:          task.ID,
+		Description: task.Description,
+		Priority:    task.Priority,
+		IsDone:      task.IsDone,
+		DateStart:   task.Dates.Start.UnixNano(),
+		DateDue:     task.Dates.Due.UnixNano(),
+		TimeZone:    task.Dates.TimeZone,
+		ProjectID:   task.ProjectID,
+		CompletedAt: task.CompletedAt.UnixNano(),
+		CreatedAt:   task.CreatedAt.UnixNano(),
+		UpdatedAt:   task.UpdatedAt.UnixNano(),
+	}
 }
 
-// NewTask instantiates the Task repository.
-func NewTask(client *esv7.Client) *Task {
+// indexedTime returns the time of the indexed date, the zero time is returned when it's undated.
+func indexedTime(val int64) time.Time {
+	if val == undated {
+		return time.Time{}
+	}
+
+	return time.Unix(0, val).UTC()
+}
+
+// NewTask instantiates the Task repository, client is either an Elasticsearch or an OpenSearch client.
+func NewTask(client esv7api.Transport) *Task {
 	return &Task{
 		client: client,
-		index:  "tasks",
+		index:  TasksAlias,
 	}
 }
 
@@ -44,20 +79,12 @@ func NewTask(client *esv7.Client) *Task {
 func (t *Task) Index(ctx context.Context, task internal.Task) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Index")
 	defer span.End()
-
-	body := indexedTask{
-		ID:          task.ID,
-		Description: task.Description,
-		Priority:    task.Priority,
-		IsDone:      task.IsDone,
-		DateStart:   task.Dates.Start.UnixNano(),
-		DateDue:     task.Dates.Due.UnixNano(),
-	}
+	defer internal.TrackDependency(ctx, internal.DependencyElasticsearch)()
 
 	var buf bytes.Buffer
 
-	if err := json.NewEncoder(&buf).Encode(body); err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	if err := json.NewEncoder(&buf).Encode(newIndexedTask(task)); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
 	}
 
 	req := esv7api.IndexRequest{
@@ -69,12 +96,12 @@ func (t *Task) Index(ctx context.Context, task internal.Task) error {
 
 	resp, err := req.Do(ctx, t.client)
 	if err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "IndexRequest.Do")
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "IndexRequest.Do")
 	}
 	defer resp.Body.Close()
 
 	if resp.IsError() {
-		return internal.NewErrorf(internal.ErrorCodeUnknown, "IndexRequest.Do %d", resp.StatusCode)
+		return newErrorf(internal.ErrorCodeUnknown, "IndexRequest.Do %d", resp.StatusCode)
 	}
 
 	io.Copy(ioutil.Discard, resp.Body) //nolint: errcheck
@@ -86,6 +113,7 @@ func (t *Task) Index(ctx context.Context, task internal.Task) error {
 func (t *Task) Delete(ctx context.Context, id string) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Delete")
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyElasticsearch)()
 
 	req := esv7api.DeleteRequest{
 		Index:      t.index,
@@ -94,12 +122,12 @@ func (t *Task) Delete(ctx context.Context, id string) error {
 
 	resp, err := req.Do(ctx, t.client)
 	if err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "DeleteRequest.Do")
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "DeleteRequest.Do")
 	}
 	defer resp.Body.Close()
 
 	if resp.IsError() {
-		return internal.NewErrorf(internal.ErrorCodeUnknown, "DeleteRequest.Do %d", resp.StatusCode)
+		return newErrorf(internal.ErrorCodeUnknown, "DeleteRequest.Do %d", resp.StatusCode)
 	}
 
 	io.Copy(ioutil.Discard, resp.Body) //nolint: errcheck
@@ -112,23 +140,35 @@ func (t *Task) Delete(ctx context.Context, id string) error {
 func (t *Task) Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Search")
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyElasticsearch)()
 
 	if args.IsZero() {
 		return internal.SearchResults{}, nil
 	}
 
-	should := make([]interface{}, 0, 3)
+	// Tasks must match all the received values and descriptions must include every term, the same as the other
+	// search engines do.
+	must := make([]interface{}, 0, 3)
 
 	if args.Description != nil {
-		should = append(should, map[string]interface{}{
+		description := map[string]interface{}{
+			"query":    *args.Description,
+			"operator": "and",
+		}
+
+		if args.Fuzziness != internal.FuzzinessNone {
+			description["fuzziness"] = args.Fuzziness
+		}
+
+		must = append(must, map[string]interface{}{
 			"match": map[string]interface{}{
-				"description": *args.Description,
+				"description": description,
 			},
 		})
 	}
 
 	if args.Priority != nil {
-		should = append(should, map[string]interface{}{
+		must = append(must, map[string]interface{}{
 			"match": map[string]interface{}{
 				"priority": *args.Priority,
 			},
@@ -136,7 +176,7 @@ func (t *Task) Search(ctx context.Context, args internal.SearchParams) (internal
 	}
 
 	if args.IsDone != nil {
-		should = append(should, map[string]interface{}{
+		must = append(must, map[string]interface{}{
 			"match": map[string]interface{}{
 				"is_done": *args.IsDone,
 			},
@@ -145,32 +185,98 @@ func (t *Task) Search(ctx context.Context, args internal.SearchParams) (internal
 
 	var query map[string]interface{}
 
-	if len(should) > 1 {
+	if len(must) > 1 {
 		query = map[string]interface{}{
 			"query": map[string]interface{}{
 				"bool": map[string]interface{}{
-					"should": should,
+					"must": must,
 				},
 			},
 		}
+	} else if len(must) == 1 {
+		query = map[string]interface{}{
+			"query": must[0],
+		}
 	} else {
 		query = map[string]interface{}{
-			"query": should[0],
+			"query": map[string]interface{}{
+				"match_all": map[string]interface{}{},
+			},
 		}
 	}
 
+	if args.Due != nil {
+		query["query"] = dueQuery(query["query"], *args.Due)
+	}
+
+	if args.ProjectID != nil {
+		query["query"] = projectQuery(query["query"], *args.ProjectID)
+	}
+
+	if args.Sort == internal.SortUrgency {
+		query["query"] = urgencyQuery(query["query"], time.Now())
+	}
+
 	query["sort"] = []interface{}{
 		"_score",
 		map[string]interface{}{"id": "asc"},
 	}
 
-	query["from"] = args.From
+	if args.Sort.ByTime() {
+		order := "asc"
+		if args.Sort.Descending() {
+			order = "desc"
+		}
+
+		query["sort"] = []interface{}{
+			map[string]interface{}{args.Sort.Field(): order},
+			map[string]interface{}{"id": order},
+		}
+	}
+
 	query["size"] = args.Size
 
+	if args.Highlight != nil {
+		query["highlight"] = map[string]interface{}{
+			"encoder": "html",
+			"fields": map[string]interface{}{
+				"description": map[string]interface{}{
+					"fragment_size":       args.Highlight.Size(),
+					"number_of_fragments": internal.MaxFragments,
+					"pre_tags":            []string{"<em>"},
+					"post_tags":           []string{"</em>"},
+				},
+			},
+		}
+	}
+
+	// Aggregations count all the matching tasks, not only the ones in the returned page.
+	if args.Facets {
+		query["aggs"] = map[string]interface{}{
+			"priority": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "priority", "size": 4},
+			},
+			"is_done": map[string]interface{}{
+				"terms": map[string]interface{}{"field": "is_done", "size": 2},
+			},
+		}
+	}
+
+	if args.Cursor != "" {
+		after, err := decodeCursor(args.Cursor)
+		if err != nil {
+			return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+		}
+
+		query["search_after"] = after
+	} else {
+		query["from"] = args.From
+	}
+
 	var buf bytes.Buffer
 
 	if err := json.NewEncoder(&buf).Encode(query); err != nil {
-		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
 	}
 
 	req := esv7api.SearchRequest{
@@ -180,12 +286,12 @@ func (t *Task) Search(ctx context.Context, args internal.SearchParams) (internal
 
 	resp, err := req.Do(ctx, t.client)
 	if err != nil {
-		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "SearchRequest.Do")
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "SearchRequest.Do")
 	}
 	defer resp.Body.Close()
 
 	if resp.IsError() {
-		return internal.SearchResults{}, internal.NewErrorf(internal.ErrorCodeUnknown, "SearchRequest.Do %d", resp.StatusCode)
+		return internal.SearchResults{}, newErrorf(internal.ErrorCodeUnknown, "SearchRequest.Do %d", resp.StatusCode)
 	}
 
 	//nolint: tagliatelle
@@ -195,27 +301,268 @@ func (t *Task) Search(ctx context.Context, args internal.SearchParams) (internal
 				Value int64 `json:"value"`
 			} `json:"total"`
 			Hits []struct {
-				Source indexedTask `json:"_source"`
+				Source    indexedTask   `json:"_source"`
+				Sort      []interface{} `json:"sort"`
+				Highlight struct {
+					Description []string `json:"description"`
+				} `json:"highlight"`
 			} `json:"hits"`
 		} `json:"hits"`
+		Aggregations struct {
+			Priority facetBuckets `json:"priority"`
+			IsDone   facetBuckets `json:"is_done"`
+		} `json:"aggregations"`
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&hits); err != nil {
-		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
 	}
 
 	res := make([]internal.Task, len(hits.Hits.Hits))
 
+	var highlights map[string][]string
+
+	if args.Highlight != nil {
+		highlights = make(map[string][]string, len(hits.Hits.Hits))
+	}
+
 	for i, hit := range hits.Hits.Hits {
 		res[i].ID = hit.Source.ID
 		res[i].Description = hit.Source.Description
 		res[i].Priority = hit.Source.Priority
-		res[i].Dates.Due = time.Unix(0, hit.Source.DateDue).UTC()
-		res[i].Dates.Start = time.Unix(0, hit.Source.DateStart).UTC()
+		res[i].IsDone = hit.Source.IsDone
+		res[i].Dates.Due = indexedTime(hit.Source.DateDue)
+		res[i].Dates.Start = indexedTime(hit.Source.DateStart)
+		res[i].Dates.TimeZone = hit.Source.TimeZone
+		res[i].ProjectID = hit.Source.ProjectID
+		res[i].CompletedAt = indexedTime(hit.Source.CompletedAt)
+		res[i].CreatedAt = indexedTime(hit.Source.CreatedAt)
+		res[i].UpdatedAt = indexedTime(hit.Source.UpdatedAt)
+
+		if highlights != nil {
+			highlights[hit.Source.ID] = hit.Highlight.Description
+		}
+	}
+
+	var facets *internal.Facets
+
+	if args.Facets {
+		facets = internal.NewFacets()
+
+		for _, bucket := range hits.Aggregations.Priority.Buckets {
+			facets.Priority[internal.Priority(bucket.Key)] += bucket.DocCount
+		}
+
+		// Booleans are aggregated using 1 for true and 0 for false.
+		for _, bucket := range hits.Aggregations.IsDone.Buckets {
+			if bucket.Key == 1 {
+				facets.Done += bucket.DocCount
+			} else {
+				facets.Undone += bucket.DocCount
+			}
+		}
+	}
+
+	var next string
+
+	if count := int64(len(hits.Hits.Hits)); count > 0 && count == args.Size {
+		next, err = encodeCursor(hits.Hits.Hits[count-1].Sort)
+		if err != nil {
+			return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "encodeCursor")
+		}
 	}
 
 	return internal.SearchResults{
-		Tasks: res,
-		Total: hits.Hits.Total.Value,
+		Tasks:      res,
+		Total:      hits.Hits.Total.Value,
+		NextCursor: next,
+		Highlights: highlights,
+		Facets:     facets,
 	}, nil
 }
+
+// facetBuckets defines the results of a terms aggregation.
+//nolint: tagliatelle
+type facetBuckets struct {
+	Buckets []struct {
+		Key      int64 `json:"key"`
+		DocCount int64 `json:"doc_count"`
+	} `json:"buckets"`
+}
+
+// Suggest returns the tasks whose descriptions include every complete word typed so far and a word starting with
+// the last one, using the "description.suggest" field indexed as you type. Suggestions are sorted by relevance.
+func (t *Task) Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Suggest")
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyElasticsearch)()
+
+	query := map[string]interface{}{
+		"size":    params.Size,
+		"_source": []string{"id", "description"},
+		"query": map[string]interface{}{
+			"multi_match": map[string]interface{}{
+				"query":    params.Prefix,
+				"type":     "bool_prefix",
+				"operator": "and",
+				"fields": []string{
+					"description.suggest",
+					"description.suggest._2gram",
+					"description.suggest._3gram",
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewEncoder.Encode")
+	}
+
+	req := esv7api.SearchRequest{
+		Index: []string{t.index},
+		Body:  &buf,
+	}
+
+	resp, err := req.Do(ctx, t.client)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "SearchRequest.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.IsError() {
+		return nil, newErrorf(internal.ErrorCodeUnknown, "SearchRequest.Do %d", resp.StatusCode)
+	}
+
+	//nolint: tagliatelle
+	var hits struct {
+		Hits struct {
+			Hits []struct {
+				Source indexedTask `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&hits); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.NewDecoder.Decode")
+	}
+
+	res := make([]internal.Suggestion, len(hits.Hits.Hits))
+
+	for i, hit := range hits.Hits.Hits {
+		res[i] = internal.Suggestion{
+			ID:          hit.Source.ID,
+			Description: hit.Source.Description,
+		}
+	}
+
+	return res, nil
+}
+
+// urgencyQuery wraps the query to score tasks by urgency: the priority value plus a decaying value, up to
+// three, depending on how close the due date is to now, this is, a high priority task is as urgent as a low
+// priority task due now. Matching documents are still required to match the original query.
+func urgencyQuery(query interface{}, now time.Time) map[string]interface{} {
+	const day = int64(24 * time.Hour)
+
+	return map[string]interface{}{
+		"function_score": map[string]interface{}{
+			"query": query,
+			"functions": []interface{}{
+				map[string]interface{}{
+					"field_value_factor": map[string]interface{}{
+						"field":   "priority",
+						"missing": 0,
+					},
+				},
+				map[string]interface{}{
+					"gauss": map[string]interface{}{
+						"date_due": map[string]interface{}{
+							"origin": now.UnixNano(),
+							"scale":  3 * day,
+							"offset": day,
+							"decay":  0.5,
+						},
+					},
+					"weight": 3,
+				},
+			},
+			"score_mode": "sum",
+			"boost_mode": "replace",
+		},
+	}
+}
+
+// decodeCursor returns the sort values of the last hit of the previous page, those are used as "search_after".
+func decodeCursor(val string) ([]interface{}, error) {
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	var res []interface{}
+
+	if err := json.Unmarshal(b, &res); err != nil || len(res) == 0 {
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return res, nil
+}
+
+func encodeCursor(sort []interface{}) (string, error) {
+	b, err := json.Marshal(sort)
+	if err != nil {
+		return "", wrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// wrapErrorf returns a wrapped error caused by Elasticsearch, see internal.WrapDependencyErrorf.
+func wrapErrorf(orig error, code internal.ErrorCode, format string, a ...interface{}) error {
+	return internal.WrapDependencyErrorf(orig, internal.DependencyElasticsearch, code, format, a...)
+}
+
+// newErrorf instantiates a new error caused by Elasticsearch.
+func newErrorf(code internal.ErrorCode, format string, a ...interface{}) error {
+	return wrapErrorf(nil, code, format, a...)
+}
+
+// projectQuery filters the tasks matching query using their project, filters don't change the scores.
+func projectQuery(query interface{}, projectID string) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": query,
+			"filter": []interface{}{
+				map[string]interface{}{"term": map[string]interface{}{"project_id": projectID}},
+			},
+		},
+	}
+}
+
+// dueQuery filters the tasks matching query using the due dates in r, filters don't change the scores. Tasks
+// without due date are indexed before the epoch, see undated, so it's the lower bound when r is unbounded.
+func dueQuery(query interface{}, r internal.DueRange) map[string]interface{} {
+	var from int64
+
+	if !r.From.IsZero() {
+		from = r.From.UnixNano()
+	}
+
+	dateDue := map[string]interface{}{"gte": from}
+
+	if !r.To.IsZero() {
+		dateDue["lt"] = r.To.UnixNano()
+	}
+
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"must": query,
+			"filter": []interface{}{
+				map[string]interface{}{"term": map[string]interface{}{"is_done": false}},
+				map[string]interface{}{"range": map[string]interface{}{"date_due": dateDue}},
+			},
+		},
+	}
+}
@@ -0,0 +1,230 @@
+package elasticsearch_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/elasticsearch"
+)
+
+// transport responds to all the requests using the body, the body of the last request is kept in req.
+type transport struct {
+	body string
+	req  []byte
+}
+
+func (t *transport) Perform(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		t.req, _ = io.ReadAll(req.Body)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(t.body)),
+	}, nil
+}
+
+func TestTask_Search_DueStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	due := now.Add(-time.Hour).UTC().Truncate(time.Second)
+
+	source := func(id string, isDone bool, start, due int64) map[string]interface{} {
+		return map[string]interface{}{
+			"_source": map[string]interface{}{
+				"id":          id,
+				"description": "task",
+				"priority":    1,
+				"is_done":     isDone,
+				"date_start":  start,
+				"date_due":    due,
+			},
+		}
+	}
+
+	// Undated tasks are indexed using the value returned by the zero time.
+	undated := time.Time{}.UnixNano()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"hits": map[string]interface{}{
+			"total": map[string]interface{}{"value": 3},
+			"hits": []interface{}{
+				source("done", true, undated, due.UnixNano()),
+				source("undated", false, undated, undated),
+				source("overdue", false, undated, due.UnixNano()),
+			},
+		},
+	})
+
+	description := "task"
+
+	res, err := elasticsearch.NewTask(&transport{body: string(body)}).
+		Search(context.Background(), internal.SearchParams{Description: &description, Size: 10})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	tests := []struct {
+		id      string
+		isDone  bool
+		due     time.Time
+		overdue bool
+	}{
+		{"done", true, due, false},
+		{"undated", false, time.Time{}, false},
+		{"overdue", false, due, true},
+	}
+
+	if len(res.Tasks) != len(tests) {
+		t.Fatalf("expected %d tasks, got %d", len(tests), len(res.Tasks))
+	}
+
+	for i, tt := range tests {
+		task := res.Tasks[i]
+
+		if task.ID != tt.id || task.IsDone != tt.isDone || !task.Dates.Due.Equal(tt.due) || !task.Dates.Start.IsZero() {
+			t.Fatalf("expected task %s (done %t, due %s), got %+v", tt.id, tt.isDone, tt.due, task)
+		}
+
+		if status := internal.NewDueStatus(task, now); status.Overdue != tt.overdue {
+			t.Fatalf("expected task %s overdue %t, got %t", tt.id, tt.overdue, status.Overdue)
+		}
+	}
+}
+
+func TestTask_Search_SortByTime(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2026, 10, 3, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"hits": map[string]interface{}{
+			"total": map[string]interface{}{"value": 2},
+			"hits": []interface{}{
+				map[string]interface{}{
+					"_source": map[string]interface{}{
+						"id":           "done",
+						"is_done":      true,
+						"completed_at": completedAt.UnixNano(),
+						"created_at":   createdAt.UnixNano(),
+						"updated_at":   updatedAt.UnixNano(),
+					},
+				},
+				map[string]interface{}{
+					"_source": map[string]interface{}{
+						"id":           "pending",
+						"completed_at": time.Time{}.UnixNano(),
+						"created_at":   createdAt.UnixNano(),
+						"updated_at":   createdAt.UnixNano(),
+					},
+				},
+			},
+		},
+	})
+
+	client := &transport{body: string(body)}
+	description := "task"
+
+	res, err := elasticsearch.NewTask(client).
+		Search(context.Background(), internal.SearchParams{
+			Description: &description,
+			Sort:        internal.SortUpdatedDesc,
+			Size:        10,
+		})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var query struct {
+		Sort []map[string]string `json:"sort"`
+	}
+
+	if err := json.Unmarshal(client.req, &query); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if len(query.Sort) != 2 || query.Sort[0]["updated_at"] != "desc" || query.Sort[1]["id"] != "desc" {
+		t.Fatalf("expected sorting by update time and id in descending order, got %+v", query.Sort)
+	}
+
+	if len(res.Tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(res.Tasks))
+	}
+
+	if done := res.Tasks[0]; !done.CompletedAt.Equal(completedAt) || !done.CreatedAt.Equal(createdAt) ||
+		!done.UpdatedAt.Equal(updatedAt) {
+		t.Fatalf("expected times to be mapped, got %+v", done)
+	}
+
+	if pending := res.Tasks[1]; !pending.CompletedAt.IsZero() || !pending.UpdatedAt.Equal(createdAt) {
+		t.Fatalf("expected pending task not to be completed, got %+v", pending)
+	}
+}
+
+func TestTask_Search_AllTerms(t *testing.T) {
+	t.Parallel()
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"hits": map[string]interface{}{
+			"total": map[string]interface{}{"value": 0},
+			"hits":  []interface{}{},
+		},
+	})
+
+	client := &transport{body: string(body)}
+	description := "buy milk"
+	priority := internal.PriorityHigh
+
+	if _, err := elasticsearch.NewTask(client).
+		Search(context.Background(), internal.SearchParams{
+			Description: &description,
+			Priority:    &priority,
+			Fuzziness:   internal.FuzzinessAuto,
+			Size:        10,
+		}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var query struct {
+		Query struct {
+			Bool struct {
+				Must []struct {
+					Match map[string]json.RawMessage `json:"match"`
+				} `json:"must"`
+				Should []interface{} `json:"should"`
+			} `json:"bool"`
+		} `json:"query"`
+	}
+
+	if err := json.Unmarshal(client.req, &query); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if must := query.Query.Bool.Must; len(must) != 2 || len(query.Query.Bool.Should) != 0 {
+		t.Fatalf("expected tasks to match all the values, got %s", client.req)
+	}
+
+	var match struct {
+		Query     string `json:"query"`
+		Operator  string `json:"operator"`
+		Fuzziness string `json:"fuzziness"`
+	}
+
+	if err := json.Unmarshal(query.Query.Bool.Must[0].Match["description"], &match); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if match.Query != description || match.Operator != "and" || match.Fuzziness != "AUTO" {
+		t.Fatalf("expected descriptions to include every term, got %+v", match)
+	}
+}
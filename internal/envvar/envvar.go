@@ -32,6 +32,16 @@ func Load(filename string) error {
 	return nil
 }
 
+// Read reads the env filename without loading it into ENV.
+func Read(filename string) (map[string]string, error) {
+	res, err := godotenv.Read(filename)
+	if err != nil {
+		return nil, internal.NewErrorf(internal.ErrorCodeUnknown, "reading env var file")
+	}
+
+	return res, nil
+}
+
 // New ...
 func New(provider Provider) *Configuration {
 	return &Configuration{
@@ -0,0 +1,123 @@
+package envvar
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// LoadFile reads the configuration file, YAML or JSON, and loads its settings into ENV for this process. Settings
+// already defined in ENV are kept, so environment variables override the file; see ReadFile.
+func LoadFile(filename string) error {
+	settings, err := ReadFile(filename)
+	if err != nil {
+		return err
+	}
+
+	for key, val := range settings {
+		if _, ok := os.LookupEnv(key); ok {
+			continue
+		}
+
+		if err := os.Setenv(key, val); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.Setenv")
+		}
+	}
+
+	return nil
+}
+
+// ReadFile reads the settings defined in the configuration file, YAML or JSON, without loading them into ENV.
+// Nested keys are joined using "_" and uppercased, for example "database: {host: localhost}" defines DATABASE_HOST,
+// and lists are joined using ",".
+func ReadFile(filename string) (map[string]string, error) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "os.ReadFile")
+	}
+
+	content, err = yaml.YAMLToJSON(content)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "yaml.YAMLToJSON")
+	}
+
+	// Numbers are kept as written, otherwise large ones would be formatted using exponents.
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var values map[string]interface{}
+
+	if err := dec.Decode(&values); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json.Decode")
+	}
+
+	res := make(map[string]string)
+
+	if err := flatten(res, "", values); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func flatten(dst map[string]string, prefix string, values map[string]interface{}) error {
+	for key, val := range values {
+		key = strings.ToUpper(prefix + key)
+
+		if nested, ok := val.(map[string]interface{}); ok {
+			if err := flatten(dst, key+"_", nested); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if list, ok := val.([]interface{}); ok {
+			res := make([]string, len(list))
+
+			for i, item := range list {
+				str, ok := scalar(item)
+				if !ok {
+					return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "%s[%d]: unsupported value", key, i)
+				}
+
+				res[i] = str
+			}
+
+			dst[key] = strings.Join(res, ",")
+
+			continue
+		}
+
+		str, ok := scalar(val)
+		if !ok {
+			return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "%s: unsupported value", key)
+		}
+
+		dst[key] = str
+	}
+
+	return nil
+}
+
+// scalar returns the value as a string, false when it's not a null, string, number nor boolean.
+func scalar(val interface{}) (string, bool) {
+	switch v := val.(type) {
+	case nil:
+		return "", true
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,45 @@
+package envvar_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+)
+
+func TestLoadFile(t *testing.T) {
+	t.Parallel()
+
+	os.Setenv("ENVVAR_FILE_OVERRIDDEN", "env")
+
+	if err := envvar.LoadFile(path.Join("fixtures", "config.yaml")); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	tests := []struct {
+		key      string
+		expected string
+	}{
+		{"ENVVAR_FILE_STRING", "value"},
+		{"ENVVAR_FILE_NUMBER", "1048576"},
+		{"ENVVAR_FILE_BOOL", "true"},
+		{"ENVVAR_FILE_EMPTY", ""},
+		{"ENVVAR_FILE_LIST", "/search/tasks=3s,/tasks:batchUpdate=5s"},
+		{"ENVVAR_FILE_OVERRIDDEN", "env"},
+	}
+
+	for _, tt := range tests {
+		if actual := os.Getenv(tt.key); actual != tt.expected {
+			t.Fatalf("expected %s to be %q, got %q", tt.key, tt.expected, actual)
+		}
+	}
+}
+
+func TestLoadFile_Error(t *testing.T) {
+	t.Parallel()
+
+	if err := envvar.LoadFile(path.Join("fixtures", "missing.yaml")); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
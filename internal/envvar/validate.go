@@ -0,0 +1,118 @@
+package envvar
+
+import (
+	"strconv"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Rule defines how the value of a setting is validated, see Configuration.Validate.
+type Rule struct {
+	Key   string
+	check func(val string) error
+}
+
+// Required validates the setting is defined and not empty.
+func Required(key string) Rule {
+	return Rule{
+		Key: key,
+		check: func(val string) error {
+			if val == "" {
+				return validation.NewError("validation_required", "is required")
+			}
+
+			return nil
+		},
+	}
+}
+
+// Int validates the setting is an integer, when defined.
+func Int(key string) Rule {
+	return optional(key, func(val string) error {
+		if _, err := strconv.ParseInt(val, 10, 64); err != nil {
+			return validation.NewError("validation_int", "must be an integer")
+		}
+
+		return nil
+	})
+}
+
+// Bool validates the setting is a boolean, when defined.
+func Bool(key string) Rule {
+	return optional(key, func(val string) error {
+		if _, err := strconv.ParseBool(val); err != nil {
+			return validation.NewError("validation_bool", "must be a boolean")
+		}
+
+		return nil
+	})
+}
+
+// Duration validates the setting is a duration, for example "1.5s", when defined.
+func Duration(key string) Rule {
+	return optional(key, func(val string) error {
+		if _, err := time.ParseDuration(val); err != nil {
+			return validation.NewError("validation_duration", "must be a duration")
+		}
+
+		return nil
+	})
+}
+
+// OneOf validates the setting is one of values, when defined.
+func OneOf(key string, values ...string) Rule {
+	in := make([]interface{}, len(values))
+	for i, val := range values {
+		in[i] = val
+	}
+
+	return optional(key, func(val string) error {
+		return validation.Validate(val, validation.In(in...)) //nolint: wrapcheck
+	})
+}
+
+func optional(key string, check func(val string) error) Rule {
+	return Rule{
+		Key: key,
+		check: func(val string) error {
+			if val == "" {
+				return nil
+			}
+
+			return check(val)
+		},
+	}
+}
+
+// Validate validates the settings using the rules, instead of failing on the first one every missing or invalid
+// setting is reported; only the first failing rule of each setting is. It's meant to be called at startup before
+// instantiating anything using the settings.
+func (c *Configuration) Validate(rules ...Rule) error {
+	errs := validation.Errors{}
+
+	for _, rule := range rules {
+		if _, ok := errs[rule.Key]; ok {
+			continue
+		}
+
+		val, err := c.Get(rule.Key)
+		if err != nil {
+			errs[rule.Key] = err
+
+			continue
+		}
+
+		if err := rule.check(val); err != nil {
+			errs[rule.Key] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return internal.WrapErrorf(errs, internal.ErrorCodeInvalidArgument, "invalid configuration")
+	}
+
+	return nil
+}
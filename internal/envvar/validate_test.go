@@ -0,0 +1,46 @@
+package envvar_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/envvar"
+	"github.com/MarioCarrion/todo-api/internal/envvar/envvartesting"
+)
+
+func TestConfiguration_Validate(t *testing.T) {
+	t.Parallel()
+
+	os.Setenv("ENVVAR_VALIDATE_INT", "one")
+	os.Setenv("ENVVAR_VALIDATE_BOOL", "true")
+	os.Setenv("ENVVAR_VALIDATE_DURATION", "1s")
+	os.Setenv("ENVVAR_VALIDATE_ONEOF", "kafka")
+
+	err := envvar.New(&envvartesting.FakeProvider{}).Validate(
+		envvar.Required("ENVVAR_VALIDATE_MISSING"),
+		envvar.Int("ENVVAR_VALIDATE_MISSING"),
+		envvar.Int("ENVVAR_VALIDATE_INT"),
+		envvar.Int("ENVVAR_VALIDATE_OPTIONAL"),
+		envvar.Bool("ENVVAR_VALIDATE_BOOL"),
+		envvar.Duration("ENVVAR_VALIDATE_DURATION"),
+		envvar.OneOf("ENVVAR_VALIDATE_ONEOF", "segment", "kafka"),
+	)
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+		t.Fatalf("expected invalid argument error, got %v", err)
+	}
+
+	var verrs validation.Errors
+	if !errors.As(err, &verrs) {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+
+	if len(verrs) != 2 || verrs["ENVVAR_VALIDATE_MISSING"] == nil || verrs["ENVVAR_VALIDATE_INT"] == nil {
+		t.Fatalf("expected missing and invalid settings, got %v", verrs)
+	}
+}
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/vault/api"
 
@@ -13,9 +14,24 @@ import (
 type Provider struct {
 	path    string
 	client  *api.Logical
+	sys     *api.Sys
 	results map[string]map[string]string
 }
 
+// Lease defines how long a secret is valid, secrets must be renewed before the lease expires to keep using them.
+type Lease struct {
+	ID        string
+	Duration  time.Duration
+	Renewable bool
+}
+
+// DatabaseCredentials defines the short-lived credentials generated by the database secrets engine.
+type DatabaseCredentials struct {
+	Username string
+	Password string
+	Lease    Lease
+}
+
 // New ...
 func New(token, addr, path string) (*Provider, error) {
 	config := &api.Config{
@@ -32,6 +48,7 @@ func New(token, addr, path string) (*Provider, error) {
 	return &Provider{
 		path:    path,
 		client:  client.Logical(),
+		sys:     client.Sys(),
 		results: make(map[string]map[string]string),
 	}, nil
 }
@@ -93,3 +110,52 @@ func (p *Provider) Get(v string) (string, error) {
 
 	return val, nil
 }
+
+// DatabaseCredentials generates new credentials using the role of the database secrets engine mounted at mount,
+// for example "database". Credentials are not cached, each call generates new ones.
+func (p *Provider) DatabaseCredentials(mount, role string) (DatabaseCredentials, error) {
+	secret, err := p.client.Read(fmt.Sprintf("%s/creds/%s", mount, role))
+	if err != nil {
+		return DatabaseCredentials{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "reading")
+	}
+
+	if secret == nil {
+		return DatabaseCredentials{}, internal.NewErrorf(internal.ErrorCodeUnknown, "role not found")
+	}
+
+	username, _ := secret.Data["username"].(string)
+	password, _ := secret.Data["password"].(string)
+
+	if username == "" || password == "" {
+		return DatabaseCredentials{}, internal.NewErrorf(internal.ErrorCodeUnknown, "invalid data in secret")
+	}
+
+	return DatabaseCredentials{
+		Username: username,
+		Password: password,
+		Lease:    newLease(secret),
+	}, nil
+}
+
+// RenewLease extends the lease by increment, the returned lease may be shorter when it reaches its maximum time to
+// live; in that case new secrets must be generated before it expires.
+func (p *Provider) RenewLease(id string, increment time.Duration) (Lease, error) {
+	secret, err := p.sys.Renew(id, int(increment.Seconds()))
+	if err != nil {
+		return Lease{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "sys.Renew")
+	}
+
+	if secret == nil {
+		return Lease{}, internal.NewErrorf(internal.ErrorCodeUnknown, "lease not found")
+	}
+
+	return newLease(secret), nil
+}
+
+func newLease(secret *api.Secret) Lease {
+	return Lease{
+		ID:        secret.LeaseID,
+		Duration:  time.Duration(secret.LeaseDuration) * time.Second,
+		Renewable: secret.Renewable,
+	}
+}
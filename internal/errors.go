@@ -1,15 +1,17 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
 )
 
 // Error represents an error that could be wrapping another error, it includes a code for determining what
-// triggered the error.
+// triggered the error and, when caused by one, the dependency that failed.
 type Error struct {
-	orig error
-	msg  string
-	code ErrorCode
+	orig       error
+	msg        string
+	code       ErrorCode
+	dependency Dependency
 }
 
 // ErrorCode defines supported error codes.
@@ -19,14 +21,54 @@ const (
 	ErrorCodeUnknown ErrorCode = iota
 	ErrorCodeNotFound
 	ErrorCodeInvalidArgument
+	ErrorCodeUnavailable
+	ErrorCodeConflict
 )
 
-// WrapErrorf returns a wrapped error.
+// Dependency defines the external dependencies that may cause errors or consume the request budget.
+type Dependency string
+
+const (
+	DependencyNone          Dependency = ""
+	DependencyElasticsearch Dependency = "elasticsearch"
+	DependencyKafka         Dependency = "kafka"
+	DependencyMemcached     Dependency = "memcached"
+	DependencyMySQL         Dependency = "mysql"
+	DependencyPostgreSQL    Dependency = "postgresql"
+	DependencyPubSub        Dependency = "pubsub"
+	DependencyRabbitMQ      Dependency = "rabbitmq"
+	DependencyRedis         Dependency = "redis"
+	DependencyS3            Dependency = "s3"
+	DependencySegment       Dependency = "segment"
+	DependencySNS           Dependency = "sns"
+	DependencySQLite        Dependency = "sqlite"
+)
+
+// WrapErrorf returns a wrapped error. When code is ErrorCodeUnknown and the wrapped error is an Error, the code of
+// the wrapped error is kept; the dependency of the wrapped error is always kept.
 func WrapErrorf(orig error, code ErrorCode, format string, a ...interface{}) error {
+	return WrapDependencyErrorf(orig, DependencyNone, code, format, a...)
+}
+
+// WrapDependencyErrorf returns a wrapped error caused by the dependency, when dependency is DependencyNone it
+// behaves like WrapErrorf.
+func WrapDependencyErrorf(orig error, dependency Dependency, code ErrorCode, format string, a ...interface{}) error {
+	var ierr *Error
+	if errors.As(orig, &ierr) {
+		if code == ErrorCodeUnknown {
+			code = ierr.code
+		}
+
+		if dependency == DependencyNone {
+			dependency = ierr.dependency
+		}
+	}
+
 	return &Error{
-		code: code,
-		orig: orig,
-		msg:  fmt.Sprintf(format, a...),
+		code:       code,
+		orig:       orig,
+		msg:        fmt.Sprintf(format, a...),
+		dependency: dependency,
 	}
 }
 
@@ -53,3 +95,8 @@ func (e *Error) Unwrap() error {
 func (e *Error) Code() ErrorCode {
 	return e.code
 }
+
+// Dependency returns the dependency that caused this error, DependencyNone if unknown.
+func (e *Error) Dependency() Dependency {
+	return e.dependency
+}
@@ -0,0 +1,120 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestWrapErrorf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  error
+		code   internal.ErrorCode
+		output internal.ErrorCode
+	}{
+		{
+			"OK: explicit code",
+			errors.New("failed"),
+			internal.ErrorCodeNotFound,
+			internal.ErrorCodeNotFound,
+		},
+		{
+			"OK: unknown code",
+			errors.New("failed"),
+			internal.ErrorCodeUnknown,
+			internal.ErrorCodeUnknown,
+		},
+		{
+			"OK: unknown code keeps wrapped code",
+			internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid"),
+			internal.ErrorCodeUnknown,
+			internal.ErrorCodeInvalidArgument,
+		},
+		{
+			"OK: explicit code overrides wrapped code",
+			internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid"),
+			internal.ErrorCodeNotFound,
+			internal.ErrorCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var ierr *internal.Error
+			if !errors.As(internal.WrapErrorf(tt.input, tt.code, "wrapped"), &ierr) {
+				t.Fatalf("expected %T error", ierr)
+			}
+
+			if actual := ierr.Code(); actual != tt.output {
+				t.Fatalf("expected code %d, got %d", tt.output, actual)
+			}
+		})
+	}
+}
+
+func TestWrapDependencyErrorf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      error
+		dependency internal.Dependency
+		output     internal.Dependency
+	}{
+		{
+			"OK: explicit dependency",
+			errors.New("failed"),
+			internal.DependencyRedis,
+			internal.DependencyRedis,
+		},
+		{
+			"OK: no dependency",
+			errors.New("failed"),
+			internal.DependencyNone,
+			internal.DependencyNone,
+		},
+		{
+			"OK: no dependency keeps wrapped dependency",
+			internal.WrapDependencyErrorf(errors.New("failed"), internal.DependencyPostgreSQL,
+				internal.ErrorCodeUnknown, "failed"),
+			internal.DependencyNone,
+			internal.DependencyPostgreSQL,
+		},
+		{
+			"OK: explicit dependency overrides wrapped dependency",
+			internal.WrapDependencyErrorf(errors.New("failed"), internal.DependencyPostgreSQL,
+				internal.ErrorCodeUnknown, "failed"),
+			internal.DependencyElasticsearch,
+			internal.DependencyElasticsearch,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// WrapErrorf keeps the dependency as well.
+			err := internal.WrapErrorf(internal.WrapDependencyErrorf(tt.input, tt.dependency, internal.ErrorCodeUnknown, "wrapped"),
+				internal.ErrorCodeUnknown, "wrapped again")
+
+			var ierr *internal.Error
+			if !errors.As(err, &ierr) {
+				t.Fatalf("expected %T error", ierr)
+			}
+
+			if actual := ierr.Dependency(); actual != tt.output {
+				t.Fatalf("expected dependency %q, got %q", tt.output, actual)
+			}
+		})
+	}
+}
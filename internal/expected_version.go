@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"context"
+)
+
+type expectedVersionKey struct{}
+
+// NewContextWithExpectedVersion returns a new context that carries the version the Task being modified is
+// expected to have, datastores supporting versions fail with ErrorCodeConflict when it doesn't match.
+func NewContextWithExpectedVersion(ctx context.Context, version int64) context.Context {
+	return context.WithValue(ctx, expectedVersionKey{}, version)
+}
+
+// ExpectedVersionFromContext returns the expected version stored in ctx, if any.
+func ExpectedVersionFromContext(ctx context.Context) (int64, bool) {
+	version, ok := ctx.Value(expectedVersionKey{}).(int64)
+
+	return version, ok
+}
+
+type mergeKey struct{}
+
+// NewContextWithMerge returns a new context indicating that updates failing because the expected version doesn't
+// match are retried merging the changes made since then, as long as those don't conflict, see TaskConflict.
+func NewContextWithMerge(ctx context.Context) context.Context {
+	return context.WithValue(ctx, mergeKey{}, true)
+}
+
+// MergeFromContext indicates whether ctx requests merging conflicting updates.
+func MergeFromContext(ctx context.Context) bool {
+	merge, _ := ctx.Value(mergeKey{}).(bool)
+
+	return merge
+}
@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//nolint: gochecknoglobals
+var (
+	closed = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/github")).NewInt64Counter(
+		"github.closed",
+		metric.WithDescription("Number of issues closed after completing their task"),
+	)
+
+	dropped = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/github")).NewInt64Counter(
+		"github.dropped",
+		metric.WithDescription("Number of changes to tasks not applied to issues because the queue was full"),
+	)
+)
+
+// IssueClient defines the client used for closing issues.
+type IssueClient interface {
+	CloseIssue(ctx context.Context, repository string, number int) error
+}
+
+// LinkRepository defines the datastore handling persisting IssueLink records.
+type LinkRepository interface {
+	FindByTask(ctx context.Context, taskID string) (internal.IssueLink, error)
+	Save(ctx context.Context, link internal.IssueLink) error
+}
+
+// Closer closes the issues of the completed Tasks in the background, so the requests completing Tasks don't wait for
+// GitHub.
+type Closer struct {
+	logger *zap.Logger
+	issues IssueClient
+	links  LinkRepository
+	queue  chan internal.Task
+}
+
+// NewCloser instantiates the Closer, queueSize indicates the maximum number of changes waiting to be applied;
+// changes are dropped when the queue is full.
+func NewCloser(logger *zap.Logger, issues IssueClient, links LinkRepository, queueSize int) *Closer {
+	return &Closer{
+		logger: logger,
+		issues: issues,
+		links:  links,
+		queue:  make(chan internal.Task, queueSize),
+	}
+}
+
+// Notify queues the changed Task to be applied by Run.
+func (c *Closer) Notify(ctx context.Context, task internal.Task) {
+	select {
+	case c.queue <- task:
+	default:
+		dropped.Add(ctx, 1)
+
+		c.logger.Warn("Issue change dropped, queue is full", zap.String("id", task.ID))
+	}
+}
+
+// Run applies the queued changes until ctx is cancelled, failures are logged.
+func (c *Closer) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-c.queue:
+			if err := c.Close(ctx, task); err != nil {
+				c.logger.Warn("Couldn't close issue", zap.String("id", task.ID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// Close closes the issue of the Task once it's done, Tasks not imported from issues are ignored. Reopening the Task
+// doesn't reopen its issue, but the issue is closed again when the Task is completed again.
+func (c *Closer) Close(ctx context.Context, task internal.Task) error {
+	link, err := c.links.FindByTask(ctx, task.ID)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.FindByTask")
+	}
+
+	if task.IsDone == link.Closed {
+		return nil
+	}
+
+	if task.IsDone {
+		if err := c.issues.CloseIssue(ctx, link.Repository, link.Number); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "issues.CloseIssue")
+		}
+
+		closed.Add(ctx, 1)
+	}
+
+	link.Closed = task.IsDone
+
+	if err := c.links.Save(ctx, link); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.Save")
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	return errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound
+}
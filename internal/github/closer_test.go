@@ -0,0 +1,61 @@
+package github_test
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/github"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+)
+
+type fakeIssues struct {
+	closed []int
+}
+
+func (f *fakeIssues) CloseIssue(_ context.Context, _ string, number int) error {
+	f.closed = append(f.closed, number)
+
+	return nil
+}
+
+func TestCloser_Close(t *testing.T) {
+	t.Parallel()
+
+	const taskID = "1c0a4b5e-5d2f-4f7a-9d4f-0b1f8a3c2e11"
+
+	ctx := context.Background()
+
+	issues := &fakeIssues{}
+	links := memory.NewIssueLink()
+
+	if err := links.Save(ctx, internal.IssueLink{Repository: "octo/todo", Number: 7, TaskID: taskID}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	closer := github.NewCloser(zap.NewNop(), issues, links, 1)
+
+	steps := []struct {
+		task   internal.Task
+		closed []int
+	}{
+		{internal.Task{ID: taskID}, nil},
+		{internal.Task{ID: taskID, IsDone: true}, []int{7}},
+		{internal.Task{ID: taskID, IsDone: true}, []int{7}},                      // Already closed.
+		{internal.Task{ID: taskID}, []int{7}},                                    // Reopened tasks don't reopen issues.
+		{internal.Task{ID: taskID, IsDone: true}, []int{7, 7}},                   // Completed again.
+		{internal.Task{ID: "7d0e3b1a-0d1c-4b8e-9a5f-2e6f1c3a9b40"}, []int{7, 7}}, // Not imported.
+	}
+
+	for i, step := range steps {
+		if err := closer.Close(ctx, step.task); err != nil {
+			t.Fatalf("%d: expected no error, got %s", i, err)
+		}
+
+		if len(issues.closed) != len(step.closed) {
+			t.Fatalf("%d: expected %v closed, got %v", i, step.closed, issues.closed)
+		}
+	}
+}
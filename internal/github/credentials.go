@@ -0,0 +1,86 @@
+package github
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// PersonalToken is a personal access token, it requires read and write access to the issues of the repositories.
+type PersonalToken string
+
+// Token returns the personal access token.
+func (p PersonalToken) Token(_ context.Context) (string, error) {
+	return string(p), nil
+}
+
+// App authenticates as an installation of a GitHub App, the App requires read and write access to issues.
+// Installation tokens are cached until they are about to expire; it's safe for concurrent use.
+type App struct {
+	client         *http.Client
+	baseURL        string
+	appID          int64
+	installationID int64
+	key            *rsa.PrivateKey
+
+	mu      sync.Mutex
+	token   string
+	expires time.Time
+}
+
+// NewApp instantiates the App credentials, key is the private key of the App.
+func NewApp(client *http.Client, baseURL string, appID, installationID int64, key *rsa.PrivateKey) *App {
+	return &App{
+		client:         client,
+		baseURL:        baseURL,
+		appID:          appID,
+		installationID: installationID,
+		key:            key,
+	}
+}
+
+// Token returns the token of the installation, a new one is requested when the cached one is about to expire.
+func (a *App) Token(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && time.Until(a.expires) > time.Minute {
+		return a.token, nil
+	}
+
+	// Clocks may drift, so the JWT is issued in the past; it can't be valid for more than 10 minutes.
+	now := time.Now()
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.StandardClaims{
+		IssuedAt:  now.Add(-time.Minute).Unix(),
+		ExpiresAt: now.Add(9 * time.Minute).Unix(),
+		Issuer:    strconv.FormatInt(a.appID, 10),
+	}).SignedString(a.key)
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "jwt.SignedString")
+	}
+
+	var res struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", a.baseURL, a.installationID)
+
+	if err := send(ctx, a.client, http.MethodPost, url, "Bearer "+signed, nil, &res); err != nil {
+		return "", err
+	}
+
+	a.token = res.Token
+	a.expires = res.ExpiresAt
+
+	return a.token, nil
+}
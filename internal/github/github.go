@@ -0,0 +1,168 @@
+// Package github imports the open issues of GitHub repositories as Tasks and closes them once their Task is
+// completed, using the REST API authenticated with a personal access token or as a GitHub App installation.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// DefaultURL is the URL of the GitHub REST API, GitHub Enterprise Server uses "https://<host>/api/v3".
+	DefaultURL = "https://api.github.com"
+
+	// issuesPageSize is the number of issues listed at once, the maximum supported.
+	issuesPageSize = 100
+)
+
+// Credentials defines the token used for authenticating the requests, see PersonalToken and App.
+type Credentials interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Client is the client of the GitHub REST API.
+type Client struct {
+	client  *http.Client
+	baseURL string
+	creds   Credentials
+}
+
+// NewClient instantiates the Client, baseURL is the URL of the API, for example DefaultURL.
+func NewClient(client *http.Client, baseURL string, creds Credentials) *Client {
+	return &Client{
+		client:  client,
+		baseURL: baseURL,
+		creds:   creds,
+	}
+}
+
+// issue is an issue as returned by the API, pull requests are returned as issues as well.
+type issue struct {
+	Number int    `json:"number"`
+	Title  string `json:"title"`
+	Labels []struct {
+		Name string `json:"name"`
+	} `json:"labels"`
+	Milestone *struct {
+		DueOn *time.Time `json:"due_on"`
+	} `json:"milestone"`
+	PullRequest *struct{} `json:"pull_request"`
+}
+
+// ListIssues returns the open issues of the repository, pull requests are not included.
+func (c *Client) ListIssues(ctx context.Context, repository string) ([]internal.Issue, error) {
+	var res []internal.Issue
+
+	for page := 1; ; page++ {
+		var issues []issue
+
+		path := fmt.Sprintf("/repos/%s/issues?state=open&per_page=%d&page=%d", repository, issuesPageSize, page)
+
+		if err := c.do(ctx, http.MethodGet, path, nil, &issues); err != nil {
+			return nil, err
+		}
+
+		for _, i := range issues {
+			if i.PullRequest != nil {
+				continue
+			}
+
+			val := internal.Issue{
+				Repository: repository,
+				Number:     i.Number,
+				Title:      i.Title,
+			}
+
+			for _, label := range i.Labels {
+				val.Labels = append(val.Labels, label.Name)
+			}
+
+			if i.Milestone != nil && i.Milestone.DueOn != nil {
+				val.Due = *i.Milestone.DueOn
+			}
+
+			res = append(res, val)
+		}
+
+		if len(issues) < issuesPageSize {
+			return res, nil
+		}
+	}
+}
+
+// CloseIssue closes the issue as completed.
+func (c *Client) CloseIssue(ctx context.Context, repository string, number int) error {
+	body := map[string]string{"state": "closed", "state_reason": "completed"}
+
+	return c.do(ctx, http.MethodPatch, fmt.Sprintf("/repos/%s/issues/%d", repository, number), body, nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, dst interface{}) error {
+	token, err := c.creds.Token(ctx)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "creds.Token")
+	}
+
+	return send(ctx, c.client, method, c.baseURL+path, "Bearer "+token, body, dst)
+}
+
+// send sends the request, the body and the response are JSON encoded. Responses indicating the resource was not
+// found use internal.ErrorCodeNotFound, the ones indicating the API is unavailable or rate limited use
+// internal.ErrorCodeUnavailable.
+func send(ctx context.Context, client *http.Client, method, url, authorization string, body, dst interface{}) error {
+	var reqBody bytes.Buffer
+
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Encode")
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, &reqBody)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "http.NewRequest")
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := client.Do(req)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnavailable, "client.Do")
+	}
+
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode == http.StatusNotFound:
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "%s %s: not found", method, url)
+	case res.StatusCode == http.StatusForbidden, res.StatusCode == http.StatusTooManyRequests,
+		res.StatusCode >= http.StatusInternalServerError:
+		return internal.NewErrorf(internal.ErrorCodeUnavailable, "%s %s: unexpected status %d", method, url,
+			res.StatusCode)
+	case res.StatusCode >= http.StatusBadRequest:
+		return internal.NewErrorf(internal.ErrorCodeUnknown, "%s %s: unexpected status %d", method, url,
+			res.StatusCode)
+	}
+
+	if dst == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(dst); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Decode")
+	}
+
+	return nil
+}
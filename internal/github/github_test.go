@@ -0,0 +1,218 @@
+package github_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/github"
+)
+
+func TestClient_ListIssues(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2026, time.October, 31, 7, 0, 0, 0, time.UTC)
+
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/repos/octo/todo/issues" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			t.Errorf("unexpected authorization %q", r.Header.Get("Authorization"))
+		}
+
+		if r.URL.Query().Get("state") != "open" {
+			t.Errorf("expected open issues, got %q", r.URL.Query().Get("state"))
+		}
+
+		issues := []map[string]interface{}{}
+
+		// First page is full, the second one lists the remaining issue.
+		if r.URL.Query().Get("page") == "1" {
+			for i := 1; i <= 100; i++ {
+				issues = append(issues, map[string]interface{}{
+					"number":       i,
+					"title":        "pull request",
+					"pull_request": map[string]string{},
+				})
+			}
+		} else {
+			issues = append(issues, map[string]interface{}{
+				"number":    101,
+				"title":     "fix login",
+				"labels":    []map[string]string{{"name": "bug"}},
+				"milestone": map[string]string{"due_on": "2026-10-31T07:00:00Z"},
+			})
+		}
+
+		_ = json.NewEncoder(w).Encode(issues)
+	})
+
+	actual, err := client.ListIssues(context.Background(), "octo/todo")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := []internal.Issue{
+		{
+			Repository: "octo/todo",
+			Number:     101,
+			Title:      "fix login",
+			Labels:     []string{"bug"},
+			Due:        due,
+		},
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestClient_CloseIssue(t *testing.T) {
+	t.Parallel()
+
+	var received map[string]string
+
+	client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch || r.URL.Path != "/repos/octo/todo/issues/7" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("expected no error, got %s", err)
+		}
+
+		_, _ = w.Write([]byte("{}"))
+	})
+
+	if err := client.CloseIssue(context.Background(), "octo/todo", 7); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if received["state"] != "closed" {
+		t.Fatalf("expected issue closed, got %v", received)
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		output internal.ErrorCode
+	}{
+		{
+			"ERR: not found",
+			http.StatusNotFound,
+			internal.ErrorCodeNotFound,
+		},
+		{
+			"ERR: rate limited",
+			http.StatusForbidden,
+			internal.ErrorCodeUnavailable,
+		},
+		{
+			"ERR: unavailable",
+			http.StatusBadGateway,
+			internal.ErrorCodeUnavailable,
+		},
+		{
+			"ERR: unauthorized",
+			http.StatusUnauthorized,
+			internal.ErrorCodeUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := newClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+			})
+
+			err := client.CloseIssue(context.Background(), "octo/todo", 7)
+
+			var ierr *internal.Error
+			if !errors.As(err, &ierr) || ierr.Code() != tt.output {
+				t.Fatalf("expected error code %d, got %s", tt.output, err)
+			}
+		})
+	}
+}
+
+func TestApp_Token(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	requests := 0
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Method != http.MethodPost || r.URL.Path != "/app/installations/42/access_tokens" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var claims jwt.StandardClaims
+
+		if _, err := jwt.ParseWithClaims(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), &claims,
+			func(*jwt.Token) (interface{}, error) { return &key.PublicKey, nil }); err != nil {
+			t.Errorf("expected valid jwt, got %s", err)
+		}
+
+		if claims.Issuer != "7" {
+			t.Errorf("expected app id as issuer, got %q", claims.Issuer)
+		}
+
+		_, _ = fmt.Fprintf(w, `{"token":"installation","expires_at":%q}`,
+			time.Now().Add(time.Hour).Format(time.RFC3339))
+	}))
+	t.Cleanup(srv.Close)
+
+	app := github.NewApp(srv.Client(), srv.URL, 7, 42, key)
+
+	for i := 0; i < 2; i++ {
+		token, err := app.Token(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if token != "installation" {
+			t.Fatalf("expected installation token, got %q", token)
+		}
+	}
+
+	if requests != 1 {
+		t.Fatalf("expected cached token, got %d requests", requests)
+	}
+}
+
+func newClient(t *testing.T, handler http.HandlerFunc) *github.Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return github.NewClient(srv.Client(), srv.URL, github.PersonalToken("secret"))
+}
@@ -0,0 +1,60 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Importer defines the service used for importing the issues of a repository.
+type Importer interface {
+	Import(ctx context.Context, repository string) (internal.IssueImport, error)
+}
+
+// Poller imports the open issues of the repositories periodically.
+type Poller struct {
+	logger       *zap.Logger
+	importer     Importer
+	repositories []string
+	interval     time.Duration
+}
+
+// NewPoller instantiates the Poller, the issues are imported every interval.
+func NewPoller(logger *zap.Logger, importer Importer, repositories []string, interval time.Duration) *Poller {
+	return &Poller{
+		logger:       logger,
+		importer:     importer,
+		repositories: repositories,
+		interval:     interval,
+	}
+}
+
+// Run imports the issues until ctx is canceled, failures are logged and retried in the next interval.
+func (p *Poller) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, repository := range p.repositories {
+			res, err := p.importer.Import(ctx, repository)
+			if err != nil {
+				p.logger.Warn("Couldn't import issues", zap.String("repository", repository), zap.Error(err))
+
+				continue
+			}
+
+			if res.Imported > 0 {
+				p.logger.Info("Issues imported", zap.String("repository", repository), zap.Int("imported", res.Imported))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package github
+
+import (
+	"context"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskMessageBroker defines the message broker used for publishing Task messages.
+type TaskMessageBroker interface {
+	Created(ctx context.Context, task internal.Task) error
+	Deleted(ctx context.Context, id string) error
+	Updated(ctx context.Context, task internal.Task) error
+	Completed(ctx context.Context, task internal.Task) error
+	Reopened(ctx context.Context, task internal.Task) error
+}
+
+// Task publishes Task messages using the original message broker, the Tasks successfully published are applied to
+// their issues as well.
+type Task struct {
+	orig   TaskMessageBroker
+	closer *Closer
+}
+
+// NewTask instantiates the Task message broker.
+func NewTask(orig TaskMessageBroker, closer *Closer) *Task {
+	return &Task{
+		orig:   orig,
+		closer: closer,
+	}
+}
+
+// Created publishes a message indicating a task was created.
+func (t *Task) Created(ctx context.Context, task internal.Task) error {
+	return t.orig.Created(ctx, task) //nolint: wrapcheck
+}
+
+// Deleted publishes a message indicating a task was deleted.
+func (t *Task) Deleted(ctx context.Context, id string) error {
+	return t.orig.Deleted(ctx, id) //nolint: wrapcheck
+}
+
+// Updated publishes a message indicating a task was updated.
+func (t *Task) Updated(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, task, t.orig.Updated)
+}
+
+// Completed publishes a message indicating a task was completed.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, task, t.orig.Completed)
+}
+
+// Reopened publishes a message indicating a completed task was reopened.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, task, t.orig.Reopened)
+}
+
+func (t *Task) publish(ctx context.Context, task internal.Task, fn func(context.Context, internal.Task) error) error {
+	if err := fn(ctx, task); err != nil {
+		return err
+	}
+
+	t.closer.Notify(ctx, task)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"crypto/rand"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// IDGenerator generates the ids assigned to new tasks, those are 128-bit values using the UUID text format.
+type IDGenerator interface {
+	NewID() string
+}
+
+// UUIDv4Generator generates random UUIDs.
+type UUIDv4Generator struct{}
+
+// NewID returns a new UUID version 4.
+func (UUIDv4Generator) NewID() string {
+	return uuid.NewString()
+}
+
+// UUIDv7Generator generates time-sortable UUIDs, new records are inserted next to each other in the indices instead
+// of in random locations.
+type UUIDv7Generator struct{}
+
+// NewID returns a new UUID version 7.
+func (UUIDv7Generator) NewID() string {
+	return uuid.Must(uuid.NewV7()).String()
+}
+
+// ULIDGenerator generates ULIDs, those are time-sortable and monotonic: ids generated in the same millisecond are
+// sorted by creation as well. See https://github.com/ulid/spec for details.
+type ULIDGenerator struct {
+	mu      sync.Mutex
+	entropy *ulid.MonotonicEntropy
+}
+
+// NewULIDGenerator instantiates the ULIDGenerator.
+func NewULIDGenerator() *ULIDGenerator {
+	return &ULIDGenerator{
+		entropy: ulid.Monotonic(rand.Reader, 0),
+	}
+}
+
+// NewID returns a new ULID using the UUID text format, see ParseID for using its canonical format.
+func (g *ULIDGenerator) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return uuid.UUID(ulid.MustNew(ulid.Now(), g.entropy)).String()
+}
+
+// ParseID returns the id using the UUID text format, id is either a UUID or a ULID using its canonical format.
+func ParseID(id string) (string, error) {
+	if len(id) == ulid.EncodedSize {
+		val, err := ulid.ParseStrict(id)
+		if err != nil {
+			return "", WrapErrorf(err, ErrorCodeInvalidArgument, "invalid ulid")
+		}
+
+		return uuid.UUID(val).String(), nil
+	}
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return "", WrapErrorf(err, ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	return val.String(), nil
+}
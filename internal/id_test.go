@@ -0,0 +1,143 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestIDGenerator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    internal.IDGenerator
+		version  uuid.Version
+		sortable bool
+	}{
+		{
+			"OK: UUIDv4",
+			internal.UUIDv4Generator{},
+			4,
+			false,
+		},
+		{
+			"OK: UUIDv7",
+			internal.UUIDv7Generator{},
+			7,
+			true,
+		},
+		{
+			"OK: ULID",
+			internal.NewULIDGenerator(),
+			0,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var prev string
+
+			for i := 0; i < 100; i++ {
+				id := tt.input.NewID()
+
+				val, err := uuid.Parse(id)
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+
+				if tt.version != 0 && val.Version() != tt.version {
+					t.Fatalf("expected version %d, got %d", tt.version, val.Version())
+				}
+
+				if tt.sortable && id <= prev {
+					t.Fatalf("expected %s to be sorted after %s", id, prev)
+				}
+
+				prev = id
+
+				// UUIDv7 is only sortable across milliseconds.
+				if i%10 == 0 {
+					time.Sleep(time.Millisecond)
+				}
+			}
+		})
+	}
+}
+
+func TestParseID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		withErr  bool
+	}{
+		{
+			"OK: UUID",
+			"0186A6E4-4C4B-7C2A-9C8D-5B1E2F3A4B5C",
+			"0186a6e4-4c4b-7c2a-9c8d-5b1e2f3a4b5c",
+			false,
+		},
+		{
+			"OK: ULID",
+			"01GTKE8K2B7VGD1EC8QF7H5V8P",
+			"0186a6e4-4c4b-3ee0-d0b9-88bbcf12ed16",
+			false,
+		},
+		{
+			"OK: ULID lowercase",
+			"01gtke8k2b7vgd1ec8qf7h5v8p",
+			"0186a6e4-4c4b-3ee0-d0b9-88bbcf12ed16",
+			false,
+		},
+		{
+			"ERR: ULID overflow",
+			"81GTKE8K2B7VGD1EC8QF7H5V8P",
+			"",
+			true,
+		},
+		{
+			"ERR: invalid",
+			"x",
+			"",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := internal.ParseID(tt.input)
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, err)
+			}
+
+			if actual != tt.expected {
+				t.Fatalf("expected %s, got %s", tt.expected, actual)
+			}
+
+			if err == nil {
+				return
+			}
+
+			var ierr *internal.Error
+			if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+				t.Fatalf("expected invalid argument error, got %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"regexp"
+	"time"
+)
+
+// repositoryRegEx matches the full names of GitHub repositories, "owner/name".
+//nolint: gochecknoglobals
+var repositoryRegEx = regexp.MustCompile(`^[A-Za-z0-9-]+/[A-Za-z0-9._-]+$`)
+
+// Issue is an open issue of a GitHub repository, imported as a Task.
+type Issue struct {
+	Repository string
+	Number     int
+	Title      string
+	Labels     []string
+	Due        time.Time // Due date of the milestone of the issue, zero when it has none.
+}
+
+// IssueLink links an imported Issue to its Task. TaskID is empty while the Issue is being imported, Closed indicates
+// the Issue was closed after completing the Task.
+type IssueLink struct {
+	Repository string
+	Number     int
+	TaskID     string
+	Closed     bool
+}
+
+// IssueImport is the result of importing the open Issues of a repository, Issues imported before are skipped.
+type IssueImport struct {
+	Repository string
+	Imported   int
+	Skipped    int
+}
+
+// ValidateRepository indicates whether repository is the full name of a GitHub repository, "owner/name".
+func ValidateRepository(repository string) error {
+	if !repositoryRegEx.MatchString(repository) {
+		return NewErrorf(ErrorCodeInvalidArgument, "invalid repository %q, must be owner/name", repository)
+	}
+
+	return nil
+}
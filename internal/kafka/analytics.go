@@ -0,0 +1,65 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Analytics represents the repository used for publishing product analytics events, messages use the format of the
+// Segment Track API so consumers can forward them to any compatible service.
+type Analytics struct {
+	producer  *kafka.Producer
+	topicName string
+}
+
+// NewAnalytics instantiates the Analytics repository.
+func NewAnalytics(producer *kafka.Producer, topicName string) *Analytics {
+	return &Analytics{
+		producer:  producer,
+		topicName: topicName,
+	}
+}
+
+// Track publishes the event.
+func (a *Analytics) Track(ctx context.Context, event internal.AnalyticsEvent) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Analytics.Track")
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyKafka)()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   semconv.MessagingSystemKey,
+			Value: attribute.StringValue("kafka"),
+		},
+	)
+
+	b, err := json.Marshal(event)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	if err := a.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &a.topicName,
+			Partition: kafka.PartitionAny,
+		},
+		Value: b,
+		Headers: []kafka.Header{
+			{
+				Key:   "content-type",
+				Value: []byte("application/json"),
+			},
+		},
+	}, nil); err != nil {
+		return internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "producer.Produce")
+	}
+
+	return nil
+}
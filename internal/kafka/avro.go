@@ -0,0 +1,214 @@
+package kafka
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/linkedin/goavro/v2"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// taskEventSchema is the Avro schema of the published events, changes must be compatible with the previous
+// versions registered in the Schema Registry.
+const taskEventSchema = `{
+  "type": "record",
+  "name": "TaskEvent",
+  "namespace": "com.mariocarrion.todo",
+  "fields": [
+    {"name": "type", "type": "string"},
+    {
+      "name": "value",
+      "type": {
+        "type": "record",
+        "name": "Task",
+        "fields": [
+          {"name": "id", "type": "string"},
+          {"name": "description", "type": "string", "default": ""},
+          {"name": "priority", "type": "int", "default": 0},
+          {"name": "is_done", "type": "boolean", "default": false},
+          {"name": "start_date", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null},
+          {"name": "due_date", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null},
+          {"name": "time_zone", "type": "string", "default": ""},
+          {"name": "project_id", "type": "string", "default": ""},
+          {"name": "completed_at", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null},
+          {"name": "created_at", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null},
+          {"name": "updated_at", "type": ["null", {"type": "long", "logicalType": "timestamp-micros"}], "default": null}
+        ]
+      }
+    }
+  ]
+}`
+
+// magicByte prefixes messages using the Confluent wire format: magic byte, schema id and Avro binary data.
+const magicByte = 0
+
+// AvroSerializer serializes events using Avro and the Confluent wire format, schemas are registered in the
+// Schema Registry.
+type AvroSerializer struct {
+	codec *goavro.Codec
+	id    int
+}
+
+// NewAvroSerializer instantiates the serializer, the schema is registered under the "<topic>-value" subject after
+// confirming it's compatible with the latest version.
+func NewAvroSerializer(ctx context.Context, registry *SchemaRegistry, topic string) (*AvroSerializer, error) {
+	codec, err := goavro.NewCodec(taskEventSchema)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "goavro.NewCodec")
+	}
+
+	id, err := registry.Register(ctx, topic+"-value", taskEventSchema)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "registry.Register")
+	}
+
+	return &AvroSerializer{
+		codec: codec,
+		id:    id,
+	}, nil
+}
+
+// IsAvro indicates whether b uses the Confluent wire format.
+func IsAvro(b []byte) bool {
+	return len(b) > 5 && b[0] == magicByte
+}
+
+// Serialize encodes the event.
+func (s *AvroSerializer) Serialize(msgType string, task internal.Task) ([]byte, error) {
+	native := map[string]interface{}{
+		"type": msgType,
+		"value": map[string]interface{}{
+			"id":           task.ID,
+			"description":  task.Description,
+			"priority":     int32(task.Priority),
+			"is_done":      task.IsDone,
+			"start_date":   newAvroTime(task.Dates.Start),
+			"due_date":     newAvroTime(task.Dates.Due),
+			"time_zone":    task.Dates.TimeZone,
+			"project_id":   task.ProjectID,
+			"completed_at": newAvroTime(task.CompletedAt),
+			"created_at":   newAvroTime(task.CreatedAt),
+			"updated_at":   newAvroTime(task.UpdatedAt),
+		},
+	}
+
+	header := make([]byte, 5)
+	header[0] = magicByte
+	binary.BigEndian.PutUint32(header[1:], uint32(s.id))
+
+	res, err := s.codec.BinaryFromNative(header, native)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "codec.BinaryFromNative")
+	}
+
+	return res, nil
+}
+
+// AvroDeserializer deserializes events using Avro and the Confluent wire format, the schemas used for serializing
+// them are retrieved from the Schema Registry.
+type AvroDeserializer struct {
+	registry *SchemaRegistry
+
+	mu     sync.Mutex
+	codecs map[int]*goavro.Codec
+}
+
+// NewAvroDeserializer instantiates the deserializer.
+func NewAvroDeserializer(registry *SchemaRegistry) *AvroDeserializer {
+	return &AvroDeserializer{
+		registry: registry,
+		codecs:   make(map[int]*goavro.Codec),
+	}
+}
+
+// Deserialize decodes the event.
+func (s *AvroDeserializer) Deserialize(ctx context.Context, b []byte) (string, internal.Task, error) {
+	if !IsAvro(b) {
+		return "", internal.Task{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid wire format")
+	}
+
+	codec, err := s.writerCodec(ctx, int(binary.BigEndian.Uint32(b[1:5])))
+	if err != nil {
+		return "", internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "writerCodec")
+	}
+
+	native, _, err := codec.NativeFromBinary(b[5:])
+	if err != nil {
+		return "", internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "codec.NativeFromBinary")
+	}
+
+	record, _ := native.(map[string]interface{})
+	value, _ := record["value"].(map[string]interface{})
+
+	msgType, _ := record["type"].(string)
+	id, _ := value["id"].(string)
+	description, _ := value["description"].(string)
+	priority, _ := value["priority"].(int32)
+	isDone, _ := value["is_done"].(bool)
+	timeZone, _ := value["time_zone"].(string)
+	projectID, _ := value["project_id"].(string)
+
+	return msgType, internal.Task{
+		ID:          id,
+		Description: description,
+		Priority:    internal.Priority(priority),
+		IsDone:      isDone,
+		Dates: internal.Dates{
+			Start:    fromAvroTime(value["start_date"]),
+			Due:      fromAvroTime(value["due_date"]),
+			TimeZone: timeZone,
+		},
+		ProjectID:   projectID,
+		CompletedAt: fromAvroTime(value["completed_at"]),
+		CreatedAt:   fromAvroTime(value["created_at"]),
+		UpdatedAt:   fromAvroTime(value["updated_at"]),
+	}, nil
+}
+
+func (s *AvroDeserializer) writerCodec(ctx context.Context, id int) (*goavro.Codec, error) {
+	s.mu.Lock()
+	codec, ok := s.codecs[id]
+	s.mu.Unlock()
+
+	if ok {
+		return codec, nil
+	}
+
+	schema, err := s.registry.Schema(ctx, id)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "registry.Schema")
+	}
+
+	codec, err = goavro.NewCodec(schema)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "goavro.NewCodec")
+	}
+
+	s.mu.Lock()
+	s.codecs[id] = codec
+	s.mu.Unlock()
+
+	return codec, nil
+}
+
+func newAvroTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+
+	return goavro.Union("long.timestamp-micros", t)
+}
+
+func fromAvroTime(v interface{}) time.Time {
+	union, ok := v.(map[string]interface{})
+	if !ok {
+		return time.Time{}
+	}
+
+	t, _ := union["long.timestamp-micros"].(time.Time)
+
+	return t.UTC()
+}
@@ -0,0 +1,99 @@
+package kafka_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/kafka"
+)
+
+func TestAvro(t *testing.T) {
+	t.Parallel()
+
+	var schema string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/compatibility/subjects/tasks-value/versions/latest":
+			w.WriteHeader(http.StatusNotFound)
+		case "/subjects/tasks-value/versions":
+			var req struct {
+				Schema string `json:"schema"`
+			}
+
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			schema = req.Schema
+
+			_, _ = w.Write([]byte(`{"id":7}`))
+		case "/schemas/ids/7":
+			_ = json.NewEncoder(w).Encode(map[string]string{"schema": schema})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	serializer, err := kafka.NewAvroSerializer(context.Background(),
+		kafka.NewSchemaRegistry(srv.Client(), srv.URL),
+		"tasks")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := internal.Task{
+		ID:          "1-2-3",
+		Description: "avro",
+		Priority:    internal.PriorityHigh,
+		IsDone:      true,
+		Dates: internal.Dates{
+			Due:      time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC),
+			TimeZone: "America/Los_Angeles",
+		},
+	}
+
+	b, err := serializer.Serialize("tasks.event.created", expected)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !kafka.IsAvro(b) {
+		t.Fatalf("expected Avro wire format")
+	}
+
+	// A new registry client is used to force retrieving the schema.
+	deserializer := kafka.NewAvroDeserializer(kafka.NewSchemaRegistry(srv.Client(), srv.URL))
+
+	msgType, actual, err := deserializer.Deserialize(context.Background(), b)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if msgType != "tasks.event.created" {
+		t.Fatalf("expected type, got %s", msgType)
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestSchemaRegistry_Register(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"is_compatible":false}`))
+	}))
+	defer srv.Close()
+
+	_, err := kafka.NewSchemaRegistry(srv.Client(), srv.URL).Register(context.Background(), "tasks-value", `"string"`)
+	if err == nil {
+		t.Fatalf("expected error, got no value")
+	}
+}
@@ -0,0 +1,247 @@
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Headers included in dead-lettered messages.
+const (
+	headerError             = "dlq.error"
+	headerAttempts          = "dlq.attempts"
+	headerFailedAt          = "dlq.failed_at"
+	headerOriginalTopic     = "dlq.original_topic"
+	headerOriginalPartition = "dlq.original_partition"
+	headerOriginalOffset    = "dlq.original_offset"
+
+	readTimeout = 2 * time.Second
+)
+
+// DeadLetter represents a message that couldn't be processed.
+type DeadLetter struct {
+	Key               []byte
+	Value             []byte
+	Error             string
+	Attempts          int
+	FailedAt          time.Time
+	OriginalTopic     string
+	OriginalPartition int32
+	OriginalOffset    int64
+	Offset            int64
+}
+
+// DeadLetterQueue moves messages that couldn't be processed to a different topic, those can be inspected and
+// replayed later.
+type DeadLetterQueue struct {
+	producer *kafka.Producer
+	config   kafka.ConfigMap
+	topic    string
+	source   string
+}
+
+// NewDeadLetterQueue instantiates the dead-letter queue, messages are moved to "<source>.dlq" and replayed back to
+// source. config is used for instantiating the consumers used for reading the dead-lettered messages.
+func NewDeadLetterQueue(producer *kafka.Producer, config kafka.ConfigMap, source string) *DeadLetterQueue {
+	return &DeadLetterQueue{
+		producer: producer,
+		config:   config,
+		topic:    source + ".dlq",
+		source:   source,
+	}
+}
+
+// Send moves the message to the dead-letter topic including the reason it failed.
+func (d *DeadLetterQueue) Send(msg *kafka.Message, cause error, attempts int) error {
+	headers := append([]kafka.Header{}, msg.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: headerError, Value: []byte(cause.Error())},
+		kafka.Header{Key: headerAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: headerFailedAt, Value: []byte(time.Now().UTC().Format(time.RFC3339Nano))},
+		kafka.Header{Key: headerOriginalPartition, Value: []byte(strconv.Itoa(int(msg.TopicPartition.Partition)))},
+		kafka.Header{Key: headerOriginalOffset, Value: []byte(msg.TopicPartition.Offset.String())},
+	)
+
+	if msg.TopicPartition.Topic != nil {
+		headers = append(headers, kafka.Header{Key: headerOriginalTopic, Value: []byte(*msg.TopicPartition.Topic)})
+	}
+
+	return d.produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &d.topic,
+			Partition: kafka.PartitionAny,
+		},
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	})
+}
+
+// List returns up to limit dead-lettered messages, from the oldest one, without consuming them.
+func (d *DeadLetterQueue) List(ctx context.Context, limit int) ([]DeadLetter, error) {
+	consumer, err := d.newConsumer("dlq-inspector")
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "newConsumer")
+	}
+	defer consumer.Close()
+
+	metadata, err := consumer.GetMetadata(&d.topic, false, int(readTimeout.Milliseconds()))
+	if err != nil {
+		return nil, internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "consumer.GetMetadata")
+	}
+
+	var partitions []kafka.TopicPartition
+
+	for _, partition := range metadata.Topics[d.topic].Partitions {
+		partitions = append(partitions, kafka.TopicPartition{
+			Topic:     &d.topic,
+			Partition: partition.ID,
+			Offset:    kafka.OffsetBeginning,
+		})
+	}
+
+	if err := consumer.Assign(partitions); err != nil {
+		return nil, internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "consumer.Assign")
+	}
+
+	res := []DeadLetter{}
+
+	for len(res) < limit && ctx.Err() == nil {
+		msg, err := consumer.ReadMessage(readTimeout)
+		if err != nil {
+			break // Timed out: no more messages.
+		}
+
+		res = append(res, newDeadLetter(msg))
+	}
+
+	return res, nil
+}
+
+// Replay consumes up to limit dead-lettered messages and publishes them back to the original topic, it returns
+// the number of replayed messages.
+func (d *DeadLetterQueue) Replay(ctx context.Context, limit int) (int, error) {
+	consumer, err := d.newConsumer("dlq-replayer")
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "newConsumer")
+	}
+	defer consumer.Close()
+
+	if err := consumer.Subscribe(d.topic, nil); err != nil {
+		return 0, internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "consumer.Subscribe")
+	}
+
+	var count int
+
+	for count < limit && ctx.Err() == nil {
+		msg, err := consumer.ReadMessage(readTimeout)
+		if err != nil {
+			break // Timed out: no more messages.
+		}
+
+		if err := d.produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{
+				Topic:     &d.source,
+				Partition: kafka.PartitionAny,
+			},
+			Key:     msg.Key,
+			Value:   msg.Value,
+			Headers: originalHeaders(msg.Headers),
+		}); err != nil {
+			return count, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "produce")
+		}
+
+		if _, err := consumer.CommitMessage(msg); err != nil {
+			return count, internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "consumer.CommitMessage")
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+func (d *DeadLetterQueue) newConsumer(groupID string) (*kafka.Consumer, error) {
+	config := kafka.ConfigMap{}
+
+	for k, v := range d.config {
+		config[k] = v
+	}
+
+	config["group.id"] = groupID
+	config["auto.offset.reset"] = "earliest"
+	config["enable.auto.commit"] = false
+
+	consumer, err := kafka.NewConsumer(&config)
+	if err != nil {
+		return nil, internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "kafka.NewConsumer")
+	}
+
+	return consumer, nil
+}
+
+// produce publishes the message and waits for its delivery.
+func (d *DeadLetterQueue) produce(msg *kafka.Message) error {
+	deliveryC := make(chan kafka.Event, 1)
+
+	if err := d.producer.Produce(msg, deliveryC); err != nil {
+		return internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "producer.Produce")
+	}
+
+	if res, ok := (<-deliveryC).(*kafka.Message); ok && res.TopicPartition.Error != nil {
+		return internal.WrapDependencyErrorf(res.TopicPartition.Error, internal.DependencyKafka, internal.ErrorCodeUnknown,
+			"delivery")
+	}
+
+	return nil
+}
+
+func newDeadLetter(msg *kafka.Message) DeadLetter {
+	res := DeadLetter{
+		Key:    msg.Key,
+		Value:  msg.Value,
+		Offset: int64(msg.TopicPartition.Offset),
+	}
+
+	for _, header := range msg.Headers {
+		val := string(header.Value)
+
+		switch header.Key {
+		case headerError:
+			res.Error = val
+		case headerAttempts:
+			res.Attempts, _ = strconv.Atoi(val)
+		case headerFailedAt:
+			res.FailedAt, _ = time.Parse(time.RFC3339Nano, val)
+		case headerOriginalTopic:
+			res.OriginalTopic = val
+		case headerOriginalPartition:
+			partition, _ := strconv.ParseInt(val, 10, 32)
+			res.OriginalPartition = int32(partition)
+		case headerOriginalOffset:
+			res.OriginalOffset, _ = strconv.ParseInt(val, 10, 64)
+		}
+	}
+
+	return res
+}
+
+// originalHeaders removes the headers added when the message was dead-lettered.
+func originalHeaders(headers []kafka.Header) []kafka.Header {
+	var res []kafka.Header
+
+	for _, header := range headers {
+		switch header.Key {
+		case headerError, headerAttempts, headerFailedAt, headerOriginalTopic, headerOriginalPartition, headerOriginalOffset:
+			continue
+		}
+
+		res = append(res, header)
+	}
+
+	return res
+}
@@ -0,0 +1,77 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Event represents a task event consumed from the topic.
+type Event struct {
+	Type  string
+	Value internal.Task
+}
+
+// EventDecoder decodes task events published using any of the supported formats.
+type EventDecoder struct {
+	avro *AvroDeserializer
+}
+
+// NewEventDecoder instantiates the EventDecoder, Avro messages are supported only when avro is not nil.
+func NewEventDecoder(avro *AvroDeserializer) *EventDecoder {
+	return &EventDecoder{
+		avro: avro,
+	}
+}
+
+// Decode decodes messages using Avro, the CloudEvents envelope or the original format.
+func (d *EventDecoder) Decode(ctx context.Context, b []byte) (Event, error) {
+	if IsAvro(b) {
+		if d.avro == nil {
+			return Event{}, internal.NewErrorf(internal.ErrorCodeUnknown, "schema registry is not configured")
+		}
+
+		msgType, task, err := d.avro.Deserialize(ctx, b)
+		if err != nil {
+			return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "avro.Deserialize")
+		}
+
+		return Event{
+			Type:  msgType,
+			Value: task,
+		}, nil
+	}
+
+	if cevt, err := internal.DecodeCloudEvent(b); err == nil {
+		task, err := cevt.DecodeTask(ctx)
+		if err != nil {
+			return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "cevt.DecodeTask")
+		}
+
+		return Event{
+			Type:  cevt.Type,
+			Value: task,
+		}, nil
+	}
+
+	var evt struct {
+		Type    string
+		Version int
+		Value   json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &evt); err != nil {
+		return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Unmarshal")
+	}
+
+	task, err := internal.DecodeTaskEventData(ctx, evt.Version, evt.Value)
+	if err != nil {
+		return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.DecodeTaskEventData")
+	}
+
+	return Event{
+		Type:  evt.Type,
+		Value: task,
+	}, nil
+}
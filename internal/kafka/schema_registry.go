@@ -0,0 +1,132 @@
+package kafka
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const schemaRegistryContentType = "application/vnd.schemaregistry.v1+json"
+
+// SchemaRegistry is a client of the Confluent Schema Registry REST API.
+type SchemaRegistry struct {
+	client  *http.Client
+	baseURL string
+
+	mu      sync.Mutex
+	schemas map[int]string
+}
+
+// NewSchemaRegistry instantiates the Schema Registry client.
+func NewSchemaRegistry(client *http.Client, baseURL string) *SchemaRegistry {
+	return &SchemaRegistry{
+		client:  client,
+		baseURL: baseURL,
+		schemas: make(map[int]string),
+	}
+}
+
+// Register registers the schema under subject and returns its id, an error is returned when the schema is not
+// compatible with the latest version registered under that subject, using the compatibility level configured
+// in the registry.
+func (s *SchemaRegistry) Register(ctx context.Context, subject, schema string) (int, error) {
+	req := struct {
+		Schema string `json:"schema"`
+	}{
+		Schema: schema,
+	}
+
+	var compatibility struct {
+		IsCompatible bool `json:"is_compatible"` //nolint: tagliatelle
+	}
+
+	path := fmt.Sprintf("/compatibility/subjects/%s/versions/latest", url.PathEscape(subject))
+
+	status, err := s.do(ctx, http.MethodPost, path, req, &compatibility)
+	if err != nil && status != http.StatusNotFound { // Not found: first version of the subject.
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "compatibility")
+	}
+
+	if err == nil && !compatibility.IsCompatible {
+		return 0, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "schema is not compatible with subject %s", subject)
+	}
+
+	var res struct {
+		ID int `json:"id"`
+	}
+
+	if _, err := s.do(ctx, http.MethodPost, fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject)), req, &res); err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "register")
+	}
+
+	s.mu.Lock()
+	s.schemas[res.ID] = schema
+	s.mu.Unlock()
+
+	return res.ID, nil
+}
+
+// Schema returns the schema registered using id.
+func (s *SchemaRegistry) Schema(ctx context.Context, id int) (string, error) {
+	s.mu.Lock()
+	schema, ok := s.schemas[id]
+	s.mu.Unlock()
+
+	if ok {
+		return schema, nil
+	}
+
+	var res struct {
+		Schema string `json:"schema"`
+	}
+
+	if _, err := s.do(ctx, http.MethodGet, fmt.Sprintf("/schemas/ids/%d", id), nil, &res); err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeUnknown, "schema")
+	}
+
+	s.mu.Lock()
+	s.schemas[id] = res.Schema
+	s.mu.Unlock()
+
+	return res.Schema, nil
+}
+
+func (s *SchemaRegistry) do(ctx context.Context, method, path string, body, target interface{}) (int, error) {
+	var b bytes.Buffer
+
+	if body != nil {
+		if err := json.NewEncoder(&b).Encode(body); err != nil {
+			return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Encode")
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, &b)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "http.NewRequestWithContext")
+	}
+
+	req.Header.Set("Accept", schemaRegistryContentType)
+	req.Header.Set("Content-Type", schemaRegistryContentType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, internal.NewErrorf(internal.ErrorCodeUnknown, "%s %s %d", method, path, resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+		return resp.StatusCode, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Decode")
+	}
+
+	return resp.StatusCode, nil
+}
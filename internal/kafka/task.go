@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 	"go.opentelemetry.io/otel/attribute"
@@ -13,22 +14,31 @@ import (
 	"github.com/MarioCarrion/todo-api/internal"
 )
 
+// source identifies the producer of the events when using the CloudEvents envelope.
+const source = "/tasks-rest-server"
+
 // Task represents the repository used for publishing Task records.
 type Task struct {
-	producer  *kafka.Producer
-	topicName string
+	producer    *kafka.Producer
+	topicName   string
+	cloudEvents bool
+	avro        *AvroSerializer
 }
 
 type event struct {
-	Type  string
-	Value internal.Task
+	Type    string
+	Version int
+	Value   internal.Task
 }
 
-// NewTask instantiates the Task repository.
-func NewTask(producer *kafka.Producer, topicName string) *Task {
+// NewTask instantiates the Task repository, when cloudEvents is true messages use the CloudEvents envelope. When
+// avro is not nil messages are serialized using Avro, in that case CloudEvents attributes are sent as headers.
+func NewTask(producer *kafka.Producer, topicName string, cloudEvents bool, avro *AvroSerializer) *Task {
 	return &Task{
-		topicName: topicName,
-		producer:  producer,
+		topicName:   topicName,
+		producer:    producer,
+		cloudEvents: cloudEvents,
+		avro:        avro,
 	}
 }
 
@@ -47,9 +57,22 @@ func (t *Task) Updated(ctx context.Context, task internal.Task) error {
 	return t.publish(ctx, "Task.Updated", "tasks.event.updated", task)
 }
 
+// Completed publishes a message indicating a task was completed, it's published after the one indicating the task
+// was updated.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Completed", "tasks.event.completed", task)
+}
+
+// Reopened publishes a message indicating a completed task was reopened, it's published after the one indicating
+// the task was updated.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Reopened", "tasks.event.reopened", task)
+}
+
 func (t *Task) publish(ctx context.Context, spanName, msgType string, task internal.Task) error {
 	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, spanName)
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyKafka)()
 
 	span.SetAttributes(
 		attribute.KeyValue{
@@ -60,11 +83,35 @@ func (t *Task) publish(ctx context.Context, spanName, msgType string, task inter
 
 	//-
 
-	var b bytes.Buffer
+	if t.avro != nil {
+		return t.produceAvro(msgType, task)
+	}
 
-	evt := event{
-		Type:  msgType,
-		Value: task,
+	var (
+		b       bytes.Buffer
+		evt     interface{}
+		headers []kafka.Header
+	)
+
+	if t.cloudEvents {
+		cevt, err := internal.NewCloudEvent(source, msgType, task)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.NewCloudEvent")
+		}
+
+		evt = cevt
+		headers = []kafka.Header{
+			{
+				Key:   "content-type",
+				Value: []byte(internal.CloudEventsContentType),
+			},
+		}
+	} else {
+		evt = event{
+			Type:    msgType,
+			Version: internal.TaskEventVersion,
+			Value:   task,
+		}
 	}
 
 	if err := json.NewEncoder(&b).Encode(evt); err != nil {
@@ -76,9 +123,53 @@ func (t *Task) publish(ctx context.Context, spanName, msgType string, task inter
 			Topic:     &t.topicName,
 			Partition: kafka.PartitionAny,
 		},
-		Value: b.Bytes(),
+		Value:   b.Bytes(),
+		Headers: headers,
+	}, nil); err != nil {
+		return internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "product.Producer")
+	}
+
+	return nil
+}
+
+func (t *Task) produceAvro(msgType string, task internal.Task) error {
+	value, err := t.avro.Serialize(msgType, task)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "avro.Serialize")
+	}
+
+	headers := []kafka.Header{
+		{
+			Key:   "content-type",
+			Value: []byte("application/avro"),
+		},
+	}
+
+	if t.cloudEvents {
+		// CloudEvents Kafka protocol binding, binary content mode.
+		evt, err := internal.NewCloudEvent(source, msgType, nil)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.NewCloudEvent")
+		}
+
+		headers = append(headers,
+			kafka.Header{Key: "ce_specversion", Value: []byte(evt.SpecVersion)},
+			kafka.Header{Key: "ce_id", Value: []byte(evt.ID)},
+			kafka.Header{Key: "ce_source", Value: []byte(evt.Source)},
+			kafka.Header{Key: "ce_type", Value: []byte(evt.Type)},
+			kafka.Header{Key: "ce_time", Value: []byte(evt.Time.Format(time.RFC3339Nano))},
+		)
+	}
+
+	if err := t.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{
+			Topic:     &t.topicName,
+			Partition: kafka.PartitionAny,
+		},
+		Value:   value,
+		Headers: headers,
 	}, nil); err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "product.Producer")
+		return internal.WrapDependencyErrorf(err, internal.DependencyKafka, internal.ErrorCodeUnknown, "product.Producer")
 	}
 
 	return nil
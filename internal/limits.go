@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// QueryLimits defines the maximum values allowed when querying Task records, those are meant to prevent a single
+// request from consuming too many resources. Zero values indicate no limit.
+type QueryLimits struct {
+	MaxPageSize      int64
+	MaxSearchTerms   int
+	MaxWildcardTerms int
+}
+
+// ValidateList indicates whether the list arguments are within the limits.
+func (l QueryLimits) ValidateList(params ListParams) error {
+	errs := validation.Errors{}
+
+	if l.MaxPageSize > 0 && params.Size > l.MaxPageSize {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be no greater than %d", l.MaxPageSize)
+	}
+
+	return errs.Filter()
+}
+
+// ValidateTrash indicates whether the arguments used for listing the trashed Tasks are within the limits.
+func (l QueryLimits) ValidateTrash(params TrashParams) error {
+	errs := validation.Errors{}
+
+	if l.MaxPageSize > 0 && params.Size > l.MaxPageSize {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be no greater than %d", l.MaxPageSize)
+	}
+
+	return errs.Filter()
+}
+
+// ValidateSuggest indicates whether the suggest arguments are within the limits.
+func (l QueryLimits) ValidateSuggest(params SuggestParams) error {
+	errs := validation.Errors{}
+
+	if l.MaxPageSize > 0 && params.Size > l.MaxPageSize {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be no greater than %d", l.MaxPageSize)
+	}
+
+	return errs.Filter()
+}
+
+// ValidateSearch indicates whether the search arguments are within the limits. Terms including "*", "?" or "~"
+// are considered wildcard or fuzzy terms, all of them are when searching using Fuzziness.
+func (l QueryLimits) ValidateSearch(args SearchParams) error {
+	errs := validation.Errors{}
+
+	if l.MaxPageSize > 0 && args.Size > l.MaxPageSize {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be no greater than %d", l.MaxPageSize)
+	}
+
+	if args.Description != nil {
+		terms := strings.Fields(*args.Description)
+
+		var wildcards int
+
+		for _, term := range terms {
+			if args.Fuzziness.Fuzzy() || strings.ContainsAny(term, "*?~") {
+				wildcards++
+			}
+		}
+
+		switch {
+		case l.MaxSearchTerms > 0 && len(terms) > l.MaxSearchTerms:
+			errs["description"] = NewErrorf(ErrorCodeInvalidArgument, "must include no more than %d terms", l.MaxSearchTerms)
+		case l.MaxWildcardTerms > 0 && wildcards > l.MaxWildcardTerms:
+			errs["description"] = NewErrorf(ErrorCodeInvalidArgument,
+				"must include no more than %d wildcard or fuzzy terms", l.MaxWildcardTerms)
+		}
+	}
+
+	return errs.Filter()
+}
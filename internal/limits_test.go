@@ -0,0 +1,135 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestQueryLimits_ValidateList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		limits  internal.QueryLimits
+		input   internal.ListParams
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.QueryLimits{MaxPageSize: 10},
+			internal.ListParams{Size: 10},
+			false,
+		},
+		{
+			"OK: no limits",
+			internal.QueryLimits{},
+			internal.ListParams{Size: 1_000},
+			false,
+		},
+		{
+			"ERR: size",
+			internal.QueryLimits{MaxPageSize: 10},
+			internal.ListParams{Size: 11},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.limits.ValidateList(tt.input)
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr validation.Errors
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestQueryLimits_ValidateSearch(t *testing.T) {
+	t.Parallel()
+
+	limits := internal.QueryLimits{
+		MaxPageSize:      10,
+		MaxSearchTerms:   3,
+		MaxWildcardTerms: 1,
+	}
+
+	tests := []struct {
+		name    string
+		input   internal.SearchParams
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.SearchParams{
+				Description: newString("buy milk*"),
+				Size:        10,
+			},
+			false,
+		},
+		{
+			"ERR: size",
+			internal.SearchParams{Size: 11},
+			true,
+		},
+		{
+			"ERR: terms",
+			internal.SearchParams{
+				Description: newString("buy milk and eggs"),
+				Size:        10,
+			},
+			true,
+		},
+		{
+			"ERR: wildcard terms",
+			internal.SearchParams{
+				Description: newString("mil* egg~"),
+				Size:        10,
+			},
+			true,
+		},
+		{
+			"ERR: fuzzy terms",
+			internal.SearchParams{
+				Description: newString("milk eggs"),
+				Size:        10,
+				Fuzziness:   internal.FuzzinessAuto,
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := limits.ValidateSearch(tt.input)
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr validation.Errors
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func newString(s string) *string {
+	return &s
+}
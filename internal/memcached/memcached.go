@@ -2,6 +2,7 @@ package memcached
 
 import (
 	"bytes"
+	"context"
 	"encoding/gob"
 	"time"
 
@@ -16,24 +17,30 @@ import (
 // errors and retry as needed.
 // See https://youtu.be/UnL2iGcD7vE for more details about that pattern.
 
-func deleteTask(client *memcache.Client, key string) {
+func deleteTask(ctx context.Context, client *memcache.Client, key string) {
+	defer internal.TrackDependency(ctx, internal.DependencyMemcached)()
+
 	_ = client.Delete(key)
 }
 
-func getTask(client *memcache.Client, key string, target interface{}) error {
+func getTask(ctx context.Context, client *memcache.Client, key string, target interface{}) error {
+	defer internal.TrackDependency(ctx, internal.DependencyMemcached)()
+
 	item, err := client.Get(key)
 	if err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Get")
+		return internal.WrapDependencyErrorf(err, internal.DependencyMemcached, internal.ErrorCodeUnknown, "client.Get")
 	}
 
 	if err := gob.NewDecoder(bytes.NewReader(item.Value)).Decode(target); err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "gob.NewDecoder")
+		return internal.WrapDependencyErrorf(err, internal.DependencyMemcached, internal.ErrorCodeUnknown, "gob.NewDecoder")
 	}
 
 	return nil
 }
 
-func setTask(client *memcache.Client, key string, value interface{}, expiration time.Duration) {
+func setTask(ctx context.Context, client *memcache.Client, key string, value interface{}, expiration time.Duration) {
+	defer internal.TrackDependency(ctx, internal.DependencyMemcached)()
+
 	var b bytes.Buffer
 
 	if err := gob.NewEncoder(&b).Encode(value); err != nil {
@@ -21,6 +21,7 @@ type SearchableTaskStore interface {
 	Delete(ctx context.Context, id string) error
 	Index(ctx context.Context, task internal.Task) error
 	Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error)
+	Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error)
 }
 
 // NewSearchableTask instantiates the Task repository.
@@ -49,20 +50,30 @@ func (t *SearchableTask) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// Suggest returns the suggestions without caching them, those change with every typed character.
+func (t *SearchableTask) Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error) {
+	res, err := t.orig.Suggest(ctx, params)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Suggest")
+	}
+
+	return res, nil
+}
+
 // Search ...
 func (t *SearchableTask) Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error) {
 	key := newSearchableKey(args)
 
 	var res internal.SearchResults
 
-	if err := getTask(t.client, key, &res); err != nil {
+	if err := getTask(ctx, t.client, key, &res); err != nil {
 		if errors.Is(err, memcache.ErrCacheMiss) {
 			res, err := t.orig.Search(ctx, args)
 			if err != nil {
 				return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Search")
 			}
 
-			setTask(t.client, key, &res, 25*time.Second)
+			setTask(ctx, t.client, key, &res, 25*time.Second)
 
 			return res, nil
 		}
@@ -92,5 +103,24 @@ func newSearchableKey(args internal.SearchParams) string {
 		isDone = *args.IsDone
 	}
 
-	return fmt.Sprintf("%s_%d_%t_%d_%d", description, priority, isDone, args.From, args.Size)
+	var highlight int
+
+	if args.Highlight != nil {
+		highlight = args.Highlight.Size()
+	}
+
+	var due string
+
+	if args.Due != nil {
+		due = fmt.Sprintf("%d_%d", args.Due.From.UnixNano(), args.Due.To.UnixNano())
+	}
+
+	var projectID string
+
+	if args.ProjectID != nil {
+		projectID = *args.ProjectID
+	}
+
+	return fmt.Sprintf("%s_%d_%t_%d_%d_%s_%s_%s_%d_%t_%s_%s", description, priority, isDone, args.From, args.Size,
+		args.Cursor, args.Sort, args.Fuzziness, highlight, args.Facets, due, projectID)
 }
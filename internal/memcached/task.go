@@ -21,7 +21,9 @@ type TaskStore interface {
 	Create(ctx context.Context, params internal.CreateParams) (internal.Task, error)
 	Delete(ctx context.Context, id string) error
 	Find(ctx context.Context, id string) (internal.Task, error)
-	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, isDone bool) error
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
+	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error
+	Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error)
 }
 
 func NewTask(client *memcache.Client, orig TaskStore, logger *zap.Logger) *Task {
@@ -41,9 +43,9 @@ func (t *Task) Create(ctx context.Context, params internal.CreateParams) (intern
 
 	// Write-Through Caching
 
-	t.logger.Info("Create: setting value")
+	t.logger.Info("Create: setting value", zap.String("request_id", internal.RequestIDFromContext(ctx)))
 
-	setTask(t.client, task.ID, &task, t.expiration)
+	setTask(ctx, t.client, task.ID, &task, t.expiration)
 
 	return task, nil
 }
@@ -53,7 +55,7 @@ func (t *Task) Delete(ctx context.Context, id string) error {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Delete")
 	}
 
-	deleteTask(t.client, id)
+	deleteTask(ctx, t.client, id)
 
 	return nil
 }
@@ -61,13 +63,13 @@ func (t *Task) Delete(ctx context.Context, id string) error {
 func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
 	var res internal.Task
 
-	t.logger.Info("Find: get value")
+	t.logger.Info("Find: get value", zap.String("request_id", internal.RequestIDFromContext(ctx)))
 
-	if err := getTask(t.client, id, &res); err == nil {
+	if err := getTask(ctx, t.client, id, &res); err == nil {
 		return res, nil
 	}
 
-	t.logger.Info("Find: not found, let's cache it")
+	t.logger.Info("Find: not found, let's cache it", zap.String("request_id", internal.RequestIDFromContext(ctx)))
 
 	// Cache-Aside Caching
 
@@ -76,19 +78,30 @@ func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
 		return res, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Find")
 	}
 
-	setTask(t.client, res.ID, &res, t.expiration)
+	setTask(ctx, t.client, res.ID, &res, t.expiration)
 
 	return res, nil
 }
 
-func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, isDone bool) error {
-	if err := t.orig.Update(ctx, id, description, priority, dates, isDone); err != nil {
+func (t *Task) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	// Pages are not cached, they change every time a record is created.
+
+	res, err := t.orig.List(ctx, params)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.List")
+	}
+
+	return res, nil
+}
+
+func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	if err := t.orig.Update(ctx, id, description, priority, dates, projectID, isDone); err != nil {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Update")
 	}
 
 	// Write-Through Caching
 
-	t.logger.Info("Update: setting value")
+	t.logger.Info("Update: setting value", zap.String("request_id", internal.RequestIDFromContext(ctx)))
 
 	// Update cache
 
@@ -96,14 +109,27 @@ func (t *Task) Update(ctx context.Context, id string, description string, priori
 	// What if any of the following instructions fail? We may end up with stale
 	// values
 
-	deleteTask(t.client, id) // XXX
+	deleteTask(ctx, t.client, id) // XXX
 
 	task, err := t.orig.Find(ctx, id)
 	if err != nil { // XXX
 		return nil //nolint: nilerr
 	}
 
-	setTask(t.client, task.ID, &task, t.expiration) // XXX
+	setTask(ctx, t.client, task.ID, &task, t.expiration) // XXX
 
 	return nil
 }
+
+func (t *Task) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	inserted, err := t.orig.Upsert(ctx, id, description, priority, dates, projectID, isDone)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Upsert")
+	}
+
+	// The cached value is populated again the next time it's read.
+
+	deleteTask(ctx, t.client, id)
+
+	return inserted, nil
+}
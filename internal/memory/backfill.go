@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// BackfillCheckpoint represents the repository used for interacting with backfill checkpoints, it's safe for
+// concurrent use.
+type BackfillCheckpoint struct {
+	mu          sync.RWMutex
+	checkpoints map[string]internal.BackfillCheckpoint
+}
+
+// NewBackfillCheckpoint instantiates the BackfillCheckpoint repository.
+func NewBackfillCheckpoint() *BackfillCheckpoint {
+	return &BackfillCheckpoint{
+		checkpoints: make(map[string]internal.BackfillCheckpoint),
+	}
+}
+
+// Find returns the checkpoint of the backfill.
+func (b *BackfillCheckpoint) Find(_ context.Context, name string) (internal.BackfillCheckpoint, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	checkpoint, ok := b.checkpoints[name]
+	if !ok {
+		return internal.BackfillCheckpoint{}, internal.NewErrorf(internal.ErrorCodeNotFound, "checkpoint not found")
+	}
+
+	return checkpoint, nil
+}
+
+// Save inserts the checkpoint or replaces the existing one.
+func (b *BackfillCheckpoint) Save(_ context.Context, checkpoint internal.BackfillCheckpoint) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.checkpoints[checkpoint.Name] = checkpoint
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// CalendarLink represents the repository used for interacting with CalendarLink records and the sync tokens of the
+// calendars, it's safe for concurrent use.
+type CalendarLink struct {
+	mu     sync.RWMutex
+	links  map[string]internal.CalendarLink
+	tokens map[string]string
+}
+
+// NewCalendarLink instantiates the CalendarLink repository.
+func NewCalendarLink() *CalendarLink {
+	return &CalendarLink{
+		links:  make(map[string]internal.CalendarLink),
+		tokens: make(map[string]string),
+	}
+}
+
+// DeleteLink deletes the existing record matching the Task id.
+func (c *CalendarLink) DeleteLink(ctx context.Context, taskID string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.DeleteLink")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(taskID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.links[taskID]; !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "calendar link not found")
+	}
+
+	delete(c.links, taskID)
+
+	return nil
+}
+
+// FindLinkByEvent returns the record matching the event id.
+func (c *CalendarLink) FindLinkByEvent(ctx context.Context, eventID string) (internal.CalendarLink, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.FindLinkByEvent")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, link := range c.links {
+		if link.EventID == eventID {
+			return link, nil
+		}
+	}
+
+	return internal.CalendarLink{}, internal.NewErrorf(internal.ErrorCodeNotFound, "calendar link not found")
+}
+
+// FindLinkByTask returns the record matching the Task id.
+func (c *CalendarLink) FindLinkByTask(ctx context.Context, taskID string) (internal.CalendarLink, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.FindLinkByTask")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(taskID); err != nil {
+		return internal.CalendarLink{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	link, ok := c.links[taskID]
+	if !ok {
+		return internal.CalendarLink{}, internal.NewErrorf(internal.ErrorCodeNotFound, "calendar link not found")
+	}
+
+	return link, nil
+}
+
+// SaveLink inserts the record or replaces the existing one.
+func (c *CalendarLink) SaveLink(ctx context.Context, link internal.CalendarLink) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.SaveLink")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(link.TaskID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if link.EventID == "" {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "event id is required")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.links[link.TaskID] = link
+
+	return nil
+}
+
+// SaveSyncToken saves the sync token of the calendar.
+func (c *CalendarLink) SaveSyncToken(ctx context.Context, calendarID, token string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.SaveSyncToken")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tokens[calendarID] = token
+
+	return nil
+}
+
+// SyncToken returns the sync token of the calendar, empty when it was not saved yet.
+func (c *CalendarLink) SyncToken(ctx context.Context, calendarID string) (string, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.SyncToken")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tokens[calendarID], nil
+}
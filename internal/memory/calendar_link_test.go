@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestCalendarLink_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.CalendarLinkRepository(t, func(testing.TB) calendar.LinkRepository {
+		return memory.NewCalendarLink()
+	})
+}
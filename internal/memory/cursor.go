@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// cursor defines the keyset used for paginating records, records are sorted by insertion order; when sorting by
+// urgency, by completion, then by urgency and then by insertion order and, when sorting by time, by the creation or
+// update time, At, and then by insertion order, both in the same direction.
+type cursor struct {
+	Sort    internal.Sort
+	Done    bool
+	Urgency int64
+	At      int64
+	Seq     int64
+}
+
+func decodeCursor(sort internal.Sort, val string) (cursor, error) {
+	if val == "" {
+		if sort.Descending() {
+			return cursor{Sort: sort, At: math.MaxInt64, Seq: math.MaxInt64}, nil
+		}
+
+		return cursor{Sort: sort, Urgency: math.MinInt64, At: math.MinInt64, Seq: -1}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 5)
+	if len(split) != 5 || split[0] != string(sort) {
+		return cursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	done, err := strconv.ParseBool(split[1])
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	urgency, err := strconv.ParseInt(split[2], 10, 64)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	at, err := strconv.ParseInt(split[3], 10, 64)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	seq, err := strconv.ParseInt(split[4], 10, 64)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return cursor{
+		Sort:    sort,
+		Done:    done,
+		Urgency: urgency,
+		At:      at,
+		Seq:     seq,
+	}, nil
+}
+
+// less indicates whether c sorts before o.
+func (c cursor) less(o cursor) bool {
+	if c.Sort.ByTime() {
+		if c.Sort.Descending() {
+			return c.At > o.At || (c.At == o.At && c.Seq > o.Seq)
+		}
+
+		return c.At < o.At || (c.At == o.At && c.Seq < o.Seq)
+	}
+
+	if c.Sort == internal.SortUrgency {
+		if c.Done != o.Done {
+			return !c.Done
+		}
+
+		if c.Urgency != o.Urgency {
+			return c.Urgency < o.Urgency
+		}
+	}
+
+	return c.Seq < o.Seq
+}
+
+func (c cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		string(c.Sort),
+		strconv.FormatBool(c.Done),
+		strconv.FormatInt(c.Urgency, 10),
+		strconv.FormatInt(c.At, 10),
+		strconv.FormatInt(c.Seq, 10),
+	}, "|")))
+}
@@ -0,0 +1,258 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// MaxFaultLatency is the maximum latency simulated for a dependency.
+const MaxFaultLatency = time.Minute
+
+// errSimulatedFailure is the error returned by dependencies simulated to fail.
+var errSimulatedFailure = errors.New("simulated failure")
+
+// Fault defines the behavior simulated for a dependency: every call takes Latency longer and fails with the
+// probability indicated by ErrorRate, from 0 to 1.
+type Fault struct {
+	Latency   time.Duration
+	ErrorRate float64
+}
+
+// Validate indicates whether the fields are valid or not.
+func (f Fault) Validate() error {
+	if f.Latency < 0 || f.Latency > MaxFaultLatency {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "latency must be between 0 and %s", MaxFaultLatency)
+	}
+
+	if f.ErrorRate < 0 || f.ErrorRate > 1 {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "error rate must be between 0 and 1")
+	}
+
+	return nil
+}
+
+// Faults keeps the faults simulated for the dependencies replaced when running without them: PostgreSQL for
+// storing tasks, Elasticsearch for searching them and Kafka for publishing events. It's safe for concurrent use.
+type Faults struct {
+	mu     sync.RWMutex
+	faults map[internal.Dependency]Fault
+}
+
+// NewFaults instantiates Faults, no faults are simulated until set.
+func NewFaults() *Faults {
+	return &Faults{
+		faults: make(map[internal.Dependency]Fault),
+	}
+}
+
+// Dependencies returns the dependencies that can be simulated.
+func (f *Faults) Dependencies() []internal.Dependency {
+	return []internal.Dependency{
+		internal.DependencyElasticsearch,
+		internal.DependencyKafka,
+		internal.DependencyPostgreSQL,
+	}
+}
+
+// Set simulates the fault for the dependency, replacing the previous one.
+func (f *Faults) Set(dependency internal.Dependency, fault Fault) error {
+	if !f.supported(dependency) {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "dependency %q can't be simulated", dependency)
+	}
+
+	if err := fault.Validate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "fault.Validate")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.faults[dependency] = fault
+
+	return nil
+}
+
+// Get returns the fault simulated for the dependency, the zero value when it behaves normally.
+func (f *Faults) Get(dependency internal.Dependency) Fault {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	return f.faults[dependency]
+}
+
+// Inject waits for the latency simulated for the dependency, or until the context is done, and then fails
+// depending on the simulated error rate. Errors are reported as caused by the dependency.
+func (f *Faults) Inject(ctx context.Context, dependency internal.Dependency) error {
+	fault := f.Get(dependency)
+
+	if fault.Latency > 0 {
+		defer internal.TrackDependency(ctx, dependency)()
+
+		timer := time.NewTimer(fault.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return internal.WrapDependencyErrorf(ctx.Err(), dependency, internal.ErrorCodeUnknown, "simulated latency")
+		case <-timer.C:
+		}
+	}
+
+	//nolint: gosec
+	if fault.ErrorRate > 0 && rand.Float64() < fault.ErrorRate {
+		return internal.WrapDependencyErrorf(errSimulatedFailure, dependency, internal.ErrorCodeUnknown, "simulated error rate")
+	}
+
+	return nil
+}
+
+func (f *Faults) supported(dependency internal.Dependency) bool {
+	for _, dep := range f.Dependencies() {
+		if dep == dependency {
+			return true
+		}
+	}
+
+	return false
+}
+
+//-
+
+// FaultyTask wraps the Task repository simulating the faults of the dependencies it replaces, PostgreSQL when
+// storing tasks and Elasticsearch when searching them.
+type FaultyTask struct {
+	orig   *Task
+	faults *Faults
+}
+
+// NewFaultyTask instantiates the FaultyTask repository.
+func NewFaultyTask(orig *Task, faults *Faults) *FaultyTask {
+	return &FaultyTask{
+		orig:   orig,
+		faults: faults,
+	}
+}
+
+// Available indicates searching is possible, it isn't while Elasticsearch is simulated to always fail.
+func (t *FaultyTask) Available() bool {
+	return t.faults.Get(internal.DependencyElasticsearch).ErrorRate < 1
+}
+
+// Create inserts a new task record.
+func (t *FaultyTask) Create(ctx context.Context, params internal.CreateParams) (internal.Task, error) {
+	if err := t.faults.Inject(ctx, internal.DependencyPostgreSQL); err != nil {
+		return internal.Task{}, err
+	}
+
+	return t.orig.Create(ctx, params)
+}
+
+// Delete deletes the existing record matching the id.
+func (t *FaultyTask) Delete(ctx context.Context, id string) error {
+	if err := t.faults.Inject(ctx, internal.DependencyPostgreSQL); err != nil {
+		return err
+	}
+
+	return t.orig.Delete(ctx, id)
+}
+
+// Find returns the requested task by searching its id.
+func (t *FaultyTask) Find(ctx context.Context, id string) (internal.Task, error) {
+	if err := t.faults.Inject(ctx, internal.DependencyPostgreSQL); err != nil {
+		return internal.Task{}, err
+	}
+
+	return t.orig.Find(ctx, id)
+}
+
+// List returns a page of tasks.
+func (t *FaultyTask) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	if err := t.faults.Inject(ctx, internal.DependencyPostgreSQL); err != nil {
+		return internal.ListResults{}, err
+	}
+
+	return t.orig.List(ctx, params)
+}
+
+// Update updates the existing record with new values.
+//nolint: lll
+func (t *FaultyTask) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	if err := t.faults.Inject(ctx, internal.DependencyPostgreSQL); err != nil {
+		return err
+	}
+
+	return t.orig.Update(ctx, id, description, priority, dates, projectID, isDone)
+}
+
+// Upsert updates the existing record or inserts a new one using the id, it indicates whether it was created.
+//nolint: lll
+func (t *FaultyTask) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	if err := t.faults.Inject(ctx, internal.DependencyPostgreSQL); err != nil {
+		return false, err
+	}
+
+	return t.orig.Upsert(ctx, id, description, priority, dates, projectID, isDone)
+}
+
+// Search returns tasks matching a query.
+func (t *FaultyTask) Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error) {
+	if err := t.faults.Inject(ctx, internal.DependencyElasticsearch); err != nil {
+		return internal.SearchResults{}, err
+	}
+
+	return t.orig.Search(ctx, args)
+}
+
+// Suggest returns the tasks whose descriptions start with the typed words.
+func (t *FaultyTask) Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error) {
+	if err := t.faults.Inject(ctx, internal.DependencyElasticsearch); err != nil {
+		return nil, err
+	}
+
+	return t.orig.Suggest(ctx, params)
+}
+
+//-
+
+// FaultyDiscard represents the message broker dropping the events, like Discard, while simulating the faults of
+// Kafka.
+type FaultyDiscard struct {
+	faults *Faults
+}
+
+// NewFaultyDiscard instantiates the FaultyDiscard message broker.
+func NewFaultyDiscard(faults *Faults) FaultyDiscard {
+	return FaultyDiscard{
+		faults: faults,
+	}
+}
+
+// Created drops the event.
+func (d FaultyDiscard) Created(ctx context.Context, _ internal.Task) error {
+	return d.faults.Inject(ctx, internal.DependencyKafka)
+}
+
+// Deleted drops the event.
+func (d FaultyDiscard) Deleted(ctx context.Context, _ string) error {
+	return d.faults.Inject(ctx, internal.DependencyKafka)
+}
+
+// Updated drops the event.
+func (d FaultyDiscard) Updated(ctx context.Context, _ internal.Task) error {
+	return d.faults.Inject(ctx, internal.DependencyKafka)
+}
+
+// Completed drops the event.
+func (d FaultyDiscard) Completed(ctx context.Context, _ internal.Task) error {
+	return d.faults.Inject(ctx, internal.DependencyKafka)
+}
+
+// Reopened drops the event.
+func (d FaultyDiscard) Reopened(ctx context.Context, _ internal.Task) error {
+	return d.faults.Inject(ctx, internal.DependencyKafka)
+}
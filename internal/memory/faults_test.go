@@ -0,0 +1,146 @@
+package memory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+)
+
+func TestFaults_Set(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		dependency internal.Dependency
+		fault      memory.Fault
+		withErr    bool
+	}{
+		{
+			"OK",
+			internal.DependencyPostgreSQL,
+			memory.Fault{Latency: time.Second, ErrorRate: 0.5},
+			false,
+		},
+		{
+			"ERR: unsupported dependency",
+			internal.DependencyRedis,
+			memory.Fault{ErrorRate: 1},
+			true,
+		},
+		{
+			"ERR: negative latency",
+			internal.DependencyElasticsearch,
+			memory.Fault{Latency: -time.Second},
+			true,
+		},
+		{
+			"ERR: error rate",
+			internal.DependencyKafka,
+			memory.Fault{ErrorRate: 1.5},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			faults := memory.NewFaults()
+
+			err := faults.Set(tt.dependency, tt.fault)
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, err)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument) {
+				t.Fatalf("expected invalid argument error, got %s", err)
+			}
+
+			expected := tt.fault
+			if tt.withErr {
+				expected = memory.Fault{}
+			}
+
+			if actual := faults.Get(tt.dependency); actual != expected {
+				t.Fatalf("expected %v, got %v", expected, actual)
+			}
+		})
+	}
+}
+
+func TestFaults_Inject(t *testing.T) {
+	t.Parallel()
+
+	faults := memory.NewFaults()
+
+	if err := faults.Set(internal.DependencyElasticsearch, memory.Fault{ErrorRate: 1}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := faults.Set(internal.DependencyKafka, memory.Fault{Latency: time.Minute}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := faults.Inject(context.Background(), internal.DependencyPostgreSQL); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var ierr *internal.Error
+
+	err := faults.Inject(context.Background(), internal.DependencyElasticsearch)
+	if !errors.As(err, &ierr) || ierr.Dependency() != internal.DependencyElasticsearch {
+		t.Fatalf("expected error caused by elasticsearch, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err = faults.Inject(ctx, internal.DependencyKafka)
+	if !errors.Is(err, context.DeadlineExceeded) || !errors.As(err, &ierr) || ierr.Dependency() != internal.DependencyKafka {
+		t.Fatalf("expected deadline exceeded caused by kafka, got %v", err)
+	}
+}
+
+func TestFaultyTask(t *testing.T) {
+	t.Parallel()
+
+	faults := memory.NewFaults()
+	store := memory.NewFaultyTask(memory.NewTask(), faults)
+
+	task, err := store.Create(context.Background(), internal.CreateParams{Description: "Buy milk"})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !store.Available() {
+		t.Fatalf("expected search to be available")
+	}
+
+	if err := faults.Set(internal.DependencyElasticsearch, memory.Fault{ErrorRate: 1}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if store.Available() {
+		t.Fatalf("expected search to be unavailable")
+	}
+
+	// Finding is not affected by the faults simulated for searching.
+	if _, err := store.Find(context.Background(), task.ID); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := faults.Set(internal.DependencyPostgreSQL, memory.Fault{ErrorRate: 1}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if _, err := store.Find(context.Background(), task.ID); err == nil {
+		t.Fatalf("expected error")
+	}
+}
@@ -0,0 +1,59 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Instance represents the repository used for interacting with the heartbeats of the instances, only the ones of
+// this process are known. It's safe for concurrent use.
+type Instance struct {
+	mu        sync.RWMutex
+	instances map[string]internal.Instance
+}
+
+// NewInstance instantiates the Instance repository.
+func NewInstance() *Instance {
+	return &Instance{
+		instances: make(map[string]internal.Instance),
+	}
+}
+
+// Delete removes the instance.
+func (i *Instance) Delete(_ context.Context, id string) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.instances, id)
+
+	return nil
+}
+
+// List returns the instances seen after seenAfter.
+func (i *Instance) List(_ context.Context, seenAfter time.Time) ([]internal.Instance, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var res []internal.Instance
+
+	for _, instance := range i.instances {
+		if instance.SeenAt.After(seenAfter) {
+			res = append(res, instance)
+		}
+	}
+
+	return res, nil
+}
+
+// Save inserts the instance or replaces the existing one.
+func (i *Instance) Save(_ context.Context, instance internal.Instance) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.instances[instance.ID] = instance
+
+	return nil
+}
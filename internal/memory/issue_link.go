@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// IssueLink represents the repository used for interacting with IssueLink records, it's safe for concurrent use.
+type IssueLink struct {
+	mu    sync.RWMutex
+	links map[string]internal.IssueLink
+}
+
+// NewIssueLink instantiates the IssueLink repository.
+func NewIssueLink() *IssueLink {
+	return &IssueLink{
+		links: make(map[string]internal.IssueLink),
+	}
+}
+
+// Claim indicates whether the Issue was not imported yet, claiming it.
+func (i *IssueLink) Claim(ctx context.Context, repository string, number int) (bool, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.Claim")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	key := issueKey(repository, number)
+
+	if _, ok := i.links[key]; ok {
+		return false, nil
+	}
+
+	i.links[key] = internal.IssueLink{Repository: repository, Number: number}
+
+	return true, nil
+}
+
+// FindByTask returns the record matching the Task id.
+func (i *IssueLink) FindByTask(ctx context.Context, taskID string) (internal.IssueLink, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.FindByTask")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(taskID); err != nil {
+		return internal.IssueLink{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	for _, link := range i.links {
+		if link.TaskID == taskID {
+			return link, nil
+		}
+	}
+
+	return internal.IssueLink{}, internal.NewErrorf(internal.ErrorCodeNotFound, "issue link not found")
+}
+
+// Release deletes the claim of the Issue, so it's imported again.
+func (i *IssueLink) Release(ctx context.Context, repository string, number int) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.Release")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.links, issueKey(repository, number))
+
+	return nil
+}
+
+// Save inserts the record or replaces the existing one.
+func (i *IssueLink) Save(ctx context.Context, link internal.IssueLink) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.Save")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(link.TaskID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.links[issueKey(link.Repository, link.Number)] = link
+
+	return nil
+}
+
+func issueKey(repository string, number int) string {
+	return fmt.Sprintf("%s#%d", repository, number)
+}
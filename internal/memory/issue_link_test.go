@@ -0,0 +1,16 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestIssueLink_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.IssueLinkRepository(t, func(testing.TB) storetesting.IssueLinkStore {
+		return memory.NewIssueLink()
+	})
+}
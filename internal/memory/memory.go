@@ -0,0 +1,56 @@
+// Package memory implements the repositories keeping the records in memory, it's meant for local development and
+// tests where running external dependencies is not an option, records are lost when the process exits.
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Discard represents the message broker used for publishing Task events, those are dropped because nothing
+// subscribes to them when running without external dependencies.
+type Discard struct{}
+
+// Created drops the event.
+func (Discard) Created(_ context.Context, _ internal.Task) error {
+	return nil
+}
+
+// Deleted drops the event.
+func (Discard) Deleted(_ context.Context, _ string) error {
+	return nil
+}
+
+// Updated drops the event.
+func (Discard) Updated(_ context.Context, _ internal.Task) error {
+	return nil
+}
+
+// Completed drops the event.
+func (Discard) Completed(_ context.Context, _ internal.Task) error {
+	return nil
+}
+
+// Reopened drops the event.
+func (Discard) Reopened(_ context.Context, _ internal.Task) error {
+	return nil
+}
+
+// newUrgency returns the urgency of a task: the due date moved earlier depending on the priority, three days for
+// high and one day for medium; tasks without due date go last.
+func newUrgency(priority internal.Priority, due time.Time) int64 {
+	if due.IsZero() {
+		due = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	switch priority {
+	case internal.PriorityHigh:
+		due = due.Add(-3 * 24 * time.Hour)
+	case internal.PriorityMedium:
+		due = due.Add(-24 * time.Hour)
+	}
+
+	return due.UnixMicro()
+}
@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Project represents the repository used for interacting with Project records, it's safe for concurrent use.
+type Project struct {
+	mu       sync.RWMutex
+	projects map[string]projectRecord
+	seq      int64
+}
+
+type projectRecord struct {
+	project internal.Project
+	seq     int64
+}
+
+// NewProject instantiates the Project repository.
+func NewProject() *Project {
+	return &Project{
+		projects: make(map[string]projectRecord),
+	}
+}
+
+// Create inserts a new project record.
+func (p *Project) Create(ctx context.Context, name string) (internal.Project, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Create")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	project := internal.Project{
+		ID:   uuid.NewString(),
+		Name: name,
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.seq++
+	p.projects[project.ID] = projectRecord{project: project, seq: p.seq}
+
+	return project, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (p *Project) Delete(ctx context.Context, id string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Delete")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.projects[id]; !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "project not found")
+	}
+
+	delete(p.projects, id)
+
+	return nil
+}
+
+// Find returns the requested project by searching its id.
+func (p *Project) Find(ctx context.Context, id string) (internal.Project, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Find")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	rec, ok := p.projects[id]
+	if !ok {
+		return internal.Project{}, internal.NewErrorf(internal.ErrorCodeNotFound, "project not found")
+	}
+
+	return rec.project, nil
+}
+
+// List returns all the projects sorted by insertion order.
+func (p *Project) List(ctx context.Context) ([]internal.Project, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.List")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	recs := make([]projectRecord, 0, len(p.projects))
+
+	for _, rec := range p.projects {
+		recs = append(recs, rec)
+	}
+
+	sort.Slice(recs, func(i, j int) bool { return recs[i].seq < recs[j].seq })
+
+	res := make([]internal.Project, len(recs))
+
+	for i, rec := range recs {
+		res[i] = rec.project
+	}
+
+	return res, nil
+}
+
+// Update updates the existing record with new values.
+func (p *Project) Update(ctx context.Context, id string, name string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Update")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	rec, ok := p.projects[id]
+	if !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "project not found")
+	}
+
+	rec.project.Name = name
+	p.projects[id] = rec
+
+	return nil
+}
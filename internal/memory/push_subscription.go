@@ -0,0 +1,116 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// PushSubscription represents the repository used for interacting with PushSubscription records and the reminders
+// already delivered to them, it's safe for concurrent use.
+type PushSubscription struct {
+	mu        sync.RWMutex
+	subs      map[string]internal.PushSubscription
+	reminders map[string]time.Time
+}
+
+// NewPushSubscription instantiates the PushSubscription repository.
+func NewPushSubscription() *PushSubscription {
+	return &PushSubscription{
+		subs:      make(map[string]internal.PushSubscription),
+		reminders: make(map[string]time.Time),
+	}
+}
+
+// ClaimReminder indicates whether the reminder of the Task due at due was not delivered yet, claiming it.
+func (p *PushSubscription) ClaimReminder(ctx context.Context, taskID string, due time.Time) (bool, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.ClaimReminder")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(taskID); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if claimed, ok := p.reminders[taskID]; ok && claimed.Equal(due) {
+		return false, nil
+	}
+
+	p.reminders[taskID] = due
+
+	return true, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (p *PushSubscription) Delete(ctx context.Context, id string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.Delete")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.subs[id]; !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "push subscription not found")
+	}
+
+	delete(p.subs, id)
+
+	return nil
+}
+
+// List returns all the records, sorted by id.
+func (p *PushSubscription) List(ctx context.Context) ([]internal.PushSubscription, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.List")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	res := make([]internal.PushSubscription, 0, len(p.subs))
+
+	for _, sub := range p.subs {
+		res = append(res, sub)
+	}
+
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	return res, nil
+}
+
+// Save inserts the record or replaces the existing one.
+func (p *PushSubscription) Save(ctx context.Context, sub internal.PushSubscription) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.Save")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(sub.ID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.subs[sub.ID] = sub
+
+	return nil
+}
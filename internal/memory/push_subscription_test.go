@@ -0,0 +1,16 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestPushSubscription_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.PushSubscriptionRepository(t, func(testing.TB) storetesting.PushSubscriptionStore {
+		return memory.NewPushSubscription()
+	})
+}
@@ -0,0 +1,555 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Task represents the repository used for interacting with Task records, it's safe for concurrent use.
+type Task struct {
+	mu    sync.RWMutex
+	tasks map[string]record
+	seq   int64
+}
+
+type record struct {
+	task internal.Task
+	seq  int64
+}
+
+// NewTask instantiates the Task repository.
+func NewTask() *Task {
+	return &Task{
+		tasks: make(map[string]record),
+	}
+}
+
+// Available indicates the repository is reachable, it always is.
+func (t *Task) Available() bool {
+	return true
+}
+
+// Create inserts a new task record.
+func (t *Task) Create(ctx context.Context, params internal.CreateParams) (internal.Task, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Create")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	id := params.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	now := time.Now().UTC()
+
+	task := internal.Task{
+		ID:          id,
+		Description: params.Description,
+		Priority:    params.Priority,
+		Dates:       params.Dates,
+		ProjectID:   params.ProjectID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.insert(task)
+
+	return task, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (t *Task) Delete(ctx context.Context, id string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Delete")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.tasks[id]; !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	delete(t.tasks, id)
+
+	return nil
+}
+
+// Find returns the requested task by searching its id.
+func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Find")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	rec, ok := t.tasks[id]
+	if !ok {
+		return internal.Task{}, internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return rec.task, nil
+}
+
+// Update updates the existing record with new values.
+//nolint: lll
+func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Update")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rec, ok := t.tasks[id]
+	if !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	rec.task = internal.Task{
+		ID:          id,
+		Description: description,
+		Priority:    priority,
+		Dates:       dates,
+		ProjectID:   projectID,
+		IsDone:      isDone,
+		CompletedAt: completedAt(rec.task, isDone),
+		CreatedAt:   rec.task.CreatedAt,
+		UpdatedAt:   time.Now().UTC(),
+	}
+
+	t.tasks[id] = rec
+
+	return nil
+}
+
+// Upsert inserts a new task record using the received id or replaces the existing one, it indicates whether the
+// record was inserted.
+//nolint: lll
+func (t *Task) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Upsert")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	now := time.Now().UTC()
+
+	task := internal.Task{
+		ID:          id,
+		Description: description,
+		Priority:    priority,
+		Dates:       dates,
+		ProjectID:   projectID,
+		IsDone:      isDone,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	rec, ok := t.tasks[id]
+	if !ok {
+		task.CompletedAt = completedAt(internal.Task{}, isDone)
+
+		t.insert(task)
+
+		return true, nil
+	}
+
+	task.CompletedAt = completedAt(rec.task, isDone)
+	task.CreatedAt = rec.task.CreatedAt
+
+	rec.task = task
+	t.tasks[id] = rec
+
+	return false, nil
+}
+
+// List returns the tasks sorted by insertion order, by urgency or by time, the keyset used for paginating the results is
+// returned as an opaque cursor.
+func (t *Task) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.List")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	tasks, total, next, err := t.page(params.Sort, params.Cursor, 0, params.Size, func(task internal.Task) bool {
+		return (params.Due == nil || params.Due.Match(task)) &&
+			(params.ProjectID == "" || task.ProjectID == params.ProjectID) &&
+			(params.Created == nil || params.Created.Match(task.CreatedAt)) &&
+			(params.Updated == nil || params.Updated.Match(task.UpdatedAt))
+	})
+	if err != nil {
+		return internal.ListResults{}, err
+	}
+
+	res := internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}
+
+	if params.Total {
+		res.Total = total
+	}
+
+	return res, nil
+}
+
+// Search returns the tasks matching all the received values, descriptions match when they include every term,
+// ignoring case. Results are sorted like List does and paginated using either From or Cursor.
+func (t *Task) Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Search")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	var terms []string
+	if args.Description != nil {
+		terms = strings.Fields(strings.ToLower(*args.Description))
+	}
+
+	// matchWord indicates whether the word matches the term, either by including it or within the allowed edits.
+	matchWord := func(term, word string) bool {
+		return strings.Contains(word, term) ||
+			(args.Fuzziness.Fuzzy() && editDistance(term, word) <= args.Fuzziness.Distance(term))
+	}
+
+	match := func(task internal.Task) bool {
+		if args.Priority != nil && task.Priority != *args.Priority {
+			return false
+		}
+
+		if args.IsDone != nil && task.IsDone != *args.IsDone {
+			return false
+		}
+
+		if args.Due != nil && !args.Due.Match(task) {
+			return false
+		}
+
+		if args.ProjectID != nil && task.ProjectID != *args.ProjectID {
+			return false
+		}
+
+		desc := strings.ToLower(task.Description)
+		words := strings.FieldsFunc(desc, isSeparator)
+
+	terms:
+		for _, term := range terms {
+			if strings.Contains(desc, term) {
+				continue
+			}
+
+			if args.Fuzziness.Fuzzy() {
+				for _, word := range words {
+					if matchWord(term, word) {
+						continue terms
+					}
+				}
+			}
+
+			return false
+		}
+
+		return true
+	}
+
+	from := args.From
+	if args.Cursor != "" {
+		from = 0
+	}
+
+	tasks, total, next, err := t.page(args.Sort, args.Cursor, from, args.Size, match)
+	if err != nil {
+		return internal.SearchResults{}, err
+	}
+
+	res := internal.SearchResults{
+		Tasks:      tasks,
+		Total:      total,
+		NextCursor: next,
+	}
+
+	if args.Facets {
+		res.Facets = t.facets(match)
+	}
+
+	if args.Highlight != nil && len(terms) > 0 {
+		res.Highlights = make(map[string][]string, len(tasks))
+
+		for _, task := range tasks {
+			res.Highlights[task.ID] = internal.HighlightFragments(task.Description, args.Highlight.Size(),
+				func(word string) bool {
+					for _, term := range terms {
+						if matchWord(term, word) {
+							return true
+						}
+					}
+
+					return false
+				})
+		}
+	}
+
+	return res, nil
+}
+
+// facets counts the tasks matching by priority and status.
+func (t *Task) facets(match func(internal.Task) bool) *internal.Facets {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	res := internal.NewFacets()
+
+	for _, rec := range t.tasks {
+		if match(rec.task) {
+			res.Add(rec.task.Priority, rec.task.IsDone, 1)
+		}
+	}
+
+	return res
+}
+
+// Suggest returns the tasks whose descriptions include every complete word typed so far and a word starting with
+// the last one, ignoring case. Suggestions are sorted by creation time.
+func (t *Task) Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Suggest")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	terms := params.Terms()
+	if len(terms) == 0 {
+		return []internal.Suggestion{}, nil
+	}
+
+	complete, last := terms[:len(terms)-1], terms[len(terms)-1]
+
+	match := func(task internal.Task) bool {
+		words := make(map[string]struct{})
+
+		for _, word := range strings.FieldsFunc(strings.ToLower(task.Description), isSeparator) {
+			words[word] = struct{}{}
+		}
+
+		for _, term := range complete {
+			if _, ok := words[term]; !ok {
+				return false
+			}
+		}
+
+		for word := range words {
+			if strings.HasPrefix(word, last) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	tasks, _, _, err := t.page(internal.SortDefault, "", 0, params.Size, match)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]internal.Suggestion, len(tasks))
+
+	for i, task := range tasks {
+		res[i] = internal.Suggestion{
+			ID:          task.ID,
+			Description: task.Description,
+		}
+	}
+
+	return res, nil
+}
+
+// insert adds a new record, t.mu must be locked.
+func (t *Task) insert(task internal.Task) {
+	t.seq++
+
+	t.tasks[task.ID] = record{
+		task: task,
+		seq:  t.seq,
+	}
+}
+
+// page returns the sorted tasks matching the filter after the cursor, skipping the first from ones, as well as
+// the total of matching tasks and the cursor of the next page, if any.
+func (t *Task) page(s internal.Sort, val string, from, size int64,
+	match func(internal.Task) bool) ([]internal.Task, int64, string, error) {
+	if s != internal.SortUrgency && !s.ByTime() {
+		s = internal.SortDefault
+	}
+
+	after, err := decodeCursor(s, val)
+	if err != nil {
+		return nil, 0, "", internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	type key struct {
+		task internal.Task
+		cur  cursor
+	}
+
+	t.mu.RLock()
+
+	keys := make([]key, 0, len(t.tasks))
+
+	for _, rec := range t.tasks {
+		if !match(rec.task) {
+			continue
+		}
+
+		keys = append(keys, key{
+			task: rec.task,
+			cur: cursor{
+				Sort:    s,
+				Done:    rec.task.IsDone,
+				Urgency: newUrgency(rec.task.Priority, rec.task.Dates.Due),
+				At:      sortedAt(s, rec.task),
+				Seq:     rec.seq,
+			},
+		})
+	}
+
+	t.mu.RUnlock()
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].cur.less(keys[j].cur)
+	})
+
+	total := int64(len(keys))
+
+	// Keys sorting after the cursor.
+	start := sort.Search(len(keys), func(i int) bool {
+		return after.less(keys[i].cur)
+	})
+
+	keys = keys[start:]
+
+	if from > int64(len(keys)) {
+		from = int64(len(keys))
+	}
+
+	keys = keys[from:]
+
+	tasks := []internal.Task{}
+
+	for i, k := range keys {
+		if size > 0 && int64(i) == size {
+			return tasks, total, keys[i-1].cur.String(), nil
+		}
+
+		tasks = append(tasks, k.task)
+	}
+
+	return tasks, total, "", nil
+}
+
+// sortedAt returns the time sorting the task, when sorting by time.
+func sortedAt(s internal.Sort, task internal.Task) int64 {
+	if s.Field() == string(internal.SortUpdated) {
+		return task.UpdatedAt.UnixNano()
+	}
+
+	return task.CreatedAt.UnixNano()
+}
+
+// isSeparator indicates whether the rune separates words.
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// editDistance returns the minimum number of single-character edits needed for changing a into b, swapping two
+// adjacent characters counts as a single edit like Elasticsearch does.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Rows of the distances matrix: the two previous ones and the current one.
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = prev[j-1] + cost
+
+			if val := prev[j] + 1; val < curr[j] {
+				curr[j] = val
+			}
+
+			if val := curr[j-1] + 1; val < curr[j] {
+				curr[j] = val
+			}
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				if val := prev2[j-2] + 1; val < curr[j] {
+					curr[j] = val
+				}
+			}
+		}
+
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
+
+// completedAt returns the completion time of the task once done: the current time when it was not done before.
+func completedAt(prev internal.Task, done bool) time.Time {
+	switch {
+	case !done:
+		return time.Time{}
+	case prev.IsDone:
+		return prev.CompletedAt
+	}
+
+	return time.Now().UTC()
+}
@@ -0,0 +1,137 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskDependency represents the repository used for interacting with the dependencies between Task records, it's
+// safe for concurrent use.
+type TaskDependency struct {
+	mu       sync.RWMutex
+	blockers map[string]map[string]struct{}
+	blocked  map[string]map[string]struct{}
+}
+
+// NewTaskDependency instantiates the TaskDependency repository.
+func NewTaskDependency() *TaskDependency {
+	return &TaskDependency{
+		blockers: make(map[string]map[string]struct{}),
+		blocked:  make(map[string]map[string]struct{}),
+	}
+}
+
+// Blocked returns the ids of the tasks blocked by the task matching the id, sorted by id.
+func (t *TaskDependency) Blocked(ctx context.Context, id string) ([]string, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Blocked")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return sortedIDs(t.blocked[id]), nil
+}
+
+// Blockers returns the ids of the tasks blocking the task matching the id, sorted by id.
+func (t *TaskDependency) Blockers(ctx context.Context, id string) ([]string, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Blockers")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return sortedIDs(t.blockers[id]), nil
+}
+
+// Create inserts the dependency, existing ones are kept as they are.
+func (t *TaskDependency) Create(ctx context.Context, dep internal.TaskDependency) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Create")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if err := validateDependencyIDs(dep); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.blockers[dep.BlockedID]; !ok {
+		t.blockers[dep.BlockedID] = make(map[string]struct{})
+	}
+
+	if _, ok := t.blocked[dep.BlockerID]; !ok {
+		t.blocked[dep.BlockerID] = make(map[string]struct{})
+	}
+
+	t.blockers[dep.BlockedID][dep.BlockerID] = struct{}{}
+	t.blocked[dep.BlockerID][dep.BlockedID] = struct{}{}
+
+	return nil
+}
+
+// Delete deletes the existing dependency.
+func (t *TaskDependency) Delete(ctx context.Context, dep internal.TaskDependency) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Delete")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if err := validateDependencyIDs(dep); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.blockers[dep.BlockedID][dep.BlockerID]; !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "dependency not found")
+	}
+
+	delete(t.blockers[dep.BlockedID], dep.BlockerID)
+	delete(t.blocked[dep.BlockerID], dep.BlockedID)
+
+	return nil
+}
+
+func validateDependencyIDs(dep internal.TaskDependency) error {
+	for _, id := range []string{dep.BlockerID, dep.BlockedID} {
+		if _, err := uuid.Parse(id); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+		}
+	}
+
+	return nil
+}
+
+func sortedIDs(ids map[string]struct{}) []string {
+	res := make([]string, 0, len(ids))
+
+	for id := range ids {
+		res = append(res, id)
+	}
+
+	sort.Strings(res)
+
+	return res
+}
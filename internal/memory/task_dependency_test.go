@@ -0,0 +1,17 @@
+package memory_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTaskDependency_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskDependencyRepository(t, func(testing.TB) service.TaskDependencyRepository {
+		return memory.NewTaskDependency()
+	})
+}
@@ -0,0 +1,283 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTask_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskRepository(t, func(testing.TB) service.TaskRepository {
+		return memory.NewTask()
+	})
+}
+
+func TestTask_SearchConformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskSearchRepository(t, func(testing.TB) (service.TaskRepository, service.TaskSearchRepository) {
+		store := memory.NewTask()
+
+		return store, store
+	})
+}
+
+func TestTask_Search(t *testing.T) {
+	t.Parallel()
+
+	store := memory.NewTask()
+
+	var tasks []internal.Task
+
+	for _, params := range []internal.CreateParams{
+		{Description: "Buy milk", Priority: internal.PriorityLow},
+		{Description: "buy bread and MILK", Priority: internal.PriorityHigh},
+		{Description: "Walk the dog", Priority: internal.PriorityHigh},
+	} {
+		task, err := store.Create(context.Background(), params)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	tasks[2].IsDone = true
+
+	if err := store.Update(context.Background(), tasks[2].ID, tasks[2].Description, tasks[2].Priority,
+		tasks[2].Dates, "", tasks[2].IsDone); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	description := func(s string) *string { return &s }
+	priority := func(p internal.Priority) *internal.Priority { return &p }
+	isDone := func(b bool) *bool { return &b }
+
+	tests := []struct {
+		name   string
+		input  internal.SearchParams
+		output []internal.Task
+		total  int64
+	}{
+		{
+			"OK: description",
+			internal.SearchParams{Description: description("milk BUY"), Size: 10},
+			[]internal.Task{tasks[0], tasks[1]},
+			2,
+		},
+		{
+			"OK: priority",
+			internal.SearchParams{Priority: priority(internal.PriorityHigh), Size: 10},
+			[]internal.Task{tasks[1], tasks[2]},
+			2,
+		},
+		{
+			"OK: priority, updated descending",
+			internal.SearchParams{Priority: priority(internal.PriorityHigh), Sort: internal.SortUpdatedDesc, Size: 10},
+			[]internal.Task{tasks[2], tasks[1]},
+			2,
+		},
+		{
+			"OK: all values",
+			internal.SearchParams{
+				Description: description("milk"),
+				Priority:    priority(internal.PriorityHigh),
+				IsDone:      isDone(false),
+				Size:        10,
+			},
+			[]internal.Task{tasks[1]},
+			1,
+		},
+		{
+			"OK: from",
+			internal.SearchParams{IsDone: isDone(false), From: 1, Size: 10},
+			[]internal.Task{tasks[1]},
+			2,
+		},
+		{
+			"OK: no results",
+			internal.SearchParams{Description: description("cat"), Size: 10},
+			[]internal.Task{},
+			0,
+		},
+		{
+			"OK: fuzziness",
+			internal.SearchParams{Description: description("mlik"), Size: 10, Fuzziness: internal.FuzzinessAuto},
+			[]internal.Task{tasks[0], tasks[1]},
+			2,
+		},
+		{
+			"OK: no fuzziness",
+			internal.SearchParams{Description: description("mlik"), Size: 10},
+			[]internal.Task{},
+			0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := store.Search(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			ignoreChanged := cmpopts.IgnoreFields(internal.Task{}, "CompletedAt", "UpdatedAt")
+
+			if !cmp.Equal(tt.output, actual.Tasks, ignoreChanged) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output, actual.Tasks, ignoreChanged))
+			}
+
+			if tt.total != actual.Total {
+				t.Fatalf("expected total %d, got %d", tt.total, actual.Total)
+			}
+		})
+	}
+
+	t.Run("OK: highlight", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := store.Search(context.Background(), internal.SearchParams{
+			Description: description("mlik"),
+			Size:        10,
+			Fuzziness:   internal.FuzzinessAuto,
+			Highlight:   &internal.Highlight{},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := map[string][]string{
+			tasks[0].ID: {"Buy <em>milk</em>"},
+			tasks[1].ID: {"buy bread and <em>MILK</em>"},
+		}
+
+		if !cmp.Equal(expected, res.Highlights) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Highlights))
+		}
+	})
+
+	t.Run("OK: facets", func(t *testing.T) {
+		t.Parallel()
+
+		// Facets count every matching task, not only the ones in the page.
+		res, err := store.Search(context.Background(), internal.SearchParams{
+			Description: description("buy"),
+			Size:        1,
+			Facets:      true,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := internal.NewFacets()
+		expected.Add(internal.PriorityLow, false, 1)
+		expected.Add(internal.PriorityHigh, false, 1)
+
+		if !cmp.Equal(expected, res.Facets) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Facets))
+		}
+	})
+
+	t.Run("OK: cursor", func(t *testing.T) {
+		t.Parallel()
+
+		args := internal.SearchParams{Description: description("milk"), Size: 1}
+
+		var actual []internal.Task
+
+		for {
+			res, err := store.Search(context.Background(), args)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual = append(actual, res.Tasks...)
+
+			if args.Cursor = res.NextCursor; args.Cursor == "" {
+				break
+			}
+		}
+
+		if expected := []internal.Task{tasks[0], tasks[1]}; !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+}
+
+func TestTask_Suggest(t *testing.T) {
+	t.Parallel()
+
+	store := memory.NewTask()
+
+	var expected []internal.Suggestion
+
+	for _, params := range []internal.CreateParams{
+		{Description: "Buy milk", Priority: internal.PriorityLow},
+		{Description: "buy bread and MILK", Priority: internal.PriorityHigh},
+		{Description: "Walk the dog", Priority: internal.PriorityHigh},
+	} {
+		task, err := store.Create(context.Background(), params)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected = append(expected, internal.Suggestion{ID: task.ID, Description: task.Description})
+	}
+
+	tests := []struct {
+		name   string
+		input  internal.SuggestParams
+		output []internal.Suggestion
+	}{
+		{
+			"OK: incomplete word",
+			internal.SuggestParams{Prefix: "MI", Size: 10},
+			[]internal.Suggestion{expected[0], expected[1]},
+		},
+		{
+			"OK: complete words",
+			internal.SuggestParams{Prefix: "buy br", Size: 10},
+			[]internal.Suggestion{expected[1]},
+		},
+		{
+			"OK: size",
+			internal.SuggestParams{Prefix: "buy", Size: 1},
+			[]internal.Suggestion{expected[0]},
+		},
+		{
+			"OK: no results",
+			internal.SuggestParams{Prefix: "ilk", Size: 10},
+			[]internal.Suggestion{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := store.Suggest(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(tt.output, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output, actual))
+			}
+		})
+	}
+}
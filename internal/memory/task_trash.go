@@ -0,0 +1,155 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskTrash represents the repository used for interacting with the trashed Task records, it's safe for
+// concurrent use.
+type TaskTrash struct {
+	mu    sync.RWMutex
+	tasks map[string]internal.TrashedTask
+}
+
+// NewTaskTrash instantiates the TaskTrash repository.
+func NewTaskTrash() *TaskTrash {
+	return &TaskTrash{
+		tasks: make(map[string]internal.TrashedTask),
+	}
+}
+
+// Delete deletes the existing record matching the id.
+func (t *TaskTrash) Delete(ctx context.Context, id string) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Delete")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if _, ok := t.tasks[id]; !ok {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	delete(t.tasks, id)
+
+	return nil
+}
+
+// Find returns the requested trashed task by searching its id.
+func (t *TaskTrash) Find(ctx context.Context, id string) (internal.TrashedTask, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Find")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.TrashedTask{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	task, ok := t.tasks[id]
+	if !ok {
+		return internal.TrashedTask{}, internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return task, nil
+}
+
+// List returns a page of trashed tasks, the most recently deleted first.
+func (t *TaskTrash) List(ctx context.Context, params internal.TrashParams) (internal.TrashResults, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.List")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	after, err := internal.DecodeTrashCursor(params.Cursor)
+	if err != nil {
+		return internal.TrashResults{}, err
+	}
+
+	t.mu.RLock()
+
+	tasks := make([]internal.TrashedTask, 0, len(t.tasks))
+
+	for _, task := range t.tasks {
+		if after.After(task) {
+			tasks = append(tasks, task)
+		}
+	}
+
+	t.mu.RUnlock()
+
+	sort.Slice(tasks, func(i, j int) bool {
+		return internal.NewTrashCursor(tasks[i]).After(tasks[j])
+	})
+
+	var res internal.TrashResults
+
+	if int64(len(tasks)) > params.Size {
+		tasks = tasks[:params.Size]
+		res.NextCursor = internal.NewTrashCursor(tasks[len(tasks)-1]).String()
+	}
+
+	res.Tasks = tasks
+
+	return res, nil
+}
+
+// Purge deletes the tasks trashed before deletedBefore, it returns the number of deleted tasks.
+func (t *TaskTrash) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Purge")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var n int64
+
+	for id, task := range t.tasks {
+		if task.DeletedAt.Before(deletedBefore) {
+			delete(t.tasks, id)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// Save inserts the trashed task or replaces the existing one.
+func (t *TaskTrash) Save(ctx context.Context, task internal.TrashedTask) error {
+	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Save")
+	span.SetAttributes(attribute.String("db.system", "memory"))
+
+	defer span.End()
+
+	if _, err := uuid.Parse(task.Task.ID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	task.Task.Version = 0
+	t.tasks[task.Task.ID] = task
+
+	return nil
+}
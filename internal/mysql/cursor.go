@@ -0,0 +1,67 @@
+package mysql
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// cursor defines the keyset used for paginating records, records are sorted by creation time and then by id or,
+// when sorting by urgency, by completion, then by urgency and then by id. At is the creation time, the urgency or,
+// when sorting by time, the requested time.
+type cursor struct {
+	Sort internal.Sort
+	Done bool
+	At   time.Time
+	ID   string
+}
+
+func decodeCursor(sort internal.Sort, val string) (cursor, error) {
+	if val == "" {
+		// The latest and earliest values supported by DATETIME.
+		if sort.Descending() {
+			return cursor{Sort: sort, At: time.Date(9999, 12, 31, 23, 59, 59, 999999000, time.UTC)}, nil
+		}
+
+		return cursor{Sort: sort, At: time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 4)
+	if len(split) != 4 || split[0] != string(sort) {
+		return cursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	done, err := strconv.ParseBool(split[1])
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, split[2])
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return cursor{
+		Sort: sort,
+		Done: done,
+		At:   at,
+		ID:   split[3],
+	}, nil
+}
+
+func (c cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		string(c.Sort),
+		strconv.FormatBool(c.Done),
+		c.At.Format(time.RFC3339Nano),
+		c.ID,
+	}, "|")))
+}
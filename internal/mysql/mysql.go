@@ -0,0 +1,126 @@
+// Package mysql implements the repositories using MySQL or MariaDB, the schema is defined in db/mysql/migrations.
+package mysql
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func convertPriority(priority string) (internal.Priority, error) {
+	switch priority {
+	case "none":
+		return internal.PriorityNone, nil
+	case "low":
+		return internal.PriorityLow, nil
+	case "medium":
+		return internal.PriorityMedium, nil
+	case "high":
+		return internal.PriorityHigh, nil
+	}
+
+	return internal.Priority(-1), internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown value: %s", priority)
+}
+
+func newPriority(p internal.Priority) string {
+	switch p {
+	case internal.PriorityNone:
+		return "none"
+	case internal.PriorityLow:
+		return "low"
+	case internal.PriorityMedium:
+		return "medium"
+	case internal.PriorityHigh:
+		return "high"
+	}
+
+	// XXX: because we are using an enum type, mysql will fail with the following value in strict mode.
+
+	return "invalid"
+}
+
+func newNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{
+		Time:  t.UTC(),
+		Valid: !t.IsZero(),
+	}
+}
+
+func newCompletedAt(done bool, now time.Time) sql.NullTime {
+	if !done {
+		return sql.NullTime{}
+	}
+
+	return newNullTime(now)
+}
+
+// completedAt returns the completion time of the task, which is only kept while the task is done.
+func completedAt(done bool, t sql.NullTime) time.Time {
+	if !done || !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time
+}
+
+// wrapErrorf returns a wrapped error caused by MySQL, see internal.WrapDependencyErrorf.
+func wrapErrorf(orig error, code internal.ErrorCode, format string, a ...interface{}) error {
+	return internal.WrapDependencyErrorf(orig, internal.DependencyMySQL, code, format, a...)
+}
+
+// dueCondition selects the tasks matching the arguments returned by newDueArgs.
+const dueCondition = `(NOT ? OR (NOT done AND due_date >= ? AND due_date < ?))`
+
+// newDueArgs returns the arguments of dueCondition, unbounded ends use dates no task is due at.
+func newDueArgs(r *internal.DueRange) []interface{} {
+	if r == nil {
+		return []interface{}{false, time.Time{}, time.Time{}}
+	}
+
+	from := time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !r.From.IsZero() {
+		from = r.From.UTC()
+	}
+
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if !r.To.IsZero() {
+		to = r.To.UTC()
+	}
+
+	return []interface{}{true, from, to}
+}
+
+// createdCondition and updatedCondition select the tasks matching the arguments returned by newTimeRangeArgs.
+const (
+	createdCondition = `(NOT ? OR (created_at >= ? AND created_at < ?))`
+	updatedCondition = `(NOT ? OR (updated_at >= ? AND updated_at < ?))`
+)
+
+// newTimeRangeArgs returns the arguments of createdCondition and updatedCondition, unbounded ends use the extreme
+// times supported by DATETIME.
+func newTimeRangeArgs(r *internal.TimeRange) []interface{} {
+	if r == nil {
+		return []interface{}{false, time.Time{}, time.Time{}}
+	}
+
+	from := time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !r.From.IsZero() {
+		from = r.From.UTC()
+	}
+
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if !r.To.IsZero() {
+		to = r.To.UTC()
+	}
+
+	return []interface{}{true, from, to}
+}
+
+// projectCondition selects the tasks matching the arguments returned by newProjectArgs.
+const projectCondition = `(? = '' OR project_id = ?)`
+
+// newProjectArgs returns the arguments of projectCondition, empty ids select all the tasks.
+func newProjectArgs(id string) []interface{} {
+	return []interface{}{id, id}
+}
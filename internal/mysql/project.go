@@ -0,0 +1,184 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Project represents the repository used for interacting with Project records.
+type Project struct {
+	db *sql.DB
+}
+
+// NewProject instantiates the Project repository.
+func NewProject(db *sql.DB) *Project {
+	return &Project{
+		db: db,
+	}
+}
+
+// Create inserts a new project record.
+func (p *Project) Create(ctx context.Context, name string) (internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Create")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	id := uuid.NewString()
+
+	if _, err := p.db.ExecContext(ctx,
+		`INSERT INTO projects (id, name, created_at) VALUES (?, ?, ?)`,
+		id,
+		name,
+		time.Now().UTC(),
+	); err != nil {
+		return internal.Project{}, wrapErrorf(err, internal.ErrorCodeUnknown, "insert project")
+	}
+
+	return internal.Project{
+		ID:   id,
+		Name: name,
+	}, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (p *Project) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Delete")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := p.db.ExecContext(ctx, `DELETE FROM projects WHERE id = ?`, id)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete project")
+	}
+
+	return projectNotFound(res)
+}
+
+// Find returns the requested project by searching its id.
+func (p *Project) Find(ctx context.Context, id string) (internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Find")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	var project internal.Project
+
+	if err := p.db.QueryRowContext(ctx, `SELECT id, name FROM projects WHERE id = ?`, id).
+		Scan(&project.ID, &project.Name); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "project not found")
+		}
+
+		return internal.Project{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select project")
+	}
+
+	return project, nil
+}
+
+// List returns all the projects sorted by creation time.
+func (p *Project) List(ctx context.Context) ([]internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.List")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	rows, err := p.db.QueryContext(ctx, `SELECT id, name FROM projects ORDER BY created_at, id`)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select projects")
+	}
+
+	defer rows.Close()
+
+	res := []internal.Project{}
+
+	for rows.Next() {
+		var project internal.Project
+
+		if err := rows.Scan(&project.ID, &project.Name); err != nil {
+			return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Scan")
+		}
+
+		res = append(res, project)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Err")
+	}
+
+	return res, nil
+}
+
+// Update updates the existing record with new values.
+func (p *Project) Update(ctx context.Context, id string, name string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Update")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := p.db.ExecContext(ctx, `UPDATE projects SET name = ? WHERE id = ?`, name, id)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "update project")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n > 0 {
+		return nil
+	}
+
+	// Rows updated using their current values are not affected, those must be told apart from missing ones.
+	var exists bool
+
+	if err := p.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM projects WHERE id = ?)`, id).Scan(&exists); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "select project")
+	}
+
+	if !exists {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "project not found")
+	}
+
+	return nil
+}
+
+func projectNotFound(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n == 0 {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "project not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,394 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Task represents the repository used for interacting with Task records.
+type Task struct {
+	db *sql.DB
+}
+
+// NewTask instantiates the Task repository, db must use the default "clientFoundRows=false" because the affected
+// rows are used for determining whether records were inserted.
+func NewTask(db *sql.DB) *Task {
+	return &Task{
+		db: db,
+	}
+}
+
+// Create inserts a new task record.
+func (t *Task) Create(ctx context.Context, params internal.CreateParams) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Create")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	id := params.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	// Times are truncated to the precision of DATETIME(6).
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	if _, err := t.db.ExecContext(ctx,
+		`INSERT INTO tasks (id, description, priority, start_date, due_date, time_zone, project_id, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		id,
+		params.Description,
+		newPriority(params.Priority),
+		newNullTime(params.Dates.Start),
+		newNullTime(params.Dates.Due),
+		params.Dates.TimeZone,
+		params.ProjectID,
+		now,
+		now,
+	); err != nil {
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "insert task")
+	}
+
+	return internal.Task{
+		ID:          id,
+		Description: params.Description,
+		Priority:    params.Priority,
+		Dates:       params.Dates,
+		ProjectID:   params.ProjectID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (t *Task) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Delete")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.db.ExecContext(ctx, `DELETE FROM tasks WHERE id = ?`, id)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete task")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n == 0 {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return nil
+}
+
+// Find returns the requested task by searching its id.
+func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Find")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	row := t.db.QueryRowContext(ctx,
+		`SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+		updated_at FROM tasks WHERE id = ?`, id)
+
+	var (
+		completed            sql.NullTime
+		createdAt, updatedAt time.Time
+	)
+
+	task, err := scanTask(row, &completed, &createdAt, &updatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task")
+	}
+
+	task.CompletedAt = completedAt(task.IsDone, completed)
+	task.CreatedAt, task.UpdatedAt = createdAt, updatedAt
+
+	return task, nil
+}
+
+// Update updates the existing record with new values.
+// nolint: lll
+func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Update")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	now := time.Now().UTC()
+
+	// Assignments are evaluated from left to right, completed_at must be set before done is.
+	res, err := t.db.ExecContext(ctx,
+		`UPDATE tasks SET description = ?, priority = ?, start_date = ?, due_date = ?, time_zone = ?, project_id = ?,
+		completed_at = CASE WHEN NOT ? THEN NULL WHEN done THEN completed_at ELSE ? END, done = ?, updated_at = ?
+		WHERE id = ?`,
+		description,
+		newPriority(priority),
+		newNullTime(dates.Start),
+		newNullTime(dates.Due),
+		dates.TimeZone,
+		projectID,
+		isDone,
+		now,
+		isDone,
+		now,
+		id,
+	)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "update task")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n > 0 {
+		return nil
+	}
+
+	// Rows updated using their current values are not affected, those must be told apart from missing ones.
+	var exists bool
+
+	if err := t.db.QueryRowContext(ctx, `SELECT EXISTS(SELECT 1 FROM tasks WHERE id = ?)`, id).Scan(&exists); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "select task")
+	}
+
+	if !exists {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return nil
+}
+
+// Upsert inserts a new task record using the received id or replaces the existing one, it indicates whether the
+// record was inserted.
+// nolint: lll
+func (t *Task) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Upsert")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	now := time.Now().UTC()
+
+	// VALUES() is used, instead of row aliases, because MariaDB does not support those; assignments are evaluated
+	// from left to right, completed_at must be set before done is.
+	res, err := t.db.ExecContext(ctx,
+		`INSERT INTO tasks (id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at,
+		created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			description  = VALUES(description),
+			priority     = VALUES(priority),
+			start_date   = VALUES(start_date),
+			due_date     = VALUES(due_date),
+			time_zone    = VALUES(time_zone),
+			project_id   = VALUES(project_id),
+			completed_at = CASE WHEN NOT VALUES(done) THEN NULL WHEN done THEN completed_at ELSE VALUES(completed_at) END,
+			done         = VALUES(done),
+			updated_at   = VALUES(updated_at)`,
+		id,
+		description,
+		newPriority(priority),
+		newNullTime(dates.Start),
+		newNullTime(dates.Due),
+		dates.TimeZone,
+		projectID,
+		isDone,
+		newCompletedAt(isDone, now),
+		now,
+		now,
+	)
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "upsert task")
+	}
+
+	// One affected row means inserted and two means updated.
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	return n == 1, nil
+}
+
+// List returns the tasks sorted by creation time, by urgency or by the requested time, the keyset used for
+// paginating the results is returned as an opaque cursor.
+func (t *Task) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.List")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	sort := params.Sort
+	if sort != internal.SortUrgency && !sort.ByTime() {
+		sort = internal.SortDefault
+	}
+
+	after, err := decodeCursor(sort, params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	filter := append(newDueArgs(params.Due), newProjectArgs(params.ProjectID)...)
+	filter = append(filter, newTimeRangeArgs(params.Created)...)
+	filter = append(filter, newTimeRangeArgs(params.Updated)...)
+
+	conditions := dueCondition + ` AND ` + projectCondition + ` AND ` + createdCondition + ` AND ` + updatedCondition
+
+	query := `SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+		updated_at, created_at
+		FROM tasks WHERE (created_at, id) > (?, ?) AND ` + conditions + ` ORDER BY created_at, id LIMIT ?`
+	args := append([]interface{}{after.At, after.ID}, filter...)
+
+	if sort == internal.SortUrgency {
+		query = `SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+			updated_at, urgency_at
+			FROM tasks WHERE (done, urgency_at, id) > (?, ?, ?) AND ` + conditions + ` ORDER BY done, urgency_at, id LIMIT ?`
+		args = append([]interface{}{after.Done, after.At, after.ID}, filter...)
+	}
+
+	if sort.ByTime() {
+		// The field is one of the known columns, it's safe to use it in the query.
+		field, op, dir := sort.Field(), ">", ""
+		if sort.Descending() {
+			op, dir = "<", " DESC"
+		}
+
+		query = `SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+			updated_at, ` + field + `
+			FROM tasks WHERE (` + field + `, id) ` + op + ` (?, ?) AND ` + conditions + ` ORDER BY ` + field + dir + `, id` + dir + `
+			LIMIT ?`
+		args = append([]interface{}{after.At, after.ID}, filter...)
+	}
+
+	// Counted before selecting the page because rows hold their connection until closed.
+	var total int64
+
+	if params.Total {
+		if err := t.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE "+conditions,
+			filter...).Scan(&total); err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "count tasks")
+		}
+	}
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.db.QueryContext(ctx, query, append(args, params.Size+1)...)
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks")
+	}
+
+	defer rows.Close()
+
+	var (
+		tasks = []internal.Task{}
+		last  cursor
+		next  string
+	)
+
+	for rows.Next() {
+		if int64(len(tasks)) == params.Size {
+			next = last.String()
+
+			break
+		}
+
+		var (
+			completed                sql.NullTime
+			createdAt, updatedAt, at time.Time
+		)
+
+		task, err := scanTask(rows, &completed, &createdAt, &updatedAt, &at)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "scanTask")
+		}
+
+		task.CompletedAt = completedAt(task.IsDone, completed)
+		task.CreatedAt, task.UpdatedAt = createdAt, updatedAt
+
+		last = cursor{Sort: sort, Done: task.IsDone, At: at, ID: task.ID}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Err")
+	}
+
+	return internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+		Total:      total,
+	}, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTask scans the task columns followed by the extra ones.
+func scanTask(row scanner, extra ...interface{}) (internal.Task, error) {
+	var (
+		task       internal.Task
+		priority   string
+		start, due sql.NullTime
+	)
+
+	dest := append([]interface{}{
+		&task.ID, &task.Description, &priority, &start, &due, &task.Dates.TimeZone, &task.ProjectID, &task.IsDone,
+	}, extra...)
+
+	if err := row.Scan(dest...); err != nil {
+		return internal.Task{}, err //nolint: wrapcheck
+	}
+
+	p, err := convertPriority(priority)
+	if err != nil {
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "convertPriority")
+	}
+
+	task.Priority = p
+	task.Dates.Start = start.Time
+	task.Dates.Due = due.Time
+
+	return task, nil
+}
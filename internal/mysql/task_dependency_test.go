@@ -0,0 +1,17 @@
+package mysql_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/mysql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTaskDependency_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskDependencyRepository(t, func(tb testing.TB) service.TaskDependencyRepository {
+		return mysql.NewTaskDependency(newDB(tb))
+	})
+}
@@ -0,0 +1,115 @@
+package mysql_test
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	driver "github.com/go-sql-driver/mysql"
+	migrate "github.com/golang-migrate/migrate/v4"
+	migratemysql "github.com/golang-migrate/migrate/v4/database/mysql"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+
+	"github.com/MarioCarrion/todo-api/internal/mysql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTask_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskRepository(t, func(tb testing.TB) service.TaskRepository {
+		return mysql.NewTask(newDB(tb))
+	})
+}
+
+func newDB(tb testing.TB) *sql.DB {
+	tb.Helper()
+
+	cfg := driver.NewConfig()
+	cfg.Net = "tcp"
+	cfg.User = "username"
+	cfg.Passwd = "password"
+	cfg.DBName = "todo"
+	cfg.ParseTime = true
+	cfg.MultiStatements = true
+	cfg.Params = map[string]string{"time_zone": "'+00:00'"}
+
+	//-
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		tb.Fatalf("Couldn't connect to docker: %s", err)
+	}
+
+	pool.MaxWait = 30 * time.Second
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "mysql",
+		Tag:        "8.0",
+		Env: []string{
+			"MYSQL_RANDOM_ROOT_PASSWORD=yes",
+			fmt.Sprintf("MYSQL_USER=%s", cfg.User),
+			fmt.Sprintf("MYSQL_PASSWORD=%s", cfg.Passwd),
+			fmt.Sprintf("MYSQL_DATABASE=%s", cfg.DBName),
+		},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{
+			Name: "no",
+		}
+	})
+	if err != nil {
+		tb.Fatalf("Couldn't start resource: %s", err)
+	}
+
+	_ = resource.Expire(120)
+
+	tb.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			tb.Fatalf("Couldn't purge container: %v", err)
+		}
+	})
+
+	cfg.Addr = fmt.Sprintf("%s:3306", resource.Container.NetworkSettings.IPAddress)
+	if runtime.GOOS == "darwin" { // MacOS-specific
+		cfg.Addr = net.JoinHostPort(resource.GetBoundIP("3306/tcp"), resource.GetPort("3306/tcp"))
+	}
+
+	db, err := sql.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		tb.Fatalf("Couldn't open DB: %s", err)
+	}
+
+	tb.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	if err := pool.Retry(db.Ping); err != nil {
+		tb.Fatalf("Couldn't ping DB: %s", err)
+	}
+
+	//-
+
+	instance, err := migratemysql.WithInstance(db, &migratemysql.Config{})
+	if err != nil {
+		tb.Fatalf("Couldn't migrate (1): %s", err)
+	}
+
+	m, err := migrate.NewWithDatabaseInstance("file://../../db/mysql/migrations/", "mysql", instance)
+	if err != nil {
+		tb.Fatalf("Couldn't migrate (2): %s", err)
+	}
+
+	if err = m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		tb.Fatalf("Couldnt' migrate (3): %s", err)
+	}
+
+	return db
+}
@@ -0,0 +1,206 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskTrash represents the repository used for interacting with the trashed Task records.
+type TaskTrash struct {
+	db *sql.DB
+}
+
+// NewTaskTrash instantiates the TaskTrash repository.
+func NewTaskTrash(db *sql.DB) *TaskTrash {
+	return &TaskTrash{
+		db: db,
+	}
+}
+
+// Delete deletes the existing record matching the id.
+func (t *TaskTrash) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Delete")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.db.ExecContext(ctx, `DELETE FROM tasks_trash WHERE id = ?`, id)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete trashed task")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n == 0 {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return nil
+}
+
+// Find returns the requested trashed task by searching its id.
+func (t *TaskTrash) Find(ctx context.Context, id string) (internal.TrashedTask, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Find")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.TrashedTask{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	row := t.db.QueryRowContext(ctx,
+		`SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, deleted_at
+		FROM tasks_trash WHERE id = ?`, id)
+
+	task, err := scanTrashedTask(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.TrashedTask{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return internal.TrashedTask{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select trashed task")
+	}
+
+	return task, nil
+}
+
+// List returns a page of trashed tasks, the most recently deleted first.
+func (t *TaskTrash) List(ctx context.Context, params internal.TrashParams) (internal.TrashResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.List")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	after, err := internal.DecodeTrashCursor(params.Cursor)
+	if err != nil {
+		return internal.TrashResults{}, err
+	}
+
+	// One more record is selected for determining whether there is a next page.
+	rows, err := t.db.QueryContext(ctx,
+		`SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, deleted_at
+		FROM tasks_trash
+		WHERE deleted_at < ? OR (deleted_at = ? AND id < ?)
+		ORDER BY deleted_at DESC, id DESC
+		LIMIT ?`,
+		after.DeletedAt,
+		after.DeletedAt,
+		after.ID,
+		params.Size+1,
+	)
+	if err != nil {
+		return internal.TrashResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select trashed tasks")
+	}
+
+	defer rows.Close()
+
+	res := internal.TrashResults{
+		Tasks: []internal.TrashedTask{},
+	}
+
+	for rows.Next() {
+		task, err := scanTrashedTask(rows)
+		if err != nil {
+			return internal.TrashResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "scanTrashedTask")
+		}
+
+		res.Tasks = append(res.Tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return internal.TrashResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Err")
+	}
+
+	if int64(len(res.Tasks)) > params.Size {
+		res.Tasks = res.Tasks[:params.Size]
+		res.NextCursor = internal.NewTrashCursor(res.Tasks[len(res.Tasks)-1]).String()
+	}
+
+	return res, nil
+}
+
+// Purge deletes the tasks trashed before deletedBefore, it returns the number of deleted tasks.
+func (t *TaskTrash) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Purge")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	res, err := t.db.ExecContext(ctx, `DELETE FROM tasks_trash WHERE deleted_at < ?`, deletedBefore.UTC())
+	if err != nil {
+		return 0, wrapErrorf(err, internal.ErrorCodeUnknown, "delete trashed tasks")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	return n, nil
+}
+
+// Save inserts the trashed task or replaces the existing one.
+func (t *TaskTrash) Save(ctx context.Context, task internal.TrashedTask) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Save")
+	span.SetAttributes(attribute.String("db.system", "mysql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyMySQL)()
+
+	if _, err := uuid.Parse(task.Task.ID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if _, err := t.db.ExecContext(ctx,
+		`REPLACE INTO tasks_trash
+		(id, description, priority, start_date, due_date, time_zone, project_id, done, deleted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		task.Task.ID,
+		task.Task.Description,
+		newPriority(task.Task.Priority),
+		newNullTime(task.Task.Dates.Start),
+		newNullTime(task.Task.Dates.Due),
+		task.Task.Dates.TimeZone,
+		task.Task.ProjectID,
+		task.Task.IsDone,
+		task.DeletedAt.UTC(),
+	); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "insert trashed task")
+	}
+
+	return nil
+}
+
+func scanTrashedTask(row scanner) (internal.TrashedTask, error) {
+	var deletedAt time.Time
+
+	task, err := scanTask(row, &deletedAt)
+	if err != nil {
+		return internal.TrashedTask{}, err
+	}
+
+	return internal.TrashedTask{
+		Task:      task,
+		DeletedAt: deletedAt.UTC(),
+	}, nil
+}
@@ -0,0 +1,17 @@
+package mysql_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/mysql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTaskTrash_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskTrashRepository(t, func(tb testing.TB) service.TaskTrashRepository {
+		return mysql.NewTaskTrash(newDB(tb))
+	})
+}
@@ -0,0 +1,135 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// priorityNames are the names of the priorities used in the messages.
+//nolint: gochecknoglobals
+var priorityNames = map[internal.Priority]string{
+	internal.PriorityNone:   "none",
+	internal.PriorityLow:    "low",
+	internal.PriorityMedium: "medium",
+	internal.PriorityHigh:   "high",
+}
+
+// field is a labeled value displayed in the messages.
+type field struct {
+	label string
+	value string
+}
+
+// newPayload returns the message describing the change using the format of the platform: Block Kit for Slack and
+// Adaptive Cards for Teams.
+func newPayload(platform Platform, event Event) ([]byte, error) {
+	title, fields := describe(event)
+
+	var payload interface{}
+
+	switch platform {
+	case PlatformSlack:
+		payload = slackPayload(title, fields)
+	case PlatformTeams:
+		payload = teamsPayload(title, fields)
+	default:
+		return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown platform %q", platform)
+	}
+
+	res, err := json.Marshal(payload)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	return res, nil
+}
+
+// describe returns the title and the fields of the message describing the change.
+func describe(event Event) (string, []field) {
+	task := event.Task
+
+	if event.Kind == EventDeleted {
+		return "Task deleted", []field{{label: "ID", value: task.ID}}
+	}
+
+	fields := []field{
+		{label: "Priority", value: priorityNames[task.Priority]},
+	}
+
+	if !task.Dates.Due.IsZero() {
+		fields = append(fields, field{label: "Due", value: task.Dates.Due.UTC().Format(time.RFC1123)})
+	}
+
+	if task.ProjectID != "" {
+		fields = append(fields, field{label: "Project", value: task.ProjectID})
+	}
+
+	fields = append(fields, field{label: "ID", value: task.ID})
+
+	return fmt.Sprintf("Task %s: %s", event.Kind, task.Description), fields
+}
+
+func slackPayload(title string, fields []field) interface{} {
+	type text struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	}
+
+	type block struct {
+		Type   string `json:"type"`
+		Text   *text  `json:"text,omitempty"`
+		Fields []text `json:"fields,omitempty"`
+	}
+
+	texts := make([]text, len(fields))
+	for i, f := range fields {
+		texts[i] = text{Type: "mrkdwn", Text: fmt.Sprintf("*%s*\n%s", f.label, f.value)}
+	}
+
+	// Text is displayed in the notifications of the clients, the blocks in the channel.
+	return struct {
+		Text   string  `json:"text"`
+		Blocks []block `json:"blocks"`
+	}{
+		Text: title,
+		Blocks: []block{
+			{Type: "header", Text: &text{Type: "plain_text", Text: title}},
+			{Type: "section", Fields: texts},
+		},
+	}
+}
+
+func teamsPayload(title string, fields []field) interface{} {
+	type fact struct {
+		Title string `json:"title"`
+		Value string `json:"value"`
+	}
+
+	facts := make([]fact, len(fields))
+	for i, f := range fields {
+		facts[i] = fact{Title: f.label, Value: f.value}
+	}
+
+	card := map[string]interface{}{
+		"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+		"type":    "AdaptiveCard",
+		"version": "1.4",
+		"body": []interface{}{
+			map[string]interface{}{"type": "TextBlock", "text": title, "weight": "Bolder", "size": "Medium", "wrap": true},
+			map[string]interface{}{"type": "FactSet", "facts": facts},
+		},
+	}
+
+	return map[string]interface{}{
+		"type": "message",
+		"attachments": []interface{}{
+			map[string]interface{}{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content":     card,
+			},
+		},
+	}
+}
@@ -0,0 +1,186 @@
+// Package notify delivers the changes to tasks to chat platforms, Slack and Microsoft Teams, using incoming
+// webhooks. Routes determine which changes are delivered to each channel.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/retry"
+)
+
+const (
+	EventCreated   = "created"
+	EventDeleted   = "deleted"
+	EventUpdated   = "updated"
+	EventCompleted = "completed"
+	EventReopened  = "reopened"
+)
+
+// Platform indicates the format of the messages delivered to a webhook.
+type Platform string
+
+const (
+	PlatformSlack Platform = "slack"
+	PlatformTeams Platform = "teams"
+)
+
+//nolint: gochecknoglobals
+var (
+	delivered = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/notify")).NewInt64Counter(
+		"notifications.delivered",
+		metric.WithDescription("Number of notifications delivered, labeled by platform"),
+	)
+
+	failed = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/notify")).NewInt64Counter(
+		"notifications.failed",
+		metric.WithDescription("Number of notifications not delivered after retrying, labeled by platform"),
+	)
+
+	dropped = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/notify")).NewInt64Counter(
+		"notifications.dropped",
+		metric.WithDescription("Number of changes not notified because the queue was full"),
+	)
+)
+
+// deliveryRetry retries the webhooks failing because of transient errors.
+//nolint: gochecknoglobals
+var deliveryRetry = retry.Policy{
+	Attempts:   3,
+	Backoff:    500 * time.Millisecond,
+	MaxBackoff: 5 * time.Second,
+	Retryable:  retryableDelivery,
+}
+
+// Event is a change to a task, deleted tasks only include their ID.
+type Event struct {
+	Kind string
+	Task internal.Task
+}
+
+// Notifier delivers the changes in the background, so the requests changing tasks don't wait for the webhooks.
+type Notifier struct {
+	logger *zap.Logger
+	client *http.Client
+	routes []Route
+	queue  chan Event
+}
+
+// New instantiates the Notifier, queueSize indicates the maximum number of changes waiting to be delivered; changes
+// are dropped when the queue is full.
+func New(logger *zap.Logger, client *http.Client, routes []Route, queueSize int) *Notifier {
+	return &Notifier{
+		logger: logger,
+		client: client,
+		routes: routes,
+		queue:  make(chan Event, queueSize),
+	}
+}
+
+// Notify queues the change to be delivered by Run.
+func (n *Notifier) Notify(ctx context.Context, event Event) {
+	select {
+	case n.queue <- event:
+	default:
+		dropped.Add(ctx, 1)
+
+		n.logger.Warn("Notification dropped, queue is full", zap.String("kind", event.Kind), zap.String("id", event.Task.ID))
+	}
+}
+
+// Run delivers the queued changes until ctx is cancelled.
+func (n *Notifier) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-n.queue:
+			n.deliver(ctx, event)
+		}
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, event Event) {
+	for _, route := range n.routes {
+		if !route.Matches(event) {
+			continue
+		}
+
+		platform := attribute.String("platform", string(route.Platform))
+
+		if err := n.post(ctx, route, event); err != nil {
+			failed.Add(ctx, 1, platform)
+
+			n.logger.Warn("Notification failed",
+				zap.String("platform", string(route.Platform)),
+				zap.String("kind", event.Kind),
+				zap.String("id", event.Task.ID),
+				zap.Error(err))
+
+			continue
+		}
+
+		delivered.Add(ctx, 1, platform)
+	}
+}
+
+func (n *Notifier) post(ctx context.Context, route Route, event Event) error {
+	payload, err := newPayload(route.Platform, event)
+	if err != nil {
+		return err
+	}
+
+	return deliveryRetry.Do(ctx, "notify."+string(route.Platform), func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, route.URL, bytes.NewReader(payload))
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "http.NewRequest")
+		}
+
+		req.Header.Set("Content-Type", "application/json")
+
+		res, err := n.client.Do(req)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnavailable, "client.Do")
+		}
+
+		res.Body.Close()
+
+		if res.StatusCode >= http.StatusBadRequest {
+			return &statusError{status: res.StatusCode}
+		}
+
+		return nil
+	})
+}
+
+// statusError indicates the webhook rejected the message.
+type statusError struct {
+	status int
+}
+
+func (e *statusError) Error() string {
+	return "unexpected status " + http.StatusText(e.status)
+}
+
+// retryableDelivery indicates whether delivering failed because of a transient error: the webhook being
+// unreachable, throttling or failing.
+func retryableDelivery(err error) bool {
+	var serr *statusError
+	if errors.As(err, &serr) {
+		return serr.status == http.StatusTooManyRequests || serr.status >= http.StatusInternalServerError
+	}
+
+	var nerr net.Error
+
+	return errors.As(err, &nerr)
+}
@@ -0,0 +1,256 @@
+package notify_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/notify"
+)
+
+func TestParseRoutes(t *testing.T) {
+	t.Parallel()
+
+	high := internal.PriorityHigh
+
+	type output struct {
+		res       []notify.Route
+		withError bool
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		output output
+	}{
+		{
+			"OK: empty",
+			"",
+			output{},
+		},
+		{
+			"OK: filters",
+			"slack https://hooks.example.com/1 projects=p1,p2 events=created,completed priority=high; teams http://teams.example.com/2",
+			output{
+				res: []notify.Route{
+					{
+						Platform:    notify.PlatformSlack,
+						URL:         "https://hooks.example.com/1",
+						Projects:    []string{"p1", "p2"},
+						Events:      []string{notify.EventCreated, notify.EventCompleted},
+						MinPriority: &high,
+					},
+					{
+						Platform: notify.PlatformTeams,
+						URL:      "http://teams.example.com/2",
+					},
+				},
+			},
+		},
+		{
+			"ERR: missing URL",
+			"slack",
+			output{withError: true},
+		},
+		{
+			"ERR: unknown platform",
+			"discord https://hooks.example.com/1",
+			output{withError: true},
+		},
+		{
+			"ERR: invalid URL",
+			"slack hooks.example.com",
+			output{withError: true},
+		},
+		{
+			"ERR: unknown event",
+			"slack https://hooks.example.com/1 events=archived",
+			output{withError: true},
+		},
+		{
+			"ERR: unknown priority",
+			"slack https://hooks.example.com/1 priority=urgent",
+			output{withError: true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := notify.ParseRoutes(tt.input)
+			if (err != nil) != tt.output.withError {
+				t.Fatalf("expected error %t, got %v", tt.output.withError, err)
+			}
+
+			if !cmp.Equal(tt.output.res, res) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output.res, res))
+			}
+		})
+	}
+}
+
+func TestRoute_Matches(t *testing.T) {
+	t.Parallel()
+
+	medium := internal.PriorityMedium
+
+	route := notify.Route{
+		Projects:    []string{"p1"},
+		Events:      []string{notify.EventCreated, notify.EventDeleted},
+		MinPriority: &medium,
+	}
+
+	tests := []struct {
+		name   string
+		input  notify.Event
+		output bool
+	}{
+		{
+			"OK",
+			notify.Event{Kind: notify.EventCreated, Task: internal.Task{ProjectID: "p1", Priority: internal.PriorityHigh}},
+			true,
+		},
+		{
+			"ERR: deleted, project unknown",
+			notify.Event{Kind: notify.EventDeleted, Task: internal.Task{ID: "1"}},
+			false,
+		},
+		{
+			"ERR: event",
+			notify.Event{Kind: notify.EventCompleted, Task: internal.Task{ProjectID: "p1", Priority: internal.PriorityHigh}},
+			false,
+		},
+		{
+			"ERR: project",
+			notify.Event{Kind: notify.EventCreated, Task: internal.Task{ProjectID: "p2", Priority: internal.PriorityHigh}},
+			false,
+		},
+		{
+			"ERR: priority",
+			notify.Event{Kind: notify.EventCreated, Task: internal.Task{ProjectID: "p1", Priority: internal.PriorityLow}},
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if res := route.Matches(tt.input); res != tt.output {
+				t.Fatalf("expected %t, got %t", tt.output, res)
+			}
+		})
+	}
+}
+
+func TestTask(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu       sync.Mutex
+		attempts int
+		bodies   = make(chan map[string]interface{}, 2)
+	)
+
+	slack := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		unavailable := attempts == 1
+		mu.Unlock()
+
+		// The first attempt fails, the message is delivered after retrying.
+		if unavailable {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		bodies <- decode(t, r.Body)
+	}))
+	defer slack.Close()
+
+	teams := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bodies <- decode(t, r.Body)
+	}))
+	defer teams.Close()
+
+	notifier := notify.New(zap.NewNop(), slack.Client(), []notify.Route{
+		{Platform: notify.PlatformSlack, URL: slack.URL},
+		{Platform: notify.PlatformTeams, URL: teams.URL, Events: []string{notify.EventCompleted}},
+	}, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go notifier.Run(ctx)
+
+	task := notify.NewTask(&fakeBroker{}, notifier)
+
+	if err := task.Created(ctx, internal.Task{ID: "1", Description: "write tests", Priority: internal.PriorityHigh}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := task.Completed(ctx, internal.Task{ID: "1", Description: "write tests", IsDone: true}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var slackBodies, teamsBodies []map[string]interface{}
+
+	for len(slackBodies) < 2 || len(teamsBodies) < 1 {
+		select {
+		case body := <-bodies:
+			if _, ok := body["blocks"]; ok {
+				slackBodies = append(slackBodies, body)
+			} else {
+				teamsBodies = append(teamsBodies, body)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected notifications, got slack %d, teams %d", len(slackBodies), len(teamsBodies))
+		}
+	}
+
+	if text := slackBodies[0]["text"]; text != "Task created: write tests" {
+		t.Fatalf("expected slack text, got %v", text)
+	}
+
+	if text := slackBodies[1]["text"]; text != "Task completed: write tests" {
+		t.Fatalf("expected slack text, got %v", text)
+	}
+
+	if typ := teamsBodies[0]["type"]; typ != "message" {
+		t.Fatalf("expected teams message, got %v", typ)
+	}
+}
+
+func decode(t *testing.T, r io.Reader) map[string]interface{} {
+	t.Helper()
+
+	var res map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&res); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+
+	return res
+}
+
+type fakeBroker struct{}
+
+func (*fakeBroker) Created(context.Context, internal.Task) error   { return nil }
+func (*fakeBroker) Deleted(context.Context, string) error          { return nil }
+func (*fakeBroker) Updated(context.Context, internal.Task) error   { return nil }
+func (*fakeBroker) Completed(context.Context, internal.Task) error { return nil }
+func (*fakeBroker) Reopened(context.Context, internal.Task) error  { return nil }
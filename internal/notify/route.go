@@ -0,0 +1,125 @@
+package notify
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Route delivers the matching changes to the webhook of a channel. Empty filters match all the changes, deleted
+// tasks only include their ID so those don't match routes filtering by project or priority.
+type Route struct {
+	Platform    Platform
+	URL         string
+	Projects    []string // IDs of the projects the tasks belong to.
+	Events      []string // Kinds of the changes, like EventCompleted.
+	MinPriority *internal.Priority
+}
+
+// Matches indicates whether the change is delivered using the route.
+func (r Route) Matches(event Event) bool {
+	if len(r.Events) > 0 && !contains(r.Events, event.Kind) {
+		return false
+	}
+
+	if len(r.Projects) > 0 && (event.Kind == EventDeleted || !contains(r.Projects, event.Task.ProjectID)) {
+		return false
+	}
+
+	if r.MinPriority != nil && (event.Kind == EventDeleted || event.Task.Priority < *r.MinPriority) {
+		return false
+	}
+
+	return true
+}
+
+// ParseRoutes parses the routes separated by ";", each one using the format
+// "<platform> <webhook URL> [projects=<id>,...] [events=<kind>,...] [priority=<minimum priority>]", for example
+// "slack https://hooks.slack.com/services/... events=completed priority=high".
+func ParseRoutes(val string) ([]Route, error) {
+	var res []Route
+
+	for _, spec := range strings.Split(val, ";") {
+		parts := strings.Fields(spec)
+		if len(parts) == 0 {
+			continue
+		}
+
+		if len(parts) < 2 {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "route %q must include the webhook URL", spec)
+		}
+
+		route := Route{
+			Platform: Platform(parts[0]),
+			URL:      parts[1],
+		}
+
+		if route.Platform != PlatformSlack && route.Platform != PlatformTeams {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown platform %q", parts[0])
+		}
+
+		if u, err := url.Parse(route.URL); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return nil, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid webhook URL in %s route", parts[0])
+		}
+
+		for _, filter := range parts[2:] {
+			if err := route.parseFilter(filter); err != nil {
+				return nil, err
+			}
+		}
+
+		res = append(res, route)
+	}
+
+	return res, nil
+}
+
+func (r *Route) parseFilter(filter string) error {
+	kv := strings.SplitN(filter, "=", 2)
+	if len(kv) != 2 || kv[1] == "" {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "filter %q must be name=value", filter)
+	}
+
+	vals := strings.Split(kv[1], ",")
+
+	switch kv[0] {
+	case "projects":
+		r.Projects = vals
+	case "events":
+		for _, val := range vals {
+			switch val {
+			case EventCreated, EventDeleted, EventUpdated, EventCompleted, EventReopened:
+			default:
+				return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown event %q", val)
+			}
+		}
+
+		r.Events = vals
+	case "priority":
+		for priority, name := range priorityNames {
+			if name == kv[1] {
+				priority := priority
+				r.MinPriority = &priority
+
+				return nil
+			}
+		}
+
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown priority %q", kv[1])
+	default:
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown filter %q", kv[0])
+	}
+
+	return nil
+}
+
+func contains(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
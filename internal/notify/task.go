@@ -0,0 +1,73 @@
+package notify
+
+import (
+	"context"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskMessageBroker defines the message broker used for publishing Task messages.
+type TaskMessageBroker interface {
+	Created(ctx context.Context, task internal.Task) error
+	Deleted(ctx context.Context, id string) error
+	Updated(ctx context.Context, task internal.Task) error
+	Completed(ctx context.Context, task internal.Task) error
+	Reopened(ctx context.Context, task internal.Task) error
+}
+
+// Task publishes Task messages using the original message broker, the changes successfully published are notified
+// as well.
+type Task struct {
+	orig     TaskMessageBroker
+	notifier *Notifier
+}
+
+// NewTask instantiates the Task message broker.
+func NewTask(orig TaskMessageBroker, notifier *Notifier) *Task {
+	return &Task{
+		orig:     orig,
+		notifier: notifier,
+	}
+}
+
+// Created publishes a message indicating a task was created.
+func (t *Task) Created(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, EventCreated, task, t.orig.Created)
+}
+
+// Deleted publishes a message indicating a task was deleted.
+func (t *Task) Deleted(ctx context.Context, id string) error {
+	if err := t.orig.Deleted(ctx, id); err != nil {
+		return err //nolint: wrapcheck
+	}
+
+	t.notifier.Notify(ctx, Event{Kind: EventDeleted, Task: internal.Task{ID: id}})
+
+	return nil
+}
+
+// Updated publishes a message indicating a task was updated.
+func (t *Task) Updated(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, EventUpdated, task, t.orig.Updated)
+}
+
+// Completed publishes a message indicating a task was completed.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, EventCompleted, task, t.orig.Completed)
+}
+
+// Reopened publishes a message indicating a completed task was reopened.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, EventReopened, task, t.orig.Reopened)
+}
+
+func (t *Task) publish(ctx context.Context, kind string, task internal.Task,
+	fn func(context.Context, internal.Task) error) error {
+	if err := fn(ctx, task); err != nil {
+		return err
+	}
+
+	t.notifier.Notify(ctx, Event{Kind: kind, Task: task})
+
+	return nil
+}
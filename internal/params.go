@@ -1,14 +1,20 @@
 package internal
 
 import (
+	"strings"
+	"time"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
-// CreateParams defines the arguments used for creating Task records.
+// CreateParams defines the arguments used for creating Task records. ID is assigned by the datastore when empty,
+// see IDGenerator. ProjectID is empty when the Task does not belong to a Project.
 type CreateParams struct {
+	ID          string
 	Description string
 	Priority    Priority
 	Dates       Dates
+	ProjectID   string
 }
 
 // Validate indicates whether the fields are valid or not.
@@ -34,24 +40,238 @@ func (c CreateParams) Validate() error {
 
 //-
 
-// SearchParams defines the arguments used for searching Task records.
+// Sort defines the order used for listing or searching Task records.
+type Sort string
+
+const (
+	// SortDefault sorts records by creation time when listing and by relevance when searching.
+	SortDefault Sort = ""
+
+	// SortUrgency sorts records by urgency, a value computed using the due date and the priority: the closer
+	// the due date and the higher the priority the more urgent a task is.
+	SortUrgency Sort = "urgency"
+
+	// SortCreated sorts records by creation time, from the oldest to the newest one.
+	SortCreated Sort = "created_at"
+
+	// SortCreatedDesc sorts records by creation time, from the newest to the oldest one.
+	SortCreatedDesc Sort = "-created_at"
+
+	// SortUpdated sorts records by update time, from the least to the most recently updated one.
+	SortUpdated Sort = "updated_at"
+
+	// SortUpdatedDesc sorts records by update time, from the most to the least recently updated one.
+	SortUpdatedDesc Sort = "-updated_at"
+)
+
+// Validate indicates whether the value is valid or not.
+func (s Sort) Validate() error {
+	switch s {
+	case SortDefault, SortUrgency, SortCreated, SortCreatedDesc, SortUpdated, SortUpdatedDesc:
+		return nil
+	}
+
+	return NewErrorf(ErrorCodeInvalidArgument, "unknown value")
+}
+
+// ByTime indicates whether records are sorted by their creation or update time, ties are sorted by id in the same
+// direction.
+func (s Sort) ByTime() bool {
+	switch s {
+	case SortCreated, SortCreatedDesc, SortUpdated, SortUpdatedDesc:
+		return true
+	}
+
+	return false
+}
+
+// Descending indicates whether records are sorted in descending order.
+func (s Sort) Descending() bool {
+	return strings.HasPrefix(string(s), "-")
+}
+
+// Field returns the name of the time sorting the records, either "created_at" or "updated_at"; it's empty when
+// records are not sorted by time.
+func (s Sort) Field() string {
+	if !s.ByTime() {
+		return ""
+	}
+
+	return strings.TrimPrefix(string(s), "-")
+}
+
+//-
+
+// SearchParams defines the arguments used for searching Task records. Fuzziness allows description terms to match
+// words including typos, Highlight indicates whether to return the fragments of the matching descriptions and
+// Facets whether to count the matching tasks by priority and status. Due selects the undone tasks due within the
+// range and ProjectID the tasks of the project.
 type SearchParams struct {
 	Description *string
 	Priority    *Priority
 	IsDone      *bool
+	Due         *DueRange
+	ProjectID   *string
 	From        int64
 	Size        int64
+	Cursor      string
+	Sort        Sort
+	Fuzziness   Fuzziness
+	Highlight   *Highlight
+	Facets      bool
 }
 
 // IsZero determines whether the search arguments have values or not.
 func (a SearchParams) IsZero() bool {
 	return a.Description == nil &&
 		a.Priority == nil &&
-		a.IsDone == nil
+		a.IsDone == nil &&
+		a.Due == nil &&
+		a.ProjectID == nil
 }
 
-// SearchResults defines the collection of tasks that were found.
+// Validate indicates whether the fields are valid or not.
+func (a SearchParams) Validate() error {
+	errs := validation.Errors{}
+
+	if err := a.Sort.Validate(); err != nil {
+		errs["sort"] = err
+	}
+
+	if err := a.Fuzziness.Validate(); err != nil {
+		errs["fuzziness"] = err
+	}
+
+	if a.Highlight != nil {
+		if err := a.Highlight.Validate(); err != nil {
+			errs["highlight"] = err
+		}
+	}
+
+	return errs.Filter()
+}
+
+// SearchResults defines the collection of tasks that were found. Highlights is only set when requested, it
+// includes the fragments of the matching descriptions indexed by task id; Facets as well.
 type SearchResults struct {
-	Tasks []Task
-	Total int64
+	Tasks      []Task
+	Total      int64
+	NextCursor string
+	Highlights map[string][]string
+	Facets     *Facets
+}
+
+//-
+
+// ListParams defines the arguments used for listing Task records. Cursor is an opaque value returned by a
+// previous call, when empty the first page is returned. Total indicates whether the total number of records is
+// returned as well. Due, when set, selects the undone tasks due within the range and ProjectID the tasks of the
+// project; Created and Updated select the tasks created or last updated within the range.
+type ListParams struct {
+	Cursor    string
+	Size      int64
+	Sort      Sort
+	Total     bool
+	Due       *DueRange
+	ProjectID string
+	Created   *TimeRange
+	Updated   *TimeRange
+}
+
+// Validate indicates whether the fields are valid or not.
+func (l ListParams) Validate() error {
+	errs := validation.Errors{}
+
+	if l.Size <= 0 {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be greater than zero")
+	}
+
+	if err := l.Sort.Validate(); err != nil {
+		errs["sort"] = err
+	}
+
+	if l.Created != nil {
+		if err := l.Created.Validate(); err != nil {
+			errs["created"] = err
+		}
+	}
+
+	if l.Updated != nil {
+		if err := l.Updated.Validate(); err != nil {
+			errs["updated"] = err
+		}
+	}
+
+	return errs.Filter()
+}
+
+// TimeRange selects the times from From, inclusive, until To, exclusive; a zero value means unbounded.
+type TimeRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// Validate indicates whether the fields are valid or not.
+func (r TimeRange) Validate() error {
+	if !r.From.IsZero() && !r.To.IsZero() && !r.From.Before(r.To) {
+		return NewErrorf(ErrorCodeInvalidArgument, "from must be before to")
+	}
+
+	return nil
+}
+
+// Match indicates whether t is selected, the zero time is never selected.
+func (r TimeRange) Match(t time.Time) bool {
+	if t.IsZero() {
+		return false
+	}
+
+	return (r.From.IsZero() || !t.Before(r.From)) && (r.To.IsZero() || t.Before(r.To))
+}
+
+// ListResults defines the collection of tasks that were listed. NextCursor is empty when there are no more
+// records to list. Total is only set when requested, TotalEstimated indicates it's an approximation because
+// counting all the records was too expensive.
+type ListResults struct {
+	Tasks          []Task
+	NextCursor     string
+	Total          int64
+	TotalEstimated bool
+}
+
+//-
+
+// SuggestParams defines the arguments used for suggesting Task records while typing, Prefix is the text typed
+// so far: its last word may be incomplete.
+type SuggestParams struct {
+	Prefix string
+	Size   int64
+}
+
+// Validate indicates whether the fields are valid or not.
+func (s SuggestParams) Validate() error {
+	errs := validation.Errors{}
+
+	if strings.TrimSpace(s.Prefix) == "" {
+		errs["prefix"] = NewErrorf(ErrorCodeInvalidArgument, "must be set")
+	}
+
+	if s.Size <= 0 {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be greater than zero")
+	}
+
+	return errs.Filter()
+}
+
+// Terms returns the lowercase words in Prefix, ignoring punctuation; the last one is the incomplete word.
+func (s SuggestParams) Terms() []string {
+	return strings.FieldsFunc(strings.ToLower(s.Prefix), func(r rune) bool {
+		return !isWordRune(r)
+	})
+}
+
+// Suggestion defines a task suggested while typing.
+type Suggestion struct {
+	ID          string
+	Description string
 }
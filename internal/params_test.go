@@ -3,8 +3,10 @@ package internal_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/MarioCarrion/todo-api/internal"
 )
@@ -56,6 +58,91 @@ func TestCreateParams_Validate(t *testing.T) {
 	}
 }
 
+func TestListParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		input   internal.ListParams
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.ListParams{
+				Cursor: "cursor",
+				Size:   10,
+			},
+			false,
+		},
+		{
+			"OK: Sort",
+			internal.ListParams{
+				Size: 10,
+				Sort: internal.SortUrgency,
+			},
+			false,
+		},
+		{
+			"OK: Sort by time",
+			internal.ListParams{
+				Size: 10,
+				Sort: internal.SortUpdatedDesc,
+			},
+			false,
+		},
+		{
+			"ERR: Size",
+			internal.ListParams{},
+			true,
+		},
+		{
+			"ERR: Sort",
+			internal.ListParams{
+				Size: 10,
+				Sort: "unknown",
+			},
+			true,
+		},
+		{
+			"OK: Updated",
+			internal.ListParams{
+				Size:    10,
+				Created: &internal.TimeRange{To: now},
+				Updated: &internal.TimeRange{From: now.Add(-time.Hour), To: now},
+			},
+			false,
+		},
+		{
+			"ERR: Updated",
+			internal.ListParams{
+				Size:    10,
+				Updated: &internal.TimeRange{From: now, To: now},
+			},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr validation.Errors
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
 func TestSearchParams_IsZero(t *testing.T) {
 	t.Parallel()
 
@@ -123,3 +210,142 @@ func TestSearchParams_IsZero(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.SearchParams
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.SearchParams{
+				Sort:      internal.SortUrgency,
+				Fuzziness: internal.FuzzinessAuto,
+				Highlight: &internal.Highlight{FragmentSize: 50},
+			},
+			false,
+		},
+		{
+			"ERR: sort",
+			internal.SearchParams{Sort: "invalid"},
+			true,
+		},
+		{
+			"ERR: fuzziness",
+			internal.SearchParams{Fuzziness: "3"},
+			true,
+		},
+		{
+			"ERR: highlight",
+			internal.SearchParams{Highlight: &internal.Highlight{FragmentSize: internal.MaxFragmentSize + 1}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr validation.Errors
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestSuggestParams_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.SuggestParams
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.SuggestParams{Prefix: "buy mi", Size: 5},
+			false,
+		},
+		{
+			"ERR: prefix",
+			internal.SuggestParams{Prefix: " ", Size: 5},
+			true,
+		},
+		{
+			"ERR: size",
+			internal.SuggestParams{Prefix: "buy"},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr validation.Errors
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestSuggestParams_Terms(t *testing.T) {
+	t.Parallel()
+
+	actual := internal.SuggestParams{Prefix: "Buy milk, br"}.Terms()
+
+	if expected := []string{"buy", "milk", "br"}; !cmp.Equal(expected, actual) {
+		t.Fatalf("expected values do not match: %s", cmp.Diff(expected, actual))
+	}
+}
+
+func TestTimeRange_Match(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		input    internal.TimeRange
+		time     time.Time
+		expected bool
+	}{
+		{"OK: unbounded", internal.TimeRange{}, now, true},
+		{"OK: from", internal.TimeRange{From: now}, now, true},
+		{"OK: to", internal.TimeRange{To: now}, now.Add(-time.Second), true},
+		{"OK: before from", internal.TimeRange{From: now}, now.Add(-time.Second), false},
+		{"OK: to excluded", internal.TimeRange{To: now}, now, false},
+		{"OK: zero time", internal.TimeRange{}, time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tt.input.Match(tt.time); actual != tt.expected {
+				t.Fatalf("expected %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package parquet
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// ContentType is the media type of the exported files.
+	ContentType = "application/vnd.apache.parquet"
+
+	// DateLayout is the layout of the dates used for partitioning the exported files.
+	DateLayout = "2006-01-02"
+
+	// rowsPerFile is the maximum number of rows written to a file, files are kept in memory until stored.
+	rowsPerFile = 100000
+)
+
+// TaskSource defines the datastore the tasks are exported from.
+type TaskSource interface {
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
+}
+
+// TaskEventSource defines the event store the task events are exported from.
+type TaskEventSource interface {
+	Events(ctx context.Context, from, to time.Time, size int32, fn func(internal.TaskEventRecord) error) error
+}
+
+// ObjectStore defines the storage the exported files are written to.
+type ObjectStore interface {
+	Put(ctx context.Context, key string, contentType string, body []byte) error
+}
+
+// ExportResults indicates the number of exported rows and the keys of the written files.
+type ExportResults struct {
+	Tasks  int64
+	Events int64
+	Keys   []string
+}
+
+// Exporter writes the tasks, and their events when those are stored, as Parquet files partitioned by date so they
+// can be queried by analytics tools without reading the production database:
+//
+//	tasks/date=YYYY-MM-DD/part-0000.parquet        snapshot of all the tasks when exporting
+//	task_events/date=YYYY-MM-DD/part-0000.parquet  events appended during that day, in UTC
+//
+// Exporting the same date again replaces the files.
+type Exporter struct {
+	tasks  TaskSource
+	events TaskEventSource
+	store  ObjectStore
+	size   int32
+}
+
+// NewExporter instantiates the Exporter, rows are read in batches of size; events is nil when tasks are not event
+// sourced, only tasks are exported in that case.
+func NewExporter(tasks TaskSource, events TaskEventSource, store ObjectStore, size int32) *Exporter {
+	return &Exporter{
+		tasks:  tasks,
+		events: events,
+		store:  store,
+		size:   size,
+	}
+}
+
+// Export writes the partitions of the date.
+func (e *Exporter) Export(ctx context.Context, date time.Time) (ExportResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Exporter.Export")
+	defer span.End()
+
+	var res ExportResults
+
+	from := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	tasks, err := e.exportTasks(ctx, from)
+	if err != nil {
+		return ExportResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "exportTasks")
+	}
+
+	res.Tasks = tasks.rows
+	res.Keys = append(res.Keys, tasks.keys...)
+
+	if e.events == nil {
+		return res, nil
+	}
+
+	events, err := e.exportEvents(ctx, from)
+	if err != nil {
+		return ExportResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "exportEvents")
+	}
+
+	res.Events = events.rows
+	res.Keys = append(res.Keys, events.keys...)
+
+	return res, nil
+}
+
+func (e *Exporter) exportTasks(ctx context.Context, date time.Time) (*partition, error) {
+	part := newPartition(e.store, "tasks", date, new(taskRow))
+	exportedAt := time.Now().UnixMilli()
+
+	params := internal.ListParams{Size: int64(e.size)}
+
+	for {
+		res, err := e.tasks.List(ctx, params)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.List")
+		}
+
+		for _, task := range res.Tasks {
+			if err := part.write(ctx, newTaskRow(task, exportedAt)); err != nil {
+				return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "part.write")
+			}
+		}
+
+		if res.NextCursor == "" {
+			break
+		}
+
+		params.Cursor = res.NextCursor
+	}
+
+	if err := part.close(ctx); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "part.close")
+	}
+
+	return part, nil
+}
+
+func (e *Exporter) exportEvents(ctx context.Context, date time.Time) (*partition, error) {
+	part := newPartition(e.store, "task_events", date, new(taskEventRow))
+
+	if err := e.events.Events(ctx, date, date.AddDate(0, 0, 1), e.size, func(event internal.TaskEventRecord) error {
+		return part.write(ctx, newTaskEventRow(event))
+	}); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "events.Events")
+	}
+
+	if err := part.close(ctx); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "part.close")
+	}
+
+	return part, nil
+}
+
+//-
+
+// partition writes the rows of a dataset for a date, rows are split in files of up to rowsPerFile rows; at least
+// one file is written so empty partitions are told apart from missing ones.
+type partition struct {
+	store  ObjectStore
+	prefix string
+	schema interface{}
+
+	buf   bytes.Buffer
+	w     *writer.ParquetWriter
+	count int64 // rows in the current file.
+	rows  int64
+	keys  []string
+}
+
+func newPartition(store ObjectStore, dataset string, date time.Time, schema interface{}) *partition {
+	return &partition{
+		store:  store,
+		prefix: fmt.Sprintf("%s/date=%s", dataset, date.Format(DateLayout)),
+		schema: schema,
+	}
+}
+
+func (p *partition) write(ctx context.Context, row interface{}) error {
+	if p.w == nil {
+		if err := p.open(); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "open")
+		}
+	}
+
+	if err := p.w.Write(row); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "w.Write")
+	}
+
+	p.count++
+	p.rows++
+
+	if p.count == rowsPerFile {
+		return p.flush(ctx)
+	}
+
+	return nil
+}
+
+// close writes the pending rows.
+func (p *partition) close(ctx context.Context) error {
+	if p.w == nil && len(p.keys) > 0 {
+		return nil
+	}
+
+	if p.w == nil {
+		if err := p.open(); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "open")
+		}
+	}
+
+	return p.flush(ctx)
+}
+
+func (p *partition) open() error {
+	p.buf.Reset()
+
+	w, err := writer.NewParquetWriterFromWriter(&p.buf, p.schema, 1)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "writer.NewParquetWriterFromWriter")
+	}
+
+	w.CompressionType = parquet.CompressionCodec_SNAPPY
+
+	p.w = w
+	p.count = 0
+
+	return nil
+}
+
+func (p *partition) flush(ctx context.Context) error {
+	if err := p.w.WriteStop(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "w.WriteStop")
+	}
+
+	key := fmt.Sprintf("%s/part-%04d.parquet", p.prefix, len(p.keys))
+
+	if err := p.store.Put(ctx, key, ContentType, p.buf.Bytes()); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "store.Put")
+	}
+
+	p.keys = append(p.keys, key)
+	p.w = nil
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package parquet_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/parquet"
+)
+
+//nolint: lll
+type task struct {
+	ID       string `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Priority string `parquet:"name=priority, type=BYTE_ARRAY, convertedtype=UTF8"`
+	DueDate  *int64 `parquet:"name=due_date, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	IsDone   bool   `parquet:"name=is_done, type=BOOLEAN"`
+}
+
+type taskEvent struct {
+	TaskID    string `parquet:"name=task_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Version   int64  `parquet:"name=version, type=INT64"`
+	Data      string `parquet:"name=data, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CreatedAt int64  `parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+func TestExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2026, 10, 16, 8, 30, 0, 0, time.UTC)
+
+	tasks := &fakeTasks{
+		pages: []internal.ListResults{
+			{
+				Tasks:      []internal.Task{{ID: "1", Priority: internal.PriorityHigh, Dates: internal.Dates{Due: due}}},
+				NextCursor: "next",
+			},
+			{
+				Tasks: []internal.Task{{ID: "2", IsDone: true}},
+			},
+		},
+	}
+
+	events := &fakeEvents{
+		events: []internal.TaskEventRecord{
+			{TaskID: "1", Version: 1, Type: "created", Data: []byte(`{"priority":"high"}`), CreatedAt: created},
+		},
+	}
+
+	store := fakeStore{}
+
+	res, err := parquet.NewExporter(tasks, events, store, 1).
+		Export(context.Background(), time.Date(2026, 10, 16, 23, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := parquet.ExportResults{
+		Tasks:  2,
+		Events: 1,
+		Keys: []string{
+			"tasks/date=2026-10-16/part-0000.parquet",
+			"task_events/date=2026-10-16/part-0000.parquet",
+		},
+	}
+
+	if !cmp.Equal(expected, res) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res))
+	}
+
+	if !events.from.Equal(time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)) || !events.to.Equal(events.from.AddDate(0, 0, 1)) {
+		t.Fatalf("expected events of the whole day, got %s - %s", events.from, events.to)
+	}
+
+	dueMillis := due.UnixMilli()
+
+	actualTasks := make([]task, 2)
+	readParquet(t, store["tasks/date=2026-10-16/part-0000.parquet"], new(task), &actualTasks)
+
+	expectedTasks := []task{
+		{ID: "1", Priority: "high", DueDate: &dueMillis},
+		{ID: "2", Priority: "none", IsDone: true},
+	}
+
+	if !cmp.Equal(expectedTasks, actualTasks) {
+		t.Fatalf("expected tasks do not match: %s", cmp.Diff(expectedTasks, actualTasks))
+	}
+
+	actualEvents := make([]taskEvent, 1)
+	readParquet(t, store["task_events/date=2026-10-16/part-0000.parquet"], new(taskEvent), &actualEvents)
+
+	expectedEvents := []taskEvent{
+		{TaskID: "1", Version: 1, Data: `{"priority":"high"}`, CreatedAt: created.UnixMilli()},
+	}
+
+	if !cmp.Equal(expectedEvents, actualEvents) {
+		t.Fatalf("expected events do not match: %s", cmp.Diff(expectedEvents, actualEvents))
+	}
+}
+
+func TestExporter_ExportEmpty(t *testing.T) {
+	t.Parallel()
+
+	store := fakeStore{}
+
+	// Tasks are not event sourced, so events are not exported.
+	res, err := parquet.NewExporter(&fakeTasks{pages: []internal.ListResults{{}}}, nil, store, 10).
+		Export(context.Background(), time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	expected := parquet.ExportResults{Keys: []string{"tasks/date=2026-10-16/part-0000.parquet"}}
+
+	if !cmp.Equal(expected, res) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res))
+	}
+
+	if _, ok := store[res.Keys[0]]; !ok {
+		t.Fatalf("expected empty file to be written")
+	}
+}
+
+func TestExporter_ExportError(t *testing.T) {
+	t.Parallel()
+
+	tasks := &fakeTasks{err: errors.New("failed")}
+
+	if _, err := parquet.NewExporter(tasks, nil, fakeStore{}, 10).Export(context.Background(), time.Now()); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func readParquet(t *testing.T, b []byte, schema interface{}, dst interface{}) {
+	t.Helper()
+
+	file, err := buffer.NewBufferFile(b)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	r, err := reader.NewParquetReader(file, schema, 1)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	defer r.ReadStop()
+
+	if err := r.Read(dst); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+}
+
+//-
+
+type fakeTasks struct {
+	pages []internal.ListResults
+	err   error
+	calls int
+}
+
+func (f *fakeTasks) List(_ context.Context, _ internal.ListParams) (internal.ListResults, error) {
+	if f.err != nil {
+		return internal.ListResults{}, f.err
+	}
+
+	res := f.pages[f.calls]
+	f.calls++
+
+	return res, nil
+}
+
+type fakeEvents struct {
+	events   []internal.TaskEventRecord
+	from, to time.Time
+}
+
+func (f *fakeEvents) Events(_ context.Context, from, to time.Time, _ int32, fn func(internal.TaskEventRecord) error) error {
+	f.from, f.to = from, to
+
+	for _, event := range f.events {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type fakeStore map[string][]byte
+
+func (f fakeStore) Put(_ context.Context, key string, _ string, body []byte) error {
+	f[key] = append([]byte(nil), body...)
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package parquet
+
+import (
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// taskRow defines the columns of the exported tasks, timestamps are milliseconds since epoch in UTC.
+//nolint: lll
+type taskRow struct {
+	ID          string `parquet:"name=id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Description string `parquet:"name=description, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Priority    string `parquet:"name=priority, type=BYTE_ARRAY, convertedtype=UTF8"`
+	StartDate   *int64 `parquet:"name=start_date, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	DueDate     *int64 `parquet:"name=due_date, type=INT64, convertedtype=TIMESTAMP_MILLIS, repetitiontype=OPTIONAL"`
+	TimeZone    string `parquet:"name=time_zone, type=BYTE_ARRAY, convertedtype=UTF8"`
+	ProjectID   string `parquet:"name=project_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	IsDone      bool   `parquet:"name=is_done, type=BOOLEAN"`
+	Version     int64  `parquet:"name=version, type=INT64"`
+	ExportedAt  int64  `parquet:"name=exported_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+func newTaskRow(task internal.Task, exportedAt int64) *taskRow {
+	return &taskRow{
+		ID:          task.ID,
+		Description: task.Description,
+		Priority:    newPriority(task.Priority),
+		StartDate:   newTimestamp(task.Dates.Start),
+		DueDate:     newTimestamp(task.Dates.Due),
+		TimeZone:    task.Dates.TimeZone,
+		ProjectID:   task.ProjectID,
+		IsDone:      task.IsDone,
+		Version:     task.Version,
+		ExportedAt:  exportedAt,
+	}
+}
+
+// taskEventRow defines the columns of the exported task events, data is the JSON encoded state included in the
+// event.
+//nolint: lll
+type taskEventRow struct {
+	TaskID    string `parquet:"name=task_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Version   int64  `parquet:"name=version, type=INT64"`
+	Type      string `parquet:"name=type, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Data      string `parquet:"name=data, type=BYTE_ARRAY, convertedtype=UTF8"`
+	CreatedAt int64  `parquet:"name=created_at, type=INT64, convertedtype=TIMESTAMP_MILLIS"`
+}
+
+func newTaskEventRow(event internal.TaskEventRecord) *taskEventRow {
+	return &taskEventRow{
+		TaskID:    event.TaskID,
+		Version:   event.Version,
+		Type:      event.Type,
+		Data:      string(event.Data),
+		CreatedAt: event.CreatedAt.UnixMilli(),
+	}
+}
+
+func newPriority(p internal.Priority) string {
+	switch p {
+	case internal.PriorityNone:
+		return "none"
+	case internal.PriorityLow:
+		return "low"
+	case internal.PriorityMedium:
+		return "medium"
+	case internal.PriorityHigh:
+		return "high"
+	}
+
+	return "invalid"
+}
+
+func newTimestamp(t time.Time) *int64 {
+	if t.IsZero() {
+		return nil
+	}
+
+	res := t.UnixMilli()
+
+	return &res
+}
@@ -0,0 +1,72 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// BackfillCheckpoint represents the repository used for interacting with backfill checkpoints.
+type BackfillCheckpoint struct {
+	q *db.Queries
+}
+
+// NewBackfillCheckpoint instantiates the BackfillCheckpoint repository.
+func NewBackfillCheckpoint(d db.DBTX) *BackfillCheckpoint {
+	return &BackfillCheckpoint{
+		q: db.New(d),
+	}
+}
+
+// Find returns the checkpoint of the backfill.
+func (b *BackfillCheckpoint) Find(ctx context.Context, name string) (internal.BackfillCheckpoint, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BackfillCheckpoint.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	res, err := b.q.SelectBackfillCheckpoint(ctx, name)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.BackfillCheckpoint{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "checkpoint not found")
+		}
+
+		return internal.BackfillCheckpoint{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select checkpoint")
+	}
+
+	return internal.BackfillCheckpoint{
+		Name:      res.Name,
+		Cursor:    res.Cursor,
+		Processed: res.Processed,
+		Done:      res.Done,
+		UpdatedAt: res.UpdatedAt,
+	}, nil
+}
+
+// Save inserts the checkpoint or replaces the existing one.
+func (b *BackfillCheckpoint) Save(ctx context.Context, checkpoint internal.BackfillCheckpoint) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "BackfillCheckpoint.Save")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if err := b.q.UpsertBackfillCheckpoint(ctx, db.UpsertBackfillCheckpointParams{
+		Name:      checkpoint.Name,
+		Cursor:    checkpoint.Cursor,
+		Processed: checkpoint.Processed,
+		Done:      checkpoint.Done,
+		UpdatedAt: checkpoint.UpdatedAt,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert checkpoint")
+	}
+
+	return nil
+}
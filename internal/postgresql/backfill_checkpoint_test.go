@@ -0,0 +1,59 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func TestBackfillCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Save/Find: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := postgresql.NewBackfillCheckpoint(newDB(t))
+
+		expected := internal.BackfillCheckpoint{
+			Name:      "task-events",
+			Cursor:    "cursor",
+			Processed: 500,
+			UpdatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		}
+
+		// Saving again replaces the checkpoint.
+		for _, done := range []bool{false, true} {
+			expected.Done = done
+
+			if err := repo.Save(context.Background(), expected); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		actual, err := repo.Find(context.Background(), expected.Name)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("Find: ERR not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := postgresql.NewBackfillCheckpoint(newDB(t)).Find(context.Background(), "missing")
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected not found error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,170 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// CalendarLink represents the repository used for interacting with CalendarLink records and the sync tokens of the
+// calendars.
+type CalendarLink struct {
+	q *db.Queries
+}
+
+// NewCalendarLink instantiates the CalendarLink repository.
+func NewCalendarLink(d db.DBTX) *CalendarLink {
+	return &CalendarLink{
+		q: db.New(d),
+	}
+}
+
+// DeleteLink deletes the existing record matching the Task id.
+func (c *CalendarLink) DeleteLink(ctx context.Context, taskID string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.DeleteLink")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(taskID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if _, err := c.q.DeleteCalendarLink(ctx, val); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "calendar link not found")
+		}
+
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete calendar link")
+	}
+
+	return nil
+}
+
+// FindLinkByEvent returns the record matching the event id.
+func (c *CalendarLink) FindLinkByEvent(ctx context.Context, eventID string) (internal.CalendarLink, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.FindLinkByEvent")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	res, err := c.q.SelectCalendarLinkByEvent(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.CalendarLink{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "calendar link not found")
+		}
+
+		return internal.CalendarLink{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select calendar link")
+	}
+
+	return newCalendarLink(res), nil
+}
+
+// FindLinkByTask returns the record matching the Task id.
+func (c *CalendarLink) FindLinkByTask(ctx context.Context, taskID string) (internal.CalendarLink, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.FindLinkByTask")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(taskID)
+	if err != nil {
+		return internal.CalendarLink{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := c.q.SelectCalendarLinkByTask(ctx, val)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.CalendarLink{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "calendar link not found")
+		}
+
+		return internal.CalendarLink{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select calendar link")
+	}
+
+	return newCalendarLink(res), nil
+}
+
+// SaveLink inserts the record or replaces the existing one.
+func (c *CalendarLink) SaveLink(ctx context.Context, link internal.CalendarLink) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.SaveLink")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(link.TaskID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if link.EventID == "" {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "event id is required")
+	}
+
+	if err := c.q.UpsertCalendarLink(ctx, db.UpsertCalendarLinkParams{
+		TaskID:      val,
+		EventID:     link.EventID,
+		Fingerprint: link.Fingerprint,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert calendar link")
+	}
+
+	return nil
+}
+
+// SaveSyncToken saves the sync token of the calendar.
+func (c *CalendarLink) SaveSyncToken(ctx context.Context, calendarID, token string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.SaveSyncToken")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if err := c.q.UpsertCalendarSyncToken(ctx, db.UpsertCalendarSyncTokenParams{
+		CalendarID: calendarID,
+		Token:      token,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert calendar sync token")
+	}
+
+	return nil
+}
+
+// SyncToken returns the sync token of the calendar, empty when it was not saved yet.
+func (c *CalendarLink) SyncToken(ctx context.Context, calendarID string) (string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "CalendarLink.SyncToken")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	res, err := c.q.SelectCalendarSyncToken(ctx, calendarID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", wrapErrorf(err, internal.ErrorCodeUnknown, "select calendar sync token")
+	}
+
+	return res, nil
+}
+
+func newCalendarLink(link db.CalendarLinks) internal.CalendarLink {
+	return internal.CalendarLink{
+		TaskID:      link.TaskID.String(),
+		EventID:     link.EventID,
+		Fingerprint: link.Fingerprint,
+	}
+}
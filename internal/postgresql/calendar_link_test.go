@@ -0,0 +1,17 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestCalendarLink_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.CalendarLinkRepository(t, func(tb testing.TB) calendar.LinkRepository {
+		return postgresql.NewCalendarLink(newDB(tb))
+	})
+}
@@ -0,0 +1,206 @@
+package postgresql
+
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// cursor defines the keyset used for paginating records, records are sorted by creation time and then by id.
+type cursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+func decodeCursor(val string) (cursor, error) {
+	if val == "" {
+		return cursor{}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 2)
+	if len(split) != 2 {
+		return cursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, split[0])
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	id, err := uuid.Parse(split[1])
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return cursor{
+		CreatedAt: createdAt,
+		ID:        id,
+	}, nil
+}
+
+func (c cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(c.CreatedAt.Format(time.RFC3339Nano) + "|" + c.ID.String()))
+}
+
+// urgencyCursor defines the keyset used for paginating records sorted by urgency, records are sorted by
+// completion, then by urgency and then by id.
+type urgencyCursor struct {
+	Done      bool
+	UrgencyAt time.Time
+	ID        uuid.UUID
+}
+
+func decodeUrgencyCursor(val string) (urgencyCursor, error) {
+	if val == "" {
+		return urgencyCursor{}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return urgencyCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 4)
+	if len(split) != 4 || split[0] != string(internal.SortUrgency) {
+		return urgencyCursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	done, err := strconv.ParseBool(split[1])
+	if err != nil {
+		return urgencyCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	urgencyAt, err := time.Parse(time.RFC3339Nano, split[2])
+	if err != nil {
+		return urgencyCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	id, err := uuid.Parse(split[3])
+	if err != nil {
+		return urgencyCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return urgencyCursor{
+		Done:      done,
+		UrgencyAt: urgencyAt,
+		ID:        id,
+	}, nil
+}
+
+func (c urgencyCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		string(internal.SortUrgency),
+		strconv.FormatBool(c.Done),
+		c.UrgencyAt.Format(time.RFC3339Nano),
+		c.ID.String(),
+	}, "|")))
+}
+
+// rankCursor defines the keyset used for paginating search results sorted by relevance, records are sorted by
+// rank in descending order and then by id.
+type rankCursor struct {
+	Rank float32
+	ID   uuid.UUID
+}
+
+func decodeRankCursor(val string) (rankCursor, error) {
+	if val == "" {
+		return rankCursor{Rank: float32(math.Inf(1))}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return rankCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 3)
+	if len(split) != 3 || split[0] != "rank" {
+		return rankCursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	rank, err := strconv.ParseFloat(split[1], 32)
+	if err != nil {
+		return rankCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	id, err := uuid.Parse(split[2])
+	if err != nil {
+		return rankCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return rankCursor{
+		Rank: float32(rank),
+		ID:   id,
+	}, nil
+}
+
+func (c rankCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		"rank",
+		strconv.FormatFloat(float64(c.Rank), 'g', -1, 32),
+		c.ID.String(),
+	}, "|")))
+}
+
+// timeCursor defines the keyset used for paginating records sorted by time, records are sorted by the requested
+// time and then by id, both in the same direction.
+type timeCursor struct {
+	Sort internal.Sort
+	At   time.Time
+	ID   uuid.UUID
+}
+
+func decodeTimeCursor(sort internal.Sort, val string) (timeCursor, error) {
+	if val == "" {
+		if sort.Descending() {
+			return timeCursor{Sort: sort, At: time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC), ID: uuid.Max}, nil
+		}
+
+		return timeCursor{Sort: sort}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return timeCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 3)
+	if len(split) != 3 || split[0] != string(sort) {
+		return timeCursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, split[1])
+	if err != nil {
+		return timeCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	id, err := uuid.Parse(split[2])
+	if err != nil {
+		return timeCursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return timeCursor{
+		Sort: sort,
+		At:   at,
+		ID:   id,
+	}, nil
+}
+
+func (c timeCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		string(c.Sort),
+		c.At.Format(time.RFC3339Nano),
+		c.ID.String(),
+	}, "|")))
+}
@@ -5,8 +5,10 @@ package db
 import (
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
 )
 
 type Priority string
@@ -30,11 +32,137 @@ func (e *Priority) Scan(src interface{}) error {
 	return nil
 }
 
+type BackfillCheckpoints struct {
+	Name      string
+	Cursor    string
+	Processed int64
+	Done      bool
+	UpdatedAt time.Time
+}
+
+type CalendarLinks struct {
+	TaskID      uuid.UUID
+	EventID     string
+	Fingerprint string
+}
+
+type CalendarSyncTokens struct {
+	CalendarID string
+	Token      string
+}
+
+type Instances struct {
+	ID         uuid.UUID
+	Version    int32
+	MinVersion int32
+	StartedAt  time.Time
+	SeenAt     time.Time
+}
+
+type IssueLinks struct {
+	Repository string
+	Number     int32
+	TaskID     uuid.NullUUID
+	Closed     bool
+}
+
+type Projects struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+type PushReminders struct {
+	TaskID uuid.UUID
+	Due    time.Time
+}
+
+type PushSubscriptions struct {
+	ID        uuid.UUID
+	Endpoint  string
+	P256dh    string
+	Auth      string
+	CreatedAt time.Time
+}
+
+type TaskDependencies struct {
+	BlockedID uuid.UUID
+	BlockerID uuid.UUID
+}
+
+type TaskEvents struct {
+	TaskID    uuid.UUID
+	Version   int64
+	Type      string
+	Data      pgtype.JSONB
+	CreatedAt time.Time
+}
+
+type TaskSnapshots struct {
+	TaskID    uuid.UUID
+	Version   int64
+	Data      pgtype.JSONB
+	CreatedAt time.Time
+}
+
+type TaskStreams struct {
+	ID        uuid.UUID
+	Version   int64
+	Deleted   bool
+	CreatedAt time.Time
+}
+
+type TaskVersions struct {
+	ID          uuid.UUID
+	Version     int64
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	Done        bool
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+}
+
 type Tasks struct {
+	ID                uuid.UUID
+	Description       string
+	Priority          Priority
+	StartDate         sql.NullTime
+	DueDate           sql.NullTime
+	Done              bool
+	CreatedAt         time.Time
+	Version           int64
+	DescriptionSearch interface{}
+	TimeZone          string
+	ProjectID         uuid.NullUUID
+	CompletedAt       sql.NullTime
+	UpdatedAt         time.Time
+}
+
+type TasksReadModel struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	Done        bool
+	UrgencyAt   time.Time
+	CreatedAt   time.Time
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+	CompletedAt sql.NullTime
+	UpdatedAt   time.Time
+}
+
+type TasksTrash struct {
 	ID          uuid.UUID
 	Description string
 	Priority    Priority
 	StartDate   sql.NullTime
 	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
 	Done        bool
+	DeletedAt   time.Time
 }
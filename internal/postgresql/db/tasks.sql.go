@@ -6,10 +6,56 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+const CountTasks = `-- name: CountTasks :one
+SELECT
+  COUNT(*)
+FROM
+  tasks
+WHERE
+  (NOT $1::BOOLEAN OR (NOT done AND due_date >= $2::TIMESTAMPTZ AND due_date < $3::TIMESTAMPTZ)) AND
+  (NOT $4::BOOLEAN OR project_id = $5::UUID) AND
+  (NOT $6::BOOLEAN OR (created_at >= $7::TIMESTAMP AND created_at < $8::TIMESTAMP)) AND
+  (NOT $9::BOOLEAN OR (updated_at >= $10::TIMESTAMP AND updated_at < $11::TIMESTAMP))
+`
+
+type CountTasksParams struct {
+	ByDue       bool
+	DueFrom     time.Time
+	DueTo       time.Time
+	ByProject   bool
+	ProjectID   uuid.UUID
+	ByCreated   bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	ByUpdated   bool
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+}
+
+func (q *Queries) CountTasks(ctx context.Context, arg CountTasksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, CountTasks,
+		arg.ByDue,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.ByProject,
+		arg.ProjectID,
+		arg.ByCreated,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.ByUpdated,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const DeleteTask = `-- name: DeleteTask :one
 DELETE FROM
   tasks
@@ -30,15 +76,19 @@ INSERT INTO tasks (
   description,
   priority,
   start_date,
-  due_date
+  due_date,
+  time_zone,
+  project_id
 )
 VALUES (
   $1,
   $2,
   $3,
-  $4
+  $4,
+  $5,
+  $6
 )
-RETURNING id
+RETURNING id, created_at, updated_at
 `
 
 type InsertTaskParams struct {
@@ -46,18 +96,80 @@ type InsertTaskParams struct {
 	Priority    Priority
 	StartDate   sql.NullTime
 	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
 }
 
-func (q *Queries) InsertTask(ctx context.Context, arg InsertTaskParams) (uuid.UUID, error) {
+type InsertTaskRow struct {
+	ID        uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) InsertTask(ctx context.Context, arg InsertTaskParams) (InsertTaskRow, error) {
 	row := q.db.QueryRow(ctx, InsertTask,
 		arg.Description,
 		arg.Priority,
 		arg.StartDate,
 		arg.DueDate,
+		arg.TimeZone,
+		arg.ProjectID,
 	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+	var i InsertTaskRow
+	err := row.Scan(&i.ID, &i.CreatedAt, &i.UpdatedAt)
+	return i, err
+}
+
+const InsertTaskWithID = `-- name: InsertTaskWithID :one
+INSERT INTO tasks (
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id
+)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+RETURNING created_at, updated_at
+`
+
+type InsertTaskWithIDParams struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+}
+
+type InsertTaskWithIDRow struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (q *Queries) InsertTaskWithID(ctx context.Context, arg InsertTaskWithIDParams) (InsertTaskWithIDRow, error) {
+	row := q.db.QueryRow(ctx, InsertTaskWithID,
+		arg.ID,
+		arg.Description,
+		arg.Priority,
+		arg.StartDate,
+		arg.DueDate,
+		arg.TimeZone,
+		arg.ProjectID,
+	)
+	var i InsertTaskWithIDRow
+	err := row.Scan(&i.CreatedAt, &i.UpdatedAt)
+	return i, err
 }
 
 const SelectTask = `-- name: SelectTask :one
@@ -67,7 +179,13 @@ SELECT
   priority,
   start_date,
   due_date,
-  done
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  version,
+  created_at,
+  updated_at
 FROM
   tasks
 WHERE
@@ -75,38 +193,492 @@ WHERE
 LIMIT 1
 `
 
-func (q *Queries) SelectTask(ctx context.Context, id uuid.UUID) (Tasks, error) {
+type SelectTaskRow struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+	Done        bool
+	CompletedAt sql.NullTime
+	Version     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (q *Queries) SelectTask(ctx context.Context, id uuid.UUID) (SelectTaskRow, error) {
 	row := q.db.QueryRow(ctx, SelectTask, id)
-	var i Tasks
+	var i SelectTaskRow
 	err := row.Scan(
 		&i.ID,
 		&i.Description,
 		&i.Priority,
 		&i.StartDate,
 		&i.DueDate,
+		&i.TimeZone,
+		&i.ProjectID,
 		&i.Done,
+		&i.CompletedAt,
+		&i.Version,
+		&i.CreatedAt,
+		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const UpdateTask = `-- name: UpdateTask :one
-UPDATE tasks SET
-  description = $1,
-  priority    = $2,
-  start_date  = $3,
-  due_date    = $4,
-  done        = $5
-WHERE id = $6
-RETURNING id AS res
+const SelectTaskVersion = `-- name: SelectTaskVersion :one
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  version
+FROM
+  task_versions
+WHERE
+  id = $1 AND
+  version = $2
+LIMIT 1
 `
 
-type UpdateTaskParams struct {
+type SelectTaskVersionParams struct {
+	ID      uuid.UUID
+	Version int64
+}
+
+type SelectTaskVersionRow struct {
+	ID          uuid.UUID
 	Description string
 	Priority    Priority
 	StartDate   sql.NullTime
 	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
 	Done        bool
+	Version     int64
+}
+
+func (q *Queries) SelectTaskVersion(ctx context.Context, arg SelectTaskVersionParams) (SelectTaskVersionRow, error) {
+	row := q.db.QueryRow(ctx, SelectTaskVersion, arg.ID, arg.Version)
+	var i SelectTaskVersionRow
+	err := row.Scan(
+		&i.ID,
+		&i.Description,
+		&i.Priority,
+		&i.StartDate,
+		&i.DueDate,
+		&i.TimeZone,
+		&i.ProjectID,
+		&i.Done,
+		&i.Version,
+	)
+	return i, err
+}
+
+const SelectTasks = `-- name: SelectTasks :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  version,
+  updated_at,
+  created_at
+FROM
+  tasks
+WHERE
+  (created_at, id) > ($1::TIMESTAMP, $2::UUID) AND
+  (NOT $3::BOOLEAN OR (NOT done AND due_date >= $4::TIMESTAMPTZ AND due_date < $5::TIMESTAMPTZ)) AND
+  (NOT $6::BOOLEAN OR project_id = $7::UUID) AND
+  (NOT $8::BOOLEAN OR (created_at >= $9::TIMESTAMP AND created_at < $10::TIMESTAMP)) AND
+  (NOT $11::BOOLEAN OR (updated_at >= $12::TIMESTAMP AND updated_at < $13::TIMESTAMP))
+ORDER BY
+  created_at, id
+LIMIT $14
+`
+
+type SelectTasksParams struct {
+	CreatedAt   time.Time
 	ID          uuid.UUID
+	ByDue       bool
+	DueFrom     time.Time
+	DueTo       time.Time
+	ByProject   bool
+	ProjectID   uuid.UUID
+	ByCreated   bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	ByUpdated   bool
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	Size        int32
+}
+
+type SelectTasksRow struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+	Done        bool
+	CompletedAt sql.NullTime
+	Version     int64
+	UpdatedAt   time.Time
+	CreatedAt   time.Time
+}
+
+func (q *Queries) SelectTasks(ctx context.Context, arg SelectTasksParams) ([]SelectTasksRow, error) {
+	rows, err := q.db.Query(ctx, SelectTasks,
+		arg.CreatedAt,
+		arg.ID,
+		arg.ByDue,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.ByProject,
+		arg.ProjectID,
+		arg.ByCreated,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.ByUpdated,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.Size,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SelectTasksRow{}
+	for rows.Next() {
+		var i SelectTasksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Description,
+			&i.Priority,
+			&i.StartDate,
+			&i.DueDate,
+			&i.TimeZone,
+			&i.ProjectID,
+			&i.Done,
+			&i.CompletedAt,
+			&i.Version,
+			&i.UpdatedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const SelectTasksByTime = `-- name: SelectTasksByTime :many
+SELECT
+  matches.id,
+  matches.description,
+  matches.priority,
+  matches.start_date,
+  matches.due_date,
+  matches.time_zone,
+  matches.project_id,
+  matches.done,
+  matches.completed_at,
+  matches.version,
+  matches.created_at,
+  matches.updated_at,
+  matches.sorted_at
+FROM (
+  SELECT
+    id,
+    description,
+    priority,
+    start_date,
+    due_date,
+    time_zone,
+    project_id,
+    done,
+    completed_at,
+    version,
+    created_at,
+    updated_at,
+    (CASE WHEN $1::BOOLEAN THEN updated_at ELSE created_at END)::TIMESTAMP AS sorted_at
+  FROM
+    tasks
+  WHERE
+    (NOT $2::BOOLEAN OR (NOT done AND due_date >= $3::TIMESTAMPTZ AND due_date < $4::TIMESTAMPTZ)) AND
+    (NOT $5::BOOLEAN OR project_id = $6::UUID) AND
+    (NOT $7::BOOLEAN OR (created_at >= $8::TIMESTAMP AND created_at < $9::TIMESTAMP)) AND
+    (NOT $10::BOOLEAN OR (updated_at >= $11::TIMESTAMP AND updated_at < $12::TIMESTAMP))
+) AS matches
+WHERE
+  (NOT $13::BOOLEAN AND (sorted_at, id) > ($14::TIMESTAMP, $15::UUID)) OR
+  ($13::BOOLEAN AND (sorted_at, id) < ($14::TIMESTAMP, $15::UUID))
+ORDER BY
+  CASE WHEN $13::BOOLEAN THEN NULL ELSE sorted_at END,
+  CASE WHEN $13::BOOLEAN THEN sorted_at END DESC,
+  CASE WHEN $13::BOOLEAN THEN NULL ELSE id END,
+  CASE WHEN $13::BOOLEAN THEN id END DESC
+LIMIT $16
+`
+
+type SelectTasksByTimeParams struct {
+	SortUpdated bool
+	ByDue       bool
+	DueFrom     time.Time
+	DueTo       time.Time
+	ByProject   bool
+	ProjectID   uuid.UUID
+	ByCreated   bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	ByUpdated   bool
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	Descending  bool
+	AfterAt     time.Time
+	ID          uuid.UUID
+	Size        int32
+}
+
+type SelectTasksByTimeRow struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+	Done        bool
+	CompletedAt sql.NullTime
+	Version     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	SortedAt    time.Time
+}
+
+func (q *Queries) SelectTasksByTime(ctx context.Context, arg SelectTasksByTimeParams) ([]SelectTasksByTimeRow, error) {
+	rows, err := q.db.Query(ctx, SelectTasksByTime,
+		arg.SortUpdated,
+		arg.ByDue,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.ByProject,
+		arg.ProjectID,
+		arg.ByCreated,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.ByUpdated,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.Descending,
+		arg.AfterAt,
+		arg.ID,
+		arg.Size,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SelectTasksByTimeRow{}
+	for rows.Next() {
+		var i SelectTasksByTimeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Description,
+			&i.Priority,
+			&i.StartDate,
+			&i.DueDate,
+			&i.TimeZone,
+			&i.ProjectID,
+			&i.Done,
+			&i.CompletedAt,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SortedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const SelectTasksByUrgency = `-- name: SelectTasksByUrgency :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  version,
+  created_at,
+  updated_at,
+  (COALESCE(due_date AT TIME ZONE 'UTC', '9999-12-31'::TIMESTAMP) - CASE priority
+    WHEN 'high'   THEN INTERVAL '3 days'
+    WHEN 'medium' THEN INTERVAL '1 day'
+    ELSE INTERVAL '0'
+  END)::TIMESTAMP AS urgency_at
+FROM
+  tasks
+WHERE
+  (
+    done,
+    COALESCE(due_date AT TIME ZONE 'UTC', '9999-12-31'::TIMESTAMP) - CASE priority
+      WHEN 'high'   THEN INTERVAL '3 days'
+      WHEN 'medium' THEN INTERVAL '1 day'
+      ELSE INTERVAL '0'
+    END,
+    id
+  ) > ($1::BOOLEAN, $2::TIMESTAMP, $3::UUID) AND
+  (NOT $4::BOOLEAN OR (NOT done AND due_date >= $5::TIMESTAMPTZ AND due_date < $6::TIMESTAMPTZ)) AND
+  (NOT $7::BOOLEAN OR project_id = $8::UUID) AND
+  (NOT $9::BOOLEAN OR (created_at >= $10::TIMESTAMP AND created_at < $11::TIMESTAMP)) AND
+  (NOT $12::BOOLEAN OR (updated_at >= $13::TIMESTAMP AND updated_at < $14::TIMESTAMP))
+ORDER BY
+  done,
+  urgency_at,
+  id
+LIMIT $15
+`
+
+type SelectTasksByUrgencyParams struct {
+	Done        bool
+	UrgencyAt   time.Time
+	ID          uuid.UUID
+	ByDue       bool
+	DueFrom     time.Time
+	DueTo       time.Time
+	ByProject   bool
+	ProjectID   uuid.UUID
+	ByCreated   bool
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	ByUpdated   bool
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	Size        int32
+}
+
+type SelectTasksByUrgencyRow struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+	Done        bool
+	CompletedAt sql.NullTime
+	Version     int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	UrgencyAt   time.Time
+}
+
+func (q *Queries) SelectTasksByUrgency(ctx context.Context, arg SelectTasksByUrgencyParams) ([]SelectTasksByUrgencyRow, error) {
+	rows, err := q.db.Query(ctx, SelectTasksByUrgency,
+		arg.Done,
+		arg.UrgencyAt,
+		arg.ID,
+		arg.ByDue,
+		arg.DueFrom,
+		arg.DueTo,
+		arg.ByProject,
+		arg.ProjectID,
+		arg.ByCreated,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.ByUpdated,
+		arg.UpdatedFrom,
+		arg.UpdatedTo,
+		arg.Size,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SelectTasksByUrgencyRow{}
+	for rows.Next() {
+		var i SelectTasksByUrgencyRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Description,
+			&i.Priority,
+			&i.StartDate,
+			&i.DueDate,
+			&i.TimeZone,
+			&i.ProjectID,
+			&i.Done,
+			&i.CompletedAt,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.UrgencyAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const UpdateTask = `-- name: UpdateTask :one
+UPDATE tasks SET
+  description  = $1,
+  priority     = $2,
+  start_date   = $3,
+  due_date     = $4,
+  time_zone    = $5,
+  project_id   = $6,
+  done         = $7,
+  completed_at = CASE
+    WHEN NOT $7 THEN NULL
+    WHEN done THEN completed_at
+    ELSE NOW()
+  END,
+  updated_at   = NOW() AT TIME ZONE 'utc',
+  version      = version + 1
+WHERE id = $8 AND ($9::BIGINT = 0 OR version = $9::BIGINT)
+RETURNING id AS res
+`
+
+type UpdateTaskParams struct {
+	Description     string
+	Priority        Priority
+	StartDate       sql.NullTime
+	DueDate         sql.NullTime
+	TimeZone        string
+	ProjectID       uuid.NullUUID
+	Done            bool
+	ID              uuid.UUID
+	ExpectedVersion int64
 }
 
 func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (uuid.UUID, error) {
@@ -115,10 +687,81 @@ func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (uuid.UU
 		arg.Priority,
 		arg.StartDate,
 		arg.DueDate,
+		arg.TimeZone,
+		arg.ProjectID,
 		arg.Done,
 		arg.ID,
+		arg.ExpectedVersion,
 	)
 	var res uuid.UUID
 	err := row.Scan(&res)
 	return res, err
 }
+
+const UpsertTask = `-- name: UpsertTask :one
+INSERT INTO tasks (
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at
+)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  CASE WHEN $8 THEN NOW() END
+)
+ON CONFLICT (id) DO UPDATE SET
+  description  = EXCLUDED.description,
+  priority     = EXCLUDED.priority,
+  start_date   = EXCLUDED.start_date,
+  due_date     = EXCLUDED.due_date,
+  time_zone    = EXCLUDED.time_zone,
+  project_id   = EXCLUDED.project_id,
+  done         = EXCLUDED.done,
+  completed_at = CASE
+    WHEN NOT EXCLUDED.done THEN NULL
+    WHEN tasks.done THEN tasks.completed_at
+    ELSE NOW()
+  END,
+  updated_at   = NOW() AT TIME ZONE 'utc',
+  version      = tasks.version + 1
+RETURNING (xmax = 0) AS inserted
+`
+
+type UpsertTaskParams struct {
+	ID          uuid.UUID
+	Description string
+	Priority    Priority
+	StartDate   sql.NullTime
+	DueDate     sql.NullTime
+	TimeZone    string
+	ProjectID   uuid.NullUUID
+	Done        bool
+}
+
+func (q *Queries) UpsertTask(ctx context.Context, arg UpsertTaskParams) (bool, error) {
+	row := q.db.QueryRow(ctx, UpsertTask,
+		arg.ID,
+		arg.Description,
+		arg.Priority,
+		arg.StartDate,
+		arg.DueDate,
+		arg.TimeZone,
+		arg.ProjectID,
+		arg.Done,
+	)
+	var inserted bool
+	err := row.Scan(&inserted)
+	return inserted, err
+}
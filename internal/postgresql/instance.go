@@ -0,0 +1,99 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// Instance represents the repository used for interacting with the heartbeats of the instances.
+type Instance struct {
+	q *db.Queries
+}
+
+// NewInstance instantiates the Instance repository.
+func NewInstance(d db.DBTX) *Instance {
+	return &Instance{
+		q: db.New(d),
+	}
+}
+
+// Delete removes the instance.
+func (i *Instance) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Instance.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if err := i.q.DeleteInstance(ctx, val); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete instance")
+	}
+
+	return nil
+}
+
+// List returns the instances seen after seenAfter, sorted by start time.
+func (i *Instance) List(ctx context.Context, seenAfter time.Time) ([]internal.Instance, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Instance.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	rows, err := i.q.SelectInstances(ctx, seenAfter)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select instances")
+	}
+
+	res := make([]internal.Instance, len(rows))
+
+	for j, row := range rows {
+		res[j] = internal.Instance{
+			ID:         row.ID.String(),
+			Version:    int(row.Version),
+			MinVersion: int(row.MinVersion),
+			StartedAt:  row.StartedAt.UTC(),
+			SeenAt:     row.SeenAt.UTC(),
+		}
+	}
+
+	return res, nil
+}
+
+// Save inserts the instance or replaces the existing one.
+func (i *Instance) Save(ctx context.Context, instance internal.Instance) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Instance.Save")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(instance.ID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if err := i.q.UpsertInstance(ctx, db.UpsertInstanceParams{
+		ID:         val,
+		Version:    int32(instance.Version),
+		MinVersion: int32(instance.MinVersion),
+		StartedAt:  instance.StartedAt,
+		SeenAt:     instance.SeenAt,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert instance")
+	}
+
+	return nil
+}
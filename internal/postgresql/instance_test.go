@@ -0,0 +1,59 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func TestInstance(t *testing.T) {
+	t.Parallel()
+
+	repo := postgresql.NewInstance(newDB(t))
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	current := internal.Instance{
+		ID:         "0f8fad5b-d9cb-469f-a165-70867728950e",
+		Version:    3,
+		MinVersion: 2,
+		StartedAt:  now.Add(-time.Hour),
+		SeenAt:     now,
+	}
+
+	gone := internal.Instance{
+		ID:         "44633fe3-b039-4fb3-a35f-a57fe3c906c7",
+		Version:    2,
+		MinVersion: 1,
+		StartedAt:  now.Add(-2 * time.Hour),
+		SeenAt:     now.Add(-time.Hour),
+	}
+
+	for _, instance := range []internal.Instance{current, gone} {
+		if err := repo.Save(context.Background(), instance); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+	}
+
+	actual, err := repo.List(context.Background(), now.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if expected := []internal.Instance{current}; !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+
+	if err := repo.Delete(context.Background(), current.ID); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if actual, err = repo.List(context.Background(), now.Add(-time.Minute)); err != nil || len(actual) != 0 {
+		t.Fatalf("expected no instances, got %v %v", actual, err)
+	}
+}
@@ -0,0 +1,119 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// IssueLink represents the repository used for interacting with IssueLink records.
+type IssueLink struct {
+	q *db.Queries
+}
+
+// NewIssueLink instantiates the IssueLink repository.
+func NewIssueLink(d db.DBTX) *IssueLink {
+	return &IssueLink{
+		q: db.New(d),
+	}
+}
+
+// Claim indicates whether the Issue was not imported yet, claiming it; only one of the instances claiming the same
+// Issue at the same time succeeds.
+func (i *IssueLink) Claim(ctx context.Context, repository string, number int) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.Claim")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	n, err := i.q.ClaimIssueLink(ctx, db.ClaimIssueLinkParams{
+		Repository: repository,
+		Number:     int32(number),
+	})
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "claim issue link")
+	}
+
+	return n > 0, nil
+}
+
+// FindByTask returns the record matching the Task id.
+func (i *IssueLink) FindByTask(ctx context.Context, taskID string) (internal.IssueLink, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.FindByTask")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(taskID)
+	if err != nil {
+		return internal.IssueLink{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := i.q.SelectIssueLinkByTask(ctx, uuid.NullUUID{UUID: val, Valid: true})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.IssueLink{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "issue link not found")
+		}
+
+		return internal.IssueLink{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select issue link")
+	}
+
+	return internal.IssueLink{
+		Repository: res.Repository,
+		Number:     int(res.Number),
+		TaskID:     res.TaskID.UUID.String(),
+		Closed:     res.Closed,
+	}, nil
+}
+
+// Release deletes the claim of the Issue, so it's imported again.
+func (i *IssueLink) Release(ctx context.Context, repository string, number int) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.Release")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if err := i.q.DeleteIssueLink(ctx, db.DeleteIssueLinkParams{
+		Repository: repository,
+		Number:     int32(number),
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete issue link")
+	}
+
+	return nil
+}
+
+// Save inserts the record or replaces the existing one.
+func (i *IssueLink) Save(ctx context.Context, link internal.IssueLink) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueLink.Save")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(link.TaskID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if err := i.q.UpsertIssueLink(ctx, db.UpsertIssueLinkParams{
+		Repository: link.Repository,
+		Number:     int32(link.Number),
+		TaskID:     uuid.NullUUID{UUID: val, Valid: true},
+		Closed:     link.Closed,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert issue link")
+	}
+
+	return nil
+}
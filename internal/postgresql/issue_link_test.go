@@ -0,0 +1,16 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestIssueLink_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.IssueLinkRepository(t, func(tb testing.TB) storetesting.IssueLinkStore {
+		return postgresql.NewIssueLink(newDB(tb))
+	})
+}
@@ -0,0 +1,53 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+)
+
+// ObservePool exports the statistics of the connection pool as metrics, those are read every time metrics are
+// collected.
+func ObservePool(pool *pgxpool.Pool) {
+	var (
+		acquired    metric.Int64ValueObserver
+		idle        metric.Int64ValueObserver
+		total       metric.Int64ValueObserver
+		maxConns    metric.Int64ValueObserver
+		acquires    metric.Int64SumObserver
+		waited      metric.Int64SumObserver
+		waitSeconds metric.Float64SumObserver
+	)
+
+	batch := metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/postgresql")).NewBatchObserver(
+		func(_ context.Context, result metric.BatchObserverResult) {
+			stat := pool.Stat()
+
+			result.Observe(nil,
+				acquired.Observation(int64(stat.AcquiredConns())),
+				idle.Observation(int64(stat.IdleConns())),
+				total.Observation(int64(stat.TotalConns())),
+				maxConns.Observation(int64(stat.MaxConns())),
+				acquires.Observation(stat.AcquireCount()),
+				waited.Observation(stat.EmptyAcquireCount()),
+				waitSeconds.Observation(stat.AcquireDuration().Seconds()),
+			)
+		})
+
+	acquired = batch.NewInt64ValueObserver("db.pool.acquired_conns",
+		metric.WithDescription("Number of connections currently in use"))
+	idle = batch.NewInt64ValueObserver("db.pool.idle_conns",
+		metric.WithDescription("Number of idle connections"))
+	total = batch.NewInt64ValueObserver("db.pool.total_conns",
+		metric.WithDescription("Number of connections, including the ones being established"))
+	maxConns = batch.NewInt64ValueObserver("db.pool.max_conns",
+		metric.WithDescription("Maximum number of connections"))
+	acquires = batch.NewInt64SumObserver("db.pool.acquires",
+		metric.WithDescription("Number of connections acquired"))
+	waited = batch.NewInt64SumObserver("db.pool.acquires_waited",
+		metric.WithDescription("Number of connections acquired after waiting for one to be available"))
+	waitSeconds = batch.NewFloat64SumObserver("db.pool.acquire_wait",
+		metric.WithDescription("Time in seconds spent acquiring connections"))
+}
@@ -1,16 +1,54 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/MarioCarrion/todo-api/internal"
 	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
 )
 
 //go:generate sqlc generate
 
+// exactCountLimit is the number of records up to which totals are counted, larger tables use the estimate of the
+// planner because counting requires scanning all of them.
+const exactCountLimit = 100_000
+
+// withTotal sets the total number of records in the table to the results, when requested.
+func withTotal(ctx context.Context, q *db.Queries, params internal.ListParams, res internal.ListResults,
+	table string, count func(context.Context) (int64, error)) (internal.ListResults, error) {
+	if !params.Total {
+		return res, nil
+	}
+
+	// Estimates include all the records in the table, so filtered records are always counted.
+	if params.Due == nil && params.ProjectID == "" {
+		estimate, err := q.EstimateRecords(ctx, table)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "estimate records")
+		}
+
+		if estimate >= exactCountLimit {
+			res.Total = estimate
+			res.TotalEstimated = true
+
+			return res, nil
+		}
+	}
+
+	var err error
+
+	if res.Total, err = count(ctx); err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "count records")
+	}
+
+	return res, nil
+}
+
 func convertPriority(priority db.Priority) (internal.Priority, error) {
 	switch priority {
 	case db.PriorityNone:
@@ -33,6 +71,85 @@ func newNullTime(t time.Time) sql.NullTime {
 	}
 }
 
+// completedAt returns the time the task was completed, zero when it's not done: older versions don't clear it when
+// reopening tasks.
+func completedAt(done bool, t sql.NullTime) time.Time {
+	if !done || !t.Valid {
+		return time.Time{}
+	}
+
+	return t.Time.UTC()
+}
+
+// newDueFilter returns the values used for selecting tasks by due date, in UTC like the stored ones; unbounded ends
+// use dates no task is due at.
+func newDueFilter(r *internal.DueRange) (bool, time.Time, time.Time) {
+	if r == nil {
+		return false, time.Time{}, time.Time{}
+	}
+
+	from := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !r.From.IsZero() {
+		from = r.From.UTC()
+	}
+
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if !r.To.IsZero() {
+		to = r.To.UTC()
+	}
+
+	return true, from, to
+}
+
+// newTimeRangeFilter returns the arguments used for filtering tasks by creation or update time, unbounded ends use
+// the extreme times.
+func newTimeRangeFilter(r *internal.TimeRange) (bool, time.Time, time.Time) {
+	if r == nil {
+		return false, time.Time{}, time.Time{}
+	}
+
+	from := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	if !r.From.IsZero() {
+		from = r.From.UTC()
+	}
+
+	to := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	if !r.To.IsZero() {
+		to = r.To.UTC()
+	}
+
+	return true, from, to
+}
+
+// newNullUUID returns the value used for storing the id of the project, empty when the task does not belong to one.
+func newNullUUID(id string) (uuid.NullUUID, error) {
+	if id == "" {
+		return uuid.NullUUID{}, nil
+	}
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return uuid.NullUUID{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid project uuid")
+	}
+
+	return uuid.NullUUID{UUID: val, Valid: true}, nil
+}
+
+// newProjectFilter returns the values used for selecting tasks by project, invalid ids match no tasks because
+// projects always use valid ones.
+func newProjectFilter(id string) (bool, uuid.UUID) {
+	if id == "" {
+		return false, uuid.UUID{}
+	}
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return true, uuid.Nil
+	}
+
+	return true, val
+}
+
 func newPriority(p internal.Priority) db.Priority {
 	switch p {
 	case internal.PriorityNone:
@@ -49,3 +166,37 @@ func newPriority(p internal.Priority) db.Priority {
 
 	return "invalid"
 }
+
+//nolint: lll
+func newTask(id uuid.UUID, description string, priority db.Priority, start, due sql.NullTime, timeZone string, projectID uuid.NullUUID, done bool) (internal.Task, error) {
+	p, err := convertPriority(priority)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "convert priority")
+	}
+
+	return internal.Task{
+		ID:          id.String(),
+		Description: description,
+		Priority:    p,
+		Dates: internal.Dates{
+			Start:    start.Time,
+			Due:      due.Time,
+			TimeZone: timeZone,
+		},
+		ProjectID: nullUUIDString(projectID),
+		IsDone:    done,
+	}, nil
+}
+
+func nullUUIDString(id uuid.NullUUID) string {
+	if !id.Valid {
+		return ""
+	}
+
+	return id.UUID.String()
+}
+
+// wrapErrorf returns a wrapped error caused by PostgreSQL, see internal.WrapDependencyErrorf.
+func wrapErrorf(orig error, code internal.ErrorCode, format string, a ...interface{}) error {
+	return internal.WrapDependencyErrorf(orig, internal.DependencyPostgreSQL, code, format, a...)
+}
@@ -0,0 +1,149 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// Project represents the repository used for interacting with Project records.
+type Project struct {
+	q *db.Queries
+}
+
+// NewProject instantiates the Project repository.
+func NewProject(d db.DBTX) *Project {
+	return &Project{
+		q: db.New(d),
+	}
+}
+
+// Create inserts a new project record, the id is assigned by the database.
+func (p *Project) Create(ctx context.Context, name string) (internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	id, err := p.q.InsertProject(ctx, name)
+	if err != nil {
+		return internal.Project{}, wrapErrorf(err, internal.ErrorCodeUnknown, "insert project")
+	}
+
+	return internal.Project{
+		ID:   id.String(),
+		Name: name,
+	}, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (p *Project) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if _, err := p.q.DeleteProject(ctx, val); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "project not found")
+		}
+
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete project")
+	}
+
+	return nil
+}
+
+// Find returns the requested project by searching its id.
+func (p *Project) Find(ctx context.Context, id string) (internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := p.q.SelectProject(ctx, val)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "project not found")
+		}
+
+		return internal.Project{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select project")
+	}
+
+	return internal.Project{
+		ID:   res.ID.String(),
+		Name: res.Name,
+	}, nil
+}
+
+// List returns all the projects sorted by creation time.
+func (p *Project) List(ctx context.Context) ([]internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	rows, err := p.q.SelectProjects(ctx)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select projects")
+	}
+
+	res := make([]internal.Project, len(rows))
+
+	for i, row := range rows {
+		res[i] = internal.Project{
+			ID:   row.ID.String(),
+			Name: row.Name,
+		}
+	}
+
+	return res, nil
+}
+
+// Update updates the existing record with new values.
+func (p *Project) Update(ctx context.Context, id string, name string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Update")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if _, err := p.q.UpdateProject(ctx, db.UpdateProjectParams{
+		ID:   val,
+		Name: name,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "project not found")
+		}
+
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "update project")
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestProject_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.ProjectRepository(t, func(tb testing.TB) service.ProjectRepository {
+		return postgresql.NewProject(newDB(tb))
+	})
+}
@@ -0,0 +1,129 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// PushSubscription represents the repository used for interacting with PushSubscription records and the reminders
+// already delivered to them.
+type PushSubscription struct {
+	q *db.Queries
+}
+
+// NewPushSubscription instantiates the PushSubscription repository.
+func NewPushSubscription(d db.DBTX) *PushSubscription {
+	return &PushSubscription{
+		q: db.New(d),
+	}
+}
+
+// ClaimReminder indicates whether the reminder of the Task due at due was not delivered yet, claiming it; only one
+// of the instances claiming the same reminder at the same time succeeds.
+func (p *PushSubscription) ClaimReminder(ctx context.Context, taskID string, due time.Time) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.ClaimReminder")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(taskID)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	n, err := p.q.ClaimPushReminder(ctx, db.ClaimPushReminderParams{
+		TaskID: val,
+		Due:    due,
+	})
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "claim push reminder")
+	}
+
+	return n > 0, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (p *PushSubscription) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if _, err := p.q.DeletePushSubscription(ctx, val); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "push subscription not found")
+		}
+
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete push subscription")
+	}
+
+	return nil
+}
+
+// List returns all the records, sorted by id.
+func (p *PushSubscription) List(ctx context.Context) ([]internal.PushSubscription, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	rows, err := p.q.SelectPushSubscriptions(ctx)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select push subscriptions")
+	}
+
+	res := make([]internal.PushSubscription, len(rows))
+
+	for i, row := range rows {
+		res[i] = internal.PushSubscription{
+			ID:       row.ID.String(),
+			Endpoint: row.Endpoint,
+			P256dh:   row.P256dh,
+			Auth:     row.Auth,
+		}
+	}
+
+	return res, nil
+}
+
+// Save inserts the record or replaces the existing one.
+func (p *PushSubscription) Save(ctx context.Context, sub internal.PushSubscription) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.Save")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(sub.ID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if err := p.q.UpsertPushSubscription(ctx, db.UpsertPushSubscriptionParams{
+		ID:       val,
+		Endpoint: sub.Endpoint,
+		P256dh:   sub.P256dh,
+		Auth:     sub.Auth,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert push subscription")
+	}
+
+	return nil
+}
@@ -0,0 +1,16 @@
+package postgresql_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestPushSubscription_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.PushSubscriptionRepository(t, func(tb testing.TB) storetesting.PushSubscriptionStore {
+		return postgresql.NewPushSubscription(newDB(tb))
+	})
+}
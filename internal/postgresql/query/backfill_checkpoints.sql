@@ -0,0 +1,33 @@
+-- name: SelectBackfillCheckpoint :one
+SELECT
+  name,
+  cursor,
+  processed,
+  done,
+  updated_at
+FROM
+  backfill_checkpoints
+WHERE
+  name = @name
+LIMIT 1;
+
+-- name: UpsertBackfillCheckpoint :exec
+INSERT INTO backfill_checkpoints (
+  name,
+  cursor,
+  processed,
+  done,
+  updated_at
+)
+VALUES (
+  @name,
+  @cursor,
+  @processed,
+  @done,
+  @updated_at
+)
+ON CONFLICT (name) DO UPDATE SET
+  cursor     = EXCLUDED.cursor,
+  processed  = EXCLUDED.processed,
+  done       = EXCLUDED.done,
+  updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,64 @@
+-- name: SelectCalendarLinkByTask :one
+SELECT
+  task_id,
+  event_id,
+  fingerprint
+FROM
+  calendar_links
+WHERE
+  task_id = @task_id
+LIMIT 1;
+
+-- name: SelectCalendarLinkByEvent :one
+SELECT
+  task_id,
+  event_id,
+  fingerprint
+FROM
+  calendar_links
+WHERE
+  event_id = @event_id
+LIMIT 1;
+
+-- name: UpsertCalendarLink :exec
+INSERT INTO calendar_links (
+  task_id,
+  event_id,
+  fingerprint
+)
+VALUES (
+  @task_id,
+  @event_id,
+  @fingerprint
+)
+ON CONFLICT (task_id) DO UPDATE SET
+  event_id    = EXCLUDED.event_id,
+  fingerprint = EXCLUDED.fingerprint;
+
+-- name: DeleteCalendarLink :one
+DELETE FROM
+  calendar_links
+WHERE
+  task_id = @task_id
+RETURNING task_id AS res;
+
+-- name: SelectCalendarSyncToken :one
+SELECT
+  token
+FROM
+  calendar_sync_tokens
+WHERE
+  calendar_id = @calendar_id
+LIMIT 1;
+
+-- name: UpsertCalendarSyncToken :exec
+INSERT INTO calendar_sync_tokens (
+  calendar_id,
+  token
+)
+VALUES (
+  @calendar_id,
+  @token
+)
+ON CONFLICT (calendar_id) DO UPDATE SET
+  token = EXCLUDED.token;
@@ -0,0 +1,39 @@
+-- name: SelectInstances :many
+SELECT
+  id,
+  version,
+  min_version,
+  started_at,
+  seen_at
+FROM
+  instances
+WHERE
+  seen_at > @seen_after
+ORDER BY
+  started_at, id;
+
+-- name: UpsertInstance :exec
+INSERT INTO instances (
+  id,
+  version,
+  min_version,
+  started_at,
+  seen_at
+)
+VALUES (
+  @id,
+  @version,
+  @min_version,
+  @started_at,
+  @seen_at
+)
+ON CONFLICT (id) DO UPDATE SET
+  version     = EXCLUDED.version,
+  min_version = EXCLUDED.min_version,
+  seen_at     = EXCLUDED.seen_at;
+
+-- name: DeleteInstance :exec
+DELETE FROM
+  instances
+WHERE
+  id = @id;
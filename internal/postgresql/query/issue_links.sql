@@ -0,0 +1,46 @@
+-- name: ClaimIssueLink :execrows
+INSERT INTO issue_links (
+  repository,
+  number
+)
+VALUES (
+  @repository,
+  @number
+)
+ON CONFLICT (repository, number) DO NOTHING;
+
+-- name: DeleteIssueLink :exec
+DELETE FROM
+  issue_links
+WHERE
+  repository = @repository AND
+  number = @number;
+
+-- name: SelectIssueLinkByTask :one
+SELECT
+  repository,
+  number,
+  task_id,
+  closed
+FROM
+  issue_links
+WHERE
+  task_id = @task_id
+LIMIT 1;
+
+-- name: UpsertIssueLink :exec
+INSERT INTO issue_links (
+  repository,
+  number,
+  task_id,
+  closed
+)
+VALUES (
+  @repository,
+  @number,
+  @task_id,
+  @closed
+)
+ON CONFLICT (repository, number) DO UPDATE SET
+  task_id = EXCLUDED.task_id,
+  closed  = EXCLUDED.closed;
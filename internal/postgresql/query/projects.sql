@@ -0,0 +1,40 @@
+-- name: SelectProject :one
+SELECT
+  id,
+  name
+FROM
+  projects
+WHERE
+  id = @id
+LIMIT 1;
+
+-- name: SelectProjects :many
+SELECT
+  id,
+  name
+FROM
+  projects
+ORDER BY
+  created_at, id;
+
+-- name: InsertProject :one
+INSERT INTO projects (
+  name
+)
+VALUES (
+  @name
+)
+RETURNING id;
+
+-- name: UpdateProject :one
+UPDATE projects SET
+  name = @name
+WHERE id = @id
+RETURNING id AS res;
+
+-- name: DeleteProject :one
+DELETE FROM
+  projects
+WHERE
+  id = @id
+RETURNING id AS res;
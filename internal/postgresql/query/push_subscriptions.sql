@@ -0,0 +1,49 @@
+-- name: SelectPushSubscriptions :many
+SELECT
+  id,
+  endpoint,
+  p256dh,
+  auth
+FROM
+  push_subscriptions
+ORDER BY
+  id;
+
+-- name: UpsertPushSubscription :exec
+INSERT INTO push_subscriptions (
+  id,
+  endpoint,
+  p256dh,
+  auth
+)
+VALUES (
+  @id,
+  @endpoint,
+  @p256dh,
+  @auth
+)
+ON CONFLICT (id) DO UPDATE SET
+  endpoint = EXCLUDED.endpoint,
+  p256dh   = EXCLUDED.p256dh,
+  auth     = EXCLUDED.auth;
+
+-- name: DeletePushSubscription :one
+DELETE FROM
+  push_subscriptions
+WHERE
+  id = @id
+RETURNING id AS res;
+
+-- name: ClaimPushReminder :execrows
+INSERT INTO push_reminders (
+  task_id,
+  due
+)
+VALUES (
+  @task_id,
+  @due
+)
+ON CONFLICT (task_id) DO UPDATE SET
+  due = EXCLUDED.due
+WHERE
+  push_reminders.due <> EXCLUDED.due;
@@ -0,0 +1,2 @@
+-- name: EstimateRecords :one
+SELECT estimate_records(@table_name::TEXT)::BIGINT AS estimate;
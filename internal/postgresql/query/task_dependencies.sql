@@ -0,0 +1,38 @@
+-- name: SelectTaskBlockers :many
+SELECT
+  blocker_id
+FROM
+  task_dependencies
+WHERE
+  blocked_id = @id
+ORDER BY
+  blocker_id;
+
+-- name: SelectTaskBlocked :many
+SELECT
+  blocked_id
+FROM
+  task_dependencies
+WHERE
+  blocker_id = @id
+ORDER BY
+  blocked_id;
+
+-- name: InsertTaskDependency :exec
+INSERT INTO task_dependencies (
+  blocked_id,
+  blocker_id
+)
+VALUES (
+  @blocked_id,
+  @blocker_id
+)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTaskDependency :one
+DELETE FROM
+  task_dependencies
+WHERE
+  blocked_id = @blocked_id AND
+  blocker_id = @blocker_id
+RETURNING blocked_id AS res;
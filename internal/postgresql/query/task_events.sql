@@ -0,0 +1,132 @@
+-- name: InsertTaskStream :exec
+INSERT INTO task_streams (
+  id,
+  version
+)
+VALUES (
+  @id,
+  0
+);
+
+-- name: SelectTaskStreamForUpdate :one
+SELECT
+  id,
+  version,
+  deleted
+FROM
+  task_streams
+WHERE
+  id = @id
+FOR UPDATE;
+
+-- name: UpdateTaskStream :exec
+UPDATE task_streams SET
+  version = @version,
+  deleted = @deleted
+WHERE id = @id;
+
+-- name: SelectTaskStreams :many
+SELECT
+  id,
+  created_at
+FROM
+  task_streams
+WHERE
+  NOT deleted AND
+  (created_at, id) > (@created_at::TIMESTAMP, @id::UUID)
+ORDER BY
+  created_at, id
+LIMIT @size;
+
+-- name: InsertTaskEvent :exec
+INSERT INTO task_events (
+  task_id,
+  version,
+  type,
+  data
+)
+VALUES (
+  @task_id,
+  @version,
+  @type,
+  @data
+);
+
+-- name: SelectTaskSnapshots :many
+SELECT
+  task_id,
+  version,
+  data
+FROM
+  task_snapshots
+WHERE
+  task_id = ANY(@ids::UUID[]);
+
+-- name: SelectTaskEventsAfterSnapshots :many
+SELECT
+  e.task_id,
+  e.version,
+  e.type,
+  e.data
+FROM
+  task_events e
+LEFT JOIN
+  task_snapshots s ON s.task_id = e.task_id
+WHERE
+  e.task_id = ANY(@ids::UUID[]) AND
+  e.version > COALESCE(s.version, 0)
+ORDER BY
+  e.task_id, e.version;
+
+-- name: UpsertTaskSnapshot :exec
+INSERT INTO task_snapshots (
+  task_id,
+  version,
+  data
+)
+VALUES (
+  @task_id,
+  @version,
+  @data
+)
+ON CONFLICT (task_id) DO UPDATE SET
+  version    = EXCLUDED.version,
+  data       = EXCLUDED.data,
+  created_at = NOW() AT TIME ZONE 'utc';
+
+-- name: BackfillTaskStream :execrows
+INSERT INTO task_streams (
+  id,
+  version,
+  created_at
+)
+VALUES (
+  @id,
+  1,
+  @created_at
+)
+ON CONFLICT (id) DO NOTHING;
+
+-- name: CountTaskStreams :one
+SELECT
+  COUNT(*)
+FROM
+  task_streams
+WHERE
+  NOT deleted;
+
+-- name: SelectTaskEventsBetween :many
+SELECT
+  task_id,
+  version,
+  type,
+  data,
+  created_at
+FROM
+  task_events
+WHERE
+  created_at < @to_created_at::TIMESTAMP AND
+  (created_at, task_id, version) > (@created_at::TIMESTAMP, @task_id::UUID, @version::BIGINT)
+ORDER BY
+  created_at, task_id, version
+LIMIT @size;
@@ -5,7 +5,13 @@ SELECT
   priority,
   start_date,
   due_date,
-  done
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  version,
+  created_at,
+  updated_at
 FROM
   tasks
 WHERE
@@ -17,24 +23,58 @@ INSERT INTO tasks (
   description,
   priority,
   start_date,
-  due_date
+  due_date,
+  time_zone,
+  project_id
 )
 VALUES (
   @description,
   @priority,
   @start_date,
-  @due_date
+  @due_date,
+  @time_zone,
+  @project_id
 )
-RETURNING id;
+RETURNING id, created_at, updated_at;
+
+-- name: InsertTaskWithID :one
+INSERT INTO tasks (
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id
+)
+VALUES (
+  @id,
+  @description,
+  @priority,
+  @start_date,
+  @due_date,
+  @time_zone,
+  @project_id
+)
+RETURNING created_at, updated_at;
 
 -- name: UpdateTask :one
 UPDATE tasks SET
-  description = @description,
-  priority    = @priority,
-  start_date  = @start_date,
-  due_date    = @due_date,
-  done        = @done
-WHERE id = @id
+  description  = @description,
+  priority     = @priority,
+  start_date   = @start_date,
+  due_date     = @due_date,
+  time_zone    = @time_zone,
+  project_id   = @project_id,
+  done         = @done,
+  completed_at = CASE
+    WHEN NOT @done THEN NULL
+    WHEN done THEN completed_at
+    ELSE NOW()
+  END,
+  updated_at   = NOW() AT TIME ZONE 'utc',
+  version      = version + 1
+WHERE id = @id AND (@expected_version::BIGINT = 0 OR version = @expected_version::BIGINT)
 RETURNING id AS res;
 
 -- name: DeleteTask :one
@@ -43,3 +83,187 @@ DELETE FROM
 WHERE
   id = @id
 RETURNING id AS res;
+
+-- name: SelectTasks :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  version,
+  updated_at,
+  created_at
+FROM
+  tasks
+WHERE
+  (created_at, id) > (@created_at::TIMESTAMP, @id::UUID) AND
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+  (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+  (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP))
+ORDER BY
+  created_at, id
+LIMIT @size;
+
+-- name: SelectTasksByTime :many
+SELECT
+  matches.id,
+  matches.description,
+  matches.priority,
+  matches.start_date,
+  matches.due_date,
+  matches.time_zone,
+  matches.project_id,
+  matches.done,
+  matches.completed_at,
+  matches.version,
+  matches.created_at,
+  matches.updated_at,
+  matches.sorted_at
+FROM (
+  SELECT
+    id,
+    description,
+    priority,
+    start_date,
+    due_date,
+    time_zone,
+    project_id,
+    done,
+    completed_at,
+    version,
+    created_at,
+    updated_at,
+    (CASE WHEN @sort_updated::BOOLEAN THEN updated_at ELSE created_at END)::TIMESTAMP AS sorted_at
+  FROM
+    tasks
+  WHERE
+    (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+    (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+    (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+    (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP))
+) AS matches
+WHERE
+  (NOT @descending::BOOLEAN AND (sorted_at, id) > (@after_at::TIMESTAMP, @id::UUID)) OR
+  (@descending::BOOLEAN AND (sorted_at, id) < (@after_at::TIMESTAMP, @id::UUID))
+ORDER BY
+  CASE WHEN @descending::BOOLEAN THEN NULL ELSE sorted_at END,
+  CASE WHEN @descending::BOOLEAN THEN sorted_at END DESC,
+  CASE WHEN @descending::BOOLEAN THEN NULL ELSE id END,
+  CASE WHEN @descending::BOOLEAN THEN id END DESC
+LIMIT @size;
+
+-- name: SelectTasksByUrgency :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  version,
+  created_at,
+  updated_at,
+  (COALESCE(due_date AT TIME ZONE 'UTC', '9999-12-31'::TIMESTAMP) - CASE priority
+    WHEN 'high'   THEN INTERVAL '3 days'
+    WHEN 'medium' THEN INTERVAL '1 day'
+    ELSE INTERVAL '0'
+  END)::TIMESTAMP AS urgency_at
+FROM
+  tasks
+WHERE
+  (
+    done,
+    COALESCE(due_date AT TIME ZONE 'UTC', '9999-12-31'::TIMESTAMP) - CASE priority
+      WHEN 'high'   THEN INTERVAL '3 days'
+      WHEN 'medium' THEN INTERVAL '1 day'
+      ELSE INTERVAL '0'
+    END,
+    id
+  ) > (@done::BOOLEAN, @urgency_at::TIMESTAMP, @id::UUID) AND
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+  (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+  (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP))
+ORDER BY
+  done,
+  urgency_at,
+  id
+LIMIT @size;
+
+-- name: UpsertTask :one
+INSERT INTO tasks (
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at
+)
+VALUES (
+  @id,
+  @description,
+  @priority,
+  @start_date,
+  @due_date,
+  @time_zone,
+  @project_id,
+  @done,
+  CASE WHEN @done THEN NOW() END
+)
+ON CONFLICT (id) DO UPDATE SET
+  description  = EXCLUDED.description,
+  priority     = EXCLUDED.priority,
+  start_date   = EXCLUDED.start_date,
+  due_date     = EXCLUDED.due_date,
+  time_zone    = EXCLUDED.time_zone,
+  project_id   = EXCLUDED.project_id,
+  done         = EXCLUDED.done,
+  completed_at = CASE
+    WHEN NOT EXCLUDED.done THEN NULL
+    WHEN tasks.done THEN tasks.completed_at
+    ELSE NOW()
+  END,
+  updated_at   = NOW() AT TIME ZONE 'utc',
+  version      = tasks.version + 1
+RETURNING (xmax = 0) AS inserted;
+
+-- name: CountTasks :one
+SELECT
+  COUNT(*)
+FROM
+  tasks
+WHERE
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+  (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+  (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP));
+
+-- name: SelectTaskVersion :one
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  version
+FROM
+  task_versions
+WHERE
+  id = @id AND
+  version = @version
+LIMIT 1;
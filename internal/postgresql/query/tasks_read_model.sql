@@ -0,0 +1,179 @@
+-- name: SelectTaskReadModel :one
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  created_at,
+  updated_at
+FROM
+  tasks_read_model
+WHERE
+  id = @id
+LIMIT 1;
+
+-- name: SelectTasksReadModel :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  updated_at,
+  created_at
+FROM
+  tasks_read_model
+WHERE
+  (created_at, id) > (@created_at::TIMESTAMP, @id::UUID) AND
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+  (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+  (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP))
+ORDER BY
+  created_at, id
+LIMIT @size;
+
+-- name: SelectTasksReadModelByTime :many
+SELECT
+  matches.id,
+  matches.description,
+  matches.priority,
+  matches.start_date,
+  matches.due_date,
+  matches.time_zone,
+  matches.project_id,
+  matches.done,
+  matches.completed_at,
+  matches.created_at,
+  matches.updated_at,
+  matches.sorted_at
+FROM (
+  SELECT
+    id,
+    description,
+    priority,
+    start_date,
+    due_date,
+    time_zone,
+    project_id,
+    done,
+    completed_at,
+    created_at,
+    updated_at,
+    (CASE WHEN @sort_updated::BOOLEAN THEN updated_at ELSE created_at END)::TIMESTAMP AS sorted_at
+  FROM
+    tasks_read_model
+  WHERE
+    (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+    (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+    (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+    (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP))
+) AS matches
+WHERE
+  (NOT @descending::BOOLEAN AND (sorted_at, id) > (@after_at::TIMESTAMP, @id::UUID)) OR
+  (@descending::BOOLEAN AND (sorted_at, id) < (@after_at::TIMESTAMP, @id::UUID))
+ORDER BY
+  CASE WHEN @descending::BOOLEAN THEN NULL ELSE sorted_at END,
+  CASE WHEN @descending::BOOLEAN THEN sorted_at END DESC,
+  CASE WHEN @descending::BOOLEAN THEN NULL ELSE id END,
+  CASE WHEN @descending::BOOLEAN THEN id END DESC
+LIMIT @size;
+
+-- name: SelectTasksReadModelByUrgency :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  created_at,
+  updated_at,
+  urgency_at
+FROM
+  tasks_read_model
+WHERE
+  (done, urgency_at, id) > (@done::BOOLEAN, @urgency_at::TIMESTAMP, @id::UUID) AND
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+  (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+  (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP))
+ORDER BY
+  done,
+  urgency_at,
+  id
+LIMIT @size;
+
+-- name: UpsertTaskReadModel :exec
+INSERT INTO tasks_read_model (
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  completed_at,
+  created_at,
+  updated_at,
+  urgency_at
+)
+VALUES (
+  @id,
+  @description,
+  @priority,
+  @start_date,
+  @due_date,
+  @time_zone,
+  @project_id,
+  @done,
+  @completed_at,
+  @created_at,
+  @updated_at,
+  COALESCE(timezone('UTC', @due_date::TIMESTAMPTZ), '9999-12-31'::TIMESTAMP) - CASE @priority::priority
+    WHEN 'high'   THEN INTERVAL '3 days'
+    WHEN 'medium' THEN INTERVAL '1 day'
+    ELSE INTERVAL '0'
+  END
+)
+ON CONFLICT (id) DO UPDATE SET
+  description  = EXCLUDED.description,
+  priority     = EXCLUDED.priority,
+  start_date   = EXCLUDED.start_date,
+  due_date     = EXCLUDED.due_date,
+  time_zone    = EXCLUDED.time_zone,
+  project_id   = EXCLUDED.project_id,
+  done         = EXCLUDED.done,
+  completed_at = EXCLUDED.completed_at,
+  updated_at   = EXCLUDED.updated_at,
+  urgency_at   = EXCLUDED.urgency_at;
+
+-- name: DeleteTaskReadModel :exec
+DELETE FROM
+  tasks_read_model
+WHERE
+  id = @id;
+
+-- name: CountTasksReadModel :one
+SELECT
+  COUNT(*)
+FROM
+  tasks_read_model
+WHERE
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID) AND
+  (NOT @by_created::BOOLEAN OR (created_at >= @created_from::TIMESTAMP AND created_at < @created_to::TIMESTAMP)) AND
+  (NOT @by_updated::BOOLEAN OR (updated_at >= @updated_from::TIMESTAMP AND updated_at < @updated_to::TIMESTAMP));
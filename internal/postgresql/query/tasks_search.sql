@@ -0,0 +1,179 @@
+-- name: SearchTasks :many
+SELECT
+  matches.id,
+  matches.description,
+  matches.priority,
+  matches.start_date,
+  matches.due_date,
+  matches.time_zone,
+  matches.project_id,
+  matches.done,
+  matches.version,
+  matches.rank
+FROM (
+  SELECT
+    id,
+    description,
+    priority,
+    start_date,
+    due_date,
+    time_zone,
+    project_id,
+    done,
+    version,
+    -- Normalized by the number of words, shorter descriptions rank higher.
+    ts_rank(description_search, plainto_tsquery('simple', @description::TEXT), 1)::REAL AS rank
+  FROM
+    tasks
+  WHERE
+    (NOT @by_description::BOOLEAN OR description_search @@ plainto_tsquery('simple', @description::TEXT)) AND
+    (NOT @by_priority::BOOLEAN OR priority = @priority::priority) AND
+    (NOT @by_done::BOOLEAN OR done = @done::BOOLEAN) AND
+    (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+    (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID)
+) AS matches
+WHERE
+  rank < @rank::REAL OR (rank = @rank::REAL AND id > @id::UUID)
+ORDER BY
+  rank DESC,
+  id
+LIMIT @size
+OFFSET @skip;
+
+-- name: SearchTasksByTime :many
+SELECT
+  matches.id,
+  matches.description,
+  matches.priority,
+  matches.start_date,
+  matches.due_date,
+  matches.time_zone,
+  matches.project_id,
+  matches.done,
+  matches.completed_at,
+  matches.version,
+  matches.created_at,
+  matches.updated_at,
+  matches.sorted_at
+FROM (
+  SELECT
+    id,
+    description,
+    priority,
+    start_date,
+    due_date,
+    time_zone,
+    project_id,
+    done,
+    completed_at,
+    version,
+    created_at,
+    updated_at,
+    (CASE WHEN @sort_updated::BOOLEAN THEN updated_at ELSE created_at END)::TIMESTAMP AS sorted_at
+  FROM
+    tasks
+  WHERE
+    (NOT @by_description::BOOLEAN OR description_search @@ plainto_tsquery('simple', @description::TEXT)) AND
+    (NOT @by_priority::BOOLEAN OR priority = @priority::priority) AND
+    (NOT @by_done::BOOLEAN OR done = @done::BOOLEAN) AND
+    (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+    (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID)
+) AS matches
+WHERE
+  (NOT @descending::BOOLEAN AND (sorted_at, id) > (@after_at::TIMESTAMP, @id::UUID)) OR
+  (@descending::BOOLEAN AND (sorted_at, id) < (@after_at::TIMESTAMP, @id::UUID))
+ORDER BY
+  CASE WHEN @descending::BOOLEAN THEN NULL ELSE sorted_at END,
+  CASE WHEN @descending::BOOLEAN THEN sorted_at END DESC,
+  CASE WHEN @descending::BOOLEAN THEN NULL ELSE id END,
+  CASE WHEN @descending::BOOLEAN THEN id END DESC
+LIMIT @size
+OFFSET @skip;
+
+-- name: SearchTasksByUrgency :many
+SELECT
+  matches.id,
+  matches.description,
+  matches.priority,
+  matches.start_date,
+  matches.due_date,
+  matches.time_zone,
+  matches.project_id,
+  matches.done,
+  matches.version,
+  matches.urgency_at
+FROM (
+  SELECT
+    id,
+    description,
+    priority,
+    start_date,
+    due_date,
+    time_zone,
+    project_id,
+    done,
+    version,
+    (COALESCE(due_date AT TIME ZONE 'UTC', '9999-12-31'::TIMESTAMP) - CASE priority
+      WHEN 'high'   THEN INTERVAL '3 days'
+      WHEN 'medium' THEN INTERVAL '1 day'
+      ELSE INTERVAL '0'
+    END)::TIMESTAMP AS urgency_at
+  FROM
+    tasks
+  WHERE
+    (NOT @by_description::BOOLEAN OR description_search @@ plainto_tsquery('simple', @description::TEXT)) AND
+    (NOT @by_priority::BOOLEAN OR priority = @priority::priority) AND
+    (NOT @by_done::BOOLEAN OR done = @done::BOOLEAN) AND
+    (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+    (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID)
+) AS matches
+WHERE
+  (done, urgency_at, id) > (@after_done::BOOLEAN, @after_urgency_at::TIMESTAMP, @id::UUID)
+ORDER BY
+  done,
+  urgency_at,
+  id
+LIMIT @size
+OFFSET @skip;
+
+-- name: CountSearchTasks :one
+SELECT
+  COUNT(*)
+FROM
+  tasks
+WHERE
+  (NOT @by_description::BOOLEAN OR description_search @@ plainto_tsquery('simple', @description::TEXT)) AND
+  (NOT @by_priority::BOOLEAN OR priority = @priority::priority) AND
+  (NOT @by_done::BOOLEAN OR done = @done::BOOLEAN) AND
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID);
+
+-- name: SuggestTasks :many
+SELECT
+  id,
+  description
+FROM
+  tasks
+WHERE
+  description_search @@ to_tsquery('simple', @query::TEXT)
+ORDER BY
+  ts_rank(description_search, to_tsquery('simple', @query::TEXT), 1) DESC,
+  id
+LIMIT @size;
+
+-- name: CountSearchTasksFacets :many
+SELECT
+  priority,
+  done,
+  COUNT(*)
+FROM
+  tasks
+WHERE
+  (NOT @by_description::BOOLEAN OR description_search @@ plainto_tsquery('simple', @description::TEXT)) AND
+  (NOT @by_priority::BOOLEAN OR priority = @priority::priority) AND
+  (NOT @by_done::BOOLEAN OR done = @done::BOOLEAN) AND
+  (NOT @by_due::BOOLEAN OR (NOT done AND due_date >= @due_from::TIMESTAMPTZ AND due_date < @due_to::TIMESTAMPTZ)) AND
+  (NOT @by_project::BOOLEAN OR project_id = @project_id::UUID)
+GROUP BY
+  priority,
+  done;
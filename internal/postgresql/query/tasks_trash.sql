@@ -0,0 +1,81 @@
+-- name: SelectTrashedTask :one
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  deleted_at
+FROM
+  tasks_trash
+WHERE
+  id = @id
+LIMIT 1;
+
+-- name: SelectTrashedTasks :many
+SELECT
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  deleted_at
+FROM
+  tasks_trash
+WHERE
+  deleted_at < @deleted_at OR (deleted_at = @deleted_at AND id < @id)
+ORDER BY
+  deleted_at DESC, id DESC
+LIMIT @size;
+
+-- name: UpsertTrashedTask :exec
+INSERT INTO tasks_trash (
+  id,
+  description,
+  priority,
+  start_date,
+  due_date,
+  time_zone,
+  project_id,
+  done,
+  deleted_at
+)
+VALUES (
+  @id,
+  @description,
+  @priority,
+  @start_date,
+  @due_date,
+  @time_zone,
+  @project_id,
+  @done,
+  @deleted_at
+)
+ON CONFLICT (id) DO UPDATE SET
+  description = EXCLUDED.description,
+  priority    = EXCLUDED.priority,
+  start_date  = EXCLUDED.start_date,
+  due_date    = EXCLUDED.due_date,
+  time_zone   = EXCLUDED.time_zone,
+  project_id  = EXCLUDED.project_id,
+  done        = EXCLUDED.done,
+  deleted_at  = EXCLUDED.deleted_at;
+
+-- name: DeleteTrashedTask :one
+DELETE FROM
+  tasks_trash
+WHERE
+  id = @id
+RETURNING id AS res;
+
+-- name: PurgeTrashedTasks :execrows
+DELETE FROM
+  tasks_trash
+WHERE
+  deleted_at < @deleted_before;
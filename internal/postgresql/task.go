@@ -31,34 +31,80 @@ func (t *Task) Create(ctx context.Context, params internal.CreateParams) (intern
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
 
 	// XXX: `ID` and `IsDone` make no sense when creating new records, that's why those are ignored.
 	// XXX: We are intentionally NOT SUPPORTING `SubTasks` and `Categories` JUST YET.
 
-	newID, err := t.q.InsertTask(ctx, db.InsertTaskParams{
-		Description: params.Description,
-		Priority:    newPriority(params.Priority),
-		StartDate:   newNullTime(params.Dates.Start),
-		DueDate:     newNullTime(params.Dates.Due),
-	})
+	res, err := t.insert(ctx, params)
 	if err != nil {
-		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "insert task")
+		return internal.Task{}, err
 	}
 
 	return internal.Task{
-		ID:          newID.String(),
+		ID:          res.ID.String(),
 		Description: params.Description,
 		Priority:    params.Priority,
 		Dates:       params.Dates,
+		ProjectID:   params.ProjectID,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+		Version:     1,
 	}, nil
 }
 
+// insert inserts the record using the received id, the database assigns one when empty; it returns the id and
+// the times assigned by the database.
+func (t *Task) insert(ctx context.Context, params internal.CreateParams) (db.InsertTaskRow, error) {
+	projectID, err := newNullUUID(params.ProjectID)
+	if err != nil {
+		return db.InsertTaskRow{}, err
+	}
+
+	if params.ID == "" {
+		res, err := t.q.InsertTask(ctx, db.InsertTaskParams{
+			Description: params.Description,
+			Priority:    newPriority(params.Priority),
+			StartDate:   newNullTime(params.Dates.Start),
+			DueDate:     newNullTime(params.Dates.Due),
+			TimeZone:    params.Dates.TimeZone,
+			ProjectID:   projectID,
+		})
+		if err != nil {
+			return db.InsertTaskRow{}, wrapErrorf(err, internal.ErrorCodeUnknown, "insert task")
+		}
+
+		return res, nil
+	}
+
+	id, err := uuid.Parse(params.ID)
+	if err != nil {
+		return db.InsertTaskRow{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.q.InsertTaskWithID(ctx, db.InsertTaskWithIDParams{
+		ID:          id,
+		Description: params.Description,
+		Priority:    newPriority(params.Priority),
+		StartDate:   newNullTime(params.Dates.Start),
+		DueDate:     newNullTime(params.Dates.Due),
+		TimeZone:    params.Dates.TimeZone,
+		ProjectID:   projectID,
+	})
+	if err != nil {
+		return db.InsertTaskRow{}, wrapErrorf(err, internal.ErrorCodeUnknown, "insert task")
+	}
+
+	return db.InsertTaskRow{ID: id, CreatedAt: res.CreatedAt, UpdatedAt: res.UpdatedAt}, nil
+}
+
 // Delete deletes the existing record matching the id.
 func (t *Task) Delete(ctx context.Context, id string) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Delete")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
 
 	val, err := uuid.Parse(id)
 	if err != nil {
@@ -71,7 +117,7 @@ func (t *Task) Delete(ctx context.Context, id string) error {
 			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
 		}
 
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "delete task")
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete task")
 	}
 
 	return nil
@@ -83,6 +129,7 @@ func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
 
 	val, err := uuid.Parse(id)
 	if err != nil {
@@ -95,32 +142,64 @@ func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
 			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
 		}
 
-		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "select task")
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task")
 	}
 
-	priority, err := convertPriority(res.Priority)
+	task, err := newTask(res.ID, res.Description, res.Priority, res.StartDate, res.DueDate, res.TimeZone, res.ProjectID, res.Done)
 	if err != nil {
-		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "convert priority")
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
 	}
 
-	return internal.Task{
-		ID:          res.ID.String(),
-		Description: res.Description,
-		Priority:    priority,
-		Dates: internal.Dates{
-			Start: res.StartDate.Time,
-			Due:   res.DueDate.Time,
-		},
-		IsDone: res.Done,
-	}, nil
+	task.Version = res.Version
+	task.CompletedAt = completedAt(res.Done, res.CompletedAt)
+	task.CreatedAt, task.UpdatedAt = res.CreatedAt, res.UpdatedAt
+
+	return task, nil
+}
+
+// FindVersion returns a previous version of the task, only the latest 10 previous versions are kept.
+func (t *Task) FindVersion(ctx context.Context, id string, version int64) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.FindVersion")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.q.SelectTaskVersion(ctx, db.SelectTaskVersionParams{
+		ID:      val,
+		Version: version,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task version not found")
+		}
+
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task version")
+	}
+
+	task, err := newTask(res.ID, res.Description, res.Priority, res.StartDate, res.DueDate, res.TimeZone, res.ProjectID, res.Done)
+	if err != nil {
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+	}
+
+	task.Version = res.Version
+
+	return task, nil
 }
 
-// Update updates the existing record with new values.
-func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, isDone bool) error {
+// Update updates the existing record with new values, when ctx carries an expected version and it doesn't match
+// the one of the record an ErrorCodeConflict error is returned.
+func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Update")
 	span.SetAttributes(attribute.String("db.system", "postgresql"))
 
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
 
 	// XXX: We will revisit the number of received arguments in future episodes.
 	val, err := uuid.Parse(id)
@@ -128,20 +207,331 @@ func (t *Task) Update(ctx context.Context, id string, description string, priori
 		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
 	}
 
+	project, err := newNullUUID(projectID)
+	if err != nil {
+		return err
+	}
+
+	// Zero matches any version.
+	expected, _ := internal.ExpectedVersionFromContext(ctx)
+
 	if _, err := t.q.UpdateTask(ctx, db.UpdateTaskParams{
+		ID:              val,
+		Description:     description,
+		Priority:        newPriority(priority),
+		StartDate:       newNullTime(dates.Start),
+		DueDate:         newNullTime(dates.Due),
+		TimeZone:        dates.TimeZone,
+		ProjectID:       project,
+		Done:            isDone,
+		ExpectedVersion: expected,
+	}); err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "update task")
+		}
+
+		if expected == 0 {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		// The record may exist using a different version.
+		if _, err := t.q.SelectTask(ctx, val); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+			}
+
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "select task")
+		}
+
+		return internal.NewErrorf(internal.ErrorCodeConflict, "task version does not match %d", expected)
+	}
+
+	return nil
+}
+
+// Upsert inserts a new task record using the received id or replaces the existing one, it indicates whether the
+// record was inserted. When ctx carries an expected version the record must exist, see Update.
+//nolint: lll
+func (t *Task) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	if _, ok := internal.ExpectedVersionFromContext(ctx); ok {
+		if err := t.Update(ctx, id, description, priority, dates, projectID, isDone); err != nil {
+			return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "Update")
+		}
+
+		return false, nil
+	}
+
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Upsert")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	project, err := newNullUUID(projectID)
+	if err != nil {
+		return false, err
+	}
+
+	inserted, err := t.q.UpsertTask(ctx, db.UpsertTaskParams{
 		ID:          val,
 		Description: description,
 		Priority:    newPriority(priority),
 		StartDate:   newNullTime(dates.Start),
 		DueDate:     newNullTime(dates.Due),
+		TimeZone:    dates.TimeZone,
+		ProjectID:   project,
 		Done:        isDone,
-	}); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+	})
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "upsert task")
+	}
+
+	return inserted, nil
+}
+
+// List returns the tasks sorted by creation time, by urgency or by the requested time, the keyset used for
+// paginating the results is returned as an opaque cursor.
+func (t *Task) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if params.Sort == internal.SortUrgency {
+		res, err := t.listByUrgency(ctx, params)
+		if err != nil {
+			return internal.ListResults{}, err
 		}
 
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "update task")
+		return withTotal(ctx, t.q, params, res, "tasks", t.count(params))
 	}
 
-	return nil
+	if params.Sort.ByTime() {
+		res, err := t.listByTime(ctx, params)
+		if err != nil {
+			return internal.ListResults{}, err
+		}
+
+		return withTotal(ctx, t.q, params, res, "tasks", t.count(params))
+	}
+
+	after, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTasks(ctx, db.SelectTasksParams{
+		CreatedAt:   after.CreatedAt,
+		ID:          after.ID,
+		ByDue:       byDue,
+		DueFrom:     dueFrom,
+		DueTo:       dueTo,
+		ByProject:   byProject,
+		ProjectID:   projectID,
+		ByCreated:   byCreated,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		ByUpdated:   byUpdated,
+		UpdatedFrom: updatedFrom,
+		UpdatedTo:   updatedTo,
+		Size:        int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = cursor{CreatedAt: last.CreatedAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.Version = row.Version
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return withTotal(ctx, t.q, params, internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, "tasks", t.count(params))
+}
+
+// listByUrgency returns the pending tasks first, sorted by their urgency; urgency is the due date moved earlier
+// depending on the priority: three days for high and one day for medium, tasks without due date go last.
+func (t *Task) listByUrgency(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	after, err := decodeUrgencyCursor(params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeUrgencyCursor")
+	}
+
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTasksByUrgency(ctx, db.SelectTasksByUrgencyParams{
+		Done:        after.Done,
+		UrgencyAt:   after.UrgencyAt,
+		ID:          after.ID,
+		ByDue:       byDue,
+		DueFrom:     dueFrom,
+		DueTo:       dueTo,
+		ByProject:   byProject,
+		ProjectID:   projectID,
+		ByCreated:   byCreated,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		ByUpdated:   byUpdated,
+		UpdatedFrom: updatedFrom,
+		UpdatedTo:   updatedTo,
+		Size:        int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks by urgency")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = urgencyCursor{Done: last.Done, UrgencyAt: last.UrgencyAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.Version = row.Version
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+// listByTime returns the tasks sorted by their creation or update time, in ascending or descending order.
+func (t *Task) listByTime(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	after, err := decodeTimeCursor(params.Sort, params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeTimeCursor")
+	}
+
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTasksByTime(ctx, db.SelectTasksByTimeParams{
+		SortUpdated: params.Sort.Field() == string(internal.SortUpdated),
+		ByDue:       byDue,
+		DueFrom:     dueFrom,
+		DueTo:       dueTo,
+		ByProject:   byProject,
+		ProjectID:   projectID,
+		ByCreated:   byCreated,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		ByUpdated:   byUpdated,
+		UpdatedFrom: updatedFrom,
+		UpdatedTo:   updatedTo,
+		Descending:  params.Sort.Descending(),
+		AfterAt:     after.At,
+		ID:          after.ID,
+		Size:        int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks by time")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = timeCursor{Sort: params.Sort, At: last.SortedAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.Version = row.Version
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+// count returns the function counting the tasks matching the parameters.
+func (t *Task) count(params internal.ListParams) func(context.Context) (int64, error) {
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	return func(ctx context.Context) (int64, error) {
+		return t.q.CountTasks(ctx, db.CountTasksParams{
+			ByDue:       byDue,
+			DueFrom:     dueFrom,
+			DueTo:       dueTo,
+			ByProject:   byProject,
+			ProjectID:   projectID,
+			ByCreated:   byCreated,
+			CreatedFrom: createdFrom,
+			CreatedTo:   createdTo,
+			ByUpdated:   byUpdated,
+			UpdatedFrom: updatedFrom,
+			UpdatedTo:   updatedTo,
+		})
+	}
 }
@@ -0,0 +1,140 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// TaskDependency represents the repository used for interacting with the dependencies between Task records.
+type TaskDependency struct {
+	q *db.Queries
+}
+
+// NewTaskDependency instantiates the TaskDependency repository.
+func NewTaskDependency(d db.DBTX) *TaskDependency {
+	return &TaskDependency{
+		q: db.New(d),
+	}
+}
+
+// Blocked returns the ids of the tasks blocked by the task matching the id, sorted by id.
+func (t *TaskDependency) Blocked(ctx context.Context, id string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Blocked")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	ids, err := t.q.SelectTaskBlocked(ctx, val)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select blocked tasks")
+	}
+
+	return uuidStrings(ids), nil
+}
+
+// Blockers returns the ids of the tasks blocking the task matching the id, sorted by id.
+func (t *TaskDependency) Blockers(ctx context.Context, id string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Blockers")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	ids, err := t.q.SelectTaskBlockers(ctx, val)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select blocker tasks")
+	}
+
+	return uuidStrings(ids), nil
+}
+
+// Create inserts the dependency, existing ones are kept as they are.
+func (t *TaskDependency) Create(ctx context.Context, dep internal.TaskDependency) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	blocked, blocker, err := parseDependency(dep)
+	if err != nil {
+		return err
+	}
+
+	if err := t.q.InsertTaskDependency(ctx, db.InsertTaskDependencyParams{
+		BlockedID: blocked,
+		BlockerID: blocker,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "insert task dependency")
+	}
+
+	return nil
+}
+
+// Delete deletes the existing dependency.
+func (t *TaskDependency) Delete(ctx context.Context, dep internal.TaskDependency) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	blocked, blocker, err := parseDependency(dep)
+	if err != nil {
+		return err
+	}
+
+	if _, err := t.q.DeleteTaskDependency(ctx, db.DeleteTaskDependencyParams{
+		BlockedID: blocked,
+		BlockerID: blocker,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "dependency not found")
+		}
+
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete task dependency")
+	}
+
+	return nil
+}
+
+func parseDependency(dep internal.TaskDependency) (blocked uuid.UUID, blocker uuid.UUID, err error) {
+	if blocked, err = uuid.Parse(dep.BlockedID); err != nil {
+		return uuid.UUID{}, uuid.UUID{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if blocker, err = uuid.Parse(dep.BlockerID); err != nil {
+		return uuid.UUID{}, uuid.UUID{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	return blocked, blocker, nil
+}
+
+func uuidStrings(ids []uuid.UUID) []string {
+	res := make([]string, len(ids))
+
+	for i, id := range ids {
+		res[i] = id.String()
+	}
+
+	return res
+}
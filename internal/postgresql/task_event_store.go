@@ -0,0 +1,807 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+const (
+	taskEventCreated = "created"
+	taskEventUpdated = "updated"
+	taskEventDeleted = "deleted"
+)
+
+// taskState is the state of a task derived from its events, "created" events and snapshots include all the
+// fields while "updated" events only include the changed ones.
+//nolint: tagliatelle
+type taskState struct {
+	Description string      `json:"description"`
+	Priority    db.Priority `json:"priority"`
+	StartDate   *time.Time  `json:"start_date"`
+	DueDate     *time.Time  `json:"due_date"`
+	TimeZone    string      `json:"time_zone,omitempty"`
+	ProjectID   string      `json:"project_id,omitempty"`
+	Done        bool        `json:"done"`
+	CompletedAt *time.Time  `json:"completed_at,omitempty"`
+	CreatedAt   *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time  `json:"updated_at,omitempty"`
+}
+
+// TaskEventStore represents the repository used for interacting with Task records stored as an append-only stream
+// of events, the state of each task is derived from the latest snapshot and the events appended after it.
+type TaskEventStore struct {
+	pool          *pgxpool.Pool
+	q             *db.Queries
+	snapshotEvery int64
+}
+
+// NewTaskEventStore instantiates the TaskEventStore repository, a snapshot is saved every snapshotEvery events
+// appended to the same task.
+func NewTaskEventStore(pool *pgxpool.Pool, snapshotEvery int64) *TaskEventStore {
+	return &TaskEventStore{
+		pool:          pool,
+		q:             db.New(pool),
+		snapshotEvery: snapshotEvery,
+	}
+}
+
+// Create appends the event creating a new task.
+func (t *TaskEventStore) Create(ctx context.Context, params internal.CreateParams) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Create")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	id := uuid.New()
+
+	if params.ID != "" {
+		var err error
+
+		if id, err = uuid.Parse(params.ID); err != nil {
+			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+		}
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	if err := t.inTx(ctx, func(q *db.Queries) error {
+		if err := q.InsertTaskStream(ctx, id); err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "insert task stream")
+		}
+
+		state := newTaskState(params.Description, params.Priority, params.Dates, params.ProjectID, false)
+		state.CreatedAt, state.UpdatedAt = &now, &now
+
+		return t.append(ctx, q, id, 0, taskEventCreated, nil, state)
+	}); err != nil {
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "inTx")
+	}
+
+	return internal.Task{
+		ID:          id.String(),
+		Description: params.Description,
+		Priority:    params.Priority,
+		Dates:       params.Dates,
+		ProjectID:   params.ProjectID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Delete appends the event deleting the existing task matching the id.
+func (t *TaskEventStore) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	return t.inTx(ctx, func(q *db.Queries) error {
+		stream, err := selectTaskStream(ctx, q, val)
+		if err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "selectTaskStream")
+		}
+
+		return t.append(ctx, q, val, stream.Version, taskEventDeleted, nil, taskState{})
+	})
+}
+
+// Find returns the requested task by searching its id.
+func (t *TaskEventStore) Find(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	states, err := loadTaskStates(ctx, t.q, []uuid.UUID{val})
+	if err != nil {
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "loadTaskStates")
+	}
+
+	state, ok := states[val]
+	if !ok {
+		return internal.Task{}, internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return state.task(val)
+}
+
+// Update appends the event updating the existing task, nothing is appended when the values did not change.
+//nolint: lll
+func (t *TaskEventStore) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Update")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	return t.inTx(ctx, func(q *db.Queries) error {
+		stream, err := selectTaskStream(ctx, q, val)
+		if err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "selectTaskStream")
+		}
+
+		states, err := loadTaskStates(ctx, q, []uuid.UUID{val})
+		if err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "loadTaskStates")
+		}
+
+		current := states[val]
+
+		return t.append(ctx, q, val, stream.Version, taskEventUpdated, &current, newTaskState(description, priority, dates, projectID, isDone))
+	})
+}
+
+// Upsert appends the event creating the task using the received id, or updating it when it already exists; it
+// indicates whether the task was created.
+//nolint: lll
+func (t *TaskEventStore) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Upsert")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	var inserted bool
+
+	if err := t.inTx(ctx, func(q *db.Queries) error {
+		state := newTaskState(description, priority, dates, projectID, isDone)
+
+		stream, err := q.SelectTaskStreamForUpdate(ctx, val)
+
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			if err := q.InsertTaskStream(ctx, val); err != nil {
+				return wrapErrorf(err, internal.ErrorCodeUnknown, "insert task stream")
+			}
+
+			inserted = true
+		case err != nil:
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "select task stream")
+		case stream.Deleted:
+			// Deleted tasks are created again continuing their stream.
+			inserted = true
+		}
+
+		if inserted {
+			return t.append(ctx, q, val, stream.Version, taskEventCreated, nil, state)
+		}
+
+		states, err := loadTaskStates(ctx, q, []uuid.UUID{val})
+		if err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "loadTaskStates")
+		}
+
+		current := states[val]
+
+		return t.append(ctx, q, val, stream.Version, taskEventUpdated, &current, state)
+	}); err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "inTx")
+	}
+
+	return inserted, nil
+}
+
+// List returns the tasks sorted by creation time, the keyset used for paginating the results is returned as an
+// opaque cursor; sorting by urgency or time and filtering by due date, project or times are not supported, the read
+// model should be used for those instead.
+func (t *TaskEventStore) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if params.Sort == internal.SortUrgency {
+		return internal.ListResults{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "sorting by urgency is not supported")
+	}
+
+	if params.Sort.ByTime() {
+		return internal.ListResults{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "sorting by time is not supported")
+	}
+
+	if params.Due != nil {
+		return internal.ListResults{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "filtering by due date is not supported")
+	}
+
+	if params.ProjectID != "" {
+		return internal.ListResults{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "filtering by project is not supported")
+	}
+
+	if params.Created != nil || params.Updated != nil {
+		return internal.ListResults{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "filtering by creation or update time is not supported")
+	}
+
+	after, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTaskStreams(ctx, db.SelectTaskStreamsParams{
+		CreatedAt: after.CreatedAt,
+		ID:        after.ID,
+		Size:      int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task streams")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = cursor{CreatedAt: last.CreatedAt, ID: last.ID}.String()
+	}
+
+	ids := make([]uuid.UUID, len(rows))
+
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+
+	states, err := loadTaskStates(ctx, t.q, ids)
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "loadTaskStates")
+	}
+
+	tasks := make([]internal.Task, 0, len(ids))
+
+	for _, id := range ids {
+		// Tasks deleted after selecting the streams are skipped.
+		state, ok := states[id]
+		if !ok {
+			continue
+		}
+
+		task, err := state.task(id)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "state.task")
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return withTotal(ctx, t.q, params, internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, "task_streams", t.q.CountTaskStreams)
+}
+
+// Events calls fn with the events appended from "from" until "to", excluding it, sorted by the time they were
+// appended; events are read in batches of size.
+//nolint: lll
+func (t *TaskEventStore) Events(ctx context.Context, from, to time.Time, size int32, fn func(internal.TaskEventRecord) error) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Events")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	params := db.SelectTaskEventsBetweenParams{
+		ToCreatedAt: to,
+		CreatedAt:   from,
+		TaskID:      uuid.Nil,
+		Size:        size,
+	}
+
+	for {
+		rows, err := t.q.SelectTaskEventsBetween(ctx, params)
+		if err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "select task events between")
+		}
+
+		for _, row := range rows {
+			if err := fn(internal.TaskEventRecord{
+				TaskID:    row.TaskID.String(),
+				Version:   row.Version,
+				Type:      row.Type,
+				Data:      row.Data.Bytes,
+				CreatedAt: row.CreatedAt,
+			}); err != nil {
+				return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "fn")
+			}
+		}
+
+		if int32(len(rows)) < size {
+			return nil
+		}
+
+		last := rows[len(rows)-1]
+		params.CreatedAt, params.TaskID, params.Version = last.CreatedAt, last.TaskID, last.Version
+	}
+}
+
+// Backfill appends the events creating the tasks stored as rows that were not backfilled before, it's used when
+// switching to the event store and returns the number of backfilled tasks.
+func (t *TaskEventStore) Backfill(ctx context.Context, size int32) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.Backfill")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	var (
+		after cursor
+		total int64
+	)
+
+	for {
+		n, backfilled, last, err := t.backfill(ctx, after, size)
+		total += backfilled
+
+		if err != nil {
+			return total, wrapErrorf(err, internal.ErrorCodeUnknown, "backfill")
+		}
+
+		if n < size {
+			return total, nil
+		}
+
+		after = last
+	}
+}
+
+// BackfillStep is Backfill processing a single batch of tasks following the one indicated by the opaque cursor, it
+// returns the cursor of the last processed task, empty when all of them were processed; see backfill.Step.
+func (t *TaskEventStore) BackfillStep(ctx context.Context, after string, size int) (string, int, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskEventStore.BackfillStep")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	c, err := decodeCursor(after)
+	if err != nil {
+		return "", 0, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	n, _, last, err := t.backfill(ctx, c, int32(size))
+	if err != nil {
+		return "", 0, wrapErrorf(err, internal.ErrorCodeUnknown, "backfill")
+	}
+
+	if int(n) < size {
+		return "", int(n), nil
+	}
+
+	return last.String(), int(n), nil
+}
+
+// backfill appends the events creating the batch of tasks following after, it returns the number of tasks in the
+// batch, the number of backfilled ones and the cursor of the last one.
+func (t *TaskEventStore) backfill(ctx context.Context, after cursor, size int32) (int32, int64, cursor, error) {
+	rows, err := t.q.SelectTasks(ctx, db.SelectTasksParams{
+		CreatedAt: after.CreatedAt,
+		ID:        after.ID,
+		Size:      size,
+	})
+	if err != nil {
+		return 0, 0, cursor{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks")
+	}
+
+	var total int64
+
+	for _, row := range rows {
+		priority, err := convertPriority(row.Priority)
+		if err != nil {
+			return 0, total, cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "convert priority")
+		}
+
+		state := newTaskState(row.Description, priority, internal.Dates{
+			Start:    row.StartDate.Time,
+			Due:      row.DueDate.Time,
+			TimeZone: row.TimeZone,
+		}, nullUUIDString(row.ProjectID), row.Done)
+		state.CreatedAt, state.UpdatedAt = &row.CreatedAt, &row.UpdatedAt
+
+		if err := t.inTx(ctx, func(q *db.Queries) error {
+			n, err := q.BackfillTaskStream(ctx, db.BackfillTaskStreamParams{
+				ID:        row.ID,
+				CreatedAt: row.CreatedAt,
+			})
+			if err != nil {
+				return wrapErrorf(err, internal.ErrorCodeUnknown, "backfill task stream")
+			}
+
+			// The stream already exists, the task was backfilled or created using the event store.
+			if n == 0 {
+				return nil
+			}
+
+			total++
+
+			return insertTaskEvent(ctx, q, row.ID, 1, taskEventCreated, state)
+		}); err != nil {
+			return 0, total, cursor{}, wrapErrorf(err, internal.ErrorCodeUnknown, "inTx")
+		}
+	}
+
+	if len(rows) == 0 {
+		return 0, total, after, nil
+	}
+
+	last := rows[len(rows)-1]
+
+	return int32(len(rows)), total, cursor{CreatedAt: last.CreatedAt, ID: last.ID}, nil
+}
+
+// append appends the event to the task stream, "updated" events only include the values changed compared to
+// current; a snapshot is saved when the new version is a multiple of snapshotEvery.
+//nolint: lll
+func (t *TaskEventStore) append(ctx context.Context, q *db.Queries, id uuid.UUID, version int64, typ string, current *taskState, state taskState) error {
+	now := time.Now()
+
+	state = state.completed(current, now).stamped(current, now)
+
+	var data interface{} = state
+
+	switch typ {
+	case taskEventUpdated:
+		changes := current.changes(state)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		// The update time changes with every event, it's only included when something else changed.
+		changes["updated_at"] = state.UpdatedAt
+
+		data = changes
+	case taskEventDeleted:
+		data = struct{}{}
+	}
+
+	version++
+
+	if err := insertTaskEvent(ctx, q, id, version, typ, data); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "insertTaskEvent")
+	}
+
+	if err := q.UpdateTaskStream(ctx, db.UpdateTaskStreamParams{
+		ID:      id,
+		Version: version,
+		Deleted: typ == taskEventDeleted,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "update task stream")
+	}
+
+	if typ == taskEventDeleted || t.snapshotEvery <= 0 || version%t.snapshotEvery != 0 {
+		return nil
+	}
+
+	snapshot, err := newJSONB(state)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "newJSONB")
+	}
+
+	if err := q.UpsertTaskSnapshot(ctx, db.UpsertTaskSnapshotParams{
+		TaskID:  id,
+		Version: version,
+		Data:    snapshot,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert task snapshot")
+	}
+
+	return nil
+}
+
+func (t *TaskEventStore) inTx(ctx context.Context, fn func(q *db.Queries) error) error {
+	tx, err := t.pool.Begin(ctx)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "pool.Begin")
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(t.q.WithTx(tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "tx.Commit")
+	}
+
+	return nil
+}
+
+// loadTaskStates returns the current state of the tasks that exist, deleted tasks are not included.
+func loadTaskStates(ctx context.Context, q *db.Queries, ids []uuid.UUID) (map[uuid.UUID]taskState, error) {
+	snapshots, err := q.SelectTaskSnapshots(ctx, ids)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select task snapshots")
+	}
+
+	states := make(map[uuid.UUID]taskState, len(ids))
+
+	for _, snapshot := range snapshots {
+		var state taskState
+
+		if err := json.Unmarshal(snapshot.Data.Bytes, &state); err != nil {
+			return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.Unmarshal snapshot")
+		}
+
+		states[snapshot.TaskID] = state
+	}
+
+	events, err := q.SelectTaskEventsAfterSnapshots(ctx, ids)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select task events")
+	}
+
+	for _, event := range events {
+		switch event.Type {
+		case taskEventDeleted:
+			delete(states, event.TaskID)
+
+			continue
+		case taskEventCreated:
+			states[event.TaskID] = taskState{}
+		case taskEventUpdated:
+		default:
+			// Events appended by newer versions are skipped, those don't change the fields known to this one.
+			internal.SkipUnrecognizedTaskEvent(ctx, event.Type)
+
+			continue
+		}
+
+		state := states[event.TaskID]
+
+		// Unmarshaling only replaces the fields included in the event.
+		if err := json.Unmarshal(event.Data.Bytes, &state); err != nil {
+			return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "json.Unmarshal event")
+		}
+
+		states[event.TaskID] = state
+	}
+
+	return states, nil
+}
+
+// selectTaskStream locks the stream of the existing task matching the id.
+func selectTaskStream(ctx context.Context, q *db.Queries, id uuid.UUID) (db.SelectTaskStreamForUpdateRow, error) {
+	stream, err := q.SelectTaskStreamForUpdate(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.SelectTaskStreamForUpdateRow{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return db.SelectTaskStreamForUpdateRow{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task stream")
+	}
+
+	if stream.Deleted {
+		return db.SelectTaskStreamForUpdateRow{}, internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return stream, nil
+}
+
+func insertTaskEvent(ctx context.Context, q *db.Queries, id uuid.UUID, version int64, typ string, data interface{}) error {
+	b, err := newJSONB(data)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "newJSONB")
+	}
+
+	if err := q.InsertTaskEvent(ctx, db.InsertTaskEventParams{
+		TaskID:  id,
+		Version: version,
+		Type:    typ,
+		Data:    b,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "insert task event")
+	}
+
+	return nil
+}
+
+func newJSONB(v interface{}) (pgtype.JSONB, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return pgtype.JSONB{}, wrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	return pgtype.JSONB{Bytes: b, Status: pgtype.Present}, nil
+}
+
+func newTaskState(description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) taskState {
+	newTime := func(t time.Time) *time.Time {
+		if t.IsZero() {
+			return nil
+		}
+
+		t = t.UTC()
+
+		return &t
+	}
+
+	return taskState{
+		Description: description,
+		Priority:    newPriority(priority),
+		StartDate:   newTime(dates.Start),
+		DueDate:     newTime(dates.Due),
+		TimeZone:    dates.TimeZone,
+		ProjectID:   projectID,
+		Done:        isDone,
+	}
+}
+
+// completed returns the state including the time the task was completed: the current one when it was already
+// done, now when it's completed by this event and none when it's not done.
+func (s taskState) completed(current *taskState, now time.Time) taskState {
+	switch {
+	case !s.Done:
+		s.CompletedAt = nil
+	case current != nil && current.Done:
+		s.CompletedAt = current.CompletedAt
+	default:
+		now = now.UTC()
+		s.CompletedAt = &now
+	}
+
+	return s
+}
+
+// stamped returns the state including the creation and update times: the creation time is the current one and
+// the update time is now, unless those were already set.
+func (s taskState) stamped(current *taskState, now time.Time) taskState {
+	now = now.UTC().Truncate(time.Microsecond)
+
+	if current != nil {
+		s.CreatedAt = current.CreatedAt
+	} else if s.CreatedAt == nil {
+		s.CreatedAt = &now
+	}
+
+	if s.UpdatedAt == nil {
+		s.UpdatedAt = &now
+	}
+
+	return s
+}
+
+// changes returns the JSON fields that are different in state.
+func (s taskState) changes(state taskState) map[string]interface{} {
+	equalTime := func(a, b *time.Time) bool {
+		if a == nil || b == nil {
+			return a == b
+		}
+
+		return a.Equal(*b)
+	}
+
+	res := make(map[string]interface{})
+
+	if s.Description != state.Description {
+		res["description"] = state.Description
+	}
+
+	if s.Priority != state.Priority {
+		res["priority"] = state.Priority
+	}
+
+	if !equalTime(s.StartDate, state.StartDate) {
+		res["start_date"] = state.StartDate
+	}
+
+	if !equalTime(s.DueDate, state.DueDate) {
+		res["due_date"] = state.DueDate
+	}
+
+	if s.TimeZone != state.TimeZone {
+		res["time_zone"] = state.TimeZone
+	}
+
+	if s.ProjectID != state.ProjectID {
+		res["project_id"] = state.ProjectID
+	}
+
+	if s.Done != state.Done {
+		res["done"] = state.Done
+	}
+
+	if !equalTime(s.CompletedAt, state.CompletedAt) {
+		res["completed_at"] = state.CompletedAt
+	}
+
+	return res
+}
+
+func (s taskState) task(id uuid.UUID) (internal.Task, error) {
+	priority, err := convertPriority(s.Priority)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "convert priority")
+	}
+
+	dates := internal.Dates{TimeZone: s.TimeZone}
+
+	if s.StartDate != nil {
+		dates.Start = *s.StartDate
+	}
+
+	if s.DueDate != nil {
+		dates.Due = *s.DueDate
+	}
+
+	var completedAt, createdAt, updatedAt time.Time
+
+	if s.Done && s.CompletedAt != nil {
+		completedAt = *s.CompletedAt
+	}
+
+	if s.CreatedAt != nil {
+		createdAt = *s.CreatedAt
+	}
+
+	if s.UpdatedAt != nil {
+		updatedAt = *s.UpdatedAt
+	}
+
+	return internal.Task{
+		ID:          id.String(),
+		Description: s.Description,
+		Priority:    priority,
+		Dates:       dates,
+		ProjectID:   s.ProjectID,
+		IsDone:      s.Done,
+		CompletedAt: completedAt,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
@@ -0,0 +1,204 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func TestTaskEventStore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Create/Update/Find/List/Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		// Snapshots are saved every other event to exercise loading state from snapshots.
+		store := postgresql.NewTaskEventStore(newDB(t), 2)
+
+		due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+
+		task, err := store.Create(context.Background(), internal.CreateParams{
+			Description: "created",
+			Priority:    internal.PriorityLow,
+			Dates:       internal.Dates{Due: due},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		for _, desc := range []string{"updated 1", "updated 2", "updated 2"} {
+			if err := store.Update(context.Background(), task.ID, desc, internal.PriorityHigh, internal.Dates{}, "", true); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		expected := internal.Task{
+			ID:          task.ID,
+			Description: "updated 2",
+			Priority:    internal.PriorityHigh,
+			IsDone:      true,
+		}
+
+		actual, err := store.Find(context.Background(), task.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actual.CompletedAt.IsZero() || !actual.UpdatedAt.After(task.UpdatedAt) {
+			t.Fatalf("expected completion and update times, got %s and %s", actual.CompletedAt, actual.UpdatedAt)
+		}
+
+		expected.CompletedAt, expected.CreatedAt, expected.UpdatedAt = actual.CompletedAt, task.CreatedAt, actual.UpdatedAt
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+
+		res, err := store.List(context.Background(), internal.ListParams{Size: 10})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if expected := []internal.Task{expected}; !cmp.Equal(expected, res.Tasks) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Tasks))
+		}
+
+		if err := store.Delete(context.Background(), task.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		var ierr *internal.Error
+
+		_, err = store.Find(context.Background(), task.ID)
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		err = store.Update(context.Background(), task.ID, "deleted", internal.PriorityNone, internal.Dates{}, "", false)
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		// Upserting a deleted task creates it again.
+		inserted, err := store.Upsert(context.Background(), task.ID, "again", internal.PriorityNone, internal.Dates{Due: due}, "", false)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !inserted {
+			t.Fatalf("expected task to be inserted")
+		}
+
+		actual, err = store.Find(context.Background(), task.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected = internal.Task{
+			ID:          task.ID,
+			Description: "again",
+			Priority:    internal.PriorityNone,
+			Dates:       internal.Dates{Due: due},
+			CreatedAt:   actual.CreatedAt,
+			UpdatedAt:   actual.UpdatedAt,
+		}
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("List: ERR urgency", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := postgresql.NewTaskEventStore(newDB(t), 2).List(context.Background(),
+			internal.ListParams{Size: 10, Sort: internal.SortUrgency})
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+
+	t.Run("Backfill: OK", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newDB(t)
+
+		task, err := postgresql.NewTask(pool).Create(context.Background(), internal.CreateParams{
+			Description: "row",
+			Priority:    internal.PriorityMedium,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		store := postgresql.NewTaskEventStore(pool, 2)
+
+		// Backfilling twice only appends the events once.
+		for _, expected := range []int64{1, 0} {
+			total, err := store.Backfill(context.Background(), 1)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if total != expected {
+				t.Fatalf("expected %d backfilled tasks, got %d", expected, total)
+			}
+		}
+
+		actual, err := store.Find(context.Background(), task.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(task, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(task, actual))
+		}
+	})
+
+	t.Run("Events: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTaskEventStore(newDB(t), 2)
+
+		from := time.Now().UTC().Add(-time.Minute)
+
+		task, err := store.Create(context.Background(), internal.CreateParams{
+			Description: "created",
+			Priority:    internal.PriorityLow,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := store.Update(context.Background(), task.ID, "updated", internal.PriorityLow, internal.Dates{}, "", false); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		var actual []string
+
+		// Batches of one exercise paginating events appended at the same time.
+		if err := store.Events(context.Background(), from, time.Now().UTC().Add(time.Minute), 1,
+			func(event internal.TaskEventRecord) error {
+				if event.TaskID != task.ID {
+					t.Fatalf("expected events of %s, got %s", task.ID, event.TaskID)
+				}
+
+				actual = append(actual, event.Type)
+
+				return nil
+			}); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if expected := []string{"created", "updated"}; !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+}
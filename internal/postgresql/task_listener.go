@@ -0,0 +1,92 @@
+package postgresql
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskChangesChannel is the channel notified by the "tasks_changed" trigger every time a task is modified.
+const TaskChangesChannel = "tasks_changed"
+
+// TaskListener listens to the changes notified by PostgreSQL and publishes them to the TaskChangeFeed, it's meant
+// for small deployments that need near real-time updates without running a message broker.
+type TaskListener struct {
+	config *pgx.ConnConfig
+	feed   *internal.TaskChangeFeed
+	logger *zap.Logger
+	retry  time.Duration
+}
+
+type taskChangeNotification struct {
+	Kind    internal.TaskChangeKind `json:"kind"`
+	ID      string                  `json:"id"`
+	Version int64                   `json:"version"`
+}
+
+// NewTaskListener instantiates the TaskListener, it uses its own connection instead of one from the pool.
+func NewTaskListener(logger *zap.Logger, pool *pgxpool.Pool, feed *internal.TaskChangeFeed) *TaskListener {
+	return &TaskListener{
+		config: pool.Config().ConnConfig,
+		feed:   feed,
+		logger: logger,
+		retry:  time.Second,
+	}
+}
+
+// Run listens to the notifications until the context is canceled, connecting again when the connection is lost;
+// changes notified while reconnecting are not received.
+func (t *TaskListener) Run(ctx context.Context) {
+	for {
+		err := t.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		t.logger.Warn("listening to task changes failed, reconnecting", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.retry):
+		}
+	}
+}
+
+func (t *TaskListener) listen(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, t.config)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "pgx.ConnectConfig")
+	}
+
+	defer conn.Close(context.Background()) //nolint: contextcheck
+
+	if _, err := conn.Exec(ctx, "LISTEN "+TaskChangesChannel); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "LISTEN")
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "conn.WaitForNotification")
+		}
+
+		var change taskChangeNotification
+
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			t.logger.Warn("invalid task change", zap.String("payload", notification.Payload), zap.Error(err))
+
+			continue
+		}
+
+		if dropped := t.feed.Publish(internal.TaskChange(change)); dropped > 0 {
+			t.logger.Warn("task change dropped by slow subscribers", zap.Int("subscribers", dropped))
+		}
+	}
+}
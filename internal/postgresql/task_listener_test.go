@@ -0,0 +1,77 @@
+package postgresql_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func TestTaskListener(t *testing.T) {
+	t.Parallel()
+
+	pool := newDB(t)
+	store := postgresql.NewTask(pool)
+	feed := internal.NewTaskChangeFeed()
+
+	changes, unsubscribe := feed.Subscribe(10)
+	defer unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go postgresql.NewTaskListener(zap.NewNop(), pool, feed).Run(ctx)
+
+	task, err := store.Create(context.Background(), internal.CreateParams{
+		Description: "listened",
+		Priority:    internal.PriorityLow,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// Changes made before the listener is ready are not received, updates are repeated until one is.
+	timeout := time.After(10 * time.Second)
+
+	var updated internal.TaskChange
+
+	for updated.Kind != internal.TaskChangeUpdated {
+		if err := store.Update(context.Background(), task.ID, "listened", task.Priority, task.Dates, "", false); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		select {
+		case updated = <-changes:
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("expected change, got none")
+		}
+	}
+
+	if updated.ID != task.ID || updated.Version <= task.Version {
+		t.Fatalf("expected update of %s, got %#v", task.ID, updated)
+	}
+
+	if err := store.Delete(context.Background(), task.ID); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	for {
+		select {
+		case change := <-changes:
+			if change.Kind == internal.TaskChangeDeleted {
+				if change.ID != task.ID {
+					t.Fatalf("expected deletion of %s, got %#v", task.ID, change)
+				}
+
+				return
+			}
+		case <-timeout:
+			t.Fatalf("expected deletion, got none")
+		}
+	}
+}
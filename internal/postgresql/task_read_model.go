@@ -0,0 +1,369 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// TaskReadModel represents the repository used for interacting with the denormalized Task records used for
+// reading, those are projected from the task events.
+type TaskReadModel struct {
+	q *db.Queries
+}
+
+// NewTaskReadModel instantiates the TaskReadModel repository.
+func NewTaskReadModel(d db.DBTX) *TaskReadModel {
+	return &TaskReadModel{
+		q: db.New(d),
+	}
+}
+
+// Save inserts the task or replaces the existing one, the creation time of existing records is kept; tasks without
+// creation or update time, projected from events stored before those were kept, use the current time.
+func (t *TaskReadModel) Save(ctx context.Context, task internal.Task) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskReadModel.Save")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(task.ID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	projectID, err := newNullUUID(task.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	createdAt, updatedAt := task.CreatedAt.UTC(), task.UpdatedAt.UTC()
+	if task.CreatedAt.IsZero() {
+		createdAt = now
+	}
+
+	if task.UpdatedAt.IsZero() {
+		updatedAt = now
+	}
+
+	if err := t.q.UpsertTaskReadModel(ctx, db.UpsertTaskReadModelParams{
+		ID:          val,
+		Description: task.Description,
+		Priority:    newPriority(task.Priority),
+		StartDate:   newNullTime(task.Dates.Start),
+		DueDate:     newNullTime(task.Dates.Due),
+		TimeZone:    task.Dates.TimeZone,
+		ProjectID:   projectID,
+		Done:        task.IsDone,
+		CompletedAt: newNullTime(task.CompletedAt),
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert task read model")
+	}
+
+	return nil
+}
+
+// Delete deletes the record matching the id, deleting a record that does not exist is not an error so events can
+// be projected more than once.
+func (t *TaskReadModel) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskReadModel.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if err := t.q.DeleteTaskReadModel(ctx, val); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete task read model")
+	}
+
+	return nil
+}
+
+// Find returns the requested task by searching its id.
+func (t *TaskReadModel) Find(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskReadModel.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.q.SelectTaskReadModel(ctx, val)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task read model")
+	}
+
+	task, err := newTask(res.ID, res.Description, res.Priority, res.StartDate, res.DueDate, res.TimeZone, res.ProjectID, res.Done)
+	if err != nil {
+		return internal.Task{}, err
+	}
+
+	task.CompletedAt = completedAt(res.Done, res.CompletedAt)
+	task.CreatedAt, task.UpdatedAt = res.CreatedAt, res.UpdatedAt
+
+	return task, nil
+}
+
+// List returns the tasks sorted by creation time, by urgency or by the requested time, using the same cursors
+// Task.List does.
+func (t *TaskReadModel) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskReadModel.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if params.Sort == internal.SortUrgency {
+		res, err := t.listByUrgency(ctx, params)
+		if err != nil {
+			return internal.ListResults{}, err
+		}
+
+		return withTotal(ctx, t.q, params, res, "tasks_read_model", t.count(params))
+	}
+
+	if params.Sort.ByTime() {
+		res, err := t.listByTime(ctx, params)
+		if err != nil {
+			return internal.ListResults{}, err
+		}
+
+		return withTotal(ctx, t.q, params, res, "tasks_read_model", t.count(params))
+	}
+
+	after, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTasksReadModel(ctx, db.SelectTasksReadModelParams{
+		CreatedAt:   after.CreatedAt,
+		ID:          after.ID,
+		ByDue:       byDue,
+		DueFrom:     dueFrom,
+		DueTo:       dueTo,
+		ByProject:   byProject,
+		ProjectID:   projectID,
+		ByCreated:   byCreated,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		ByUpdated:   byUpdated,
+		UpdatedFrom: updatedFrom,
+		UpdatedTo:   updatedTo,
+		Size:        int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks read model")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = cursor{CreatedAt: last.CreatedAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return withTotal(ctx, t.q, params, internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, "tasks_read_model", t.count(params))
+}
+
+// listByUrgency uses the urgency precomputed when the task was projected.
+func (t *TaskReadModel) listByUrgency(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	after, err := decodeUrgencyCursor(params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeUrgencyCursor")
+	}
+
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTasksReadModelByUrgency(ctx, db.SelectTasksReadModelByUrgencyParams{
+		Done:        after.Done,
+		UrgencyAt:   after.UrgencyAt,
+		ID:          after.ID,
+		ByDue:       byDue,
+		DueFrom:     dueFrom,
+		DueTo:       dueTo,
+		ByProject:   byProject,
+		ProjectID:   projectID,
+		ByCreated:   byCreated,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		ByUpdated:   byUpdated,
+		UpdatedFrom: updatedFrom,
+		UpdatedTo:   updatedTo,
+		Size:        int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks read model by urgency")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = urgencyCursor{Done: last.Done, UrgencyAt: last.UrgencyAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+// listByTime returns the tasks sorted by their creation or update time, in ascending or descending order.
+func (t *TaskReadModel) listByTime(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	after, err := decodeTimeCursor(params.Sort, params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeTimeCursor")
+	}
+
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SelectTasksReadModelByTime(ctx, db.SelectTasksReadModelByTimeParams{
+		SortUpdated: params.Sort.Field() == string(internal.SortUpdated),
+		ByDue:       byDue,
+		DueFrom:     dueFrom,
+		DueTo:       dueTo,
+		ByProject:   byProject,
+		ProjectID:   projectID,
+		ByCreated:   byCreated,
+		CreatedFrom: createdFrom,
+		CreatedTo:   createdTo,
+		ByUpdated:   byUpdated,
+		UpdatedFrom: updatedFrom,
+		UpdatedTo:   updatedTo,
+		Descending:  params.Sort.Descending(),
+		AfterAt:     after.At,
+		ID:          after.ID,
+		Size:        int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks read model by time")
+	}
+
+	var next string
+
+	if int64(len(rows)) > params.Size {
+		rows = rows[:params.Size]
+
+		last := rows[len(rows)-1]
+		next = timeCursor{Sort: params.Sort, At: last.SortedAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+// count returns the function counting the tasks matching the parameters.
+func (t *TaskReadModel) count(params internal.ListParams) func(context.Context) (int64, error) {
+	byDue, dueFrom, dueTo := newDueFilter(params.Due)
+	byProject, projectID := newProjectFilter(params.ProjectID)
+	byCreated, createdFrom, createdTo := newTimeRangeFilter(params.Created)
+	byUpdated, updatedFrom, updatedTo := newTimeRangeFilter(params.Updated)
+
+	return func(ctx context.Context) (int64, error) {
+		return t.q.CountTasksReadModel(ctx, db.CountTasksReadModelParams{
+			ByDue:       byDue,
+			DueFrom:     dueFrom,
+			DueTo:       dueTo,
+			ByProject:   byProject,
+			ProjectID:   projectID,
+			ByCreated:   byCreated,
+			CreatedFrom: createdFrom,
+			CreatedTo:   createdTo,
+			ByUpdated:   byUpdated,
+			UpdatedFrom: updatedFrom,
+			UpdatedTo:   updatedTo,
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+)
+
+func TestTaskReadModel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Save/Find/List/Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTaskReadModel(newDB(t))
+
+		due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+		created := time.Now().UTC().Truncate(time.Microsecond)
+
+		urgent := internal.Task{
+			ID:          "0d1bb9a1-a0a6-4e55-a7a2-2f5a4b8c0f01",
+			Description: "urgent",
+			Priority:    internal.PriorityHigh,
+			Dates:       internal.Dates{Due: due},
+			CreatedAt:   created,
+			UpdatedAt:   created,
+		}
+
+		pending := internal.Task{
+			ID:          "0d1bb9a1-a0a6-4e55-a7a2-2f5a4b8c0f02",
+			Description: "created",
+			Priority:    internal.PriorityLow,
+			CreatedAt:   created,
+			UpdatedAt:   created,
+		}
+
+		for _, task := range []internal.Task{pending, urgent} {
+			if err := store.Save(context.Background(), task); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		// Projecting an update replaces the record.
+		pending.Description = "updated"
+		pending.UpdatedAt = created.Add(time.Second)
+
+		if err := store.Save(context.Background(), pending); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err := store.Find(context.Background(), pending.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !cmp.Equal(pending, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(pending, actual))
+		}
+
+		res, err := store.List(context.Background(), internal.ListParams{Size: 10, Sort: internal.SortUrgency})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if expected := []internal.Task{urgent, pending}; !cmp.Equal(expected, res.Tasks) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Tasks))
+		}
+
+		// Deleting is idempotent.
+		for i := 0; i < 2; i++ {
+			if err := store.Delete(context.Background(), pending.ID); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		_, err = store.Find(context.Background(), pending.ID)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+
+	t.Run("Save: ERR uuid", func(t *testing.T) {
+		t.Parallel()
+
+		err := postgresql.NewTaskReadModel(newDB(t)).Save(context.Background(), internal.Task{ID: "x"})
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
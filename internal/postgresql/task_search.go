@@ -0,0 +1,398 @@
+package postgresql
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// TaskSearch represents the repository used for searching Task records using the full-text search of PostgreSQL,
+// it's meant for deployments that don't run Elasticsearch.
+type TaskSearch struct {
+	q *db.Queries
+}
+
+// NewTaskSearch instantiates the TaskSearch repository.
+func NewTaskSearch(d db.DBTX) *TaskSearch {
+	return &TaskSearch{
+		q: db.New(d),
+	}
+}
+
+// Available indicates whether searching is available, it always is because tasks are searched where they are
+// stored.
+func (t *TaskSearch) Available() bool {
+	return true
+}
+
+// Search returns the tasks matching all the received values, descriptions match when they include every word.
+// Results are sorted by relevance, by urgency or by time, like List does, and paginated using either From or Cursor.
+// Fuzzy matching is not supported, highlighted fragments include the words matching exactly.
+func (t *TaskSearch) Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskSearch.Search")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	if args.Fuzziness.Fuzzy() {
+		return internal.SearchResults{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"fuzzy matching is not supported")
+	}
+
+	if args.IsZero() {
+		return internal.SearchResults{}, nil
+	}
+
+	filter := newSearchFilter(args)
+
+	var skip int32
+
+	if args.Cursor == "" {
+		skip = int32(args.From)
+	}
+
+	var (
+		res internal.SearchResults
+		err error
+	)
+
+	switch {
+	case args.Sort == internal.SortUrgency:
+		res, err = t.searchByUrgency(ctx, filter, args.Cursor, skip, args.Size)
+	case args.Sort.ByTime():
+		res, err = t.searchByTime(ctx, filter, args.Sort, args.Cursor, skip, args.Size)
+	default:
+		res, err = t.searchByRank(ctx, filter, args.Cursor, skip, args.Size)
+	}
+
+	if err != nil {
+		return internal.SearchResults{}, err
+	}
+
+	if res.Total, err = t.q.CountSearchTasks(ctx, db.CountSearchTasksParams(filter)); err != nil {
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "count search tasks")
+	}
+
+	if args.Highlight != nil && args.Description != nil {
+		res.Highlights = highlight(res.Tasks, *args.Description, args.Highlight.Size())
+	}
+
+	if args.Facets {
+		if res.Facets, err = t.facets(ctx, filter); err != nil {
+			return internal.SearchResults{}, err
+		}
+	}
+
+	return res, nil
+}
+
+// facets counts the matching tasks by priority and status.
+func (t *TaskSearch) facets(ctx context.Context, filter searchFilter) (*internal.Facets, error) {
+	rows, err := t.q.CountSearchTasksFacets(ctx, db.CountSearchTasksFacetsParams(filter))
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "count search tasks facets")
+	}
+
+	res := internal.NewFacets()
+
+	for _, row := range rows {
+		priority, err := convertPriority(row.Priority)
+		if err != nil {
+			return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "convertPriority")
+		}
+
+		res.Add(priority, row.Done, row.Count)
+	}
+
+	return res, nil
+}
+
+// highlight returns the fragments of the descriptions including the words in query, those are matched ignoring
+// case like the "simple" text search configuration does.
+func highlight(tasks []internal.Task, query string, size int) map[string][]string {
+	terms := make(map[string]struct{})
+
+	for _, term := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		terms[term] = struct{}{}
+	}
+
+	res := make(map[string][]string, len(tasks))
+
+	for _, task := range tasks {
+		res[task.ID] = internal.HighlightFragments(task.Description, size, func(word string) bool {
+			_, ok := terms[word]
+
+			return ok
+		})
+	}
+
+	return res
+}
+
+// Suggest returns the tasks whose descriptions include every complete word typed so far and a word starting with
+// the last one. Suggestions are sorted by relevance.
+func (t *TaskSearch) Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskSearch.Suggest")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	terms := params.Terms()
+	if len(terms) == 0 {
+		return []internal.Suggestion{}, nil
+	}
+
+	// Terms only include letters and digits, so they are safe to use as tsquery lexemes.
+	terms[len(terms)-1] += ":*"
+
+	rows, err := t.q.SuggestTasks(ctx, db.SuggestTasksParams{
+		Query: strings.Join(terms, " & "),
+		Size:  int32(params.Size),
+	})
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "suggest tasks")
+	}
+
+	res := make([]internal.Suggestion, len(rows))
+
+	for i, row := range rows {
+		res[i] = internal.Suggestion{
+			ID:          row.ID.String(),
+			Description: row.Description,
+		}
+	}
+
+	return res, nil
+}
+
+func (t *TaskSearch) searchByRank(ctx context.Context, filter searchFilter, val string, skip int32,
+	size int64) (internal.SearchResults, error) {
+	after, err := decodeRankCursor(val)
+	if err != nil {
+		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeRankCursor")
+	}
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SearchTasks(ctx, db.SearchTasksParams{
+		ByDescription: filter.ByDescription,
+		Description:   filter.Description,
+		ByPriority:    filter.ByPriority,
+		Priority:      filter.Priority,
+		ByDone:        filter.ByDone,
+		Done:          filter.Done,
+		ByDue:         filter.ByDue,
+		DueFrom:       filter.DueFrom,
+		DueTo:         filter.DueTo,
+		ByProject:     filter.ByProject,
+		ProjectID:     filter.ProjectID,
+		Rank:          after.Rank,
+		ID:            after.ID,
+		Skip:          skip,
+		Size:          int32(size + 1),
+	})
+	if err != nil {
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "search tasks")
+	}
+
+	var next string
+
+	if int64(len(rows)) > size {
+		rows = rows[:size]
+
+		last := rows[len(rows)-1]
+		next = rankCursor{Rank: last.Rank, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.Version = row.Version
+
+		tasks[i] = task
+	}
+
+	return internal.SearchResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+func (t *TaskSearch) searchByUrgency(ctx context.Context, filter searchFilter, val string, skip int32,
+	size int64) (internal.SearchResults, error) {
+	after, err := decodeUrgencyCursor(val)
+	if err != nil {
+		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeUrgencyCursor")
+	}
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SearchTasksByUrgency(ctx, db.SearchTasksByUrgencyParams{
+		ByDescription:  filter.ByDescription,
+		Description:    filter.Description,
+		ByPriority:     filter.ByPriority,
+		Priority:       filter.Priority,
+		ByDone:         filter.ByDone,
+		Done:           filter.Done,
+		ByDue:          filter.ByDue,
+		DueFrom:        filter.DueFrom,
+		DueTo:          filter.DueTo,
+		ByProject:      filter.ByProject,
+		ProjectID:      filter.ProjectID,
+		AfterDone:      after.Done,
+		AfterUrgencyAt: after.UrgencyAt,
+		ID:             after.ID,
+		Skip:           skip,
+		Size:           int32(size + 1),
+	})
+	if err != nil {
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "search tasks by urgency")
+	}
+
+	var next string
+
+	if int64(len(rows)) > size {
+		rows = rows[:size]
+
+		last := rows[len(rows)-1]
+		next = urgencyCursor{Done: last.Done, UrgencyAt: last.UrgencyAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.Version = row.Version
+
+		tasks[i] = task
+	}
+
+	return internal.SearchResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+func (t *TaskSearch) searchByTime(ctx context.Context, filter searchFilter, sort internal.Sort, val string, skip int32,
+	size int64) (internal.SearchResults, error) {
+	after, err := decodeTimeCursor(sort, val)
+	if err != nil {
+		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeTimeCursor")
+	}
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.q.SearchTasksByTime(ctx, db.SearchTasksByTimeParams{
+		SortUpdated:   sort.Field() == string(internal.SortUpdated),
+		ByDescription: filter.ByDescription,
+		Description:   filter.Description,
+		ByPriority:    filter.ByPriority,
+		Priority:      filter.Priority,
+		ByDone:        filter.ByDone,
+		Done:          filter.Done,
+		ByDue:         filter.ByDue,
+		DueFrom:       filter.DueFrom,
+		DueTo:         filter.DueTo,
+		ByProject:     filter.ByProject,
+		ProjectID:     filter.ProjectID,
+		Descending:    sort.Descending(),
+		AfterAt:       after.At,
+		ID:            after.ID,
+		Skip:          skip,
+		Size:          int32(size + 1),
+	})
+	if err != nil {
+		return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "search tasks by time")
+	}
+
+	var next string
+
+	if int64(len(rows)) > size {
+		rows = rows[:size]
+
+		last := rows[len(rows)-1]
+		next = timeCursor{Sort: sort, At: last.SortedAt, ID: last.ID}.String()
+	}
+
+	tasks := make([]internal.Task, len(rows))
+
+	for i, row := range rows {
+		task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+		if err != nil {
+			return internal.SearchResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+		}
+
+		task.Version = row.Version
+		task.CompletedAt = completedAt(row.Done, row.CompletedAt)
+		task.CreatedAt, task.UpdatedAt = row.CreatedAt, row.UpdatedAt
+
+		tasks[i] = task
+	}
+
+	return internal.SearchResults{
+		Tasks:      tasks,
+		NextCursor: next,
+	}, nil
+}
+
+// searchFilter defines the values tasks must match, it uses the same fields as db.CountSearchTasksParams.
+type searchFilter struct {
+	ByDescription bool
+	Description   string
+	ByPriority    bool
+	Priority      db.Priority
+	ByDone        bool
+	Done          bool
+	ByDue         bool
+	DueFrom       time.Time
+	DueTo         time.Time
+	ByProject     bool
+	ProjectID     uuid.UUID
+}
+
+func newSearchFilter(args internal.SearchParams) searchFilter {
+	// Priority is always a valid value, even when not filtering by it, because the argument is cast to the enum.
+	res := searchFilter{Priority: db.PriorityNone}
+
+	if args.Description != nil {
+		res.ByDescription = true
+		res.Description = *args.Description
+	}
+
+	if args.Priority != nil {
+		res.ByPriority = true
+		res.Priority = newPriority(*args.Priority)
+	}
+
+	if args.IsDone != nil {
+		res.ByDone = true
+		res.Done = *args.IsDone
+	}
+
+	res.ByDue, res.DueFrom, res.DueTo = newDueFilter(args.Due)
+
+	if args.ProjectID != nil {
+		res.ByProject, res.ProjectID = newProjectFilter(*args.ProjectID)
+	}
+
+	return res
+}
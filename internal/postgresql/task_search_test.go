@@ -0,0 +1,296 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTaskSearch_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskSearchRepository(t, func(tb testing.TB) (service.TaskRepository, service.TaskSearchRepository) {
+		pool := newDB(tb)
+
+		return postgresql.NewTask(pool), postgresql.NewTaskSearch(pool)
+	})
+}
+
+func TestTaskSearch_Search(t *testing.T) {
+	t.Parallel()
+
+	pool := newDB(t)
+	store := postgresql.NewTask(pool)
+
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	ids := make(map[string]string)
+
+	for _, params := range []internal.CreateParams{
+		{Description: "buy milk and bread", Priority: internal.PriorityLow},
+		{Description: "buy milk", Priority: internal.PriorityHigh, Dates: internal.Dates{Due: due}},
+		{Description: "walk the dog", Priority: internal.PriorityHigh},
+		{Description: "Milk the cow", Priority: internal.PriorityMedium},
+	} {
+		task, err := store.Create(context.Background(), params)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		ids[params.Description] = task.ID
+
+		// Creation times must be different to make the order deterministic.
+		time.Sleep(time.Millisecond)
+	}
+
+	search := postgresql.NewTaskSearch(pool)
+
+	ptrString := func(s string) *string { return &s }
+	ptrPriority := func(p internal.Priority) *internal.Priority { return &p }
+
+	tests := []struct {
+		name     string
+		input    internal.SearchParams
+		expected []string
+	}{
+		{
+			"OK: description, relevance",
+			internal.SearchParams{Description: ptrString("milk"), Size: 10},
+			[]string{"buy milk", "Milk the cow", "buy milk and bread"},
+		},
+		{
+			"OK: description, every word",
+			internal.SearchParams{Description: ptrString("buy milk"), Size: 10},
+			[]string{"buy milk", "buy milk and bread"},
+		},
+		{
+			"OK: description and priority",
+			internal.SearchParams{Description: ptrString("milk"), Priority: ptrPriority(internal.PriorityHigh), Size: 10},
+			[]string{"buy milk"},
+		},
+		{
+			"OK: priority, urgency",
+			internal.SearchParams{Priority: ptrPriority(internal.PriorityHigh), Sort: internal.SortUrgency, Size: 10},
+			[]string{"buy milk", "walk the dog"},
+		},
+		{
+			"OK: description, newest",
+			internal.SearchParams{Description: ptrString("milk"), Sort: internal.SortCreatedDesc, Size: 10},
+			[]string{"Milk the cow", "buy milk", "buy milk and bread"},
+		},
+		{
+			"OK: from",
+			internal.SearchParams{Description: ptrString("milk"), From: 1, Size: 10},
+			[]string{"Milk the cow", "buy milk and bread"},
+		},
+		{
+			"OK: no matches",
+			internal.SearchParams{Description: ptrString("cat"), Size: 10},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := search.Search(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual := make([]string, len(res.Tasks))
+
+			for i, task := range res.Tasks {
+				if ids[task.Description] != task.ID {
+					t.Fatalf("unexpected task %#v", task)
+				}
+
+				actual[i] = task.Description
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+
+			if expected := int64(len(tt.expected)) + tt.input.From; res.Total != expected {
+				t.Fatalf("expected total %d, got %d", expected, res.Total)
+			}
+		})
+	}
+
+	t.Run("OK: highlight", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := search.Search(context.Background(), internal.SearchParams{
+			Description: ptrString("cow milk"),
+			Size:        10,
+			Highlight:   &internal.Highlight{},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := map[string][]string{
+			ids["Milk the cow"]: {"<em>Milk</em> the <em>cow</em>"},
+		}
+
+		if !cmp.Equal(expected, res.Highlights) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Highlights))
+		}
+	})
+
+	t.Run("OK: facets", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := search.Search(context.Background(), internal.SearchParams{
+			Description: ptrString("milk"),
+			Size:        1,
+			Facets:      true,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := internal.NewFacets()
+		expected.Add(internal.PriorityLow, false, 1)
+		expected.Add(internal.PriorityHigh, false, 1)
+		expected.Add(internal.PriorityMedium, false, 1)
+
+		if !cmp.Equal(expected, res.Facets) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, res.Facets))
+		}
+	})
+
+	t.Run("ERR: fuzziness", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := search.Search(context.Background(), internal.SearchParams{
+			Description: ptrString("mlik"),
+			Size:        10,
+			Fuzziness:   internal.FuzzinessAuto,
+		})
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected invalid argument error, got %s", err)
+		}
+	})
+
+	t.Run("OK: cursor", func(t *testing.T) {
+		t.Parallel()
+
+		for _, sort := range []internal.Sort{
+			internal.SortDefault, internal.SortUrgency, internal.SortCreated, internal.SortCreatedDesc,
+			internal.SortUpdated, internal.SortUpdatedDesc,
+		} {
+			var (
+				actual []string
+				cursor string
+			)
+
+			for {
+				res, err := search.Search(context.Background(), internal.SearchParams{
+					Description: ptrString("milk"),
+					Size:        1,
+					Cursor:      cursor,
+					Sort:        sort,
+				})
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+
+				for _, task := range res.Tasks {
+					actual = append(actual, task.Description)
+				}
+
+				if cursor = res.NextCursor; cursor == "" {
+					break
+				}
+			}
+
+			if len(actual) != 3 {
+				t.Fatalf("%q: expected 3 tasks, got %v", sort, actual)
+			}
+		}
+	})
+}
+
+func TestTaskSearch_Suggest(t *testing.T) {
+	t.Parallel()
+
+	pool := newDB(t)
+	store := postgresql.NewTask(pool)
+
+	for _, params := range []internal.CreateParams{
+		{Description: "buy milk and bread", Priority: internal.PriorityLow},
+		{Description: "buy milk", Priority: internal.PriorityHigh},
+		{Description: "walk the dog", Priority: internal.PriorityHigh},
+	} {
+		if _, err := store.Create(context.Background(), params); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+	}
+
+	search := postgresql.NewTaskSearch(pool)
+
+	tests := []struct {
+		name     string
+		input    internal.SuggestParams
+		expected []string
+	}{
+		{
+			"OK: incomplete word",
+			internal.SuggestParams{Prefix: "Mil", Size: 10},
+			[]string{"buy milk", "buy milk and bread"},
+		},
+		{
+			"OK: complete words",
+			internal.SuggestParams{Prefix: "buy milk an", Size: 10},
+			[]string{"buy milk and bread"},
+		},
+		{
+			"OK: size",
+			internal.SuggestParams{Prefix: "buy", Size: 1},
+			[]string{"buy milk"},
+		},
+		{
+			"OK: punctuation",
+			internal.SuggestParams{Prefix: "'&!", Size: 10},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := search.Suggest(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual := make([]string, len(res))
+
+			for i, suggestion := range res {
+				actual[i] = suggestion.Description
+			}
+
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
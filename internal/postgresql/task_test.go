@@ -25,8 +25,18 @@ import (
 
 	"github.com/MarioCarrion/todo-api/internal"
 	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
 )
 
+func TestTask_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskRepository(t, func(tb testing.TB) service.TaskRepository {
+		return postgresql.NewTask(newDB(tb))
+	})
+}
+
 func TestTask_Create(t *testing.T) {
 	t.Parallel()
 
@@ -177,6 +187,132 @@ func TestTask_Find(t *testing.T) {
 	})
 }
 
+func TestTask_List(t *testing.T) {
+	t.Parallel()
+
+	t.Run("List: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTask(newDB(t))
+
+		expected := make([]internal.Task, 3)
+
+		for i := range expected {
+			task, err := store.Create(context.Background(), internal.CreateParams{
+				Description: fmt.Sprintf("test %d", i),
+				Priority:    internal.PriorityNone,
+				Dates:       internal.Dates{},
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected[i] = task
+		}
+
+		var (
+			actual []internal.Task
+			cursor string
+		)
+
+		for {
+			res, err := store.List(context.Background(), internal.ListParams{
+				Cursor: cursor,
+				Size:   2,
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual = append(actual, res.Tasks...)
+
+			if res.NextCursor == "" {
+				break
+			}
+
+			cursor = res.NextCursor
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("List: OK urgency", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTask(newDB(t))
+
+		now := time.Now().UTC().Truncate(time.Hour)
+		day := 24 * time.Hour
+
+		create := func(priority internal.Priority, due time.Time) internal.Task {
+			task, err := store.Create(context.Background(), internal.CreateParams{
+				Description: "test",
+				Priority:    priority,
+				Dates:       internal.Dates{Due: due},
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			return task
+		}
+
+		noDue := create(internal.PriorityMedium, time.Time{})
+		low := create(internal.PriorityLow, now.Add(3*day))
+		high := create(internal.PriorityHigh, now.Add(5*day))
+		none := create(internal.PriorityNone, now.Add(day))
+
+		expected := []internal.Task{none, high, low, noDue}
+
+		var (
+			actual []internal.Task
+			cursor string
+		)
+
+		for {
+			res, err := store.List(context.Background(), internal.ListParams{
+				Cursor: cursor,
+				Size:   3,
+				Sort:   internal.SortUrgency,
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual = append(actual, res.Tasks...)
+
+			if res.NextCursor == "" {
+				break
+			}
+
+			cursor = res.NextCursor
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("List: ERR cursor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := postgresql.NewTask(newDB(t)).List(context.Background(), internal.ListParams{
+			Cursor: "x",
+			Size:   2,
+		})
+		if err == nil {
+			t.Fatalf("expected error, got not value")
+		}
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
+
 func TestTask_Update(t *testing.T) {
 	t.Parallel()
 
@@ -203,7 +339,7 @@ func TestTask_Update(t *testing.T) {
 			originalTask.Description,
 			originalTask.Priority,
 			originalTask.Dates,
-			originalTask.IsDone); err != nil {
+			"", originalTask.IsDone); err != nil {
 			t.Fatalf("expected no error, got %s", err)
 		}
 
@@ -216,6 +352,14 @@ func TestTask_Update(t *testing.T) {
 			return x.Unix() == y.Unix()
 		})
 
+		originalTask.Version++
+
+		if actualTask.UpdatedAt.Before(originalTask.UpdatedAt) {
+			t.Fatalf("expected update time after %s, got %s", originalTask.UpdatedAt, actualTask.UpdatedAt)
+		}
+
+		originalTask.UpdatedAt = actualTask.UpdatedAt
+
 		if !cmp.Equal(originalTask, actualTask, opts) {
 			t.Fatalf("expected result does not match: %s", cmp.Diff(originalTask, actualTask))
 		}
@@ -229,7 +373,7 @@ func TestTask_Update(t *testing.T) {
 			"",
 			internal.PriorityNone,
 			internal.Dates{},
-			false)
+			"", false)
 		if err == nil {
 			t.Fatalf("expected error, got not value")
 		}
@@ -259,7 +403,7 @@ func TestTask_Update(t *testing.T) {
 			"",
 			internal.Priority(-1),
 			internal.Dates{},
-			false)
+			"", false)
 		if err == nil {
 			t.Fatalf("expected error, got not value")
 		}
@@ -278,7 +422,7 @@ func TestTask_Update(t *testing.T) {
 			"",
 			internal.PriorityNone,
 			internal.Dates{},
-			false)
+			"", false)
 		if err == nil {
 			t.Fatalf("expected error, got not value")
 		}
@@ -288,6 +432,86 @@ func TestTask_Update(t *testing.T) {
 			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
 		}
 	})
+
+	t.Run("Update: expected version", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTask(newDB(t))
+
+		task, err := store.Create(context.Background(), internal.CreateParams{
+			Description: "test",
+			Priority:    internal.PriorityLow,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		ctx := internal.NewContextWithExpectedVersion(context.Background(), task.Version)
+
+		if err := store.Update(ctx, task.ID, "first", task.Priority, task.Dates, "", task.IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		// The version was increased by the previous update, this one is rejected.
+		err = store.Update(ctx, task.ID, "second", task.Priority, task.Dates, "", task.IsDone)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeConflict {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		_, err = store.Upsert(ctx, task.ID, "second", task.Priority, task.Dates, "", task.IsDone)
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeConflict {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+
+		actual, err := store.Find(context.Background(), task.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actual.Description != "first" || actual.Version != task.Version+1 {
+			t.Fatalf("expected first update, got %#v", actual)
+		}
+	})
+
+	t.Run("FindVersion: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTask(newDB(t))
+
+		task, err := store.Create(context.Background(), internal.CreateParams{
+			Description: "test",
+			Priority:    internal.PriorityLow,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := store.Update(context.Background(), task.ID, "first", task.Priority, task.Dates, "", task.IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err := store.FindVersion(context.Background(), task.ID, task.Version)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		// Previous versions don't keep the creation and update times.
+		task.CreatedAt, task.UpdatedAt = time.Time{}, time.Time{}
+
+		if !cmp.Equal(task, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(task, actual))
+		}
+
+		// The current version is not a previous one.
+		_, err = store.FindVersion(context.Background(), task.ID, task.Version+1)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
 }
 
 func newDB(tb testing.TB) *pgxpool.Pool {
@@ -388,3 +612,68 @@ func newDB(tb testing.TB) *pgxpool.Pool {
 
 	return dbpool
 }
+
+func TestTask_Upsert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Upsert: OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := postgresql.NewTask(newDB(t))
+
+		const id = "7d9cf4fa-6b0c-4c4b-9b3c-1d1c4e1d8a11"
+
+		inserted, err := store.Upsert(context.Background(), id, "created", internal.PriorityLow, internal.Dates{}, "", false)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !inserted {
+			t.Fatalf("expected inserted record")
+		}
+
+		inserted, err = store.Upsert(context.Background(), id, "replaced", internal.PriorityHigh, internal.Dates{}, "", true)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if inserted {
+			t.Fatalf("expected replaced record")
+		}
+
+		actual, err := store.Find(context.Background(), id)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := internal.Task{
+			ID:          id,
+			Description: "replaced",
+			Priority:    internal.PriorityHigh,
+			IsDone:      true,
+			CompletedAt: actual.CompletedAt,
+			CreatedAt:   actual.CreatedAt,
+			UpdatedAt:   actual.UpdatedAt,
+			Version:     2,
+		}
+
+		if actual.CompletedAt.IsZero() {
+			t.Fatal("expected completion time, got none")
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("Upsert: ERR uuid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := postgresql.NewTask(newDB(t)).Upsert(context.Background(), "x", "", internal.PriorityLow, internal.Dates{}, "", false)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
@@ -0,0 +1,191 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql/db"
+)
+
+// TaskTrash represents the repository used for interacting with the trashed Task records.
+type TaskTrash struct {
+	q *db.Queries
+}
+
+// NewTaskTrash instantiates the TaskTrash repository.
+func NewTaskTrash(d db.DBTX) *TaskTrash {
+	return &TaskTrash{
+		q: db.New(d),
+	}
+}
+
+// Delete deletes the existing record matching the id.
+func (t *TaskTrash) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Delete")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	if _, err := t.q.DeleteTrashedTask(ctx, val); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete trashed task")
+	}
+
+	return nil
+}
+
+// Find returns the requested trashed task by searching its id.
+func (t *TaskTrash) Find(ctx context.Context, id string) (internal.TrashedTask, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Find")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	val, err := uuid.Parse(id)
+	if err != nil {
+		return internal.TrashedTask{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.q.SelectTrashedTask(ctx, val)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return internal.TrashedTask{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return internal.TrashedTask{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select trashed task")
+	}
+
+	return newTrashedTask(res)
+}
+
+// List returns a page of trashed tasks, the most recently deleted first.
+func (t *TaskTrash) List(ctx context.Context, params internal.TrashParams) (internal.TrashResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.List")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	after, err := internal.DecodeTrashCursor(params.Cursor)
+	if err != nil {
+		return internal.TrashResults{}, err
+	}
+
+	var id uuid.UUID
+
+	if after.ID != "" {
+		if id, err = uuid.Parse(after.ID); err != nil {
+			return internal.TrashResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+		}
+	}
+
+	// One more record is selected for determining whether there is a next page.
+	rows, err := t.q.SelectTrashedTasks(ctx, db.SelectTrashedTasksParams{
+		DeletedAt: after.DeletedAt,
+		ID:        id,
+		Size:      int32(params.Size + 1),
+	})
+	if err != nil {
+		return internal.TrashResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select trashed tasks")
+	}
+
+	res := internal.TrashResults{
+		Tasks: make([]internal.TrashedTask, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		task, err := newTrashedTask(row)
+		if err != nil {
+			return internal.TrashResults{}, err
+		}
+
+		res.Tasks = append(res.Tasks, task)
+	}
+
+	if int64(len(res.Tasks)) > params.Size {
+		res.Tasks = res.Tasks[:params.Size]
+		res.NextCursor = internal.NewTrashCursor(res.Tasks[len(res.Tasks)-1]).String()
+	}
+
+	return res, nil
+}
+
+// Purge deletes the tasks trashed before deletedBefore, it returns the number of deleted tasks.
+func (t *TaskTrash) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Purge")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	n, err := t.q.PurgeTrashedTasks(ctx, deletedBefore)
+	if err != nil {
+		return 0, wrapErrorf(err, internal.ErrorCodeUnknown, "delete trashed tasks")
+	}
+
+	return n, nil
+}
+
+// Save inserts the trashed task or replaces the existing one.
+func (t *TaskTrash) Save(ctx context.Context, task internal.TrashedTask) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskTrash.Save")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPostgreSQL)()
+
+	id, err := uuid.Parse(task.Task.ID)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	projectID, err := newNullUUID(task.Task.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if err := t.q.UpsertTrashedTask(ctx, db.UpsertTrashedTaskParams{
+		ID:          id,
+		Description: task.Task.Description,
+		Priority:    newPriority(task.Task.Priority),
+		StartDate:   newNullTime(task.Task.Dates.Start),
+		DueDate:     newNullTime(task.Task.Dates.Due),
+		TimeZone:    task.Task.Dates.TimeZone,
+		ProjectID:   projectID,
+		Done:        task.Task.IsDone,
+		DeletedAt:   task.DeletedAt,
+	}); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "upsert trashed task")
+	}
+
+	return nil
+}
+
+func newTrashedTask(row db.TasksTrash) (internal.TrashedTask, error) {
+	task, err := newTask(row.ID, row.Description, row.Priority, row.StartDate, row.DueDate, row.TimeZone, row.ProjectID, row.Done)
+	if err != nil {
+		return internal.TrashedTask{}, wrapErrorf(err, internal.ErrorCodeUnknown, "newTask")
+	}
+
+	return internal.TrashedTask{
+		Task:      task,
+		DeletedAt: row.DeletedAt.UTC(),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"syscall"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/retry"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+const (
+	// errCodeSerializationFailure and errCodeDeadlockDetected indicate the transaction was rolled back because of
+	// concurrent transactions, running it again usually succeeds.
+	errCodeSerializationFailure = "40001"
+	errCodeDeadlockDetected     = "40P01"
+)
+
+//nolint: gochecknoglobals
+var transactionRetry = retry.Policy{
+	Attempts:   3,
+	Backoff:    20 * time.Millisecond,
+	MaxBackoff: 200 * time.Millisecond,
+	Retryable:  retryableTransaction,
+}
+
+// UnitOfWork represents the datastore running multiple repository calls in a single PostgreSQL transaction.
+type UnitOfWork struct {
+	pool *pgxpool.Pool
+}
+
+// NewUnitOfWork instantiates the UnitOfWork datastore.
+func NewUnitOfWork(pool *pgxpool.Pool) *UnitOfWork {
+	return &UnitOfWork{
+		pool: pool,
+	}
+}
+
+// Do runs fn using repositories bound to a new transaction, the transaction is committed when fn returns no error
+// and rolled back otherwise. Transactions failing because of serialization failures, deadlocks or connection errors
+// are run again, so fn may be called more than once.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, repos service.TxRepositories) error) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "UnitOfWork.Do")
+	span.SetAttributes(attribute.String("db.system", "postgresql"))
+
+	defer span.End()
+
+	return transactionRetry.Do(ctx, "postgresql.transaction", func(ctx context.Context) error {
+		return u.do(ctx, fn)
+	})
+}
+
+func (u *UnitOfWork) do(ctx context.Context, fn func(ctx context.Context, repos service.TxRepositories) error) error {
+	tx, err := u.pool.Begin(ctx)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "pool.Begin")
+	}
+
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if err := fn(ctx, txRepositories{tx: tx}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return wrapErrorf(&commitError{err: err}, internal.ErrorCodeUnknown, "tx.Commit")
+	}
+
+	return nil
+}
+
+// commitError indicates committing the transaction failed, when the connection failed it's not known whether the
+// transaction was committed.
+type commitError struct {
+	err error
+}
+
+func (e *commitError) Error() string {
+	return e.err.Error()
+}
+
+func (e *commitError) Unwrap() error {
+	return e.err
+}
+
+// retryableTransaction indicates whether the transaction failed because of a transient error and it was not
+// committed.
+func retryableTransaction(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == errCodeSerializationFailure || pgErr.Code == errCodeDeadlockDetected
+	}
+
+	var cerr *commitError
+	if errors.As(err, &cerr) {
+		return false
+	}
+
+	return pgconn.SafeToRetry(err) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// txRepositories defines the repositories bound to a transaction.
+type txRepositories struct {
+	tx pgx.Tx
+}
+
+func (r txRepositories) Task() service.TaskRepository {
+	return NewTask(r.tx)
+}
+
+func (r txRepositories) Project() service.ProjectRepository {
+	return NewProject(r.tx)
+}
+
+func (r txRepositories) TaskDependency() service.TaskDependencyRepository {
+	return NewTaskDependency(r.tx)
+}
@@ -0,0 +1,74 @@
+package postgresql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/postgresql"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+func TestUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	errRollback := errors.New("rollback")
+
+	tests := []struct {
+		name  string
+		input error
+		found bool
+	}{
+		{
+			"OK: committed",
+			nil,
+			true,
+		},
+		{
+			"OK: rolled back",
+			errRollback,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := newDB(t)
+
+			var created internal.Task
+
+			err := postgresql.NewUnitOfWork(pool).Do(context.Background(),
+				func(ctx context.Context, repos service.TxRepositories) error {
+					task, err := repos.Task().Create(ctx, internal.CreateParams{
+						Description: "unit of work",
+						Priority:    internal.PriorityLow,
+					})
+					if err != nil {
+						return err
+					}
+
+					// Changes are visible within the same transaction.
+					if _, err := repos.Task().Find(ctx, task.ID); err != nil {
+						return err
+					}
+
+					created = task
+
+					return tt.input
+				})
+			if !errors.Is(err, tt.input) {
+				t.Fatalf("expected error %v, got %v", tt.input, err)
+			}
+
+			_, err = postgresql.NewTask(pool).Find(context.Background(), created.ID)
+			if found := err == nil; found != tt.found {
+				t.Fatalf("expected found %t, got error %v", tt.found, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package internal
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Project groups related Tasks, Tasks indicate the Project they belong to using their ProjectID.
+type Project struct {
+	ID   string
+	Name string
+}
+
+// Validate indicates whether the fields are valid or not.
+func (p Project) Validate() error {
+	if err := validation.ValidateStruct(&p,
+		validation.Field(&p.Name, validation.Required),
+	); err != nil {
+		return WrapErrorf(err, ErrorCodeInvalidArgument, "invalid values")
+	}
+
+	return nil
+}
+
+// ProjectDeleteStrategy defines what happens to the Tasks of a deleted Project.
+type ProjectDeleteStrategy string
+
+const (
+	// ProjectDeleteOrphan keeps the Tasks, those don't belong to any Project anymore.
+	ProjectDeleteOrphan ProjectDeleteStrategy = "orphan"
+
+	// ProjectDeleteCascade deletes the Tasks as well.
+	ProjectDeleteCascade ProjectDeleteStrategy = "cascade"
+)
+
+// Validate indicates whether the value is valid or not.
+func (s ProjectDeleteStrategy) Validate() error {
+	switch s {
+	case ProjectDeleteOrphan, ProjectDeleteCascade:
+		return nil
+	}
+
+	return NewErrorf(ErrorCodeInvalidArgument, "unknown value")
+}
@@ -0,0 +1,91 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestProject_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.Project
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.Project{Name: "home"},
+			false,
+		},
+		{
+			"ERR: Name",
+			internal.Project{},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestProjectDeleteStrategy_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.ProjectDeleteStrategy
+		withErr bool
+	}{
+		{
+			"OK: ProjectDeleteOrphan",
+			internal.ProjectDeleteOrphan,
+			false,
+		},
+		{
+			"OK: ProjectDeleteCascade",
+			internal.ProjectDeleteCascade,
+			false,
+		},
+		{
+			"ERR: unknown value",
+			internal.ProjectDeleteStrategy("keep"),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
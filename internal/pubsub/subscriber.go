@@ -0,0 +1,94 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+
+	"cloud.google.com/go/pubsub"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Event represents a task event received from the subscription.
+type Event struct {
+	Type  string
+	Value internal.Task
+}
+
+// Handler processes events, messages are acknowledged only when it returns nil.
+type Handler func(ctx context.Context, evt Event) error
+
+// Subscriber receives task events, the subscription must have message ordering enabled to receive the events of
+// the same task in the order they were published.
+type Subscriber struct {
+	subscription *pubsub.Subscription
+}
+
+// NewSubscriber instantiates the Subscriber.
+func NewSubscriber(subscription *pubsub.Subscription) *Subscriber {
+	return &Subscriber{
+		subscription: subscription,
+	}
+}
+
+// Receive receives messages until ctx is canceled, messages are acknowledged after handler processes them
+// successfully, otherwise those are negatively acknowledged to be redelivered.
+func (s *Subscriber) Receive(ctx context.Context, handler Handler) error {
+	if err := s.subscription.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		evt, err := decodeEvent(ctx, msg.Data)
+		if err != nil {
+			// Invalid messages are never going to be processed, those are redelivered until the dead-letter
+			// policy of the subscription moves them to the dead-letter topic.
+			msg.Nack()
+
+			return
+		}
+
+		if err := handler(ctx, evt); err != nil {
+			msg.Nack()
+
+			return
+		}
+
+		msg.Ack()
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "subscription.Receive")
+	}
+
+	return nil
+}
+
+// decodeEvent decodes messages using the CloudEvents envelope or the original format.
+func decodeEvent(ctx context.Context, b []byte) (Event, error) {
+	if cevt, err := internal.DecodeCloudEvent(b); err == nil {
+		task, err := cevt.DecodeTask(ctx)
+		if err != nil {
+			return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "cevt.DecodeTask")
+		}
+
+		return Event{
+			Type:  cevt.Type,
+			Value: task,
+		}, nil
+	}
+
+	var evt struct {
+		Type    string
+		Version int
+		Value   json.RawMessage
+	}
+
+	if err := json.Unmarshal(b, &evt); err != nil {
+		return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Unmarshal")
+	}
+
+	task, err := internal.DecodeTaskEventData(ctx, evt.Version, evt.Value)
+	if err != nil {
+		return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.DecodeTaskEventData")
+	}
+
+	return Event{
+		Type:  evt.Type,
+		Value: task,
+	}, nil
+}
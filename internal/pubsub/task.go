@@ -0,0 +1,123 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+
+	"cloud.google.com/go/pubsub"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// source identifies the producer of the events when using the CloudEvents envelope.
+const source = "/tasks-rest-server"
+
+// Task represents the repository used for publishing Task records.
+type Task struct {
+	topic       *pubsub.Topic
+	cloudEvents bool
+}
+
+type event struct {
+	Type    string
+	Version int
+	Value   internal.Task
+}
+
+// NewTask instantiates the Task repository, when cloudEvents is true messages use the CloudEvents envelope. Message
+// ordering is enabled in topic, events of the same task are delivered in the order they were published.
+func NewTask(topic *pubsub.Topic, cloudEvents bool) *Task {
+	topic.EnableMessageOrdering = true
+
+	return &Task{
+		topic:       topic,
+		cloudEvents: cloudEvents,
+	}
+}
+
+// Created publishes a message indicating a task was created.
+func (t *Task) Created(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Created", "tasks.event.created", task)
+}
+
+// Deleted publishes a message indicating a task was deleted.
+func (t *Task) Deleted(ctx context.Context, id string) error {
+	return t.publish(ctx, "Task.Deleted", "tasks.event.deleted", internal.Task{ID: id})
+}
+
+// Updated publishes a message indicating a task was updated.
+func (t *Task) Updated(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Updated", "tasks.event.updated", task)
+}
+
+// Completed publishes a message indicating a task was completed, it's published after the one indicating the task
+// was updated.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Completed", "tasks.event.completed", task)
+}
+
+// Reopened publishes a message indicating a completed task was reopened, it's published after the one indicating
+// the task was updated.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Reopened", "tasks.event.reopened", task)
+}
+
+func (t *Task) publish(ctx context.Context, spanName, msgType string, task internal.Task) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, spanName)
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyPubSub)()
+
+	span.SetAttributes(
+		attribute.KeyValue{
+			Key:   semconv.MessagingSystemKey,
+			Value: attribute.StringValue("pubsub"),
+		},
+	)
+
+	//-
+
+	var evt interface{} = event{
+		Type:    msgType,
+		Version: internal.TaskEventVersion,
+		Value:   task,
+	}
+
+	contentType := "application/json"
+
+	if t.cloudEvents {
+		cevt, err := internal.NewCloudEvent(source, msgType, task)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.NewCloudEvent")
+		}
+
+		evt = cevt
+		contentType = internal.CloudEventsContentType
+	}
+
+	b, err := json.Marshal(evt)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	res := t.topic.Publish(ctx, &pubsub.Message{
+		Data:        b,
+		OrderingKey: task.ID,
+		Attributes: map[string]string{
+			"type":         msgType,
+			"content-type": contentType,
+		},
+	})
+
+	if _, err := res.Get(ctx); err != nil {
+		// Publishing messages using the same ordering key is paused after a failure, so following events are
+		// not delivered before this one, resuming allows retrying.
+		t.topic.ResumePublish(task.ID)
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "topic.Publish")
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package pubsub_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	gpubsub "cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/pubsub"
+)
+
+func TestTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cloudEvents bool
+	}{
+		{
+			"OK",
+			false,
+		},
+		{
+			"OK: CloudEvents",
+			true,
+		},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			srv, topic, sub := newSubscription(t, fmt.Sprintf("tasks-%d", i))
+
+			task := internal.Task{
+				ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+				Description: "pubsub",
+				Priority:    internal.PriorityHigh,
+			}
+
+			publisher := pubsub.NewTask(topic, tt.cloudEvents)
+
+			if err := publisher.Created(context.Background(), task); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			updated := task
+			updated.IsDone = true
+
+			if err := publisher.Updated(context.Background(), updated); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if err := publisher.Deleted(context.Background(), task.ID); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			// The fake server does not deliver messages in order, so only the ordering key is verified.
+			for _, msg := range srv.Messages() {
+				if msg.OrderingKey != task.ID {
+					t.Fatalf("expected ordering key %s, got %s", task.ID, msg.OrderingKey)
+				}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			var (
+				mutex  sync.Mutex
+				actual []pubsub.Event
+			)
+
+			if err := pubsub.NewSubscriber(sub).Receive(ctx, func(_ context.Context, evt pubsub.Event) error {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if actual = append(actual, evt); len(actual) == 3 {
+					cancel()
+				}
+
+				return nil
+			}); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected := []pubsub.Event{
+				{Type: "tasks.event.created", Value: task},
+				{Type: "tasks.event.updated", Value: updated},
+				{Type: "tasks.event.deleted", Value: internal.Task{ID: task.ID}},
+			}
+
+			sortByType := cmpopts.SortSlices(func(a, b pubsub.Event) bool { return a.Type < b.Type })
+
+			if !cmp.Equal(expected, actual, sortByType) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual, sortByType))
+			}
+		})
+	}
+}
+
+func newSubscription(tb testing.TB, name string) (*pstest.Server, *gpubsub.Topic, *gpubsub.Subscription) {
+	tb.Helper()
+
+	srv := pstest.NewServer()
+
+	conn, err := grpc.Dial(srv.Addr, grpc.WithInsecure())
+	if err != nil {
+		tb.Fatalf("Couldn't dial: %s", err)
+	}
+
+	client, err := gpubsub.NewClient(context.Background(), "todo-api", option.WithGRPCConn(conn))
+	if err != nil {
+		tb.Fatalf("Couldn't instantiate client: %s", err)
+	}
+
+	tb.Cleanup(func() {
+		_ = client.Close()
+		_ = srv.Close()
+	})
+
+	topic, err := client.CreateTopic(context.Background(), name)
+	if err != nil {
+		tb.Fatalf("Couldn't create topic: %s", err)
+	}
+
+	tb.Cleanup(topic.Stop)
+
+	sub, err := client.CreateSubscription(context.Background(), name, gpubsub.SubscriptionConfig{
+		Topic:                 topic,
+		EnableMessageOrdering: true,
+	})
+	if err != nil {
+		tb.Fatalf("Couldn't create subscription: %s", err)
+	}
+
+	return srv, topic, sub
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"net/url"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// pushSubscriptionNamespace is the namespace of the IDs of PushSubscriptions, see NewPushSubscriptionID.
+//nolint: gochecknoglobals
+var pushSubscriptionNamespace = uuid.MustParse("9c1e6b52-3f0d-4b8e-a4b1-5d7f2c9e8a31")
+
+// PushSubscription is a browser subscribed to receive notifications using the Web Push protocol, it's the
+// PushSubscription of the Push API: Endpoint is the URL of the push service, P256dh and Auth are the keys used
+// for encrypting the messages.
+type PushSubscription struct {
+	ID       string
+	Endpoint string
+	P256dh   string
+	Auth     string
+}
+
+// NewPushSubscriptionID returns the ID of the subscription using endpoint, it's always the same for the same
+// endpoint so subscribing the same browser again replaces the existing subscription.
+func NewPushSubscriptionID(endpoint string) string {
+	return uuid.NewSHA1(pushSubscriptionNamespace, []byte(endpoint)).String()
+}
+
+// Validate indicates whether the fields are valid or not.
+func (s PushSubscription) Validate() error {
+	if err := validation.ValidateStruct(&s,
+		validation.Field(&s.Endpoint, validation.Required, validation.By(validatePushEndpoint)),
+		validation.Field(&s.P256dh, validation.Required),
+		validation.Field(&s.Auth, validation.Required),
+	); err != nil {
+		return WrapErrorf(err, ErrorCodeInvalidArgument, "invalid values")
+	}
+
+	return nil
+}
+
+// validatePushEndpoint indicates whether the endpoint is an URL using HTTPS, required by the push services.
+func validatePushEndpoint(val interface{}) error {
+	endpoint, _ := val.(string)
+
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return NewErrorf(ErrorCodeInvalidArgument, "must be an https URL")
+	}
+
+	return nil
+}
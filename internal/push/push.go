@@ -0,0 +1,104 @@
+// Package push delivers notifications to browsers using the Web Push protocol, RFC 8030, encrypting the messages
+// as described in RFC 8291 and identifying the server to the push services using VAPID, RFC 8292.
+package push
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// VAPID identifies the server to the push services, the keys are the base64 URL encoded ECDSA P-256 keys; browsers
+// subscribe using PublicKey as the applicationServerKey. Subscriber is the email address contacted by the push
+// services when there are problems with the messages.
+type VAPID struct {
+	PublicKey  string
+	PrivateKey string
+	Subscriber string
+}
+
+// Message is the payload delivered to the service worker of the browser, which displays the notification.
+type Message struct {
+	Title  string    `json:"title"`
+	Body   string    `json:"body"`
+	TaskID string    `json:"task_id"`
+	Due    time.Time `json:"due"`
+}
+
+// Pusher delivers the messages to the push services.
+type Pusher struct {
+	client *http.Client
+	vapid  VAPID
+}
+
+// NewPusher instantiates the Pusher.
+func NewPusher(client *http.Client, vapid VAPID) *Pusher {
+	return &Pusher{
+		client: client,
+		vapid:  vapid,
+	}
+}
+
+// Send delivers the message to the subscription, push services keep it up to ttl while the browser is offline.
+// Messages about the same Task replace the pending ones. Errors using internal.ErrorCodeNotFound indicate the
+// subscription expired or was cancelled, it must not be used anymore.
+func (p *Pusher) Send(ctx context.Context, sub internal.PushSubscription, msg Message, ttl time.Duration) error {
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Marshal")
+	}
+
+	res, err := webpush.SendNotificationWithContext(ctx, payload,
+		&webpush.Subscription{
+			Endpoint: sub.Endpoint,
+			Keys: webpush.Keys{
+				Auth:   sub.Auth,
+				P256dh: sub.P256dh,
+			},
+		},
+		&webpush.Options{
+			HTTPClient:      p.client,
+			Subscriber:      p.vapid.Subscriber,
+			Topic:           topic(msg.TaskID),
+			TTL:             int(ttl.Seconds()),
+			Urgency:         webpush.UrgencyHigh,
+			VAPIDPublicKey:  p.vapid.PublicKey,
+			VAPIDPrivateKey: p.vapid.PrivateKey,
+		})
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnavailable, "webpush.SendNotification")
+	}
+
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	switch {
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "subscription gone")
+	case res.StatusCode >= http.StatusBadRequest:
+		return internal.NewErrorf(internal.ErrorCodeUnavailable, "unexpected status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// topic returns the Topic header replacing the pending messages about the same Task, it's limited to 32 characters
+// of the base64 URL alphabet so the dashes of the ID are removed.
+func topic(taskID string) string {
+	res := make([]byte, 0, len(taskID))
+
+	for i := 0; i < len(taskID) && len(res) < 32; i++ {
+		if taskID[i] != '-' {
+			res = append(res, taskID[i])
+		}
+	}
+
+	return string(res)
+}
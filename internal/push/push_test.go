@@ -0,0 +1,124 @@
+package push_test
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	webpush "github.com/SherClockHolmes/webpush-go"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/push"
+)
+
+func TestPusher_Send(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		code   internal.ErrorCode
+	}{
+		{
+			"OK",
+			http.StatusCreated,
+			0,
+		},
+		{
+			"ERR: gone",
+			http.StatusGone,
+			internal.ErrorCodeNotFound,
+		},
+		{
+			"ERR: unavailable",
+			http.StatusTooManyRequests,
+			internal.ErrorCodeUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var req *http.Request
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				req = r
+
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			pusher := push.NewPusher(srv.Client(), newVAPID(t))
+
+			err := pusher.Send(context.Background(), newSubscription(t, srv.URL),
+				push.Message{Title: "Task due soon", TaskID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"}, time.Hour)
+
+			var ierr *internal.Error
+			if tt.code != 0 && (!errors.As(err, &ierr) || ierr.Code() != tt.code) {
+				t.Fatalf("expected error code %d, got %v", tt.code, err)
+			}
+
+			if tt.code == 0 && err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !strings.HasPrefix(req.Header.Get("Authorization"), "vapid t=") {
+				t.Fatalf("expected VAPID authorization, got %q", req.Header.Get("Authorization"))
+			}
+
+			for header, expected := range map[string]string{
+				"Content-Encoding": "aes128gcm",
+				"TTL":              "3600",
+				"Topic":            "aaaaaaaabbbbccccddddeeeeeeeeeeee",
+				"Urgency":          "high",
+			} {
+				if actual := req.Header.Get(header); actual != expected {
+					t.Fatalf("expected %s %q, got %q", header, expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func newVAPID(t *testing.T) push.VAPID {
+	t.Helper()
+
+	private, public, err := webpush.GenerateVAPIDKeys()
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	return push.VAPID{PublicKey: public, PrivateKey: private, Subscriber: "todo@example.com"}
+}
+
+// newSubscription returns a subscription using the keys a browser would generate.
+func newSubscription(t *testing.T, endpoint string) internal.PushSubscription {
+	t.Helper()
+
+	key, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	auth := make([]byte, 16)
+	if _, err := rand.Read(auth); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	return internal.PushSubscription{
+		ID:       internal.NewPushSubscriptionID(endpoint),
+		Endpoint: endpoint,
+		P256dh:   base64.RawURLEncoding.EncodeToString(key.PublicKey().Bytes()),
+		Auth:     base64.RawURLEncoding.EncodeToString(auth),
+	}
+}
@@ -0,0 +1,182 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// reminderPageSize is the number of due Tasks read at once.
+const reminderPageSize = 100
+
+//nolint: gochecknoglobals
+var (
+	sent = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/push")).NewInt64Counter(
+		"push.sent",
+		metric.WithDescription("Number of reminders delivered to push subscriptions"),
+	)
+
+	failed = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/push")).NewInt64Counter(
+		"push.failed",
+		metric.WithDescription("Number of reminders not delivered to push subscriptions"),
+	)
+)
+
+// TaskRepository defines the datastore used for listing the due Tasks.
+type TaskRepository interface {
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
+}
+
+// SubscriptionRepository defines the datastore keeping the subscriptions and the reminders already delivered.
+type SubscriptionRepository interface {
+	ClaimReminder(ctx context.Context, taskID string, due time.Time) (bool, error)
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context) ([]internal.PushSubscription, error)
+}
+
+// Sender defines the client delivering the messages, see Pusher.
+type Sender interface {
+	Send(ctx context.Context, sub internal.PushSubscription, msg Message, ttl time.Duration) error
+}
+
+// Reminder delivers a reminder to every subscription when undone Tasks are about to be due. Each reminder is
+// claimed before delivering it, so all the instances can run it at the same time; Tasks whose due date changes
+// are reminded again.
+type Reminder struct {
+	logger   *zap.Logger
+	tasks    TaskRepository
+	subs     SubscriptionRepository
+	sender   Sender
+	lead     time.Duration
+	interval time.Duration
+}
+
+// NewReminder instantiates the Reminder, Tasks are reminded lead before their due date and checked every interval.
+func NewReminder(logger *zap.Logger, tasks TaskRepository, subs SubscriptionRepository, sender Sender,
+	lead, interval time.Duration) *Reminder {
+	return &Reminder{
+		logger:   logger,
+		tasks:    tasks,
+		subs:     subs,
+		sender:   sender,
+		lead:     lead,
+		interval: interval,
+	}
+}
+
+// Run delivers the reminders until ctx is canceled, failures are logged and retried in the next interval.
+func (r *Reminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := r.Remind(ctx); err != nil {
+			r.logger.Warn("Couldn't deliver reminders", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Remind delivers the reminders of the Tasks due within the lead time, it returns the number of Tasks reminded.
+func (r *Reminder) Remind(ctx context.Context) (int, error) {
+	subs, err := r.subs.List(ctx)
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "subs.List")
+	}
+
+	// Reminders are not claimed while nobody is subscribed, those are delivered once subscribed.
+	if len(subs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+
+	params := internal.ListParams{
+		Size: reminderPageSize,
+		Due:  &internal.DueRange{From: now, To: now.Add(r.lead)},
+	}
+
+	var reminded int
+
+	for {
+		res, err := r.tasks.List(ctx, params)
+		if err != nil {
+			return reminded, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.List")
+		}
+
+		for _, task := range res.Tasks {
+			claimed, err := r.subs.ClaimReminder(ctx, task.ID, task.Dates.Due)
+			if err != nil {
+				return reminded, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "subs.ClaimReminder")
+			}
+
+			if !claimed {
+				continue
+			}
+
+			subs = r.remind(ctx, subs, task, task.Dates.Due.Sub(now))
+			reminded++
+		}
+
+		if res.NextCursor == "" {
+			return reminded, nil
+		}
+
+		params.Cursor = res.NextCursor
+	}
+}
+
+// remind delivers the reminder to the subscriptions, it returns the ones still valid. The message expires when the
+// Task is due.
+func (r *Reminder) remind(ctx context.Context, subs []internal.PushSubscription, task internal.Task,
+	ttl time.Duration) []internal.PushSubscription {
+	msg := Message{
+		Title:  "Task due soon",
+		Body:   task.Description,
+		TaskID: task.ID,
+		Due:    task.Dates.Due,
+	}
+
+	valid := subs[:0]
+
+	for _, sub := range subs {
+		err := r.sender.Send(ctx, sub, msg, ttl)
+
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound {
+			r.logger.Info("Push subscription gone, removing it", zap.String("id", sub.ID))
+
+			if err := r.subs.Delete(ctx, sub.ID); err != nil {
+				r.logger.Warn("Couldn't remove push subscription", zap.String("id", sub.ID), zap.Error(err))
+			}
+
+			continue
+		}
+
+		valid = append(valid, sub)
+
+		if err != nil {
+			failed.Add(ctx, 1)
+
+			r.logger.Warn("Couldn't deliver reminder", zap.String("id", sub.ID), zap.String("task", task.ID),
+				zap.Error(err))
+
+			continue
+		}
+
+		sent.Add(ctx, 1)
+	}
+
+	return valid
+}
@@ -0,0 +1,112 @@
+package push_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/push"
+)
+
+func TestReminder_Remind(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	tasks := memory.NewTask()
+
+	create := func(description string, due time.Time) internal.Task {
+		task, err := tasks.Create(context.Background(), internal.CreateParams{
+			Description: description,
+			Priority:    internal.PriorityHigh,
+			Dates:       internal.Dates{Due: due},
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		return task
+	}
+
+	soon := create("soon", now.Add(30*time.Minute))
+	create("later", now.Add(3*time.Hour))
+	create("overdue", now.Add(-time.Minute))
+
+	subs := memory.NewPushSubscription()
+
+	active := internal.PushSubscription{ID: internal.NewPushSubscriptionID("https://push.example.com/active")}
+	gone := internal.PushSubscription{ID: internal.NewPushSubscriptionID("https://push.example.com/gone")}
+
+	sender := &fakeSender{gone: map[string]bool{gone.ID: true}}
+
+	reminder := push.NewReminder(zap.NewNop(), tasks, subs, sender, time.Hour, time.Minute)
+
+	// Nobody is subscribed: nothing is claimed.
+
+	assertReminded(t, reminder, 0)
+
+	for _, sub := range []internal.PushSubscription{active, gone} {
+		if err := subs.Save(context.Background(), sub); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+	}
+
+	// Only the task due within the lead time is reminded, once.
+
+	assertReminded(t, reminder, 1)
+	assertReminded(t, reminder, 0)
+
+	expected := []string{active.ID + " " + soon.ID, gone.ID + " " + soon.ID}
+	if !cmp.Equal(expected, sender.sent) {
+		t.Fatalf("expected sent does not match: %s", cmp.Diff(expected, sender.sent))
+	}
+
+	// Subscriptions gone are removed.
+
+	actual, err := subs.List(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal([]internal.PushSubscription{active}, actual) {
+		t.Fatalf("expected subscriptions do not match: %s", cmp.Diff([]internal.PushSubscription{active}, actual))
+	}
+}
+
+func assertReminded(t *testing.T, reminder *push.Reminder, expected int) {
+	t.Helper()
+
+	n, err := reminder.Remind(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if n != expected {
+		t.Fatalf("expected %d reminded tasks, got %d", expected, n)
+	}
+}
+
+type fakeSender struct {
+	mu   sync.Mutex
+	gone map[string]bool
+	sent []string
+}
+
+func (f *fakeSender) Send(_ context.Context, sub internal.PushSubscription, msg push.Message, _ time.Duration) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.sent = append(f.sent, sub.ID+" "+msg.TaskID)
+
+	if f.gone[sub.ID] {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "subscription gone")
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestPushSubscription_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.PushSubscription
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.PushSubscription{Endpoint: "https://push.example.com/1", P256dh: "key", Auth: "secret"},
+			false,
+		},
+		{
+			"ERR: Endpoint missing",
+			internal.PushSubscription{P256dh: "key", Auth: "secret"},
+			true,
+		},
+		{
+			"ERR: Endpoint not https",
+			internal.PushSubscription{Endpoint: "http://push.example.com/1", P256dh: "key", Auth: "secret"},
+			true,
+		},
+		{
+			"ERR: Keys",
+			internal.PushSubscription{Endpoint: "https://push.example.com/1"},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actualErr := tt.input.Validate()
+			if (actualErr != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %s", tt.withErr, actualErr)
+			}
+
+			var ierr *internal.Error
+			if tt.withErr && !errors.As(actualErr, &ierr) {
+				t.Fatalf("expected %T error, got %T", ierr, actualErr)
+			}
+		})
+	}
+}
+
+func TestNewPushSubscriptionID(t *testing.T) {
+	t.Parallel()
+
+	id := internal.NewPushSubscriptionID("https://push.example.com/1")
+
+	if other := internal.NewPushSubscriptionID("https://push.example.com/1"); other != id {
+		t.Fatalf("expected same id %s, got %s", id, other)
+	}
+
+	if other := internal.NewPushSubscriptionID("https://push.example.com/2"); other == id {
+		t.Fatalf("expected different id, got %s", other)
+	}
+}
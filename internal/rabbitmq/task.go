@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/gob"
+	"encoding/json"
 	"time"
 
 	"github.com/streadway/amqp"
@@ -14,15 +15,20 @@ import (
 	"github.com/MarioCarrion/todo-api/internal"
 )
 
+// source identifies the producer of the events when using the CloudEvents envelope.
+const source = "/tasks-rest-server"
+
 // Task represents the repository used for publishing Task records.
 type Task struct {
-	ch *amqp.Channel
+	ch          *amqp.Channel
+	cloudEvents bool
 }
 
-// NewTask instantiates the Task repository.
-func NewTask(channel *amqp.Channel) (*Task, error) {
+// NewTask instantiates the Task repository, when cloudEvents is true messages use the CloudEvents envelope.
+func NewTask(channel *amqp.Channel, cloudEvents bool) (*Task, error) {
 	return &Task{
-		ch: channel,
+		ch:          channel,
+		cloudEvents: cloudEvents,
 	}, nil
 }
 
@@ -41,9 +47,22 @@ func (t *Task) Updated(ctx context.Context, task internal.Task) error {
 	return t.publish(ctx, "Task.Updated", "tasks.event.updated", task)
 }
 
+// Completed publishes a message indicating a task was completed, it's published after the one indicating the task
+// was updated.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Completed", "tasks.event.completed", task)
+}
+
+// Reopened publishes a message indicating a completed task was reopened, it's published after the one indicating
+// the task was updated.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Reopened", "tasks.event.reopened", task)
+}
+
 func (t *Task) publish(ctx context.Context, spanName, routingKey string, event interface{}) error {
 	_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, spanName)
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyRabbitMQ)()
 
 	span.SetAttributes(
 		attribute.KeyValue{
@@ -60,7 +79,20 @@ func (t *Task) publish(ctx context.Context, spanName, routingKey string, event i
 
 	var b bytes.Buffer
 
-	if err := gob.NewEncoder(&b).Encode(event); err != nil {
+	contentType := "application/x-encoding-gob" // XXX: We will revisit this in future episodes
+
+	if t.cloudEvents {
+		evt, err := internal.NewCloudEvent(source, routingKey, event)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.NewCloudEvent")
+		}
+
+		if err := json.NewEncoder(&b).Encode(evt); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Encode")
+		}
+
+		contentType = internal.CloudEventsContentType
+	} else if err := gob.NewEncoder(&b).Encode(event); err != nil {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "gob.Encode")
 	}
 
@@ -71,7 +103,7 @@ func (t *Task) publish(ctx context.Context, spanName, routingKey string, event i
 		false,      // immediate
 		amqp.Publishing{
 			AppId:       "tasks-rest-server",
-			ContentType: "application/x-encoding-gob", // XXX: We will revisit this in future episodes
+			ContentType: contentType,
 			Body:        b.Bytes(),
 			Timestamp:   time.Now(),
 		})
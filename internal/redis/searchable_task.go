@@ -0,0 +1,251 @@
+package redis
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// refreshTimeout defines how long a background refresh is allowed to take, it is also used as the expiration
+	// of the lock preventing concurrent refreshes of the same query.
+	refreshTimeout = 5 * time.Second
+)
+
+// SearchableTask caches search results using the stale-while-revalidate strategy: fresh results are returned as
+// they are, stale results are returned while they are refreshed in the background.
+type SearchableTask struct {
+	client   *redis.Client
+	orig     SearchableTaskStore
+	logger   *zap.Logger
+	fresh    time.Duration
+	stale    time.Duration
+	requests metric.Int64Counter
+}
+
+// SearchableTaskStore defines the datastore used for searching Task records.
+type SearchableTaskStore interface {
+	Delete(ctx context.Context, id string) error
+	Index(ctx context.Context, task internal.Task) error
+	Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error)
+	Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error)
+}
+
+type cachedSearchResults struct {
+	Results    internal.SearchResults
+	FreshUntil time.Time
+}
+
+// NewSearchableTask instantiates the SearchableTask repository. Cached results are considered fresh during the
+// "fresh" duration and then served as stale, while being refreshed, during the "stale" duration.
+func NewSearchableTask(logger *zap.Logger, client *redis.Client, orig SearchableTaskStore, fresh, stale time.Duration) *SearchableTask {
+	meter := global.Meter("github.com/MarioCarrion/todo-api/internal/redis")
+
+	return &SearchableTask{
+		client: client,
+		orig:   orig,
+		logger: logger,
+		fresh:  fresh,
+		stale:  stale,
+		requests: metric.Must(meter).NewInt64Counter("search_cache.requests",
+			metric.WithDescription("Number of cached search requests, labeled by result: hit, stale or miss"),
+		),
+	}
+}
+
+// Index ...
+func (t *SearchableTask) Index(ctx context.Context, task internal.Task) error {
+	if err := t.orig.Index(ctx, task); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Index")
+	}
+
+	return nil
+}
+
+// Delete ...
+func (t *SearchableTask) Delete(ctx context.Context, id string) error {
+	if err := t.orig.Delete(ctx, id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Delete")
+	}
+
+	return nil
+}
+
+// Suggest returns the suggestions without caching them, those change with every typed character.
+func (t *SearchableTask) Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error) {
+	res, err := t.orig.Suggest(ctx, params)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Suggest")
+	}
+
+	return res, nil
+}
+
+// Search returns the cached results, when those are stale they are refreshed in the background.
+func (t *SearchableTask) Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error) {
+	key := newSearchKey(args)
+
+	cached, err := t.get(ctx, key)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			t.logger.Warn("search cache unavailable",
+				zap.Error(err),
+				zap.String("request_id", internal.RequestIDFromContext(ctx)))
+		}
+
+		t.requests.Add(ctx, 1, attribute.String("result", "miss"))
+
+		res, err := t.orig.Search(ctx, args)
+		if err != nil {
+			return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "orig.Search")
+		}
+
+		t.set(ctx, key, res)
+
+		return res, nil
+	}
+
+	if time.Now().After(cached.FreshUntil) {
+		t.requests.Add(ctx, 1, attribute.String("result", "stale"))
+
+		go t.refresh(key, args)
+
+		return cached.Results, nil
+	}
+
+	t.requests.Add(ctx, 1, attribute.String("result", "hit"))
+
+	return cached.Results, nil
+}
+
+func (t *SearchableTask) get(ctx context.Context, key string) (cachedSearchResults, error) {
+	defer internal.TrackDependency(ctx, internal.DependencyRedis)()
+
+	val, err := t.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return cachedSearchResults{}, internal.WrapDependencyErrorf(err, internal.DependencyRedis, internal.ErrorCodeUnknown,
+			"client.Get")
+	}
+
+	var res cachedSearchResults
+
+	if err := json.NewDecoder(bytes.NewReader(val)).Decode(&res); err != nil {
+		return cachedSearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Decode")
+	}
+
+	return res, nil
+}
+
+// set caches the results, errors are logged and ignored because the original datastore is the source of truth.
+func (t *SearchableTask) set(ctx context.Context, key string, res internal.SearchResults) {
+	defer internal.TrackDependency(ctx, internal.DependencyRedis)()
+
+	var b bytes.Buffer
+
+	if err := json.NewEncoder(&b).Encode(cachedSearchResults{
+		Results:    res,
+		FreshUntil: time.Now().Add(t.fresh),
+	}); err != nil {
+		t.logger.Warn("couldn't encode search results",
+			zap.Error(err),
+			zap.String("request_id", internal.RequestIDFromContext(ctx)))
+
+		return
+	}
+
+	if err := t.client.Set(ctx, key, b.Bytes(), t.fresh+t.stale).Err(); err != nil {
+		t.logger.Warn("couldn't cache search results",
+			zap.Error(err),
+			zap.String("request_id", internal.RequestIDFromContext(ctx)))
+	}
+}
+
+// refresh searches and caches the results again, a lock is used to make sure only one refresh happens at the same
+// time for the same query.
+func (t *SearchableTask) refresh(key string, args internal.SearchParams) {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTimeout)
+	defer cancel()
+
+	locked, err := t.client.SetNX(ctx, key+":refresh", 1, refreshTimeout).Result()
+	if err != nil || !locked {
+		return
+	}
+
+	defer t.client.Del(ctx, key+":refresh")
+
+	res, err := t.orig.Search(ctx, args)
+	if err != nil {
+		t.logger.Warn("couldn't refresh search results", zap.Error(err))
+
+		return
+	}
+
+	t.set(ctx, key, res)
+}
+
+// newSearchKey returns the key used for caching, values are normalized so equivalent queries share the same key.
+func newSearchKey(args internal.SearchParams) string {
+	var (
+		description = "-"
+		priority    = "-"
+		isDone      = "-"
+		highlight   = "-"
+		due         = "-"
+		projectID   = "-"
+	)
+
+	if args.Description != nil {
+		description = strings.Join(strings.Fields(strings.ToLower(*args.Description)), " ")
+	}
+
+	if args.Priority != nil {
+		priority = fmt.Sprintf("%d", *args.Priority)
+	}
+
+	if args.IsDone != nil {
+		isDone = fmt.Sprintf("%t", *args.IsDone)
+	}
+
+	if args.Highlight != nil {
+		highlight = fmt.Sprintf("%d", args.Highlight.Size())
+	}
+
+	if args.Due != nil {
+		due = fmt.Sprintf("%d_%d", args.Due.From.UnixNano(), args.Due.To.UnixNano())
+	}
+
+	if args.ProjectID != nil {
+		projectID = *args.ProjectID
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		description,
+		priority,
+		isDone,
+		fmt.Sprintf("%d", args.From),
+		fmt.Sprintf("%d", args.Size),
+		args.Cursor,
+		string(args.Sort),
+		string(args.Fuzziness),
+		highlight,
+		fmt.Sprintf("%t", args.Facets),
+		due,
+		projectID,
+	}, "\x00")))
+
+	return "tasks.search." + hex.EncodeToString(sum[:])
+}
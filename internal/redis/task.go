@@ -4,6 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"syscall"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"go.opentelemetry.io/otel/attribute"
@@ -11,17 +16,31 @@ import (
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/retry"
 )
 
+// source identifies the producer of the events when using the CloudEvents envelope.
+const source = "/tasks-rest-server"
+
+//nolint: gochecknoglobals
+var publishRetry = retry.Policy{
+	Attempts:   3,
+	Backoff:    50 * time.Millisecond,
+	MaxBackoff: 500 * time.Millisecond,
+	Retryable:  retryablePublish,
+}
+
 // Task represents the repository used for publishing Task records.
 type Task struct {
-	client *redis.Client
+	client      *redis.Client
+	cloudEvents bool
 }
 
-// NewTask instantiates the Task repository.
-func NewTask(client *redis.Client) *Task {
+// NewTask instantiates the Task repository, when cloudEvents is true messages use the CloudEvents envelope.
+func NewTask(client *redis.Client, cloudEvents bool) *Task {
 	return &Task{
-		client: client,
+		client:      client,
+		cloudEvents: cloudEvents,
 	}
 }
 
@@ -40,9 +59,22 @@ func (t *Task) Updated(ctx context.Context, task internal.Task) error {
 	return t.publish(ctx, "Task.Updated", "tasks.event.updated", task)
 }
 
+// Completed publishes a message indicating a task was completed, it's published after the one indicating the task
+// was updated.
+func (t *Task) Completed(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Completed", "tasks.event.completed", task)
+}
+
+// Reopened publishes a message indicating a completed task was reopened, it's published after the one indicating
+// the task was updated.
+func (t *Task) Reopened(ctx context.Context, task internal.Task) error {
+	return t.publish(ctx, "Task.Reopened", "tasks.event.reopened", task)
+}
+
 func (t *Task) publish(ctx context.Context, spanName, channel string, event interface{}) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, spanName)
 	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyRedis)()
 
 	span.SetAttributes(
 		semconv.DBSystemRedis,
@@ -54,16 +86,37 @@ func (t *Task) publish(ctx context.Context, spanName, channel string, event inte
 
 	//-
 
+	if t.cloudEvents {
+		evt, err := internal.NewCloudEvent(source, channel, event)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.NewCloudEvent")
+		}
+
+		event = evt
+	}
+
 	var b bytes.Buffer
 
 	if err := json.NewEncoder(&b).Encode(event); err != nil {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Encode")
 	}
 
-	res := t.client.Publish(ctx, channel, b.Bytes())
-	if err := res.Err(); err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.Publish")
+	if err := publishRetry.Do(ctx, "redis.publish", func(ctx context.Context) error {
+		return t.client.Publish(ctx, channel, b.Bytes()).Err() //nolint: wrapcheck
+	}); err != nil {
+		return internal.WrapDependencyErrorf(err, internal.DependencyRedis, internal.ErrorCodeUnknown, "client.Publish")
 	}
 
 	return nil
 }
+
+// retryablePublish indicates whether publishing failed because the connection to Redis failed, messages may be
+// published twice when the connection fails after sending them, like when replaying the disk queue.
+func retryablePublish(err error) bool {
+	var nerr net.Error
+	if errors.As(err, &nerr) {
+		return !nerr.Timeout()
+	}
+
+	return errors.Is(err, io.EOF) || errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED)
+}
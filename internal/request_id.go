@@ -0,0 +1,19 @@
+package internal
+
+import (
+	"context"
+)
+
+type requestIDKey struct{}
+
+// NewContextWithRequestID returns a new context that carries the request id.
+func NewContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request id stored in ctx, if any.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+
+	return id
+}
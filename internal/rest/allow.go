@@ -0,0 +1,75 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// RegisterAllow configures the router to respond with "405 Method Not Allowed", including the "Allow" header, when
+// a route matches the path but not the method, and to respond to OPTIONS requests of all the routes without an
+// explicit OPTIONS handler. It must be called after all the routes are registered.
+func RegisterAllow(router *mux.Router) {
+	router.MethodNotAllowedHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", strings.Join(allowedMethods(router, r), ", "))
+
+		renderResponse(r.Context(), w, &ErrorResponse{Error: "method not allowed"}, http.StatusMethodNotAllowed)
+	})
+
+	router.Methods(http.MethodOptions).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods := allowedMethods(router, r)
+		if len(methods) == 0 {
+			http.NotFound(w, r)
+
+			return
+		}
+
+		w.Header().Set("Allow", strings.Join(methods, ", "))
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// allowedMethods returns the methods of the routes matching the request path, in the order they were registered,
+// OPTIONS is always included when at least one route matches.
+func allowedMethods(router *mux.Router, r *http.Request) []string {
+	var res []string
+
+	seen := make(map[string]struct{})
+
+	_ = router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		// Routes without a path, like the OPTIONS one above, match every request.
+		if _, err := route.GetPathTemplate(); err != nil {
+			return nil
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil //nolint: nilerr
+		}
+
+		var match mux.RouteMatch
+		if !route.Match(r, &match) && !errors.Is(match.MatchErr, mux.ErrMethodMismatch) {
+			return nil
+		}
+
+		for _, method := range methods {
+			if _, ok := seen[method]; !ok {
+				seen[method] = struct{}{}
+
+				res = append(res, method)
+			}
+		}
+
+		return nil
+	})
+
+	if len(res) > 0 {
+		if _, ok := seen[http.MethodOptions]; !ok {
+			res = append(res, http.MethodOptions)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,91 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestRegisterAllow(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		status int
+		allow  string
+	}{
+		{
+			"OK: supported method",
+			http.MethodGet,
+			"/tasks",
+			http.StatusOK,
+			"",
+		},
+		{
+			"OK: OPTIONS",
+			http.MethodOptions,
+			"/tasks",
+			http.StatusNoContent,
+			"GET, POST, OPTIONS",
+		},
+		{
+			"ERR: method not allowed",
+			http.MethodDelete,
+			"/tasks",
+			http.StatusMethodNotAllowed,
+			"GET, POST, OPTIONS",
+		},
+		{
+			"ERR: method not allowed with variables",
+			http.MethodPost,
+			"/tasks/123",
+			http.StatusMethodNotAllowed,
+			"GET, OPTIONS",
+		},
+		{
+			"ERR: OPTIONS not found",
+			http.MethodOptions,
+			"/unknown",
+			http.StatusNotFound,
+			"",
+		},
+	}
+
+	ok := func(w http.ResponseWriter, _ *http.Request) {}
+
+	router := mux.NewRouter()
+	router.HandleFunc("/tasks", ok).Methods(http.MethodGet)
+	router.HandleFunc("/tasks", ok).Methods(http.MethodPost)
+	router.HandleFunc("/tasks/{id:[0-9]+}", ok).Methods(http.MethodGet)
+
+	rest.RegisterAllow(router)
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+
+			router.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.target, nil))
+
+			res := rec.Result()
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.status {
+				t.Fatalf("expected status %d, got %d", tt.status, res.StatusCode)
+			}
+
+			if allow := res.Header.Get("Allow"); allow != tt.allow {
+				t.Fatalf("expected Allow %q, got %q", tt.allow, allow)
+			}
+		})
+	}
+}
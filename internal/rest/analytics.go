@@ -0,0 +1,18 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// AnalyticsConsent is a middleware that stores whether clients consent to product analytics in the request context,
+// clients consent unless they opt out using the Global Privacy Control, "Sec-GPC: 1", or the Do Not Track,
+// "DNT: 1", headers.
+func AnalyticsConsent(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		consent := r.Header.Get("Sec-GPC") != "1" && r.Header.Get("DNT") != "1"
+
+		next.ServeHTTP(w, r.WithContext(internal.NewContextWithAnalyticsConsent(r.Context(), consent)))
+	})
+}
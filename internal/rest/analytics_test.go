@@ -0,0 +1,71 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestAnalyticsConsent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		header   string
+		value    string
+		expected bool
+	}{
+		{
+			"OK: consent",
+			"",
+			"",
+			true,
+		},
+		{
+			"OK: global privacy control",
+			"Sec-GPC",
+			"1",
+			false,
+		},
+		{
+			"OK: do not track",
+			"DNT",
+			"1",
+			false,
+		},
+		{
+			"OK: do not track disabled",
+			"DNT",
+			"0",
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual bool
+
+			handler := rest.AnalyticsConsent(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				actual = internal.AnalyticsConsentFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+			if tt.header != "" {
+				req.Header.Set(tt.header, tt.value)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			if actual != tt.expected {
+				t.Fatalf("expected consent %t, got %t", tt.expected, actual)
+			}
+		})
+	}
+}
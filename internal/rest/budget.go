@@ -0,0 +1,113 @@
+package rest
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// ServerTimingHeader is the header used for returning the time consumed by each dependency in debug mode.
+const ServerTimingHeader = "Server-Timing"
+
+// Budget returns a middleware that tracks how much of limit, the time available for handling a request, was
+// consumed by each dependency; the breakdown is added to the current span and, when debug is enabled, returned
+// using the Server-Timing header.
+func Budget(limit time.Duration, debug bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, budget := internal.NewContextWithBudget(r.Context(), limit)
+
+			if debug {
+				w = &serverTimingWriter{ResponseWriter: w, budget: budget}
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+
+			trace.SpanFromContext(ctx).SetAttributes(BudgetAttributes(budget)...)
+		})
+	}
+}
+
+// BudgetAttributes returns the time consumed by each dependency, in milliseconds and as a percentage of the
+// limit when known, as span attributes.
+func BudgetAttributes(budget *internal.Budget) []attribute.KeyValue {
+	entries := budget.Entries()
+
+	res := make([]attribute.KeyValue, 0, len(entries)*2)
+
+	for _, entry := range entries {
+		key := "budget." + string(entry.Dependency)
+
+		res = append(res, attribute.Float64(key+".ms", milliseconds(entry.Duration)))
+
+		if budget.Limit() > 0 {
+			res = append(res, attribute.Float64(key+".percent", budget.Percent(entry.Duration)))
+		}
+	}
+
+	return res
+}
+
+// serverTimingWriter adds the Server-Timing header before the response headers are written, at that point the
+// dependencies needed for rendering the response were already called.
+type serverTimingWriter struct {
+	http.ResponseWriter
+	budget  *internal.Budget
+	written bool
+}
+
+func (s *serverTimingWriter) WriteHeader(status int) {
+	if !s.written {
+		s.written = true
+
+		s.Header().Set(ServerTimingHeader, serverTiming(s.budget))
+	}
+
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *serverTimingWriter) Write(b []byte) (int, error) {
+	if !s.written {
+		s.WriteHeader(http.StatusOK)
+	}
+
+	return s.ResponseWriter.Write(b) //nolint: wrapcheck
+}
+
+// Hijack lets upgraded connections, like WebSockets, take over the connection; no headers are added to those.
+func (s *serverTimingWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, internal.NewErrorf(internal.ErrorCodeUnknown, "hijacking not supported")
+	}
+
+	return hijacker.Hijack() //nolint: wrapcheck
+}
+
+// serverTiming returns the Server-Timing value summarizing the budget, for example
+// "postgresql;dur=12.1, total;dur=15.3".
+func serverTiming(budget *internal.Budget) string {
+	entries := budget.Entries()
+
+	res := make([]string, 0, len(entries)+1)
+
+	for _, entry := range entries {
+		res = append(res, fmt.Sprintf("%s;dur=%.1f", entry.Dependency, milliseconds(entry.Duration)))
+	}
+
+	res = append(res, fmt.Sprintf("total;dur=%.1f", milliseconds(budget.Elapsed())))
+
+	return strings.Join(res, ", ")
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
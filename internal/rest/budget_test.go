@@ -0,0 +1,87 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		debug  bool
+		output string
+	}{
+		{
+			"OK: debug",
+			true,
+			`^elasticsearch;dur=80\.0, postgresql;dur=12\.0, total;dur=[0-9.]+$`,
+		},
+		{
+			"OK: no debug",
+			false,
+			`^$`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := rest.Budget(time.Second, tt.debug)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				budget := internal.BudgetFromContext(r.Context())
+				if budget == nil {
+					t.Fatalf("expected budget in context")
+				}
+
+				budget.Add(internal.DependencyPostgreSQL, 12*time.Millisecond)
+				budget.Add(internal.DependencyElasticsearch, 80*time.Millisecond)
+
+				_, _ = w.Write([]byte("{}"))
+			}))
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			if actual := rec.Header().Get(rest.ServerTimingHeader); !regexp.MustCompile(tt.output).MatchString(actual) {
+				t.Fatalf("expected header matching %s, got %s", tt.output, actual)
+			}
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+			}
+		})
+	}
+}
+
+func TestBudgetAttributes(t *testing.T) {
+	t.Parallel()
+
+	_, budget := internal.NewContextWithBudget(httptest.NewRequest(http.MethodGet, "/", nil).Context(), time.Second)
+
+	budget.Add(internal.DependencyPostgreSQL, 100*time.Millisecond)
+
+	actual := rest.BudgetAttributes(budget)
+
+	if len(actual) != 2 {
+		t.Fatalf("expected 2 attributes, got %d", len(actual))
+	}
+
+	if actual[0].Key != "budget.postgresql.ms" || actual[0].Value.AsFloat64() != 100 {
+		t.Fatalf("expected milliseconds, got %v", actual[0])
+	}
+
+	if actual[1].Key != "budget.postgresql.percent" || actual[1].Value.AsFloat64() != 10 {
+		t.Fatalf("expected percent, got %v", actual[1])
+	}
+}
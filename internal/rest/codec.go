@@ -0,0 +1,181 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+const (
+	// MessagePackContentType is the media type used for exchanging MessagePack payloads.
+	MessagePackContentType = "application/msgpack"
+
+	jsonContentType = "application/json"
+)
+
+// Codec encodes and decodes payloads using a media type. The payloads of all the codecs have the same structure as
+// the JSON ones, those are converted from and to JSON so the field names, validations and errors are the same.
+type Codec interface {
+	ContentType() string
+
+	// FromJSON converts the JSON document to the media type.
+	FromJSON(data []byte) ([]byte, error)
+
+	// ToJSON converts the payload to a JSON document.
+	ToJSON(data []byte) ([]byte, error)
+}
+
+// codecs are the supported codecs indexed by media type, JSON is used when not negotiated.
+//nolint: gochecknoglobals
+var codecs = map[string]Codec{
+	MessagePackContentType:  msgpackCodec{},
+	"application/x-msgpack": msgpackCodec{},
+}
+
+type codecCtxKey struct{}
+
+// Negotiate selects the codec used for rendering the responses using the Accept header, JSON is used when none of
+// the requested media types are supported.
+func Negotiate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept")
+
+		if codec := negotiateCodec(r.Header.Get("Accept")); codec != nil {
+			r = r.WithContext(context.WithValue(r.Context(), codecCtxKey{}, codec))
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// codecFromContext returns the codec negotiated by Negotiate, nil when JSON is used.
+func codecFromContext(ctx context.Context) Codec {
+	codec, _ := ctx.Value(codecCtxKey{}).(Codec)
+
+	return codec
+}
+
+// requestCodec returns the codec used by the body of r according to its Content-Type, nil when it's JSON or any
+// other media type; those are decoded as JSON.
+func requestCodec(r *http.Request) Codec {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return nil
+	}
+
+	return codecs[mediaType]
+}
+
+// negotiateCodec returns the supported codec with the highest quality in accept, nil when JSON or an unsupported
+// media type is preferred.
+func negotiateCodec(accept string) Codec {
+	type candidate struct {
+		mediaType string
+		quality   float64
+	}
+
+	var candidates []candidate
+
+	for _, val := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(val))
+		if err != nil {
+			continue
+		}
+
+		quality := 1.0
+
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		if quality > 0 {
+			candidates = append(candidates, candidate{mediaType: mediaType, quality: quality})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].quality > candidates[j].quality })
+
+	for _, c := range candidates {
+		if c.mediaType == jsonContentType {
+			return nil
+		}
+
+		if codec, ok := codecs[c.mediaType]; ok {
+			return codec
+		}
+	}
+
+	return nil
+}
+
+// decodeJSONValue decodes the JSON document into a generic value, numbers are kept as integers when possible.
+func decodeJSONValue(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var res interface{}
+
+	if err := dec.Decode(&res); err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	return convertNumbers(res), nil
+}
+
+// convertNumbers replaces the json.Number values with int64 or float64 values.
+func convertNumbers(val interface{}) interface{} {
+	switch v := val.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+
+		f, _ := v.Float64()
+
+		return f
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = convertNumbers(elem)
+		}
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = convertNumbers(elem)
+		}
+	}
+
+	return val
+}
+
+type msgpackCodec struct{}
+
+func (msgpackCodec) ContentType() string {
+	return MessagePackContentType
+}
+
+func (msgpackCodec) FromJSON(data []byte) ([]byte, error) {
+	val, err := decodeJSONValue(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return msgpack.Marshal(val) //nolint: wrapcheck
+}
+
+func (msgpackCodec) ToJSON(data []byte) ([]byte, error) {
+	var val interface{}
+
+	if err := msgpack.Unmarshal(data, &val); err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	return json.Marshal(val) //nolint: wrapcheck
+}
@@ -0,0 +1,191 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestNegotiate(t *testing.T) {
+	t.Parallel()
+
+	msgpackToJSON := func(t *testing.T, data []byte) []byte {
+		t.Helper()
+
+		var val interface{}
+
+		if err := msgpack.Unmarshal(data, &val); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		res, _ := json.Marshal(val)
+
+		return res
+	}
+
+	identity := func(_ *testing.T, data []byte) []byte { return data }
+
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+		toJSON      func(*testing.T, []byte) []byte
+	}{
+		{
+			"OK: default",
+			"",
+			"application/json",
+			identity,
+		},
+		{
+			"OK: msgpack",
+			"application/msgpack",
+			rest.MessagePackContentType,
+			msgpackToJSON,
+		},
+		{
+			"OK: msgpack preferred",
+			"application/json;q=0.5, application/x-msgpack",
+			rest.MessagePackContentType,
+			msgpackToJSON,
+		},
+		{
+			"OK: protobuf unsupported",
+			"application/x-protobuf",
+			"application/json",
+			identity,
+		},
+		{
+			"OK: JSON preferred",
+			"application/json, application/msgpack;q=0.9",
+			"application/json",
+			identity,
+		},
+		{
+			"OK: unsupported",
+			"application/xml",
+			"application/json",
+			identity,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			router.Use(rest.Negotiate)
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(internal.Task{ID: "a-b-c", Description: "task", Priority: internal.PriorityHigh}, nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil)
+			req.Header.Set("Accept", tt.accept)
+
+			res := doRequest(router, req)
+			defer res.Body.Close()
+
+			if actual := res.Header.Get("Content-Type"); actual != tt.contentType {
+				t.Fatalf("expected Content-Type %q, actual %q", tt.contentType, actual)
+			}
+
+			body, _ := io.ReadAll(res.Body)
+
+			var actual rest.ReadTasksResponse
+
+			if err := json.Unmarshal(tt.toJSON(t, body), &actual); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected := rest.ReadTasksResponse{Task: rest.Task{ID: "a-b-c", Description: "task", Priority: "high"}}
+
+			if !cmp.Equal(expected, actual) {
+				t.Fatalf("expected results don't match: %s", cmp.Diff(expected, actual))
+			}
+		})
+	}
+}
+
+func TestDecodeRequest_Codecs(t *testing.T) {
+	t.Parallel()
+
+	msgpackBody, _ := msgpack.Marshal(map[string]interface{}{"description": "renew passport", "priority": "low"})
+
+	unknownBody, _ := msgpack.Marshal(map[string]interface{}{"descripton": "renew passport"})
+
+	tests := []struct {
+		name           string
+		contentType    string
+		body           []byte
+		expectedStatus int
+	}{
+		{
+			"OK: msgpack",
+			rest.MessagePackContentType,
+			msgpackBody,
+			http.StatusCreated,
+		},
+
+		{
+			"ERR: unknown field",
+			rest.MessagePackContentType,
+			unknownBody,
+			http.StatusBadRequest,
+		},
+		{
+			"ERR: malformed",
+			rest.MessagePackContentType,
+			[]byte{0xc1},
+			http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+
+			svc := &resttesting.FakeTaskService{}
+			svc.CreateReturns(internal.Task{ID: "1-2-3", Description: "renew passport", Priority: internal.PriorityLow}, nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodPost, "/tasks", bytes.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+
+			res := doRequest(router, req)
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.expectedStatus {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if tt.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			_, params := svc.CreateArgsForCall(0)
+			if params.Description != "renew passport" || params.Priority != internal.PriorityLow {
+				t.Fatalf("expected decoded params, got %+v", params)
+			}
+		})
+	}
+}
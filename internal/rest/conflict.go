@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// PreferHeader is the header used by clients for requesting optional behaviors, see RFC 7240.
+	PreferHeader = "Prefer"
+
+	// PreferenceAppliedHeader is the header indicating the preferences that were honored.
+	PreferenceAppliedHeader = "Preference-Applied"
+
+	// PreferenceMerge requests merging the changes made since the version indicated by If-Match, instead of
+	// failing, when those don't conflict with the update.
+	PreferenceMerge = "merge"
+)
+
+// TaskConflict describes an update rejected because the task changed since the expected version: Base is that
+// version, Theirs is the current one and Yours includes the rejected values. Fields lists the fields changed
+// by both using different values.
+type TaskConflict struct {
+	Base   Task     `json:"base"`
+	Theirs Task     `json:"theirs"`
+	Yours  Task     `json:"yours"`
+	Fields []string `json:"fields"`
+}
+
+func newTaskConflict(ctx context.Context, conflict internal.TaskConflict) *TaskConflict {
+	return &TaskConflict{
+		Base:   newTask(ctx, conflict.Base),
+		Theirs: newTask(ctx, conflict.Theirs),
+		Yours:  newTask(ctx, conflict.Yours),
+		Fields: conflict.Conflicts(),
+	}
+}
+
+// preferMerge indicates whether the request prefers merging conflicting updates.
+func preferMerge(r *http.Request) bool {
+	for _, val := range r.Header.Values(PreferHeader) {
+		for _, pref := range strings.Split(val, ",") {
+			// Preferences may include parameters, those are not used.
+			if name := strings.SplitN(pref, ";", 2)[0]; strings.EqualFold(strings.TrimSpace(name), PreferenceMerge) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
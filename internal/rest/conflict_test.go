@@ -0,0 +1,127 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTasks_Conflict(t *testing.T) {
+	t.Parallel()
+
+	base := internal.Task{ID: "a-b-c", Description: "buy milk", Priority: internal.PriorityLow, Version: 3}
+
+	theirs := base
+	theirs.Description = "buy eggs"
+	theirs.Version = 4
+
+	yours := base
+	yours.Description = "buy bread"
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeTaskService{}
+	svc.UpdateReturns(internal.WrapErrorf(&internal.TaskConflictError{
+		Conflict: internal.TaskConflict{Base: base, Theirs: theirs, Yours: yours},
+	}, internal.ErrorCodeConflict, "task changed"))
+
+	rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+	req := httptest.NewRequest(http.MethodPut, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+		strings.NewReader(`{"description":"buy bread","priority":"low"}`))
+	req.Header.Set(rest.IfMatchHeader, `"3"`)
+
+	res := doRequest(router, req)
+
+	assertResponse(t, res, test{
+		&rest.ErrorResponse{
+			Error: "update failed",
+			Conflict: &rest.TaskConflict{
+				Base:   rest.Task{ID: "a-b-c", Description: "buy milk", Priority: "low"},
+				Theirs: rest.Task{ID: "a-b-c", Description: "buy eggs", Priority: "low"},
+				Yours:  rest.Task{ID: "a-b-c", Description: "buy bread", Priority: "low"},
+				Fields: []string{"description"},
+			},
+		},
+		&rest.ErrorResponse{},
+	})
+
+	if res.StatusCode != http.StatusConflict {
+		t.Fatalf("expected code %d, actual %d", http.StatusConflict, res.StatusCode)
+	}
+
+	if actual := res.Header.Get(rest.ETagHeader); actual != `"4"` {
+		t.Fatalf("expected ETag of the current version, actual %q", actual)
+	}
+}
+
+func TestTasks_PreferMerge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		prefer  string
+		ifMatch string
+		merge   bool
+	}{
+		{
+			"OK: merge",
+			"respond-async, merge",
+			`"3"`,
+			true,
+		},
+		{
+			"OK: without If-Match",
+			"merge",
+			"",
+			false,
+		},
+		{
+			"OK: without preference",
+			"",
+			`"3"`,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodPut, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+				strings.NewReader(`{"description":"buy bread","priority":"low"}`))
+			req.Header.Set(rest.IfMatchHeader, tt.ifMatch)
+			req.Header.Set(rest.PreferHeader, tt.prefer)
+
+			res := doRequest(router, req)
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+			}
+
+			ctx, _, _, _, _, _, _ := svc.UpdateArgsForCall(0)
+
+			if actual := internal.MergeFromContext(ctx); actual != tt.merge {
+				t.Fatalf("expected merge %t, actual %t", tt.merge, actual)
+			}
+
+			if actual := res.Header.Get(rest.PreferenceAppliedHeader) == rest.PreferenceMerge; actual != tt.merge {
+				t.Fatalf("expected preference applied %t, actual %t", tt.merge, actual)
+			}
+		})
+	}
+}
@@ -1,29 +1,59 @@
 package rest
 
 import (
+	"context"
 	"time"
 
 	"github.com/MarioCarrion/todo-api/internal"
 )
 
-// Dates indicates a point in time where a task starts or completes, dates are not enforced on Tasks.
+// Dates indicates a point in time where a task starts or completes, dates are not enforced on Tasks. Dates are
+// RFC 3339 values that may include any offset, TimeZone is the IANA time zone the dates are rendered in, when empty
+// the one requested by the client is used.
+//nolint: tagliatelle
 type Dates struct {
-	Start time.Time `json:"start"`
-	Due   time.Time `json:"due"`
+	Start    time.Time `json:"start"`
+	Due      time.Time `json:"due"`
+	TimeZone string    `json:"time_zone,omitempty"`
 }
 
 // NewDates ...
 func NewDates(d internal.Dates) Dates {
 	return Dates{
-		Start: d.Start,
-		Due:   d.Due,
+		Start:    d.Start,
+		Due:      d.Due,
+		TimeZone: d.TimeZone,
 	}
 }
 
+// newDates returns the dates rendered in their own time zone or, when they don't have one, in the time zone
+// requested by the client.
+func newDates(ctx context.Context, d internal.Dates) Dates {
+	loc := d.Location()
+	if loc == nil {
+		loc = currentTime(ctx).Location()
+	}
+
+	in := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return t
+		}
+
+		return t.In(loc)
+	}
+
+	res := NewDates(d)
+	res.Start = in(d.Start)
+	res.Due = in(d.Due)
+
+	return res
+}
+
 // Convert returns the domain type defining the internal representation.
 func (d Dates) Convert() internal.Dates {
 	return internal.Dates{
-		Start: d.Start,
-		Due:   d.Due,
+		Start:    d.Start,
+		Due:      d.Due,
+		TimeZone: d.TimeZone,
 	}
 }
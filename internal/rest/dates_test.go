@@ -27,6 +27,14 @@ func TestDates_Marshal(t *testing.T) {
 			},
 			[]byte(`{"start":"2009-11-10T23:00:00Z","due":"0001-01-01T00:00:00Z"}`),
 		},
+		{
+			"OK: TimeZone",
+			rest.Dates{
+				Due:      time.Date(2009, 11, 10, 23, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+				TimeZone: "America/New_York",
+			},
+			[]byte(`{"start":"0001-01-01T00:00:00Z","due":"2009-11-10T23:00:00-05:00","time_zone":"America/New_York"}`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -101,6 +109,17 @@ func TestDates_Convert(t *testing.T) {
 				Due:   time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC).Add(time.Hour),
 			},
 		},
+		{
+			"OK: TimeZone",
+			rest.Dates{
+				Due:      time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC),
+				TimeZone: "America/New_York",
+			},
+			internal.Dates{
+				Due:      time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC),
+				TimeZone: "America/New_York",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// maxRequestBodySize is the largest JSON body accepted by the handlers by default, in bytes; see Limits.
+const maxRequestBodySize = 1 << 20
+
+// errRequestBodyTooLarge indicates the body is larger than the limit.
+var errRequestBodyTooLarge = errors.New("request body too large")
+
+// decodeRequest decodes the JSON body of r into dst rejecting unknown fields, so typos like "descripton" are not
+// silently ignored. Unknown and malformed fields are returned as validation errors keyed by field. Bodies not
+// limited by the Limits middleware use maxRequestBodySize. Bodies using the media type of a Codec are converted
+// to JSON first.
+func decodeRequest(w http.ResponseWriter, r *http.Request, dst interface{}) error {
+	if !bodyLimited(r.Context()) {
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+	}
+
+	var src io.Reader = r.Body
+
+	if codec := requestCodec(r); codec != nil {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return internal.WrapErrorf(bodyTooLarge(err), internal.ErrorCodeInvalidArgument, "io.ReadAll")
+		}
+
+		if len(data) == 0 {
+			return internal.WrapErrorf(io.EOF, internal.ErrorCodeInvalidArgument, "empty body")
+		}
+
+		if data, err = codec.ToJSON(data); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid %s body", codec.ContentType())
+		}
+
+		src = bytes.NewReader(data)
+	}
+
+	dec := json.NewDecoder(src)
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(dst); err != nil {
+		return newDecodeError(bodyTooLarge(err))
+	}
+
+	if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "body must contain a single JSON value")
+	}
+
+	return nil
+}
+
+// newDecodeError returns the error describing why decoding failed.
+func newDecodeError(err error) error {
+	const unknownFieldPrefix = "json: unknown field "
+
+	var terr *json.UnmarshalTypeError
+
+	switch {
+	case errors.Is(err, io.EOF):
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "empty body")
+	case errors.As(err, &terr) && terr.Field != "":
+		return internal.WrapErrorf(validation.Errors{
+			terr.Field: fmt.Errorf("cannot be a JSON %s", terr.Value),
+		}, internal.ErrorCodeInvalidArgument, "json decoder")
+	case strings.HasPrefix(err.Error(), unknownFieldPrefix):
+		field, uerr := strconv.Unquote(strings.TrimPrefix(err.Error(), unknownFieldPrefix))
+		if uerr != nil {
+			field = strings.TrimPrefix(err.Error(), unknownFieldPrefix)
+		}
+
+		return internal.WrapErrorf(validation.Errors{
+			field: errors.New("unknown field"),
+		}, internal.ErrorCodeInvalidArgument, "json decoder")
+	}
+
+	return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder")
+}
+
+// bodyTooLarge returns errRequestBodyTooLarge when err indicates the body read using http.MaxBytesReader exceeded
+// the limit, err otherwise. The error is matched by its message, it's not exported before Go 1.19.
+func bodyTooLarge(err error) error {
+	if err != nil && err.Error() == "http: request body too large" {
+		return errRequestBodyTooLarge
+	}
+
+	return err
+}
+
+type bodyLimitedKey struct{}
+
+// withBodyLimited returns a copy of ctx indicating the body of the request is already limited, see Limits.
+func withBodyLimited(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bodyLimitedKey{}, true)
+}
+
+// bodyLimited indicates whether the body of the request is already limited.
+func bodyLimited(ctx context.Context) bool {
+	v, _ := ctx.Value(bodyLimitedKey{}).(bool)
+
+	return v
+}
@@ -0,0 +1,144 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"hash/fnv"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// ETagHeader is the header used for returning the version of a task.
+	ETagHeader = "ETag"
+
+	// IfMatchHeader is the header used by clients for updating a task only when its version still matches.
+	IfMatchHeader = "If-Match"
+
+	// IfNoneMatchHeader is the header used by clients for reading a task only when its version changed.
+	IfNoneMatchHeader = "If-None-Match"
+
+	// IfModifiedSinceHeader is the header used by clients for reading a task only when it changed since then.
+	IfModifiedSinceHeader = "If-Modified-Since"
+
+	// LastModifiedHeader is the header used for returning the time a task was last changed.
+	LastModifiedHeader = "Last-Modified"
+
+	// cacheControl makes clients and shared caches revalidate the task before using it, tasks are private.
+	cacheControl = "private, no-cache"
+)
+
+// newETag returns the strong entity tag of the version, empty when versions are not supported.
+func newETag(version int64) string {
+	if version <= 0 {
+		return ""
+	}
+
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// newRepresentationETag returns the strong entity tag of the representation of the version rendered as res: the
+// version for the default representation, otherwise the version followed by a hash of the negotiated media type and
+// the rendered fields. This way representations including values relative to the current time, like "human_dates"
+// or "is_overdue", are not considered current once those change.
+func newRepresentationETag(ctx context.Context, version int64, res interface{}) string {
+	etag := newETag(version)
+	if etag == "" || (codecFromContext(ctx) == nil && !timeRelative(ctx)) {
+		return etag
+	}
+
+	content, err := json.Marshal(res)
+	if err != nil {
+		return ""
+	}
+
+	hash := fnv.New64a()
+
+	if codec := codecFromContext(ctx); codec != nil {
+		_, _ = hash.Write([]byte(codec.ContentType()))
+	}
+
+	_, _ = hash.Write(content)
+
+	return `"` + strconv.FormatInt(version, 10) + "-" + strconv.FormatUint(hash.Sum64(), 36) + `"`
+}
+
+// timeRelative indicates whether the representation includes values relative to the current time, those change
+// even when the task does not.
+func timeRelative(ctx context.Context) bool {
+	_, humanized := ctx.Value(humanizeKey{}).(locale)
+
+	return humanized || ProfileRequested(ctx, ProfileTaskOverdue) || ProfileRequested(ctx, ProfileTaskDue)
+}
+
+// parseIfMatch returns the version expected by the If-Match value, it indicates whether one was received; "*" is
+// the same as not receiving a value. The ETags of all the representations of the version are accepted.
+func parseIfMatch(val string) (int64, bool, error) {
+	val = strings.TrimSpace(val)
+	if val == "" || val == "*" {
+		return 0, false, nil
+	}
+
+	if len(val) < 3 || val[0] != '"' || val[len(val)-1] != '"' {
+		return 0, false, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid If-Match, a strong ETag is expected")
+	}
+
+	tag := val[1 : len(val)-1]
+	if i := strings.Index(tag, "-"); i > 0 {
+		tag = tag[:i]
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version <= 0 {
+		return 0, false, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid If-Match, unknown ETag")
+	}
+
+	return version, true, nil
+}
+
+// setCacheHeaders sets the headers used by clients for revalidating the task: ETag, see newRepresentationETag, and
+// Last-Modified, using the time the task was last changed; those are omitted when empty.
+func setCacheHeaders(w http.ResponseWriter, etag string, updatedAt time.Time) {
+	w.Header().Set("Cache-Control", cacheControl)
+
+	if etag != "" {
+		w.Header().Set(ETagHeader, etag)
+	}
+
+	if !updatedAt.IsZero() {
+		w.Header().Set(LastModifiedHeader, updatedAt.UTC().Format(http.TimeFormat))
+	}
+}
+
+// notModified indicates whether the task the client has is still current, according to If-None-Match or, when not
+// received, If-Modified-Since.
+func notModified(r *http.Request, etag string, updatedAt time.Time) bool {
+	if val := r.Header.Get(IfNoneMatchHeader); val != "" {
+		for _, candidate := range strings.Split(val, ",") {
+			candidate = strings.TrimSpace(candidate)
+
+			// Weak comparison, the ETag already identifies the representation.
+			if candidate == "*" || (etag != "" && strings.TrimPrefix(candidate, "W/") == etag) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if updatedAt.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get(IfModifiedSinceHeader))
+	if err != nil {
+		return false
+	}
+
+	// Last-Modified has a resolution of seconds.
+	return !updatedAt.Truncate(time.Second).After(since)
+}
@@ -0,0 +1,394 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTasks_ETag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		version int64
+		etag    string
+	}{
+		{
+			"OK: versioned",
+			3,
+			`"3"`,
+		},
+		{
+			"OK: unversioned",
+			0,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(internal.Task{ID: "a-b-c", Description: "task", Version: tt.version}, nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+			defer res.Body.Close()
+
+			if actual := res.Header.Get(rest.ETagHeader); actual != tt.etag {
+				t.Fatalf("expected ETag %q, actual %q", tt.etag, actual)
+			}
+		})
+	}
+}
+
+func TestTasks_NotModified(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2021, 6, 1, 10, 30, 15, 500, time.UTC)
+
+	tests := []struct {
+		name           string
+		headers        map[string]string
+		expectedStatus int
+	}{
+		{
+			"OK: 200 without validators",
+			nil,
+			http.StatusOK,
+		},
+		{
+			"OK: 304 matching ETag",
+			map[string]string{rest.IfNoneMatchHeader: `"1", W/"3"`},
+			http.StatusNotModified,
+		},
+		{
+			"OK: 304 any ETag",
+			map[string]string{rest.IfNoneMatchHeader: "*"},
+			http.StatusNotModified,
+		},
+		{
+			"OK: 200 different ETag",
+			map[string]string{rest.IfNoneMatchHeader: `"2"`},
+			http.StatusOK,
+		},
+		{
+			"OK: 304 not modified since",
+			map[string]string{rest.IfModifiedSinceHeader: "Tue, 01 Jun 2021 10:30:15 GMT"},
+			http.StatusNotModified,
+		},
+		{
+			"OK: 200 modified since",
+			map[string]string{rest.IfModifiedSinceHeader: "Tue, 01 Jun 2021 10:30:14 GMT"},
+			http.StatusOK,
+		},
+		{
+			"OK: 200 If-None-Match takes precedence",
+			map[string]string{
+				rest.IfNoneMatchHeader:     `"2"`,
+				rest.IfModifiedSinceHeader: "Tue, 01 Jun 2021 10:30:15 GMT",
+			},
+			http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(internal.Task{ID: "a-b-c", Description: "task", Version: 3, UpdatedAt: updatedAt}, nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil)
+
+			for key, val := range tt.headers {
+				req.Header.Set(key, val)
+			}
+
+			res := doRequest(router, req)
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.expectedStatus {
+				t.Fatalf("expected status %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if actual := res.Header.Get(rest.ETagHeader); actual != `"3"` {
+				t.Fatalf("expected ETag %q, actual %q", `"3"`, actual)
+			}
+
+			if actual := res.Header.Get(rest.LastModifiedHeader); actual != "Tue, 01 Jun 2021 10:30:15 GMT" {
+				t.Fatalf("expected Last-Modified, actual %q", actual)
+			}
+
+			if actual := res.Header.Get("Cache-Control"); actual != "private, no-cache" {
+				t.Fatalf("expected Cache-Control, actual %q", actual)
+			}
+		})
+	}
+}
+
+func TestTasks_NotModifiedRepresentation(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2021, 6, 1, 10, 30, 15, 500, time.UTC)
+
+	tests := []struct {
+		name    string
+		target  string
+		headers map[string]string
+		due     time.Time
+	}{
+		{
+			"OK: msgpack",
+			"/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			map[string]string{"Accept": rest.MessagePackContentType},
+			time.Time{},
+		},
+		{
+			"OK: overdue",
+			"/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			map[string]string{rest.AcceptProfileHeader: string(rest.ProfileTaskOverdue)},
+			time.Now().Add(-time.Hour),
+		},
+		{
+			"OK: humanized",
+			"/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee?humanize=true",
+			map[string]string{"Accept-Language": "en"},
+			time.Now().Add(48 * time.Hour),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			router.Use(rest.Negotiate, rest.Profiles, rest.Humanize)
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(internal.Task{
+				ID:          "a-b-c",
+				Description: "task",
+				Version:     3,
+				UpdatedAt:   updatedAt,
+				Dates:       internal.Dates{Due: tt.due},
+			}, nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			newRequest := func(headers map[string]string) *http.Request {
+				req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+
+				for key, val := range tt.headers {
+					req.Header.Set(key, val)
+				}
+
+				for key, val := range headers {
+					req.Header.Set(key, val)
+				}
+
+				return req
+			}
+
+			res := doRequest(router, newRequest(nil))
+			defer res.Body.Close()
+
+			etag := res.Header.Get(rest.ETagHeader)
+			if !strings.HasPrefix(etag, `"3-`) {
+				t.Fatalf("expected ETag of the representation, actual %q", etag)
+			}
+
+			// The ETag of the version identifies a different representation.
+			res = doRequest(router, newRequest(map[string]string{rest.IfNoneMatchHeader: `"3"`}))
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				t.Fatalf("expected status %d, actual %d", http.StatusOK, res.StatusCode)
+			}
+
+			res = doRequest(router, newRequest(map[string]string{rest.IfNoneMatchHeader: etag}))
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusNotModified {
+				t.Fatalf("expected status %d, actual %d", http.StatusNotModified, res.StatusCode)
+			}
+		})
+	}
+
+	// Values relative to the current time change the ETag, even when the version is the same.
+	etags := make(map[string]struct{})
+
+	for _, due := range []time.Time{time.Now().Add(-time.Hour), time.Now().Add(time.Hour)} {
+		router := mux.NewRouter()
+		router.Use(rest.Profiles)
+
+		svc := &resttesting.FakeTaskService{}
+		svc.TaskReturns(internal.Task{ID: "a-b-c", Description: "task", Version: 3, Dates: internal.Dates{Due: due}}, nil)
+
+		rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+		req := httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil)
+		req.Header.Set(rest.AcceptProfileHeader, string(rest.ProfileTaskOverdue))
+		req.Header.Set(rest.IfModifiedSinceHeader, time.Now().UTC().Format(http.TimeFormat))
+
+		res := doRequest(router, req)
+		defer res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("expected If-Modified-Since to be ignored, actual status %d", res.StatusCode)
+		}
+
+		etags[res.Header.Get(rest.ETagHeader)] = struct{}{}
+	}
+
+	if len(etags) != 2 {
+		t.Fatalf("expected different ETags, actual %v", etags)
+	}
+}
+
+func TestTasks_IfMatch(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus  int
+		expectedVersion int64
+		withVersion     bool
+	}
+
+	tests := []struct {
+		name    string
+		setup   func(*resttesting.FakeTaskService)
+		ifMatch string
+		output  output
+	}{
+		{
+			"OK: 200 matching version",
+			func(*resttesting.FakeTaskService) {},
+			`"3"`,
+			output{
+				expectedStatus:  http.StatusOK,
+				expectedVersion: 3,
+				withVersion:     true,
+			},
+		},
+		{
+			"OK: 200 any version",
+			func(*resttesting.FakeTaskService) {},
+			"*",
+			output{
+				expectedStatus: http.StatusOK,
+			},
+		},
+		{
+			"OK: 200 missing",
+			func(*resttesting.FakeTaskService) {},
+			"",
+			output{
+				expectedStatus: http.StatusOK,
+			},
+		},
+		{
+			"OK: 200 representation",
+			func(*resttesting.FakeTaskService) {},
+			`"3-1x2y3z"`,
+			output{
+				expectedStatus:  http.StatusOK,
+				expectedVersion: 3,
+				withVersion:     true,
+			},
+		},
+		{
+			"ERR: 400 weak",
+			func(*resttesting.FakeTaskService) {},
+			`W/"3"`,
+			output{
+				expectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			"ERR: 400 invalid",
+			func(*resttesting.FakeTaskService) {},
+			`"x"`,
+			output{
+				expectedStatus: http.StatusBadRequest,
+			},
+		},
+		{
+			"ERR: 409",
+			func(s *resttesting.FakeTaskService) {
+				s.UpdateReturns(internal.NewErrorf(internal.ErrorCodeConflict, "version does not match"))
+			},
+			`"2"`,
+			output{
+				expectedStatus:  http.StatusConflict,
+				expectedVersion: 2,
+				withVersion:     true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodPut, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+				strings.NewReader(`{"description":"update task","priority":"low"}`))
+			if tt.ifMatch != "" {
+				req.Header.Set(rest.IfMatchHeader, tt.ifMatch)
+			}
+
+			res := doRequest(router, req)
+			defer res.Body.Close()
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if tt.output.expectedStatus == http.StatusBadRequest {
+				if svc.UpdateCallCount() != 0 {
+					t.Fatalf("expected no update")
+				}
+
+				return
+			}
+
+			ctx, _, _, _, _, _, _ := svc.UpdateArgsForCall(0)
+
+			version, ok := internal.ExpectedVersionFromContext(ctx)
+			if ok != tt.output.withVersion || version != tt.output.expectedVersion {
+				t.Fatalf("expected version %d (%t), actual %d (%t)", tt.output.expectedVersion, tt.output.withVersion,
+					version, ok)
+			}
+		})
+	}
+}
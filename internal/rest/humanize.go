@@ -0,0 +1,151 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+// HumanizeQueryParam is the query parameter used by clients for requesting human-readable dates, those are
+// localized using the Accept-Language header.
+const HumanizeQueryParam = "humanize"
+
+// HumanDates indicates, using human-readable and localized strings, when a task starts or completes relative to
+// the time the response was generated, for example "due in 3 days".
+type HumanDates struct {
+	Start string `json:"start,omitempty"`
+	Due   string `json:"due,omitempty"`
+}
+
+type unit int
+
+const (
+	unitMinute unit = iota
+	unitHour
+	unitDay
+	unitMonth
+	unitYear
+)
+
+// locale defines the strings used for humanizing dates in a language, the phrases use the future form first
+// and then the past one, the units use the singular form first and then the plural one.
+type locale struct {
+	tag   language.Tag
+	start [2]string
+	due   [2]string
+	units [5][2]string
+}
+
+func locales() []locale {
+	return []locale{
+		{
+			tag:   language.English,
+			start: [2]string{"starts in %s", "started %s ago"},
+			due:   [2]string{"due in %s", "due %s ago"},
+			units: [5][2]string{{"minute", "minutes"}, {"hour", "hours"}, {"day", "days"}, {"month", "months"}, {"year", "years"}},
+		},
+		{
+			tag:   language.Spanish,
+			start: [2]string{"empieza en %s", "empezó hace %s"},
+			due:   [2]string{"vence en %s", "venció hace %s"},
+			units: [5][2]string{{"minuto", "minutos"}, {"hora", "horas"}, {"día", "días"}, {"mes", "meses"}, {"año", "años"}},
+		},
+		{
+			tag:   language.French,
+			start: [2]string{"commence dans %s", "a commencé il y a %s"},
+			due:   [2]string{"échéance dans %s", "échu depuis %s"},
+			units: [5][2]string{{"minute", "minutes"}, {"heure", "heures"}, {"jour", "jours"}, {"mois", "mois"}, {"an", "ans"}},
+		},
+	}
+}
+
+type humanizeKey struct{}
+
+// Humanize is a middleware that stores the language used for humanizing dates in the request context, when
+// requested using the "humanize" query parameter. The language is negotiated using the Accept-Language header,
+// English is used when none of the requested languages is supported.
+func Humanize(next http.Handler) http.Handler {
+	supported := locales()
+	tags := make([]language.Tag, len(supported))
+
+	for i, l := range supported {
+		tags[i] = l.tag
+	}
+
+	matcher := language.NewMatcher(tags)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ok, _ := strconv.ParseBool(r.URL.Query().Get(HumanizeQueryParam)); !ok {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		requested, _, _ := language.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+		_, index, _ := matcher.Match(requested...)
+
+		w.Header().Add("Vary", "Accept-Language")
+		w.Header().Set("Content-Language", supported[index].tag.String())
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), humanizeKey{}, supported[index])))
+	})
+}
+
+// newHumanDates returns the humanized dates, nil is returned when those were not requested.
+func newHumanDates(ctx context.Context, start, due, now time.Time) *HumanDates {
+	l, ok := ctx.Value(humanizeKey{}).(locale)
+	if !ok {
+		return nil
+	}
+
+	return &HumanDates{
+		Start: l.humanize(l.start, start, now),
+		Due:   l.humanize(l.due, due, now),
+	}
+}
+
+func (l locale) humanize(phrases [2]string, t, now time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	diff := t.Sub(now)
+
+	phrase := phrases[0]
+	if diff < 0 {
+		phrase = phrases[1]
+		diff = -diff
+	}
+
+	var (
+		value float64
+		u     unit
+	)
+
+	switch days := diff.Hours() / 24; {
+	case diff < time.Hour:
+		value, u = diff.Minutes(), unitMinute
+	case diff < 24*time.Hour:
+		value, u = diff.Hours(), unitHour
+	case days < 30:
+		value, u = days, unitDay
+	case days < 365:
+		value, u = days/30, unitMonth
+	default:
+		value, u = days/365, unitYear
+	}
+
+	count := int(math.Max(1, math.Round(value)))
+
+	name := l.units[u][1]
+	if count == 1 {
+		name = l.units[u][0]
+	}
+
+	return fmt.Sprintf(phrase, fmt.Sprintf("%d %s", count, name))
+}
@@ -0,0 +1,112 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestHumanize(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now().Add(-49 * time.Hour).UTC()
+	due := time.Now().Add(73 * time.Hour).UTC()
+
+	tests := []struct {
+		name             string
+		target           string
+		language         string
+		expected         *rest.HumanDates
+		expectedLanguage string
+	}{
+		{
+			"OK: default language",
+			"/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a?humanize=true",
+			"",
+			&rest.HumanDates{Start: "started 2 days ago", Due: "due in 3 days"},
+			"en",
+		},
+		{
+			"OK: spanish",
+			"/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a?humanize=true",
+			"es-MX,es;q=0.9,en;q=0.8",
+			&rest.HumanDates{Start: "empezó hace 2 días", Due: "vence en 3 días"},
+			"es",
+		},
+		{
+			"OK: french",
+			"/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a?humanize=1",
+			"fr-CA",
+			&rest.HumanDates{Start: "a commencé il y a 2 jours", Due: "échéance dans 3 jours"},
+			"fr",
+		},
+		{
+			"OK: unsupported language",
+			"/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a?humanize=true",
+			"de",
+			&rest.HumanDates{Start: "started 2 days ago", Due: "due in 3 days"},
+			"en",
+		},
+		{
+			"OK: not requested",
+			"/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+			"es",
+			nil,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			router.Use(rest.Humanize)
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(
+				internal.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "humanized",
+					Priority:    internal.PriorityHigh,
+					Dates:       internal.Dates{Start: start, Due: due},
+				},
+				nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.language != "" {
+				req.Header.Set("Accept-Language", tt.language)
+			}
+
+			res := doRequest(router, req)
+
+			if actual := res.Header.Get("Content-Language"); actual != tt.expectedLanguage {
+				t.Fatalf("expected language %q, got %q", tt.expectedLanguage, actual)
+			}
+
+			assertResponse(t, res, test{
+				&rest.ReadTasksResponse{
+					Task: rest.Task{
+						ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+						Description: "humanized",
+						Priority:    "high",
+						Dates:       rest.Dates{Start: start, Due: due},
+						HumanDates:  tt.expected,
+					},
+				},
+				&rest.ReadTasksResponse{},
+			})
+		})
+	}
+}
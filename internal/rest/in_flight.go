@@ -0,0 +1,96 @@
+package rest
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// InFlightRequest defines a request being handled.
+type InFlightRequest struct {
+	Method    string
+	Route     string
+	RequestID string
+	TraceID   string
+	Started   time.Time
+}
+
+// InFlight tracks the requests being handled, operators use it for deciding whether it's safe to terminate a
+// server that is slow to drain.
+type InFlight struct {
+	mu       sync.Mutex
+	seq      uint64
+	requests map[uint64]InFlightRequest
+}
+
+// NewInFlight instantiates the InFlight tracker.
+func NewInFlight() *InFlight {
+	return &InFlight{
+		requests: make(map[uint64]InFlightRequest),
+	}
+}
+
+// Middleware tracks the requests until they are handled, the route template is used instead of the URL so values
+// like ids are not exposed.
+func (i *InFlight) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := InFlightRequest{
+			Method:    r.Method,
+			RequestID: internal.RequestIDFromContext(r.Context()),
+			Started:   time.Now(),
+		}
+
+		if route := mux.CurrentRoute(r); route != nil {
+			req.Route, _ = route.GetPathTemplate()
+		}
+
+		if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+			req.TraceID = sc.TraceID().String()
+		}
+
+		id := i.add(req)
+		defer i.remove(id)
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Requests returns the requests being handled, the oldest ones first.
+func (i *InFlight) Requests() []InFlightRequest {
+	i.mu.Lock()
+
+	res := make([]InFlightRequest, 0, len(i.requests))
+
+	for _, req := range i.requests {
+		res = append(res, req)
+	}
+
+	i.mu.Unlock()
+
+	sort.Slice(res, func(a, b int) bool { return res[a].Started.Before(res[b].Started) })
+
+	return res
+}
+
+func (i *InFlight) add(req InFlightRequest) uint64 {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.seq++
+	i.requests[i.seq] = req
+
+	return i.seq
+}
+
+func (i *InFlight) remove(id uint64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.requests, id)
+}
@@ -0,0 +1,46 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestInFlight(t *testing.T) {
+	t.Parallel()
+
+	inFlight := rest.NewInFlight()
+
+	var actual []rest.InFlightRequest
+
+	router := mux.NewRouter()
+	router.Use(rest.RequestID, inFlight.Middleware)
+	router.HandleFunc("/tasks/{id}", func(w http.ResponseWriter, r *http.Request) {
+		actual = inFlight.Requests()
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/tasks/123", nil)
+	req.Header.Set(rest.RequestIDHeader, "abc-123")
+
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if len(actual) != 1 {
+		t.Fatalf("expected 1 request in flight, got %d", len(actual))
+	}
+
+	if actual[0].Method != http.MethodGet || actual[0].Route != "/tasks/{id}" || actual[0].RequestID != "abc-123" {
+		t.Fatalf("unexpected request in flight %+v", actual[0])
+	}
+
+	if actual[0].Started.IsZero() {
+		t.Fatalf("expected start time, got zero value")
+	}
+
+	if requests := inFlight.Requests(); len(requests) != 0 {
+		t.Fatalf("expected no requests in flight after handling them, got %d", len(requests))
+	}
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//counterfeiter:generate -o resttesting/issue_import_service.gen.go . IssueImportService
+
+// IssueImportService defines the application service in charge of importing GitHub issues as Tasks.
+type IssueImportService interface {
+	Import(ctx context.Context, repository string) (internal.IssueImport, error)
+}
+
+// IssueHandler defines the handlers used for importing GitHub issues on demand, in addition to the periodic imports.
+type IssueHandler struct {
+	svc IssueImportService
+}
+
+// NewIssueHandler instantiates the Issue handlers.
+func NewIssueHandler(svc IssueImportService) *IssueHandler {
+	return &IssueHandler{
+		svc: svc,
+	}
+}
+
+// Register connects the handlers to the router.
+func (i *IssueHandler) Register(r *mux.Router) {
+	r.HandleFunc("/github/imports", i.importIssues).Methods(http.MethodPost)
+}
+
+// CreateIssueImportsRequest defines the request used for importing the open issues of a repository.
+type CreateIssueImportsRequest struct {
+	Repository string `json:"repository"`
+}
+
+// CreateIssueImportsResponse defines the response returned back after importing the open issues of a repository,
+// issues imported before are skipped.
+type CreateIssueImportsResponse struct {
+	Repository string `json:"repository"`
+	Imported   int    `json:"imported"`
+	Skipped    int    `json:"skipped"`
+}
+
+func (i *IssueHandler) importIssues(w http.ResponseWriter, r *http.Request) {
+	var req CreateIssueImportsRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	defer r.Body.Close()
+
+	res, err := i.svc.Import(r.Context(), req.Repository)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "import failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w,
+		&CreateIssueImportsResponse{
+			Repository: res.Repository,
+			Imported:   res.Imported,
+			Skipped:    res.Skipped,
+		},
+		http.StatusOK)
+}
@@ -0,0 +1,120 @@
+package rest_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestIssue_Import(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeIssueImportService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeIssueImportService) {
+				s.ImportReturns(internal.IssueImport{Repository: "octo/todo", Imported: 2, Skipped: 1}, nil)
+			},
+			[]byte(`{"repository":"octo/todo"}`),
+			output{
+				http.StatusOK,
+				&rest.CreateIssueImportsResponse{Repository: "octo/todo", Imported: 2, Skipped: 1},
+				&rest.CreateIssueImportsResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakeIssueImportService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 service",
+			func(s *resttesting.FakeIssueImportService) {
+				s.ImportReturns(internal.IssueImport{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "repository is not configured"))
+			},
+			[]byte(`{"repository":"octo/other"}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "import failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeIssueImportService) {
+				s.ImportReturns(internal.IssueImport{}, errors.New("service error"))
+			},
+			[]byte(`{"repository":"octo/todo"}`),
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeIssueImportService{}
+			tt.setup(svc)
+
+			rest.NewIssueHandler(svc).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/github/imports", bytes.NewReader(tt.input)))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if tt.output.expectedStatus == http.StatusOK {
+				if _, actual := svc.ImportArgsForCall(0); !cmp.Equal("octo/todo", actual) {
+					t.Fatalf("expected arguments do not match: %s", cmp.Diff("octo/todo", actual))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// responseWriteMargin is the time available for writing the response once the deadline of the handler is exceeded,
+// so the 504 response is written before the server stops writing to the connection.
+const responseWriteMargin = 5 * time.Second
+
+// RequestLimits defines the limits applied to requests by the Limits middleware.
+type RequestLimits struct {
+	MaxBodySize   int64                    // Bytes, zero means maxRequestBodySize.
+	Timeout       time.Duration            // Time available for handling a request, zero means no deadline.
+	RouteTimeouts map[string]time.Duration // Timeouts used instead of Timeout, keyed by unversioned path template.
+
+	// ReadHeaderTimeout is the time available for reading the request headers, it's used by the server instead of
+	// the middleware.
+	ReadHeaderTimeout time.Duration
+}
+
+// RouteTimeout returns the time available for handling requests to the route matching the path template, the
+// same timeout applies to all the versions of the route.
+func (l RequestLimits) RouteTimeout(template string) time.Duration {
+	if timeout, ok := l.RouteTimeouts[unversionedTemplate(template)]; ok {
+		return timeout
+	}
+
+	return l.Timeout
+}
+
+// ValidateRoutes returns an error when RouteTimeouts includes path templates not registered in the router, those
+// would be ignored otherwise. It must be called after all the routes are registered.
+func (l RequestLimits) ValidateRoutes(router *mux.Router) error {
+	templates := make(map[string]struct{})
+
+	_ = router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		if template, err := route.GetPathTemplate(); err == nil {
+			templates[unversionedTemplate(template)] = struct{}{}
+		}
+
+		return nil
+	})
+
+	var unknown []string
+
+	for template := range l.RouteTimeouts {
+		if _, ok := templates[template]; !ok {
+			unknown = append(unknown, template)
+		}
+	}
+
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown route timeout path templates: %s",
+			strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// MaxTimeout returns the largest timeout of all the routes, servers must allow writing responses for that long.
+func (l RequestLimits) MaxTimeout() time.Duration {
+	res := l.Timeout
+
+	for _, timeout := range l.RouteTimeouts {
+		if timeout > res {
+			res = timeout
+		}
+	}
+
+	return res
+}
+
+// WriteTimeout returns the time servers must allow for writing responses: the largest timeout of all the routes plus
+// the time needed for writing the 504 response of the handlers exceeding it. Zero means requests have no deadline.
+func (l RequestLimits) WriteTimeout() time.Duration {
+	if l.MaxTimeout() <= 0 {
+		return 0
+	}
+
+	return l.MaxTimeout() + responseWriteMargin
+}
+
+// Limits returns a middleware limiting the size of request bodies and the time available for handling requests,
+// exceeding those results in 413 and 504 responses respectively. WebSocket upgrades are not time limited, those
+// connections are long-lived.
+func Limits(limits RequestLimits) func(http.Handler) http.Handler {
+	maxBodySize := limits.MaxBodySize
+	if maxBodySize <= 0 {
+		maxBodySize = maxRequestBodySize
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+			r = r.WithContext(withBodyLimited(r.Context()))
+
+			var template string
+
+			if route := mux.CurrentRoute(r); route != nil {
+				template, _ = route.GetPathTemplate()
+			}
+
+			if timeout := limits.RouteTimeout(template); timeout > 0 &&
+				!strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+				ctx, cancel := context.WithTimeout(r.Context(), timeout)
+				defer cancel()
+
+				r = r.WithContext(ctx)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package rest_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	limits := rest.RequestLimits{
+		MaxBodySize:   16,
+		Timeout:       time.Minute,
+		RouteTimeouts: map[string]time.Duration{"/tasks": 10 * time.Millisecond},
+	}
+
+	tests := []struct {
+		name     string
+		req      *http.Request
+		expected rest.Problem
+	}{
+		{
+			"ERR: 413",
+			httptest.NewRequest(http.MethodPost, "/tasks",
+				bytes.NewReader([]byte(`{"description":"renew passport"}`))),
+			rest.Problem{
+				Type:   "about:blank",
+				Title:  "Request Entity Too Large",
+				Status: http.StatusRequestEntityTooLarge,
+				Detail: "request body too large",
+			},
+		},
+		{
+			"ERR: 504",
+			httptest.NewRequest(http.MethodGet, "/tasks", nil),
+			rest.Problem{
+				Type:   "about:blank",
+				Title:  "Gateway Timeout",
+				Status: http.StatusGatewayTimeout,
+				Detail: "request timeout",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &resttesting.FakeTaskService{}
+			svc.ListStub = func(ctx context.Context, _ internal.ListParams) (internal.ListResults, error) {
+				<-ctx.Done()
+
+				return internal.ListResults{}, internal.WrapErrorf(ctx.Err(), internal.ErrorCodeUnknown, "list")
+			}
+
+			router := mux.NewRouter()
+			router.Use(rest.Limits(limits))
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			res := doRequest(router, tt.req)
+
+			if ct := res.Header.Get("Content-Type"); ct != rest.ProblemContentType {
+				t.Fatalf("expected content type %s, got %s", rest.ProblemContentType, ct)
+			}
+
+			assertResponse(t, res, test{&tt.expected, &rest.Problem{}})
+		})
+	}
+}
+
+func TestRequestLimits_MaxTimeout(t *testing.T) {
+	t.Parallel()
+
+	limits := rest.RequestLimits{
+		Timeout:       time.Second,
+		RouteTimeouts: map[string]time.Duration{"/search/tasks": 5 * time.Second, "/tasks": time.Millisecond},
+	}
+
+	if actual := limits.MaxTimeout(); actual != 5*time.Second {
+		t.Fatalf("expected 5s, got %s", actual)
+	}
+
+	if actual := limits.RouteTimeout("/projects"); actual != time.Second {
+		t.Fatalf("expected 1s, got %s", actual)
+	}
+
+	if actual := limits.RouteTimeout("/api/v1/search/tasks"); actual != 5*time.Second {
+		t.Fatalf("expected 5s, got %s", actual)
+	}
+}
+
+func TestRequestLimits_WriteTimeout(t *testing.T) {
+	t.Parallel()
+
+	limits := rest.RequestLimits{
+		Timeout:       time.Second,
+		RouteTimeouts: map[string]time.Duration{"/search/tasks": 5 * time.Second},
+	}
+
+	// Responses of handlers exceeding their deadline are written after it.
+	if actual := limits.WriteTimeout(); actual <= limits.MaxTimeout() {
+		t.Fatalf("expected more than %s, got %s", limits.MaxTimeout(), actual)
+	}
+
+	if actual := (rest.RequestLimits{}).WriteTimeout(); actual != 0 {
+		t.Fatalf("expected no timeout, got %s", actual)
+	}
+}
+
+func TestRequestLimits_ValidateRoutes(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	v := router.PathPrefix("/api/v1").Subrouter()
+
+	v.HandleFunc("/tasks:batchUpdate", func(http.ResponseWriter, *http.Request) {})
+	v.HandleFunc("/tasks/{id}", func(http.ResponseWriter, *http.Request) {})
+
+	limits := rest.RequestLimits{
+		RouteTimeouts: map[string]time.Duration{"/tasks:batchUpdate": time.Second, "/tasks/{id}": time.Second},
+	}
+
+	if err := limits.ValidateRoutes(router); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	// Templates must match the registered routes exactly.
+	limits.RouteTimeouts["/tasks/batch"] = time.Second
+
+	if err := limits.ValidateRoutes(router); err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
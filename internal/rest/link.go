@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// LinkHeader is the header used for returning the links to the other pages of paginated results, see RFC 8288.
+const LinkHeader = "Link"
+
+// setPaginationLinks sets the links to the first and next pages, the latter only when there are more results.
+// Keyset pagination only moves forward, so "prev" and "last" are not included.
+func setPaginationLinks(w http.ResponseWriter, r *http.Request, next string) {
+	links := []string{newLink(r.URL, "", "first")}
+
+	if next != "" {
+		links = append(links, newLink(r.URL, next, "next"))
+	}
+
+	w.Header().Add(LinkHeader, strings.Join(links, ", "))
+}
+
+// newLink returns the link to the same path and query using the cursor, the reference is relative to the request
+// so it's valid regardless of the host or scheme used by clients.
+func newLink(u *url.URL, cursor, rel string) string {
+	query := u.Query()
+	query.Del("cursor")
+
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+
+	ref := url.URL{Path: u.Path, RawQuery: query.Encode()}
+
+	return "<" + ref.String() + `>; rel="` + rel + `"`
+}
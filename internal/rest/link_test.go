@@ -0,0 +1,82 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTasks_Link(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeTaskService)
+		method string
+		target string
+		link   string
+	}{
+		{
+			"OK: list next page",
+			func(s *resttesting.FakeTaskService) {
+				s.ListReturns(internal.ListResults{Tasks: []internal.Task{}, NextCursor: "next"}, nil)
+			},
+			http.MethodGet,
+			"/tasks?cursor=current&size=1&sort=urgency",
+			`</tasks?size=1&sort=urgency>; rel="first", </tasks?cursor=next&size=1&sort=urgency>; rel="next"`,
+		},
+		{
+			"OK: list last page",
+			func(s *resttesting.FakeTaskService) {
+				s.ListReturns(internal.ListResults{Tasks: []internal.Task{}}, nil)
+			},
+			http.MethodGet,
+			"/tasks?cursor=current",
+			`</tasks>; rel="first"`,
+		},
+		{
+			"OK: search next page",
+			func(s *resttesting.FakeTaskService) {
+				s.ByReturns(internal.SearchResults{Tasks: []internal.Task{}, NextCursor: "next"}, nil)
+			},
+			http.MethodPost,
+			"/search/tasks",
+			`</search/tasks>; rel="first", </search/tasks?cursor=next>; rel="next"`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			search := &resttesting.FakeAvailability{}
+			search.AvailableReturns(true)
+
+			rest.NewTaskHandler(svc, search, rest.Semantics{}).Register(router)
+
+			res := doRequest(router, httptest.NewRequest(tt.method, tt.target, strings.NewReader("{}")))
+			defer res.Body.Close()
+
+			if res.StatusCode != http.StatusOK {
+				t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+			}
+
+			if actual := res.Header.Get(rest.LinkHeader); actual != tt.link {
+				t.Fatalf("expected Link %q, actual %q", tt.link, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+	otelunit "go.opentelemetry.io/otel/unit"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//nolint: gochecknoglobals
+var serverDuration = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/rest")).NewFloat64ValueRecorder(
+	"http.server.duration",
+	metric.WithDescription("Time in milliseconds spent handling requests, labeled by method, route and status"),
+	metric.WithUnit(otelunit.Milliseconds),
+)
+
+// Metrics is a middleware that records the time spent handling requests. Those are labeled using the route path
+// template instead of the path, "/tasks/{id}" for example, to keep the number of label values bounded.
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		route := "unknown"
+
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(sw, r)
+
+		serverDuration.Record(r.Context(), milliseconds(time.Since(start)),
+			attribute.String("http.method", r.Method),
+			attribute.String("http.route", route),
+			attribute.String("http.status_code", strconv.Itoa(sw.status)),
+		)
+	})
+}
+
+// statusWriter keeps the status of the response, it's 200 unless WriteHeader is called with a different one.
+type statusWriter struct {
+	http.ResponseWriter
+	status  int
+	written bool
+}
+
+func (s *statusWriter) WriteHeader(status int) {
+	if !s.written {
+		s.written = true
+		s.status = status
+	}
+
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *statusWriter) Write(b []byte) (int, error) {
+	s.written = true
+
+	return s.ResponseWriter.Write(b) //nolint: wrapcheck
+}
+
+// Hijack lets upgraded connections, like WebSockets, take over the connection.
+func (s *statusWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := s.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, internal.NewErrorf(internal.ErrorCodeUnknown, "hijacking not supported")
+	}
+
+	s.status = http.StatusSwitchingProtocols
+
+	return hijacker.Hijack() //nolint: wrapcheck
+}
+
+// Flush sends buffered data to the client, it's needed for streaming responses like server-sent events.
+func (s *statusWriter) Flush() {
+	if flusher, ok := s.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,35 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestMetrics(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	router.Use(rest.Metrics)
+
+	router.HandleFunc("/tasks/{id}", func(w http.ResponseWriter, _ *http.Request) {
+		if _, ok := w.(http.Flusher); !ok {
+			t.Fatalf("expected writer to be a flusher")
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		w.WriteHeader(http.StatusInternalServerError) // Ignored by the server, already written.
+
+		_, _ = w.Write([]byte("ok"))
+	})
+
+	res := doRequest(router, httptest.NewRequest(http.MethodGet, "/tasks/123", nil))
+
+	if res.StatusCode != http.StatusAccepted {
+		t.Fatalf("expected code %d, actual %d", http.StatusAccepted, res.StatusCode)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/ghodss/yaml"
 	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
 )
 
 //go:generate go run ../../cmd/openapi-gen/main.go -path .
@@ -31,7 +33,7 @@ func NewOpenAPI3() openapi3.T {
 		Servers: openapi3.Servers{
 			&openapi3.Server{
 				Description: "Local development",
-				URL:         "http://127.0.0.1:9234",
+				URL:         "http://127.0.0.1:9234/api/v1",
 			},
 		},
 	}
@@ -48,18 +50,162 @@ func NewOpenAPI3() openapi3.T {
 					WithNullable()).
 				WithProperty("due", openapi3.NewStringSchema().
 					WithFormat("date-time").
-					WithNullable())),
+					WithNullable()).
+				WithProperty("time_zone", openapi3.NewStringSchema())),
+		"HumanDates": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("start", openapi3.NewStringSchema()).
+				WithProperty("due", openapi3.NewStringSchema())),
+		"Highlights": openapi3.NewSchemaRef("",
+			&openapi3.Schema{
+				Type:        "object",
+				Description: "HTML-escaped fragments of the matching descriptions indexed by task id.",
+				AdditionalProperties: openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema()).NewRef(),
+			}),
+		"Facets": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("priority", openapi3.NewObjectSchema().
+					WithProperty("none", openapi3.NewInt64Schema()).
+					WithProperty("low", openapi3.NewInt64Schema()).
+					WithProperty("medium", openapi3.NewInt64Schema()).
+					WithProperty("high", openapi3.NewInt64Schema())).
+				WithProperty("is_done", openapi3.NewObjectSchema().
+					WithProperty("true", openapi3.NewInt64Schema()).
+					WithProperty("false", openapi3.NewInt64Schema()))),
+		"TaskConflict": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithPropertyRef("base", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Task",
+				}).
+				WithPropertyRef("theirs", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Task",
+				}).
+				WithPropertyRef("yours", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Task",
+				}).
+				WithProperty("fields", openapi3.NewArraySchema().
+					WithItems(openapi3.NewStringSchema()))),
+		"MethodSemantics": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("safe", openapi3.NewBoolSchema()).
+				WithProperty("idempotent", openapi3.NewBoolSchema()).
+				WithProperty("missing_status", openapi3.NewIntegerSchema()).
+				WithProperty("creates", openapi3.NewBoolSchema())),
 		"Task": openapi3.NewSchemaRef("",
 			openapi3.NewObjectSchema().
 				WithProperty("id", openapi3.NewUUIDSchema()).
 				WithProperty("description", openapi3.NewStringSchema()).
 				WithProperty("is_done", openapi3.NewBoolSchema()).
+				WithProperty("is_overdue", &openapi3.Schema{
+					Type:        "boolean",
+					Description: "Experimental, included when requesting the task-overdue profile using Accept-Profile.",
+				}).
+				WithProperty("is_due_today", &openapi3.Schema{
+					Type:        "boolean",
+					Description: "Experimental, included when requesting the task-due profile using Accept-Profile.",
+				}).
+				WithProperty("due_in_days", &openapi3.Schema{
+					Type:        "integer",
+					Description: "Experimental, included when requesting the task-due profile using Accept-Profile.",
+				}).
 				WithPropertyRef("priority", &openapi3.SchemaRef{
 					Ref: "#/components/schemas/Priority",
 				}).
 				WithPropertyRef("dates", &openapi3.SchemaRef{
 					Ref: "#/components/schemas/Dates",
+				}).
+				WithProperty("project_id", openapi3.NewUUIDSchema()).
+				WithProperty("completed_at", &openapi3.Schema{
+					Type:        "string",
+					Format:      "date-time",
+					Description: "Time the task was completed, only included when it's done.",
+				}).
+				WithProperty("created_at", &openapi3.Schema{
+					Type:        "string",
+					Format:      "date-time",
+					Description: "Time the task was created, not included when the datastore does not keep it.",
+				}).
+				WithProperty("updated_at", &openapi3.Schema{
+					Type:        "string",
+					Format:      "date-time",
+					Description: "Time the task was last changed, not included when the datastore does not keep it.",
+				}).
+				WithPropertyRef("human_dates", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/HumanDates",
 				})),
+		"TrashedTask": openapi3.NewSchemaRef("",
+			&openapi3.Schema{
+				Description: "Deleted task kept in the trash until it's restored or purged.",
+				AllOf: openapi3.SchemaRefs{
+					&openapi3.SchemaRef{
+						Ref: "#/components/schemas/Task",
+					},
+					openapi3.NewObjectSchema().
+						WithProperty("deleted_at", openapi3.NewDateTimeSchema()).
+						NewRef(),
+				},
+			}),
+		"TaskPatch": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("fields", &openapi3.Schema{
+					Type:        "object",
+					Description: "Fields changed in the task, missing ones are kept and an empty project_id removes the project.",
+					Properties: openapi3.Schemas{
+						"description": openapi3.NewStringSchema().NewRef(),
+						"priority": &openapi3.SchemaRef{
+							Ref: "#/components/schemas/Priority",
+						},
+						"dates": &openapi3.SchemaRef{
+							Ref: "#/components/schemas/Dates",
+						},
+						"project_id": openapi3.NewStringSchema().NewRef(),
+						"is_done":    openapi3.NewBoolSchema().NewRef(),
+					},
+				})),
+		"BatchUpdateTasksResult": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("status", &openapi3.Schema{
+					Type:        "integer",
+					Description: "Status used when updating the task alone, 424 when not applied because other patches failed.",
+				}).
+				WithPropertyRef("task", &openapi3.SchemaRef{
+					Ref: "#/components/schemas/Task",
+				}).
+				WithProperty("error", openapi3.NewObjectSchema().
+					WithProperty("error", openapi3.NewStringSchema()).
+					WithProperty("code", openapi3.NewStringSchema()))),
+		"Project": openapi3.NewSchemaRef("",
+			openapi3.NewObjectSchema().
+				WithProperty("id", openapi3.NewUUIDSchema()).
+				WithProperty("name", openapi3.NewStringSchema())),
+		"TaskSuggestions": openapi3.NewSchemaRef("",
+			&openapi3.Schema{
+				Type:        "object",
+				Description: "Experimental, included when requesting the task-suggestions profile using Accept-Profile.",
+				Properties: openapi3.Schemas{
+					"priority": &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Priority",
+					},
+					"due": openapi3.NewDateTimeSchema().NewRef(),
+				},
+			}),
+	}
+
+	swagger.Components.Parameters = openapi3.ParametersMap{
+		"HumanizeParameter": &openapi3.ParameterRef{
+			Value: openapi3.NewQueryParameter("humanize").
+				WithDescription("Includes human_dates in tasks, localized using Accept-Language.").
+				WithSchema(openapi3.NewBoolSchema().
+					WithDefault(false)),
+		},
+		"TimeZoneParameter": &openapi3.ParameterRef{
+			Value: openapi3.NewHeaderParameter("Time-Zone").
+				WithDescription("IANA time zone used for rendering dates and computing the days tasks are due.").
+				WithSchema(openapi3.NewStringSchema()),
+		},
 	}
 
 	swagger.Components.RequestBodies = openapi3.RequestBodies{
@@ -75,7 +221,8 @@ func NewOpenAPI3() openapi3.T {
 					}).
 					WithPropertyRef("dates", &openapi3.SchemaRef{
 						Ref: "#/components/schemas/Dates",
-					})),
+					}).
+					WithProperty("project_id", openapi3.NewUUIDSchema())),
 		},
 		"UpdateTasksRequest": &openapi3.RequestBodyRef{
 			Value: openapi3.NewRequestBody().
@@ -91,7 +238,8 @@ func NewOpenAPI3() openapi3.T {
 					}).
 					WithPropertyRef("dates", &openapi3.SchemaRef{
 						Ref: "#/components/schemas/Dates",
-					})),
+					}).
+					WithProperty("project_id", openapi3.NewUUIDSchema())),
 		},
 		"SearchTasksRequest": &openapi3.RequestBodyRef{
 			Value: openapi3.NewRequestBody().
@@ -110,7 +258,89 @@ func NewOpenAPI3() openapi3.T {
 					WithProperty("from", openapi3.NewInt64Schema().
 						WithDefault(0)).
 					WithProperty("size", openapi3.NewInt64Schema().
-						WithDefault(10))),
+						WithDefault(10)).
+					WithProperty("fuzziness", &openapi3.Schema{
+						Type:        "string",
+						Description: "Maximum number of edits allowed for terms to match: AUTO, 0, 1 or 2.",
+					}).
+					WithProperty("highlight", openapi3.NewObjectSchema().
+						WithProperty("fragment_size", openapi3.NewIntegerSchema().
+							WithMin(0).
+							WithMax(internal.MaxFragmentSize).
+							WithDefault(internal.DefaultFragmentSize))).
+					WithProperty("facets", &openapi3.Schema{
+						Type:        "boolean",
+						Description: "Whether to count the matching tasks by priority and status.",
+					}).
+					WithProperty("overdue", &openapi3.Schema{
+						Type:        "boolean",
+						Description: "Whether to only match undone tasks whose due date passed.",
+					}).
+					WithProperty("due_today", &openapi3.Schema{
+						Type:        "boolean",
+						Description: "Whether to only match undone tasks due today, in the requested Time-Zone.",
+					}).
+					WithProperty("due_in_days", &openapi3.Schema{
+						Type:        "integer",
+						Description: "Only match undone tasks due in this number of days, in the requested Time-Zone.",
+					}).
+					WithProperty("project_id", &openapi3.Schema{
+						Type:        "string",
+						Format:      "uuid",
+						Description: "Only match the tasks of this project.",
+					})),
+		},
+		"ProjectsRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for creating or updating a project.").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithProperty("name", openapi3.NewStringSchema().
+						WithMinLength(1))),
+		},
+		"PushSubscriptionRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for subscribing to the reminders, the value of PushSubscription.toJSON().").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithProperty("endpoint", openapi3.NewStringSchema().
+						WithMinLength(1)).
+					WithProperty("expirationTime", openapi3.NewInt64Schema().
+						WithNullable()).
+					WithProperty("keys", openapi3.NewObjectSchema().
+						WithProperty("p256dh", openapi3.NewStringSchema()).
+						WithProperty("auth", openapi3.NewStringSchema()))),
+		},
+		"IssueImportsRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for importing the open issues of a GitHub repository as tasks.").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithProperty("repository", openapi3.NewStringSchema().
+						WithPattern(`^[A-Za-z0-9-]+/[A-Za-z0-9._-]+$`))),
+		},
+		"BatchUpdateTasksRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for updating multiple tasks at once.").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("patches", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type:     "array",
+							MinItems: 1,
+							MaxItems: openapi3.Uint64Ptr(internal.MaxBatchSize),
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/TaskPatch",
+							},
+						},
+					})),
+		},
+		"CreateTaskDependenciesRequest": &openapi3.RequestBodyRef{
+			Value: openapi3.NewRequestBody().
+				WithDescription("Request used for indicating a task is blocked by another one.").
+				WithRequired(true).
+				WithJSONSchema(openapi3.NewSchema().
+					WithProperty("blocked_by", openapi3.NewUUIDSchema())),
 		},
 	}
 
@@ -119,7 +349,20 @@ func NewOpenAPI3() openapi3.T {
 			Value: openapi3.NewResponse().
 				WithDescription("Response when errors happen.").
 				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
-					WithProperty("error", openapi3.NewStringSchema()))),
+					WithProperty("error", openapi3.NewStringSchema()).
+					WithProperty("code", openapi3.NewStringSchema()).
+					WithProperty("request_id", openapi3.NewStringSchema()))),
+		},
+		"ConflictResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response when the task changed since the If-Match version, or when it is blocked by unfinished tasks.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("error", openapi3.NewStringSchema()).
+					WithProperty("code", openapi3.NewStringSchema()).
+					WithProperty("request_id", openapi3.NewStringSchema()).
+					WithPropertyRef("conflict", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/TaskConflict",
+					}))),
 		},
 		"CreateTasksResponse": &openapi3.ResponseRef{
 			Value: openapi3.NewResponse().
@@ -127,8 +370,70 @@ func NewOpenAPI3() openapi3.T {
 				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
 					WithPropertyRef("task", &openapi3.SchemaRef{
 						Ref: "#/components/schemas/Task",
+					}).
+					WithPropertyRef("suggestions", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/TaskSuggestions",
+					}))),
+		},
+		"ListTasksResponse": &openapi3.ResponseRef{
+			Value: withPaginationHeaders(openapi3.NewResponse().
+				WithDescription("Response returned back after listing tasks.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("tasks", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Task",
+							},
+						},
+					}).
+					WithProperty("next_cursor", openapi3.NewStringSchema()).
+					WithProperty("total", openapi3.NewInt64Schema()).
+					WithProperty("total_estimated", openapi3.NewBoolSchema())))),
+		},
+		"ListTrashResponse": &openapi3.ResponseRef{
+			Value: withPaginationHeaders(openapi3.NewResponse().
+				WithDescription("Response returned back after listing the deleted tasks.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("tasks", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/TrashedTask",
+							},
+						},
+					}).
+					WithProperty("next_cursor", openapi3.NewStringSchema())))),
+		},
+		"BatchUpdateTasksResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after updating multiple tasks, either all patches are applied or none.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("applied", openapi3.NewBoolSchema()).
+					WithPropertyRef("results", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/BatchUpdateTasksResult",
+							},
+						},
 					}))),
 		},
+		"OptionsResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response describing the semantics of the supported methods.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("methods", openapi3.NewObjectSchema().
+						WithPropertyRef("GET", &openapi3.SchemaRef{
+							Ref: "#/components/schemas/MethodSemantics",
+						}).
+						WithPropertyRef("PUT", &openapi3.SchemaRef{
+							Ref: "#/components/schemas/MethodSemantics",
+						}).
+						WithPropertyRef("DELETE", &openapi3.SchemaRef{
+							Ref: "#/components/schemas/MethodSemantics",
+						})))),
+		},
 		"ReadTasksResponse": &openapi3.ResponseRef{
 			Value: openapi3.NewResponse().
 				WithDescription("Response returned back after searching one task.").
@@ -138,7 +443,7 @@ func NewOpenAPI3() openapi3.T {
 					}))),
 		},
 		"SearchTasksResponse": &openapi3.ResponseRef{
-			Value: openapi3.NewResponse().
+			Value: withPaginationHeaders(openapi3.NewResponse().
 				WithDescription("Response returned back after searching for any task.").
 				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
 					WithPropertyRef("tasks", &openapi3.SchemaRef{
@@ -149,12 +454,174 @@ func NewOpenAPI3() openapi3.T {
 							},
 						},
 					}).
-					WithProperty("total", openapi3.NewInt64Schema()))),
+					WithProperty("total", openapi3.NewInt64Schema()).
+					WithProperty("next_cursor", openapi3.NewStringSchema()).
+					WithPropertyRef("highlights", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Highlights",
+					}).
+					WithPropertyRef("facets", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Facets",
+					})))),
+		},
+		"ProjectResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after creating or searching one project.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("project", &openapi3.SchemaRef{
+						Ref: "#/components/schemas/Project",
+					}))),
+		},
+		"ListProjectsResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after listing projects.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("projects", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Project",
+							},
+						},
+					}))),
+		},
+		"PushKeyResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after reading the VAPID public key.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("public_key", openapi3.NewStringSchema()))),
+		},
+		"PushSubscriptionResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after subscribing to the reminders.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("id", openapi3.NewUUIDSchema()))),
+		},
+		"IssueImportsResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after importing the open issues of a GitHub repository.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("repository", openapi3.NewStringSchema()).
+					WithProperty("imported", openapi3.NewIntegerSchema()).
+					WithProperty("skipped", openapi3.NewIntegerSchema()))),
+		},
+		"TaskDependenciesResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after reading the dependencies of a task.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithPropertyRef("blocked_by", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Task",
+							},
+						},
+					}).
+					WithPropertyRef("blocks", &openapi3.SchemaRef{
+						Value: &openapi3.Schema{
+							Type: "array",
+							Items: &openapi3.SchemaRef{
+								Ref: "#/components/schemas/Task",
+							},
+						},
+					}))),
+		},
+		"SuggestTasksResponse": &openapi3.ResponseRef{
+			Value: openapi3.NewResponse().
+				WithDescription("Response returned back after suggesting tasks.").
+				WithContent(openapi3.NewContentWithJSONSchema(openapi3.NewSchema().
+					WithProperty("suggestions", openapi3.NewArraySchema().
+						WithItems(openapi3.NewObjectSchema().
+							WithProperty("id", openapi3.NewUUIDSchema()).
+							WithProperty("description", openapi3.NewStringSchema()))))),
 		},
 	}
 
 	swagger.Paths = openapi3.Paths{
 		"/tasks": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ListTask",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewQueryParameter("cursor").
+							WithDescription("Opaque value returned as next_cursor by a previous call.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("size").
+							WithSchema(openapi3.NewInt64Schema().
+								WithMin(1).
+								WithDefault(10)),
+					},
+					{
+						Value: openapi3.NewQueryParameter("sort").
+							WithDescription("Order of the results, creation time is used by default; a leading - sorts by time in descending order.").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("urgency", "created_at", "-created_at", "updated_at", "-updated_at")),
+					},
+					{
+						Value: openapi3.NewQueryParameter("total").
+							WithDescription("Whether to return the total of tasks, it may be estimated for large sets.").
+							WithSchema(openapi3.NewBoolSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("overdue").
+							WithDescription("Whether to only list undone tasks whose due date passed.").
+							WithSchema(openapi3.NewBoolSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("due_today").
+							WithDescription("Whether to only list undone tasks due today, in the requested Time-Zone.").
+							WithSchema(openapi3.NewBoolSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("due_in_days").
+							WithDescription("Only list undone tasks due in this number of days, in the requested Time-Zone.").
+							WithSchema(openapi3.NewIntegerSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("project_id").
+							WithDescription("Only list the tasks of this project.").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("created_from").
+							WithDescription("Only list the tasks created at or after this time.").
+							WithSchema(openapi3.NewDateTimeSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("created_to").
+							WithDescription("Only list the tasks created before this time.").
+							WithSchema(openapi3.NewDateTimeSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("updated_from").
+							WithDescription("Only list the tasks last changed at or after this time.").
+							WithSchema(openapi3.NewDateTimeSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("updated_to").
+							WithDescription("Only list the tasks last changed before this time.").
+							WithSchema(openapi3.NewDateTimeSchema()),
+					},
+					{
+						Ref: "#/components/parameters/HumanizeParameter",
+					},
+					{
+						Ref: "#/components/parameters/TimeZoneParameter",
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ListTasksResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
 			Post: &openapi3.Operation{
 				OperationID: "CreateTask",
 				RequestBody: &openapi3.RequestBodyRef{
@@ -173,64 +640,84 @@ func NewOpenAPI3() openapi3.T {
 				},
 			},
 		},
-		"/tasks/{taskId}": &openapi3.PathItem{
-			Delete: &openapi3.Operation{
-				OperationID: "DeleteTask",
+		"/tasks:batchUpdate": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "BatchUpdateTask",
+				Description: "Applies the patches in a single transaction, the results indicate the status of each patch.",
 				Parameters: []*openapi3.ParameterRef{
 					{
-						Value: openapi3.NewPathParameter("taskId").
-							WithSchema(openapi3.NewUUIDSchema()),
+						Value: openapi3.NewQueryParameter("force").
+							WithDescription("Whether to complete tasks even when the tasks blocking them are not done.").
+							WithSchema(openapi3.NewBoolSchema()),
 					},
 				},
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/BatchUpdateTasksRequest",
+				},
 				Responses: openapi3.Responses{
 					"200": &openapi3.ResponseRef{
-						Value: openapi3.NewResponse().WithDescription("Task updated"),
+						Ref: "#/components/responses/BatchUpdateTasksResponse",
 					},
-					"404": &openapi3.ResponseRef{
-						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
 					},
 					"500": &openapi3.ResponseRef{
 						Ref: "#/components/responses/ErrorResponse",
 					},
 				},
 			},
+		},
+		"/tasks/trash": &openapi3.PathItem{
 			Get: &openapi3.Operation{
-				OperationID: "ReadTask",
+				OperationID: "ListTrashedTask",
+				Description: "Returns the deleted tasks, the most recently deleted first; those are purged after the retention period.",
 				Parameters: []*openapi3.ParameterRef{
 					{
-						Value: openapi3.NewPathParameter("taskId").
-							WithSchema(openapi3.NewUUIDSchema()),
+						Value: openapi3.NewQueryParameter("cursor").
+							WithDescription("Opaque value returned as next_cursor by a previous call.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("size").
+							WithSchema(openapi3.NewInt64Schema().
+								WithMin(1).
+								WithDefault(10)),
+					},
+					{
+						Ref: "#/components/parameters/HumanizeParameter",
+					},
+					{
+						Ref: "#/components/parameters/TimeZoneParameter",
 					},
 				},
 				Responses: openapi3.Responses{
 					"200": &openapi3.ResponseRef{
-						Ref: "#/components/responses/ReadTasksResponse",
+						Ref: "#/components/responses/ListTrashResponse",
 					},
-					"404": &openapi3.ResponseRef{
-						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
 					},
 					"500": &openapi3.ResponseRef{
 						Ref: "#/components/responses/ErrorResponse",
 					},
 				},
 			},
-			Put: &openapi3.Operation{
-				OperationID: "UpdateTask",
+		},
+		"/tasks/{taskId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeleteTask",
 				Parameters: []*openapi3.ParameterRef{
 					{
 						Value: openapi3.NewPathParameter("taskId").
 							WithSchema(openapi3.NewUUIDSchema()),
 					},
 				},
-				RequestBody: &openapi3.RequestBodyRef{
-					Ref: "#/components/requestBodies/UpdateTasksRequest",
-				},
 				Responses: openapi3.Responses{
 					"200": &openapi3.ResponseRef{
 						Value: openapi3.NewResponse().WithDescription("Task updated"),
 					},
-					"400": &openapi3.ResponseRef{
-						Ref: "#/components/responses/ErrorResponse",
+					"204": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found, when configured to treat it as deleted"),
 					},
 					"404": &openapi3.ResponseRef{
 						Value: openapi3.NewResponse().WithDescription("Task not found"),
@@ -240,36 +727,589 @@ func NewOpenAPI3() openapi3.T {
 					},
 				},
 			},
-		},
-		"/search/tasks": &openapi3.PathItem{
-			Post: &openapi3.Operation{
-				OperationID: "SearchTask",
-				RequestBody: &openapi3.RequestBodyRef{
-					Ref: "#/components/requestBodies/SearchTasksRequest",
+			Options: &openapi3.Operation{
+				OperationID: "OptionsTask",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
 				},
 				Responses: openapi3.Responses{
 					"200": &openapi3.ResponseRef{
-						Ref: "#/components/responses/SearchTasksResponse",
-					},
-					"400": &openapi3.ResponseRef{
-						Ref: "#/components/responses/ErrorResponse",
-					},
-					"500": &openapi3.ResponseRef{
-						Ref: "#/components/responses/ErrorResponse",
+						Ref: "#/components/responses/OptionsResponse",
 					},
 				},
 			},
-		},
+			Get: &openapi3.Operation{
+				OperationID: "ReadTask",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Ref: "#/components/parameters/HumanizeParameter",
+					},
+					{
+						Ref: "#/components/parameters/TimeZoneParameter",
+					},
+					{
+						Value: openapi3.NewHeaderParameter("If-None-Match").
+							WithDescription("ETag returned when reading the task, 304 is returned when it still matches.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewHeaderParameter("If-Modified-Since").
+							WithDescription("Last-Modified returned when reading the task, 304 is returned when it did not change since then; ignored when If-None-Match is used.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ReadTasksResponse",
+					},
+					"304": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not modified"),
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Put: &openapi3.Operation{
+				OperationID: "UpdateTask",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewHeaderParameter("If-Match").
+							WithDescription("ETag returned when reading the task, the task is only updated when it still matches.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewHeaderParameter("Prefer").
+							WithDescription("Use merge for merging the changes made since the If-Match version, when not conflicting.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("force").
+							WithDescription("Whether to complete the task even when the tasks blocking it are not done.").
+							WithSchema(openapi3.NewBoolSchema()),
+					},
+				},
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/UpdateTasksRequest",
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task updated"),
+					},
+					"201": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task created, when configured to create missing tasks"),
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"409": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ConflictResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/tasks/{taskId}/clone": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "CloneTask",
+				Description: "Creates a new pending task copying the description, priority, dates and project of an existing one.",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"201": &openapi3.ResponseRef{
+						Ref: "#/components/responses/CreateTasksResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/tasks/{taskId}/restore": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "RestoreTask",
+				Description: "Moves a deleted task back from the trash, without project when the original one was deleted.",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ReadTasksResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found in the trash"),
+					},
+					"409": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task already exists"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/tasks/{taskId}/complete": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "CompleteTask",
+				Description: "Marks the task as done keeping the time it was completed, done tasks are kept as they are.",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewHeaderParameter("If-Match").
+							WithDescription("ETag returned when reading the task, the task is only updated when it still matches.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("force").
+							WithDescription("Whether to complete the task even when the tasks blocking it are not done.").
+							WithSchema(openapi3.NewBoolSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ReadTasksResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"409": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task blocked by tasks not done yet, or changed since the If-Match version"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/tasks/{taskId}/reopen": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "ReopenTask",
+				Description: "Marks the done task as not done, tasks not done are kept as they are.",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewHeaderParameter("If-Match").
+							WithDescription("ETag returned when reading the task, the task is only updated when it still matches.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ReadTasksResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"409": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task changed since the If-Match version"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/tasks/{taskId}/dependencies": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadTaskDependencies",
+				Description: "Returns the tasks blocking the task and the ones blocked by it.",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/TaskDependenciesResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Post: &openapi3.Operation{
+				OperationID: "CreateTaskDependency",
+				Description: "Indicates the task is blocked by another one, it can't be completed until the latter is done.",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/CreateTaskDependenciesRequest",
+				},
+				Responses: openapi3.Responses{
+					"201": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Dependency created"),
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Task not found"),
+					},
+					"409": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Dependency creates a cycle"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/tasks/{taskId}/dependencies/{blockerId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeleteTaskDependency",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("taskId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewPathParameter("blockerId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Dependency deleted"),
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Dependency not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/projects": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ListProject",
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ListProjectsResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Post: &openapi3.Operation{
+				OperationID: "CreateProject",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/ProjectsRequest",
+				},
+				Responses: openapi3.Responses{
+					"201": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ProjectResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/projects/{projectId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeleteProject",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("projectId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("strategy").
+							WithDescription("What happens to the tasks of the project: orphan keeps them and cascade deletes them.").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("orphan", "cascade").
+								WithDefault("orphan")),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Project deleted"),
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Project not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Get: &openapi3.Operation{
+				OperationID: "ReadProject",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("projectId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ProjectResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Project not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+			Put: &openapi3.Operation{
+				OperationID: "UpdateProject",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("projectId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/ProjectsRequest",
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Project updated"),
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Project not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/github/imports": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "CreateIssueImport",
+				Description: "Imports the open issues of a configured GitHub repository, only served when GitHub is configured.",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/IssueImportsRequest",
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/IssueImportsResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/push/key": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "ReadPushKey",
+				Description: "Returns the VAPID public key used as applicationServerKey, only served when Web Push is configured.",
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/PushKeyResponse",
+					},
+				},
+			},
+		},
+		"/push/subscriptions": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "CreatePushSubscription",
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/PushSubscriptionRequest",
+				},
+				Responses: openapi3.Responses{
+					"201": &openapi3.ResponseRef{
+						Ref: "#/components/responses/PushSubscriptionResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/push/subscriptions/{subscriptionId}": &openapi3.PathItem{
+			Delete: &openapi3.Operation{
+				OperationID: "DeletePushSubscription",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewPathParameter("subscriptionId").
+							WithSchema(openapi3.NewUUIDSchema()),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Subscription deleted"),
+					},
+					"404": &openapi3.ResponseRef{
+						Value: openapi3.NewResponse().WithDescription("Subscription not found"),
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/search/tasks": &openapi3.PathItem{
+			Post: &openapi3.Operation{
+				OperationID: "SearchTask",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewQueryParameter("cursor").
+							WithDescription("Opaque value returned as next_cursor by a previous call, from is ignored when used.").
+							WithSchema(openapi3.NewStringSchema()),
+					},
+					{
+						Value: openapi3.NewQueryParameter("sort").
+							WithDescription("Order of the results, relevance is used by default; a leading - sorts by time in descending order.").
+							WithSchema(openapi3.NewStringSchema().
+								WithEnum("urgency", "created_at", "-created_at", "updated_at", "-updated_at")),
+					},
+					{
+						Ref: "#/components/parameters/HumanizeParameter",
+					},
+					{
+						Ref: "#/components/parameters/TimeZoneParameter",
+					},
+				},
+				RequestBody: &openapi3.RequestBodyRef{
+					Ref: "#/components/requestBodies/SearchTasksRequest",
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/SearchTasksResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"503": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
+		"/search/tasks/suggest": &openapi3.PathItem{
+			Get: &openapi3.Operation{
+				OperationID: "SuggestTask",
+				Parameters: []*openapi3.ParameterRef{
+					{
+						Value: openapi3.NewQueryParameter("q").
+							WithDescription("Text typed so far, its last word may be incomplete.").
+							WithRequired(true).
+							WithSchema(openapi3.NewStringSchema().
+								WithMinLength(1)),
+					},
+					{
+						Value: openapi3.NewQueryParameter("size").
+							WithSchema(openapi3.NewInt64Schema().
+								WithMin(1).
+								WithDefault(5)),
+					},
+				},
+				Responses: openapi3.Responses{
+					"200": &openapi3.ResponseRef{
+						Ref: "#/components/responses/SuggestTasksResponse",
+					},
+					"400": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"500": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+					"503": &openapi3.ResponseRef{
+						Ref: "#/components/responses/ErrorResponse",
+					},
+				},
+			},
+		},
 	}
 
 	return swagger
 }
 
+// withPaginationHeaders adds the headers returned with paginated results.
+func withPaginationHeaders(res *openapi3.Response) *openapi3.Response {
+	res.Headers = openapi3.Headers{
+		LinkHeader: &openapi3.HeaderRef{
+			Value: &openapi3.Header{
+				Parameter: openapi3.Parameter{
+					Description: `Links to the first and next pages, for example: </tasks?cursor=abc>; rel="next".`,
+					Schema:      openapi3.NewStringSchema().NewRef(),
+				},
+			},
+		},
+	}
+
+	return res
+}
+
 func RegisterOpenAPI(router *mux.Router) {
 	swagger := NewOpenAPI3()
 
 	router.HandleFunc("/openapi3.json", func(w http.ResponseWriter, r *http.Request) {
-		renderResponse(w, &swagger, http.StatusOK)
+		renderResponse(r.Context(), w, &swagger, http.StatusOK)
 	}).Methods(http.MethodGet)
 
 	router.HandleFunc("/openapi3.yaml", func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ProblemContentType is the media type of Problem responses.
+const ProblemContentType = "application/problem+json"
+
+// Problem represents an error response using the RFC 7807 format, it's used when the limits of the request are
+// exceeded; see Limits.
+type Problem struct {
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Status    int    `json:"status"`
+	Detail    string `json:"detail,omitempty"`
+	Code      string `json:"code,omitempty"`
+	RequestID string `json:"request_id,omitempty"` //nolint: tagliatelle
+}
+
+func newProblem(resp ErrorResponse, status int) Problem {
+	return Problem{
+		Type:      "about:blank",
+		Title:     http.StatusText(status),
+		Status:    status,
+		Detail:    resp.Error,
+		Code:      resp.Code,
+		RequestID: resp.RequestID,
+	}
+}
+
+func renderProblem(w http.ResponseWriter, problem Problem) {
+	w.Header().Set("Content-Type", ProblemContentType)
+
+	content, err := json.Marshal(problem)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(problem.Status)
+
+	if _, err = w.Write(content); err != nil { //nolint: staticcheck
+		// XXX Do something with the error ;)
+	}
+}
@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+const (
+	// AcceptProfileHeader is the header used by clients for requesting profiles, multiple profiles are separated
+	// by commas.
+	AcceptProfileHeader = "Accept-Profile"
+
+	// ContentProfileHeader is the header used for returning the profiles applied to the response.
+	ContentProfileHeader = "Content-Profile"
+)
+
+// Profile defines a set of experimental response fields, those are only included when requested by clients so
+// schema changes can be trialed before making them generally available.
+type Profile string
+
+const (
+	// ProfileTaskOverdue includes the "is_overdue" field in tasks.
+	ProfileTaskOverdue Profile = "task-overdue"
+
+	// ProfileTaskSuggestions includes the "suggestions" field when creating tasks.
+	ProfileTaskSuggestions Profile = "task-suggestions"
+
+	// ProfileTaskDue includes the "is_due_today" and "due_in_days" fields in tasks.
+	ProfileTaskDue Profile = "task-due"
+)
+
+// supported indicates whether the profile is supported, unknown profiles are ignored.
+func (p Profile) supported() bool {
+	switch p {
+	case ProfileTaskOverdue, ProfileTaskSuggestions, ProfileTaskDue:
+		return true
+	}
+
+	return false
+}
+
+type profilesKey struct{}
+
+// Profiles is a middleware that stores the supported profiles requested by clients in the request context.
+func Profiles(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", AcceptProfileHeader)
+
+		var requested []string
+
+		for _, val := range strings.Split(r.Header.Get(AcceptProfileHeader), ",") {
+			profile := Profile(strings.TrimSpace(val))
+			if profile.supported() {
+				requested = append(requested, string(profile))
+			}
+		}
+
+		if len(requested) == 0 {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		w.Header().Set(ContentProfileHeader, strings.Join(requested, ", "))
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), profilesKey{}, requested)))
+	})
+}
+
+// ProfileRequested indicates whether the profile was requested.
+func ProfileRequested(ctx context.Context, profile Profile) bool {
+	requested, _ := ctx.Value(profilesKey{}).([]string)
+
+	for _, val := range requested {
+		if val == string(profile) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestProfiles(t *testing.T) {
+	t.Parallel()
+
+	newBool := func(b bool) *bool {
+		return &b
+	}
+
+	due := time.Now().Add(-time.Hour).UTC()
+
+	tests := []struct {
+		name            string
+		profile         string
+		expected        *rest.ReadTasksResponse
+		expectedProfile string
+	}{
+		{
+			"OK: profile",
+			"unknown, task-overdue",
+			&rest.ReadTasksResponse{
+				Task: rest.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "overdue",
+					Priority:    "high",
+					Dates:       rest.Dates{Due: due},
+					IsOverdue:   newBool(true),
+				},
+			},
+			"task-overdue",
+		},
+		{
+			"OK: no profile",
+			"",
+			&rest.ReadTasksResponse{
+				Task: rest.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "overdue",
+					Priority:    "high",
+					Dates:       rest.Dates{Due: due},
+				},
+			},
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			router.Use(rest.Profiles)
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(
+				internal.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "overdue",
+					Priority:    internal.PriorityHigh,
+					Dates:       internal.Dates{Due: due},
+				},
+				nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, "/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a", nil)
+			if tt.profile != "" {
+				req.Header.Set(rest.AcceptProfileHeader, tt.profile)
+			}
+
+			res := doRequest(router, req)
+
+			if actual := res.Header.Get(rest.ContentProfileHeader); actual != tt.expectedProfile {
+				t.Fatalf("expected profile %q, got %q", tt.expectedProfile, actual)
+			}
+
+			assertResponse(t, res, test{tt.expected, &rest.ReadTasksResponse{}})
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//counterfeiter:generate -o resttesting/project_service.gen.go . ProjectService
+
+// ProjectService defines the application service in charge of interacting with Projects.
+type ProjectService interface {
+	Create(ctx context.Context, name string) (internal.Project, error)
+	Delete(ctx context.Context, id string, strategy internal.ProjectDeleteStrategy) error
+	Find(ctx context.Context, id string) (internal.Project, error)
+	List(ctx context.Context) ([]internal.Project, error)
+	Update(ctx context.Context, id string, name string) error
+}
+
+// ProjectHandler defines the handlers of the Project resources, the Tasks of a Project are listed using the
+// "project_id" query parameter of the Task resources.
+type ProjectHandler struct {
+	svc ProjectService
+}
+
+// NewProjectHandler instantiates the Project handlers.
+func NewProjectHandler(svc ProjectService) *ProjectHandler {
+	return &ProjectHandler{
+		svc: svc,
+	}
+}
+
+// Register connects the handlers to the router.
+func (p *ProjectHandler) Register(r *mux.Router) {
+	r.HandleFunc("/projects", p.create).Methods(http.MethodPost)
+	r.HandleFunc("/projects", p.list).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/projects/{id:%s}", uuidRegEx), p.project).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/projects/{id:%s}", uuidRegEx), p.update).Methods(http.MethodPut)
+	r.HandleFunc(fmt.Sprintf("/projects/{id:%s}", uuidRegEx), p.delete).Methods(http.MethodDelete)
+}
+
+// Project groups related tasks.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+func newProject(project internal.Project) Project {
+	return Project{
+		ID:   project.ID,
+		Name: project.Name,
+	}
+}
+
+// CreateProjectsRequest defines the request used for creating projects.
+type CreateProjectsRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateProjectsResponse defines the response returned back after creating projects.
+type CreateProjectsResponse struct {
+	Project Project `json:"project"`
+}
+
+func (p *ProjectHandler) create(w http.ResponseWriter, r *http.Request) {
+	var req CreateProjectsRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	defer r.Body.Close()
+
+	project, err := p.svc.Create(r.Context(), req.Name)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "create failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w,
+		&CreateProjectsResponse{
+			Project: newProject(project),
+		},
+		http.StatusCreated)
+}
+
+// delete deletes the project, the "strategy" query parameter indicates what happens to its tasks: "orphan", the
+// default, keeps them without project and "cascade" deletes them.
+func (p *ProjectHandler) delete(w http.ResponseWriter, r *http.Request) {
+	strategy := internal.ProjectDeleteOrphan
+
+	if val := r.URL.Query().Get("strategy"); val != "" {
+		strategy = internal.ProjectDeleteStrategy(val)
+	}
+
+	// NOTE: Safe to ignore missing values, because it's always defined.
+	if err := p.svc.Delete(r.Context(), mux.Vars(r)["id"], strategy); err != nil {
+		renderErrorResponse(r.Context(), w, "delete failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, struct{}{}, http.StatusOK)
+}
+
+// ListProjectsResponse defines the response returned back after listing projects.
+type ListProjectsResponse struct {
+	Projects []Project `json:"projects"`
+}
+
+func (p *ProjectHandler) list(w http.ResponseWriter, r *http.Request) {
+	res, err := p.svc.List(r.Context())
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "list failed", err)
+
+		return
+	}
+
+	projects := make([]Project, len(res))
+
+	for i, project := range res {
+		projects[i] = newProject(project)
+	}
+
+	renderResponse(r.Context(), w, &ListProjectsResponse{Projects: projects}, http.StatusOK)
+}
+
+// ReadProjectsResponse defines the response returned back after searching one project.
+type ReadProjectsResponse struct {
+	Project Project `json:"project"`
+}
+
+func (p *ProjectHandler) project(w http.ResponseWriter, r *http.Request) {
+	// NOTE: Safe to ignore missing values, because it's always defined.
+	project, err := p.svc.Find(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w,
+		&ReadProjectsResponse{
+			Project: newProject(project),
+		},
+		http.StatusOK)
+}
+
+// UpdateProjectsRequest defines the request used for updating a project.
+type UpdateProjectsRequest struct {
+	Name string `json:"name"`
+}
+
+func (p *ProjectHandler) update(w http.ResponseWriter, r *http.Request) {
+	var req UpdateProjectsRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	defer r.Body.Close()
+
+	// NOTE: Safe to ignore missing values, because it's always defined.
+	if err := p.svc.Update(r.Context(), mux.Vars(r)["id"], req.Name); err != nil {
+		renderErrorResponse(r.Context(), w, "update failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, &struct{}{}, http.StatusOK)
+}
@@ -0,0 +1,274 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestProjects_Post(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeProjectService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 201",
+			func(s *resttesting.FakeProjectService) {
+				s.CreateReturns(
+					internal.Project{
+						ID:   "1-2-3",
+						Name: "home",
+					},
+					nil)
+			},
+			func() []byte {
+				b, _ := json.Marshal(&rest.CreateProjectsRequest{
+					Name: "home",
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusCreated,
+				&rest.CreateProjectsResponse{
+					Project: rest.Project{
+						ID:   "1-2-3",
+						Name: "home",
+					},
+				},
+				&rest.CreateProjectsResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakeProjectService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 unknown field",
+			func(*resttesting.FakeProjectService) {},
+			[]byte(`{"nme":"home"}`),
+			output{
+				http.StatusBadRequest,
+				&validationsResponse{
+					Error:       "invalid request",
+					Validations: map[string]string{"nme": "unknown field"},
+				},
+				&validationsResponse{},
+			},
+		},
+		{
+			"ERR: 400 malformed field",
+			func(*resttesting.FakeProjectService) {},
+			[]byte(`{"name":1}`),
+			output{
+				http.StatusBadRequest,
+				&validationsResponse{
+					Error:       "invalid request",
+					Validations: map[string]string{"name": "cannot be a JSON number"},
+				},
+				&validationsResponse{},
+			},
+		},
+		{
+			"ERR: 400 trailing data",
+			func(*resttesting.FakeProjectService) {},
+			[]byte(`{"name":"home"}{}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 413",
+			func(*resttesting.FakeProjectService) {},
+			[]byte(`{"name":"` + strings.Repeat("x", 1<<20) + `"}`),
+			output{
+				http.StatusRequestEntityTooLarge,
+				&rest.Problem{
+					Type:   "about:blank",
+					Title:  "Request Entity Too Large",
+					Status: http.StatusRequestEntityTooLarge,
+					Detail: "request body too large",
+				},
+				&rest.Problem{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeProjectService) {
+				s.CreateReturns(internal.Project{}, errors.New("service error"))
+			},
+			[]byte(`{}`),
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeProjectService{}
+			tt.setup(svc)
+
+			rest.NewProjectHandler(svc).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/projects", bytes.NewReader(tt.input)))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+// validationsResponse is the subset of rest.ErrorResponse used for asserting validation errors, which can't be
+// decoded into validation.Errors.
+type validationsResponse struct {
+	Error       string            `json:"error"`
+	Validations map[string]string `json:"validations"`
+}
+
+func TestProjects_Delete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		setup            func(*resttesting.FakeProjectService)
+		target           string
+		expectedStrategy internal.ProjectDeleteStrategy
+		expectedStatus   int
+	}{
+		{
+			"OK: default strategy",
+			func(*resttesting.FakeProjectService) {},
+			"/projects/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			internal.ProjectDeleteOrphan,
+			http.StatusOK,
+		},
+		{
+			"OK: cascade",
+			func(*resttesting.FakeProjectService) {},
+			"/projects/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee?strategy=cascade",
+			internal.ProjectDeleteCascade,
+			http.StatusOK,
+		},
+		{
+			"ERR: 400",
+			func(s *resttesting.FakeProjectService) {
+				s.DeleteReturns(internal.NewErrorf(internal.ErrorCodeInvalidArgument, "unknown value"))
+			},
+			"/projects/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee?strategy=keep",
+			internal.ProjectDeleteStrategy("keep"),
+			http.StatusBadRequest,
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeProjectService) {
+				s.DeleteReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			"/projects/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			internal.ProjectDeleteOrphan,
+			http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeProjectService{}
+			tt.setup(svc)
+
+			rest.NewProjectHandler(svc).Register(router)
+
+			//-
+
+			res := doRequest(router, httptest.NewRequest(http.MethodDelete, tt.target, nil))
+			defer res.Body.Close()
+
+			//-
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if _, id, strategy := svc.DeleteArgsForCall(0); id != "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee" ||
+				strategy != tt.expectedStrategy {
+				t.Fatalf("expected arguments do not match: %s %s", id, strategy)
+			}
+		})
+	}
+}
+
+func TestProjects_List(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeProjectService{}
+	svc.ListReturns([]internal.Project{{ID: "1-2-3", Name: "home"}, {ID: "4-5-6", Name: "work"}}, nil)
+
+	rest.NewProjectHandler(svc).Register(router)
+
+	res := doRequest(router, httptest.NewRequest(http.MethodGet, "/projects", nil))
+
+	assertResponse(t, res, test{
+		&rest.ListProjectsResponse{
+			Projects: []rest.Project{{ID: "1-2-3", Name: "home"}, {ID: "4-5-6", Name: "work"}},
+		},
+		&rest.ListProjectsResponse{},
+	})
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//counterfeiter:generate -o resttesting/push_subscription_service.gen.go . PushSubscriptionService
+
+// PushSubscriptionService defines the application service in charge of interacting with PushSubscriptions.
+type PushSubscriptionService interface {
+	Register(ctx context.Context, sub internal.PushSubscription) (internal.PushSubscription, error)
+	Unregister(ctx context.Context, id string) error
+}
+
+// PushHandler defines the handlers used by browsers for subscribing to the notifications delivered using the Web
+// Push protocol.
+type PushHandler struct {
+	svc       PushSubscriptionService
+	publicKey string
+}
+
+// NewPushHandler instantiates the Push handlers, publicKey is the VAPID public key browsers use as the
+// applicationServerKey when subscribing.
+func NewPushHandler(svc PushSubscriptionService, publicKey string) *PushHandler {
+	return &PushHandler{
+		svc:       svc,
+		publicKey: publicKey,
+	}
+}
+
+// Register connects the handlers to the router.
+func (p *PushHandler) Register(r *mux.Router) {
+	r.HandleFunc("/push/key", p.key).Methods(http.MethodGet)
+	r.HandleFunc("/push/subscriptions", p.subscribe).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/push/subscriptions/{id:%s}", uuidRegEx), p.unsubscribe).Methods(http.MethodDelete)
+}
+
+// PushKeyResponse defines the response returned back after reading the VAPID public key.
+type PushKeyResponse struct {
+	PublicKey string `json:"public_key"`
+}
+
+func (p *PushHandler) key(w http.ResponseWriter, r *http.Request) {
+	renderResponse(r.Context(), w, &PushKeyResponse{PublicKey: p.publicKey}, http.StatusOK)
+}
+
+// PushSubscriptionKeys are the keys used for encrypting the messages, as returned by PushSubscription.toJSON().
+type PushSubscriptionKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+// CreatePushSubscriptionsRequest defines the request used for subscribing, it's the value returned by
+// PushSubscription.toJSON() in the browser.
+type CreatePushSubscriptionsRequest struct {
+	Endpoint       string               `json:"endpoint"`
+	ExpirationTime *int64               `json:"expirationTime,omitempty"`
+	Keys           PushSubscriptionKeys `json:"keys"`
+}
+
+// CreatePushSubscriptionsResponse defines the response returned back after subscribing, ID is used for
+// unsubscribing.
+type CreatePushSubscriptionsResponse struct {
+	ID string `json:"id"`
+}
+
+func (p *PushHandler) subscribe(w http.ResponseWriter, r *http.Request) {
+	var req CreatePushSubscriptionsRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	defer r.Body.Close()
+
+	sub, err := p.svc.Register(r.Context(), internal.PushSubscription{
+		Endpoint: req.Endpoint,
+		P256dh:   req.Keys.P256dh,
+		Auth:     req.Keys.Auth,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "subscribe failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, &CreatePushSubscriptionsResponse{ID: sub.ID}, http.StatusCreated)
+}
+
+func (p *PushHandler) unsubscribe(w http.ResponseWriter, r *http.Request) {
+	// NOTE: Safe to ignore missing values, because it's always defined.
+	if err := p.svc.Unregister(r.Context(), mux.Vars(r)["id"]); err != nil {
+		renderErrorResponse(r.Context(), w, "unsubscribe failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, struct{}{}, http.StatusOK)
+}
@@ -0,0 +1,191 @@
+package rest_test
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestPush_Key(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+
+	rest.NewPushHandler(&resttesting.FakePushSubscriptionService{}, "BPublicKey").Register(router)
+
+	res := doRequest(router, httptest.NewRequest(http.MethodGet, "/push/key", nil))
+
+	assertResponse(t, res, test{&rest.PushKeyResponse{PublicKey: "BPublicKey"}, &rest.PushKeyResponse{}})
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+}
+
+func TestPush_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakePushSubscriptionService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 201",
+			func(s *resttesting.FakePushSubscriptionService) {
+				s.RegisterReturns(internal.PushSubscription{ID: "1-2-3"}, nil)
+			},
+			[]byte(`{"endpoint":"https://push.example.com/1","expirationTime":null,"keys":{"p256dh":"key","auth":"secret"}}`),
+			output{
+				http.StatusCreated,
+				&rest.CreatePushSubscriptionsResponse{ID: "1-2-3"},
+				&rest.CreatePushSubscriptionsResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakePushSubscriptionService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 service",
+			func(s *resttesting.FakePushSubscriptionService) {
+				s.RegisterReturns(internal.PushSubscription{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid values"))
+			},
+			[]byte(`{"endpoint":"http://push.example.com/1","keys":{"p256dh":"key","auth":"secret"}}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "subscribe failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakePushSubscriptionService) {
+				s.RegisterReturns(internal.PushSubscription{}, errors.New("service error"))
+			},
+			[]byte(`{}`),
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakePushSubscriptionService{}
+			tt.setup(svc)
+
+			rest.NewPushHandler(svc, "").Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/push/subscriptions", bytes.NewReader(tt.input)))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if tt.output.expectedStatus == http.StatusCreated {
+				expected := internal.PushSubscription{Endpoint: "https://push.example.com/1", P256dh: "key", Auth: "secret"}
+
+				if _, actual := svc.RegisterArgsForCall(0); !cmp.Equal(expected, actual) {
+					t.Fatalf("expected arguments do not match: %s", cmp.Diff(expected, actual))
+				}
+			}
+		})
+	}
+}
+
+func TestPush_Unsubscribe(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		setup          func(*resttesting.FakePushSubscriptionService)
+		expectedStatus int
+	}{
+		{
+			"OK",
+			func(*resttesting.FakePushSubscriptionService) {},
+			http.StatusOK,
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakePushSubscriptionService) {
+				s.UnregisterReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakePushSubscriptionService{}
+			tt.setup(svc)
+
+			rest.NewPushHandler(svc, "").Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodDelete, "/push/subscriptions/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+			defer res.Body.Close()
+
+			//-
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if _, id := svc.UnregisterArgsForCall(0); id != "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee" {
+				t.Fatalf("expected id does not match: %s", id)
+			}
+		})
+	}
+}
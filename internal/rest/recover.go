@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Recover returns a middleware that recovers panics in handlers: those are recorded in the current span, logged
+// including the stack trace and the request id, and a 500 response is returned instead of closing the connection.
+// Panics using http.ErrAbortHandler are not recovered, those are meant to abort the response.
+func Recover(logger *zap.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				val := recover()
+				if val == nil {
+					return
+				}
+
+				if err, ok := val.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+					panic(val)
+				}
+
+				err := fmt.Errorf("panic: %v", val)
+
+				span := trace.SpanFromContext(r.Context())
+				span.RecordError(err)
+				span.SetStatus(codes.Error, "panic")
+
+				logger.Error("Panic recovered",
+					zap.Error(err),
+					zap.String("url", r.URL.String()),
+					zap.String("request_id", internal.RequestIDFromContext(r.Context())),
+					zap.ByteString("stack", debug.Stack()),
+				)
+
+				renderErrorResponse(r.Context(), w, "internal error", err)
+			}()
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
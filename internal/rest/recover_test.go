@@ -0,0 +1,50 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestRecover(t *testing.T) {
+	t.Parallel()
+
+	core, logs := observer.New(zap.ErrorLevel)
+
+	handler := rest.RequestID(rest.Recover(zap.New(core))(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		panic("boom")
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+	req.Header.Set(rest.RequestIDHeader, "abc")
+
+	rr := httptest.NewRecorder()
+
+	handler.ServeHTTP(rr, req)
+
+	res := rr.Result()
+
+	if res.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected code %d, actual %d", http.StatusInternalServerError, res.StatusCode)
+	}
+
+	assertResponse(t, res, test{
+		&rest.ErrorResponse{Error: "internal error", RequestID: "abc"},
+		&rest.ErrorResponse{},
+	})
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("expected one log entry, got %d", len(entries))
+	}
+
+	fields := entries[0].ContextMap()
+	if fields["request_id"] != "abc" || fields["stack"] == "" {
+		t.Fatalf("expected request id and stack trace, got %v", fields)
+	}
+}
@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// RequestIDHeader is the header used for receiving and returning the request id.
+	RequestIDHeader = "X-Request-ID"
+
+	maxRequestIDLength = 128
+)
+
+// RequestID is a middleware that honors the request id sent by clients or generates a new one when missing or
+// invalid, the value is stored in the request context, returned as a header and added to the current span.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = uuid.NewString()
+		}
+
+		trace.SpanFromContext(r.Context()).SetAttributes(attribute.String("http.request_id", id))
+
+		w.Header().Set(RequestIDHeader, id)
+
+		next.ServeHTTP(w, r.WithContext(internal.NewContextWithRequestID(r.Context(), id)))
+	})
+}
+
+// validRequestID indicates whether the value is safe to be logged and returned back, only printable ASCII
+// characters are allowed.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range id {
+		if c < '!' || c > '~' {
+			return false
+		}
+	}
+
+	return true
+}
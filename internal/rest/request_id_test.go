@@ -0,0 +1,81 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		generated bool
+	}{
+		{
+			"OK: honored",
+			"abc-123",
+			false,
+		},
+		{
+			"OK: generated when missing",
+			"",
+			true,
+		},
+		{
+			"OK: generated when invalid",
+			"abc 123\n",
+			true,
+		},
+		{
+			"OK: generated when too long",
+			strings.Repeat("x", 129),
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual string
+
+			handler := rest.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				actual = internal.RequestIDFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.input != "" {
+				req.Header.Set(rest.RequestIDHeader, tt.input)
+			}
+
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			if actual == "" {
+				t.Fatalf("expected request id, got empty value")
+			}
+
+			if header := rec.Header().Get(rest.RequestIDHeader); header != actual {
+				t.Fatalf("expected header %s, got %s", actual, header)
+			}
+
+			if !tt.generated && actual != tt.input {
+				t.Fatalf("expected %s, got %s", tt.input, actual)
+			}
+
+			if tt.generated && actual == tt.input {
+				t.Fatalf("expected generated value, got %s", actual)
+			}
+		})
+	}
+}
@@ -7,19 +7,86 @@ import (
 	"net/http"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
 	"go.opentelemetry.io/otel/trace"
 
 	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/breaker"
 )
 
 // ErrorResponse represents a response containing an error message.
 type ErrorResponse struct {
 	Error       string            `json:"error"`
+	Code        string            `json:"code,omitempty"`
+	RequestID   string            `json:"request_id,omitempty"` //nolint: tagliatelle
 	Validations validation.Errors `json:"validations,omitempty"`
+	Conflict    *TaskConflict     `json:"conflict,omitempty"`
 }
 
+// errorCodeDependencyPrefix prefixes the error code of server errors caused by a dependency, for example
+// "dependency_postgresql".
+const errorCodeDependencyPrefix = "dependency_"
+
+// errorCodeCircuitOpen indicates the request was rejected right away because the dependency failed repeatedly, see
+// breaker.Breaker.
+const errorCodeCircuitOpen = "circuit_open"
+
+//nolint: gochecknoglobals
+var errorResponses = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/rest")).NewInt64Counter(
+	"http.server.errors",
+	metric.WithDescription("Number of error responses, labeled by status and by the dependency causing server errors"),
+)
+
 func renderErrorResponse(ctx context.Context, w http.ResponseWriter, msg string, err error) {
-	resp := ErrorResponse{Error: msg}
+	resp, status := newErrorResponse(ctx, msg, err)
+
+	var cerr *internal.TaskConflictError
+	if status == http.StatusConflict && errors.As(err, &cerr) {
+		if etag := newETag(cerr.Conflict.Theirs.Version); etag != "" {
+			w.Header().Set(ETagHeader, etag)
+		}
+	}
+
+	var (
+		ierr       *internal.Error
+		dependency internal.Dependency
+	)
+
+	if errors.As(err, &ierr) && status >= http.StatusInternalServerError {
+		dependency = ierr.Dependency()
+	}
+
+	errorResponses.Add(ctx, 1,
+		attribute.Int("status", status),
+		attribute.String("dependency", string(dependency)),
+	)
+
+	if err != nil {
+		_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "rest.renderErrorResponse")
+		defer span.End()
+
+		span.RecordError(err)
+	}
+
+	// XXX fmt.Printf("Error: %v\n", err)
+
+	if status == http.StatusRequestEntityTooLarge || status == http.StatusGatewayTimeout {
+		renderProblem(w, newProblem(resp, status))
+
+		return
+	}
+
+	renderResponse(ctx, w, resp, status)
+}
+
+// newErrorResponse returns the response describing err and its status.
+func newErrorResponse(ctx context.Context, msg string, err error) (ErrorResponse, int) {
+	resp := ErrorResponse{
+		Error:     msg,
+		RequestID: internal.RequestIDFromContext(ctx),
+	}
 	status := http.StatusInternalServerError
 
 	var ierr *internal.Error
@@ -29,6 +96,15 @@ func renderErrorResponse(ctx context.Context, w http.ResponseWriter, msg string,
 		switch ierr.Code() {
 		case internal.ErrorCodeNotFound:
 			status = http.StatusNotFound
+		case internal.ErrorCodeUnavailable:
+			status = http.StatusServiceUnavailable
+		case internal.ErrorCodeConflict:
+			status = http.StatusConflict
+
+			var cerr *internal.TaskConflictError
+			if errors.As(ierr, &cerr) {
+				resp.Conflict = newTaskConflict(ctx, cerr.Conflict)
+			}
 		case internal.ErrorCodeInvalidArgument:
 			status = http.StatusBadRequest
 
@@ -43,22 +119,41 @@ func renderErrorResponse(ctx context.Context, w http.ResponseWriter, msg string,
 		}
 	}
 
-	if err != nil {
-		_, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "rest.renderErrorResponse")
-		defer span.End()
+	if ierr != nil && status >= http.StatusInternalServerError {
+		if dependency := ierr.Dependency(); dependency != internal.DependencyNone {
+			resp.Code = errorCodeDependencyPrefix + string(dependency)
+		}
 
-		span.RecordError(err)
+		if errors.Is(err, breaker.ErrOpen) {
+			resp.Code = errorCodeCircuitOpen
+		}
 	}
 
-	// XXX fmt.Printf("Error: %v\n", err)
+	// Limits exceeded while handling the request, see Limits, take precedence over the code of the error.
+	switch {
+	case errors.Is(err, errRequestBodyTooLarge):
+		resp.Error, resp.Validations = "request body too large", nil
+		status = http.StatusRequestEntityTooLarge
+	case errors.Is(err, context.DeadlineExceeded) && ctx.Err() != nil:
+		resp.Error = "request timeout"
+		status = http.StatusGatewayTimeout
+	}
 
-	renderResponse(w, resp, status)
+	return resp, status
 }
 
-func renderResponse(w http.ResponseWriter, res interface{}, status int) {
-	w.Header().Set("Content-Type", "application/json")
+// renderResponse renders res using the codec negotiated by Negotiate, JSON by default.
+func renderResponse(ctx context.Context, w http.ResponseWriter, res interface{}, status int) {
+	contentType := jsonContentType
 
 	content, err := json.Marshal(res)
+	if err == nil {
+		if codec := codecFromContext(ctx); codec != nil {
+			contentType = codec.ContentType()
+			content, err = codec.FromJSON(content)
+		}
+	}
+
 	if err != nil {
 		// XXX Do something with the error ;)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -66,6 +161,7 @@ func renderResponse(w http.ResponseWriter, res interface{}, status int) {
 		return
 	}
 
+	w.Header().Set("Content-Type", contentType)
 	w.WriteHeader(status)
 
 	if _, err = w.Write(content); err != nil { //nolint: staticcheck
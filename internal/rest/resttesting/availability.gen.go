@@ -0,0 +1,102 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package resttesting
+
+import (
+	"sync"
+
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+type FakeAvailability struct {
+	AvailableStub        func() bool
+	availableMutex       sync.RWMutex
+	availableArgsForCall []struct {
+	}
+	availableReturns struct {
+		result1 bool
+	}
+	availableReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAvailability) Available() bool {
+	fake.availableMutex.Lock()
+	ret, specificReturn := fake.availableReturnsOnCall[len(fake.availableArgsForCall)]
+	fake.availableArgsForCall = append(fake.availableArgsForCall, struct {
+	}{})
+	stub := fake.AvailableStub
+	fakeReturns := fake.availableReturns
+	fake.recordInvocation("Available", []interface{}{})
+	fake.availableMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeAvailability) AvailableCallCount() int {
+	fake.availableMutex.RLock()
+	defer fake.availableMutex.RUnlock()
+	return len(fake.availableArgsForCall)
+}
+
+func (fake *FakeAvailability) AvailableCalls(stub func() bool) {
+	fake.availableMutex.Lock()
+	defer fake.availableMutex.Unlock()
+	fake.AvailableStub = stub
+}
+
+func (fake *FakeAvailability) AvailableReturns(result1 bool) {
+	fake.availableMutex.Lock()
+	defer fake.availableMutex.Unlock()
+	fake.AvailableStub = nil
+	fake.availableReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAvailability) AvailableReturnsOnCall(i int, result1 bool) {
+	fake.availableMutex.Lock()
+	defer fake.availableMutex.Unlock()
+	fake.AvailableStub = nil
+	if fake.availableReturnsOnCall == nil {
+		fake.availableReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.availableReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAvailability) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.availableMutex.RLock()
+	defer fake.availableMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAvailability) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rest.Availability = new(FakeAvailability)
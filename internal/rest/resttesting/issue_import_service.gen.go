@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package resttesting
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+type FakeIssueImportService struct {
+	ImportStub        func(context.Context, string) (internal.IssueImport, error)
+	importMutex       sync.RWMutex
+	importArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	importReturns struct {
+		result1 internal.IssueImport
+		result2 error
+	}
+	importReturnsOnCall map[int]struct {
+		result1 internal.IssueImport
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIssueImportService) Import(arg1 context.Context, arg2 string) (internal.IssueImport, error) {
+	fake.importMutex.Lock()
+	ret, specificReturn := fake.importReturnsOnCall[len(fake.importArgsForCall)]
+	fake.importArgsForCall = append(fake.importArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ImportStub
+	fakeReturns := fake.importReturns
+	fake.recordInvocation("Import", []interface{}{arg1, arg2})
+	fake.importMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIssueImportService) ImportCallCount() int {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	return len(fake.importArgsForCall)
+}
+
+func (fake *FakeIssueImportService) ImportCalls(stub func(context.Context, string) (internal.IssueImport, error)) {
+	fake.importMutex.Lock()
+	defer fake.importMutex.Unlock()
+	fake.ImportStub = stub
+}
+
+func (fake *FakeIssueImportService) ImportArgsForCall(i int) (context.Context, string) {
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	argsForCall := fake.importArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIssueImportService) ImportReturns(result1 internal.IssueImport, result2 error) {
+	fake.importMutex.Lock()
+	defer fake.importMutex.Unlock()
+	fake.ImportStub = nil
+	fake.importReturns = struct {
+		result1 internal.IssueImport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIssueImportService) ImportReturnsOnCall(i int, result1 internal.IssueImport, result2 error) {
+	fake.importMutex.Lock()
+	defer fake.importMutex.Unlock()
+	fake.ImportStub = nil
+	if fake.importReturnsOnCall == nil {
+		fake.importReturnsOnCall = make(map[int]struct {
+			result1 internal.IssueImport
+			result2 error
+		})
+	}
+	fake.importReturnsOnCall[i] = struct {
+		result1 internal.IssueImport
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIssueImportService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.importMutex.RLock()
+	defer fake.importMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIssueImportService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rest.IssueImportService = new(FakeIssueImportService)
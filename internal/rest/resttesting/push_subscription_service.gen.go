@@ -0,0 +1,196 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package resttesting
+
+import (
+	"context"
+	"sync"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+)
+
+type FakePushSubscriptionService struct {
+	RegisterStub        func(context.Context, internal.PushSubscription) (internal.PushSubscription, error)
+	registerMutex       sync.RWMutex
+	registerArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.PushSubscription
+	}
+	registerReturns struct {
+		result1 internal.PushSubscription
+		result2 error
+	}
+	registerReturnsOnCall map[int]struct {
+		result1 internal.PushSubscription
+		result2 error
+	}
+	UnregisterStub        func(context.Context, string) error
+	unregisterMutex       sync.RWMutex
+	unregisterArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	unregisterReturns struct {
+		result1 error
+	}
+	unregisterReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePushSubscriptionService) Register(arg1 context.Context, arg2 internal.PushSubscription) (internal.PushSubscription, error) {
+	fake.registerMutex.Lock()
+	ret, specificReturn := fake.registerReturnsOnCall[len(fake.registerArgsForCall)]
+	fake.registerArgsForCall = append(fake.registerArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.PushSubscription
+	}{arg1, arg2})
+	stub := fake.RegisterStub
+	fakeReturns := fake.registerReturns
+	fake.recordInvocation("Register", []interface{}{arg1, arg2})
+	fake.registerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePushSubscriptionService) RegisterCallCount() int {
+	fake.registerMutex.RLock()
+	defer fake.registerMutex.RUnlock()
+	return len(fake.registerArgsForCall)
+}
+
+func (fake *FakePushSubscriptionService) RegisterCalls(stub func(context.Context, internal.PushSubscription) (internal.PushSubscription, error)) {
+	fake.registerMutex.Lock()
+	defer fake.registerMutex.Unlock()
+	fake.RegisterStub = stub
+}
+
+func (fake *FakePushSubscriptionService) RegisterArgsForCall(i int) (context.Context, internal.PushSubscription) {
+	fake.registerMutex.RLock()
+	defer fake.registerMutex.RUnlock()
+	argsForCall := fake.registerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePushSubscriptionService) RegisterReturns(result1 internal.PushSubscription, result2 error) {
+	fake.registerMutex.Lock()
+	defer fake.registerMutex.Unlock()
+	fake.RegisterStub = nil
+	fake.registerReturns = struct {
+		result1 internal.PushSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushSubscriptionService) RegisterReturnsOnCall(i int, result1 internal.PushSubscription, result2 error) {
+	fake.registerMutex.Lock()
+	defer fake.registerMutex.Unlock()
+	fake.RegisterStub = nil
+	if fake.registerReturnsOnCall == nil {
+		fake.registerReturnsOnCall = make(map[int]struct {
+			result1 internal.PushSubscription
+			result2 error
+		})
+	}
+	fake.registerReturnsOnCall[i] = struct {
+		result1 internal.PushSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushSubscriptionService) Unregister(arg1 context.Context, arg2 string) error {
+	fake.unregisterMutex.Lock()
+	ret, specificReturn := fake.unregisterReturnsOnCall[len(fake.unregisterArgsForCall)]
+	fake.unregisterArgsForCall = append(fake.unregisterArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UnregisterStub
+	fakeReturns := fake.unregisterReturns
+	fake.recordInvocation("Unregister", []interface{}{arg1, arg2})
+	fake.unregisterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePushSubscriptionService) UnregisterCallCount() int {
+	fake.unregisterMutex.RLock()
+	defer fake.unregisterMutex.RUnlock()
+	return len(fake.unregisterArgsForCall)
+}
+
+func (fake *FakePushSubscriptionService) UnregisterCalls(stub func(context.Context, string) error) {
+	fake.unregisterMutex.Lock()
+	defer fake.unregisterMutex.Unlock()
+	fake.UnregisterStub = stub
+}
+
+func (fake *FakePushSubscriptionService) UnregisterArgsForCall(i int) (context.Context, string) {
+	fake.unregisterMutex.RLock()
+	defer fake.unregisterMutex.RUnlock()
+	argsForCall := fake.unregisterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePushSubscriptionService) UnregisterReturns(result1 error) {
+	fake.unregisterMutex.Lock()
+	defer fake.unregisterMutex.Unlock()
+	fake.UnregisterStub = nil
+	fake.unregisterReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePushSubscriptionService) UnregisterReturnsOnCall(i int, result1 error) {
+	fake.unregisterMutex.Lock()
+	defer fake.unregisterMutex.Unlock()
+	fake.UnregisterStub = nil
+	if fake.unregisterReturnsOnCall == nil {
+		fake.unregisterReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unregisterReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePushSubscriptionService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.registerMutex.RLock()
+	defer fake.registerMutex.RUnlock()
+	fake.unregisterMutex.RLock()
+	defer fake.unregisterMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePushSubscriptionService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ rest.PushSubscriptionService = new(FakePushSubscriptionService)
@@ -10,6 +10,32 @@ import (
 )
 
 type FakeTaskService struct {
+	AddDependencyStub        func(context.Context, internal.TaskDependency) error
+	addDependencyMutex       sync.RWMutex
+	addDependencyArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.TaskDependency
+	}
+	addDependencyReturns struct {
+		result1 error
+	}
+	addDependencyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	BatchUpdateStub        func(context.Context, internal.TaskPatches) (internal.BatchUpdateResults, error)
+	batchUpdateMutex       sync.RWMutex
+	batchUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.TaskPatches
+	}
+	batchUpdateReturns struct {
+		result1 internal.BatchUpdateResults
+		result2 error
+	}
+	batchUpdateReturnsOnCall map[int]struct {
+		result1 internal.BatchUpdateResults
+		result2 error
+	}
 	ByStub        func(context.Context, internal.SearchParams) (internal.SearchResults, error)
 	byMutex       sync.RWMutex
 	byArgsForCall []struct {
@@ -24,6 +50,34 @@ type FakeTaskService struct {
 		result1 internal.SearchResults
 		result2 error
 	}
+	CloneStub        func(context.Context, string) (internal.Task, error)
+	cloneMutex       sync.RWMutex
+	cloneArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	cloneReturns struct {
+		result1 internal.Task
+		result2 error
+	}
+	cloneReturnsOnCall map[int]struct {
+		result1 internal.Task
+		result2 error
+	}
+	CompleteStub        func(context.Context, string) (internal.Task, error)
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	completeReturns struct {
+		result1 internal.Task
+		result2 error
+	}
+	completeReturnsOnCall map[int]struct {
+		result1 internal.Task
+		result2 error
+	}
 	CreateStub        func(context.Context, internal.CreateParams) (internal.Task, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -50,6 +104,102 @@ type FakeTaskService struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DependenciesStub        func(context.Context, string) (internal.TaskDependencies, error)
+	dependenciesMutex       sync.RWMutex
+	dependenciesArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	dependenciesReturns struct {
+		result1 internal.TaskDependencies
+		result2 error
+	}
+	dependenciesReturnsOnCall map[int]struct {
+		result1 internal.TaskDependencies
+		result2 error
+	}
+	ListStub        func(context.Context, internal.ListParams) (internal.ListResults, error)
+	listMutex       sync.RWMutex
+	listArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.ListParams
+	}
+	listReturns struct {
+		result1 internal.ListResults
+		result2 error
+	}
+	listReturnsOnCall map[int]struct {
+		result1 internal.ListResults
+		result2 error
+	}
+	RemoveDependencyStub        func(context.Context, internal.TaskDependency) error
+	removeDependencyMutex       sync.RWMutex
+	removeDependencyArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.TaskDependency
+	}
+	removeDependencyReturns struct {
+		result1 error
+	}
+	removeDependencyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReopenStub        func(context.Context, string) (internal.Task, error)
+	reopenMutex       sync.RWMutex
+	reopenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	reopenReturns struct {
+		result1 internal.Task
+		result2 error
+	}
+	reopenReturnsOnCall map[int]struct {
+		result1 internal.Task
+		result2 error
+	}
+	RestoreStub        func(context.Context, string) (internal.Task, error)
+	restoreMutex       sync.RWMutex
+	restoreArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	restoreReturns struct {
+		result1 internal.Task
+		result2 error
+	}
+	restoreReturnsOnCall map[int]struct {
+		result1 internal.Task
+		result2 error
+	}
+	SuggestStub        func(context.Context, internal.SuggestParams) ([]internal.Suggestion, error)
+	suggestMutex       sync.RWMutex
+	suggestArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.SuggestParams
+	}
+	suggestReturns struct {
+		result1 []internal.Suggestion
+		result2 error
+	}
+	suggestReturnsOnCall map[int]struct {
+		result1 []internal.Suggestion
+		result2 error
+	}
+	SuggestValuesStub        func(context.Context, internal.Task) (internal.TaskSuggestions, error)
+	suggestValuesMutex       sync.RWMutex
+	suggestValuesArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.Task
+	}
+	suggestValuesReturns struct {
+		result1 internal.TaskSuggestions
+		result2 error
+	}
+	suggestValuesReturnsOnCall map[int]struct {
+		result1 internal.TaskSuggestions
+		result2 error
+	}
 	TaskStub        func(context.Context, string) (internal.Task, error)
 	taskMutex       sync.RWMutex
 	taskArgsForCall []struct {
@@ -64,7 +214,21 @@ type FakeTaskService struct {
 		result1 internal.Task
 		result2 error
 	}
-	UpdateStub        func(context.Context, string, string, internal.Priority, internal.Dates, bool) error
+	TrashStub        func(context.Context, internal.TrashParams) (internal.TrashResults, error)
+	trashMutex       sync.RWMutex
+	trashArgsForCall []struct {
+		arg1 context.Context
+		arg2 internal.TrashParams
+	}
+	trashReturns struct {
+		result1 internal.TrashResults
+		result2 error
+	}
+	trashReturnsOnCall map[int]struct {
+		result1 internal.TrashResults
+		result2 error
+	}
+	UpdateStub        func(context.Context, string, string, internal.Priority, internal.Dates, string, bool) error
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
 		arg1 context.Context
@@ -72,7 +236,8 @@ type FakeTaskService struct {
 		arg3 string
 		arg4 internal.Priority
 		arg5 internal.Dates
-		arg6 bool
+		arg6 string
+		arg7 bool
 	}
 	updateReturns struct {
 		result1 error
@@ -80,10 +245,156 @@ type FakeTaskService struct {
 	updateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpsertStub        func(context.Context, string, string, internal.Priority, internal.Dates, string, bool) (bool, error)
+	upsertMutex       sync.RWMutex
+	upsertArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Priority
+		arg5 internal.Dates
+		arg6 string
+		arg7 bool
+	}
+	upsertReturns struct {
+		result1 bool
+		result2 error
+	}
+	upsertReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskService) AddDependency(arg1 context.Context, arg2 internal.TaskDependency) error {
+	fake.addDependencyMutex.Lock()
+	ret, specificReturn := fake.addDependencyReturnsOnCall[len(fake.addDependencyArgsForCall)]
+	fake.addDependencyArgsForCall = append(fake.addDependencyArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.TaskDependency
+	}{arg1, arg2})
+	stub := fake.AddDependencyStub
+	fakeReturns := fake.addDependencyReturns
+	fake.recordInvocation("AddDependency", []interface{}{arg1, arg2})
+	fake.addDependencyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskService) AddDependencyCallCount() int {
+	fake.addDependencyMutex.RLock()
+	defer fake.addDependencyMutex.RUnlock()
+	return len(fake.addDependencyArgsForCall)
+}
+
+func (fake *FakeTaskService) AddDependencyCalls(stub func(context.Context, internal.TaskDependency) error) {
+	fake.addDependencyMutex.Lock()
+	defer fake.addDependencyMutex.Unlock()
+	fake.AddDependencyStub = stub
+}
+
+func (fake *FakeTaskService) AddDependencyArgsForCall(i int) (context.Context, internal.TaskDependency) {
+	fake.addDependencyMutex.RLock()
+	defer fake.addDependencyMutex.RUnlock()
+	argsForCall := fake.addDependencyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) AddDependencyReturns(result1 error) {
+	fake.addDependencyMutex.Lock()
+	defer fake.addDependencyMutex.Unlock()
+	fake.AddDependencyStub = nil
+	fake.addDependencyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskService) AddDependencyReturnsOnCall(i int, result1 error) {
+	fake.addDependencyMutex.Lock()
+	defer fake.addDependencyMutex.Unlock()
+	fake.AddDependencyStub = nil
+	if fake.addDependencyReturnsOnCall == nil {
+		fake.addDependencyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addDependencyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskService) BatchUpdate(arg1 context.Context, arg2 internal.TaskPatches) (internal.BatchUpdateResults, error) {
+	fake.batchUpdateMutex.Lock()
+	ret, specificReturn := fake.batchUpdateReturnsOnCall[len(fake.batchUpdateArgsForCall)]
+	fake.batchUpdateArgsForCall = append(fake.batchUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.TaskPatches
+	}{arg1, arg2})
+	stub := fake.BatchUpdateStub
+	fakeReturns := fake.batchUpdateReturns
+	fake.recordInvocation("BatchUpdate", []interface{}{arg1, arg2})
+	fake.batchUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) BatchUpdateCallCount() int {
+	fake.batchUpdateMutex.RLock()
+	defer fake.batchUpdateMutex.RUnlock()
+	return len(fake.batchUpdateArgsForCall)
+}
+
+func (fake *FakeTaskService) BatchUpdateCalls(stub func(context.Context, internal.TaskPatches) (internal.BatchUpdateResults, error)) {
+	fake.batchUpdateMutex.Lock()
+	defer fake.batchUpdateMutex.Unlock()
+	fake.BatchUpdateStub = stub
+}
+
+func (fake *FakeTaskService) BatchUpdateArgsForCall(i int) (context.Context, internal.TaskPatches) {
+	fake.batchUpdateMutex.RLock()
+	defer fake.batchUpdateMutex.RUnlock()
+	argsForCall := fake.batchUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) BatchUpdateReturns(result1 internal.BatchUpdateResults, result2 error) {
+	fake.batchUpdateMutex.Lock()
+	defer fake.batchUpdateMutex.Unlock()
+	fake.BatchUpdateStub = nil
+	fake.batchUpdateReturns = struct {
+		result1 internal.BatchUpdateResults
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) BatchUpdateReturnsOnCall(i int, result1 internal.BatchUpdateResults, result2 error) {
+	fake.batchUpdateMutex.Lock()
+	defer fake.batchUpdateMutex.Unlock()
+	fake.BatchUpdateStub = nil
+	if fake.batchUpdateReturnsOnCall == nil {
+		fake.batchUpdateReturnsOnCall = make(map[int]struct {
+			result1 internal.BatchUpdateResults
+			result2 error
+		})
+	}
+	fake.batchUpdateReturnsOnCall[i] = struct {
+		result1 internal.BatchUpdateResults
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskService) By(arg1 context.Context, arg2 internal.SearchParams) (internal.SearchResults, error) {
 	fake.byMutex.Lock()
 	ret, specificReturn := fake.byReturnsOnCall[len(fake.byArgsForCall)]
@@ -149,6 +460,136 @@ func (fake *FakeTaskService) ByReturnsOnCall(i int, result1 internal.SearchResul
 	}{result1, result2}
 }
 
+func (fake *FakeTaskService) Clone(arg1 context.Context, arg2 string) (internal.Task, error) {
+	fake.cloneMutex.Lock()
+	ret, specificReturn := fake.cloneReturnsOnCall[len(fake.cloneArgsForCall)]
+	fake.cloneArgsForCall = append(fake.cloneArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CloneStub
+	fakeReturns := fake.cloneReturns
+	fake.recordInvocation("Clone", []interface{}{arg1, arg2})
+	fake.cloneMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) CloneCallCount() int {
+	fake.cloneMutex.RLock()
+	defer fake.cloneMutex.RUnlock()
+	return len(fake.cloneArgsForCall)
+}
+
+func (fake *FakeTaskService) CloneCalls(stub func(context.Context, string) (internal.Task, error)) {
+	fake.cloneMutex.Lock()
+	defer fake.cloneMutex.Unlock()
+	fake.CloneStub = stub
+}
+
+func (fake *FakeTaskService) CloneArgsForCall(i int) (context.Context, string) {
+	fake.cloneMutex.RLock()
+	defer fake.cloneMutex.RUnlock()
+	argsForCall := fake.cloneArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) CloneReturns(result1 internal.Task, result2 error) {
+	fake.cloneMutex.Lock()
+	defer fake.cloneMutex.Unlock()
+	fake.CloneStub = nil
+	fake.cloneReturns = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) CloneReturnsOnCall(i int, result1 internal.Task, result2 error) {
+	fake.cloneMutex.Lock()
+	defer fake.cloneMutex.Unlock()
+	fake.CloneStub = nil
+	if fake.cloneReturnsOnCall == nil {
+		fake.cloneReturnsOnCall = make(map[int]struct {
+			result1 internal.Task
+			result2 error
+		})
+	}
+	fake.cloneReturnsOnCall[i] = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) Complete(arg1 context.Context, arg2 string) (internal.Task, error) {
+	fake.completeMutex.Lock()
+	ret, specificReturn := fake.completeReturnsOnCall[len(fake.completeArgsForCall)]
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CompleteStub
+	fakeReturns := fake.completeReturns
+	fake.recordInvocation("Complete", []interface{}{arg1, arg2})
+	fake.completeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeTaskService) CompleteCalls(stub func(context.Context, string) (internal.Task, error)) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = stub
+}
+
+func (fake *FakeTaskService) CompleteArgsForCall(i int) (context.Context, string) {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	argsForCall := fake.completeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) CompleteReturns(result1 internal.Task, result2 error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = nil
+	fake.completeReturns = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) CompleteReturnsOnCall(i int, result1 internal.Task, result2 error) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = nil
+	if fake.completeReturnsOnCall == nil {
+		fake.completeReturnsOnCall = make(map[int]struct {
+			result1 internal.Task
+			result2 error
+		})
+	}
+	fake.completeReturnsOnCall[i] = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskService) Create(arg1 context.Context, arg2 internal.CreateParams) (internal.Task, error) {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
@@ -276,6 +717,458 @@ func (fake *FakeTaskService) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskService) Dependencies(arg1 context.Context, arg2 string) (internal.TaskDependencies, error) {
+	fake.dependenciesMutex.Lock()
+	ret, specificReturn := fake.dependenciesReturnsOnCall[len(fake.dependenciesArgsForCall)]
+	fake.dependenciesArgsForCall = append(fake.dependenciesArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DependenciesStub
+	fakeReturns := fake.dependenciesReturns
+	fake.recordInvocation("Dependencies", []interface{}{arg1, arg2})
+	fake.dependenciesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) DependenciesCallCount() int {
+	fake.dependenciesMutex.RLock()
+	defer fake.dependenciesMutex.RUnlock()
+	return len(fake.dependenciesArgsForCall)
+}
+
+func (fake *FakeTaskService) DependenciesCalls(stub func(context.Context, string) (internal.TaskDependencies, error)) {
+	fake.dependenciesMutex.Lock()
+	defer fake.dependenciesMutex.Unlock()
+	fake.DependenciesStub = stub
+}
+
+func (fake *FakeTaskService) DependenciesArgsForCall(i int) (context.Context, string) {
+	fake.dependenciesMutex.RLock()
+	defer fake.dependenciesMutex.RUnlock()
+	argsForCall := fake.dependenciesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) DependenciesReturns(result1 internal.TaskDependencies, result2 error) {
+	fake.dependenciesMutex.Lock()
+	defer fake.dependenciesMutex.Unlock()
+	fake.DependenciesStub = nil
+	fake.dependenciesReturns = struct {
+		result1 internal.TaskDependencies
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) DependenciesReturnsOnCall(i int, result1 internal.TaskDependencies, result2 error) {
+	fake.dependenciesMutex.Lock()
+	defer fake.dependenciesMutex.Unlock()
+	fake.DependenciesStub = nil
+	if fake.dependenciesReturnsOnCall == nil {
+		fake.dependenciesReturnsOnCall = make(map[int]struct {
+			result1 internal.TaskDependencies
+			result2 error
+		})
+	}
+	fake.dependenciesReturnsOnCall[i] = struct {
+		result1 internal.TaskDependencies
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) List(arg1 context.Context, arg2 internal.ListParams) (internal.ListResults, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.ListParams
+	}{arg1, arg2})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeTaskService) ListCalls(stub func(context.Context, internal.ListParams) (internal.ListResults, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeTaskService) ListArgsForCall(i int) (context.Context, internal.ListParams) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) ListReturns(result1 internal.ListResults, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 internal.ListResults
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) ListReturnsOnCall(i int, result1 internal.ListResults, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 internal.ListResults
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 internal.ListResults
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) RemoveDependency(arg1 context.Context, arg2 internal.TaskDependency) error {
+	fake.removeDependencyMutex.Lock()
+	ret, specificReturn := fake.removeDependencyReturnsOnCall[len(fake.removeDependencyArgsForCall)]
+	fake.removeDependencyArgsForCall = append(fake.removeDependencyArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.TaskDependency
+	}{arg1, arg2})
+	stub := fake.RemoveDependencyStub
+	fakeReturns := fake.removeDependencyReturns
+	fake.recordInvocation("RemoveDependency", []interface{}{arg1, arg2})
+	fake.removeDependencyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskService) RemoveDependencyCallCount() int {
+	fake.removeDependencyMutex.RLock()
+	defer fake.removeDependencyMutex.RUnlock()
+	return len(fake.removeDependencyArgsForCall)
+}
+
+func (fake *FakeTaskService) RemoveDependencyCalls(stub func(context.Context, internal.TaskDependency) error) {
+	fake.removeDependencyMutex.Lock()
+	defer fake.removeDependencyMutex.Unlock()
+	fake.RemoveDependencyStub = stub
+}
+
+func (fake *FakeTaskService) RemoveDependencyArgsForCall(i int) (context.Context, internal.TaskDependency) {
+	fake.removeDependencyMutex.RLock()
+	defer fake.removeDependencyMutex.RUnlock()
+	argsForCall := fake.removeDependencyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) RemoveDependencyReturns(result1 error) {
+	fake.removeDependencyMutex.Lock()
+	defer fake.removeDependencyMutex.Unlock()
+	fake.RemoveDependencyStub = nil
+	fake.removeDependencyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskService) RemoveDependencyReturnsOnCall(i int, result1 error) {
+	fake.removeDependencyMutex.Lock()
+	defer fake.removeDependencyMutex.Unlock()
+	fake.RemoveDependencyStub = nil
+	if fake.removeDependencyReturnsOnCall == nil {
+		fake.removeDependencyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDependencyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskService) Reopen(arg1 context.Context, arg2 string) (internal.Task, error) {
+	fake.reopenMutex.Lock()
+	ret, specificReturn := fake.reopenReturnsOnCall[len(fake.reopenArgsForCall)]
+	fake.reopenArgsForCall = append(fake.reopenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ReopenStub
+	fakeReturns := fake.reopenReturns
+	fake.recordInvocation("Reopen", []interface{}{arg1, arg2})
+	fake.reopenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) ReopenCallCount() int {
+	fake.reopenMutex.RLock()
+	defer fake.reopenMutex.RUnlock()
+	return len(fake.reopenArgsForCall)
+}
+
+func (fake *FakeTaskService) ReopenCalls(stub func(context.Context, string) (internal.Task, error)) {
+	fake.reopenMutex.Lock()
+	defer fake.reopenMutex.Unlock()
+	fake.ReopenStub = stub
+}
+
+func (fake *FakeTaskService) ReopenArgsForCall(i int) (context.Context, string) {
+	fake.reopenMutex.RLock()
+	defer fake.reopenMutex.RUnlock()
+	argsForCall := fake.reopenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) ReopenReturns(result1 internal.Task, result2 error) {
+	fake.reopenMutex.Lock()
+	defer fake.reopenMutex.Unlock()
+	fake.ReopenStub = nil
+	fake.reopenReturns = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) ReopenReturnsOnCall(i int, result1 internal.Task, result2 error) {
+	fake.reopenMutex.Lock()
+	defer fake.reopenMutex.Unlock()
+	fake.ReopenStub = nil
+	if fake.reopenReturnsOnCall == nil {
+		fake.reopenReturnsOnCall = make(map[int]struct {
+			result1 internal.Task
+			result2 error
+		})
+	}
+	fake.reopenReturnsOnCall[i] = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) Restore(arg1 context.Context, arg2 string) (internal.Task, error) {
+	fake.restoreMutex.Lock()
+	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
+	fake.restoreArgsForCall = append(fake.restoreArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RestoreStub
+	fakeReturns := fake.restoreReturns
+	fake.recordInvocation("Restore", []interface{}{arg1, arg2})
+	fake.restoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) RestoreCallCount() int {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return len(fake.restoreArgsForCall)
+}
+
+func (fake *FakeTaskService) RestoreCalls(stub func(context.Context, string) (internal.Task, error)) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = stub
+}
+
+func (fake *FakeTaskService) RestoreArgsForCall(i int) (context.Context, string) {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	argsForCall := fake.restoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) RestoreReturns(result1 internal.Task, result2 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	fake.restoreReturns = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) RestoreReturnsOnCall(i int, result1 internal.Task, result2 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	if fake.restoreReturnsOnCall == nil {
+		fake.restoreReturnsOnCall = make(map[int]struct {
+			result1 internal.Task
+			result2 error
+		})
+	}
+	fake.restoreReturnsOnCall[i] = struct {
+		result1 internal.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) Suggest(arg1 context.Context, arg2 internal.SuggestParams) ([]internal.Suggestion, error) {
+	fake.suggestMutex.Lock()
+	ret, specificReturn := fake.suggestReturnsOnCall[len(fake.suggestArgsForCall)]
+	fake.suggestArgsForCall = append(fake.suggestArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.SuggestParams
+	}{arg1, arg2})
+	stub := fake.SuggestStub
+	fakeReturns := fake.suggestReturns
+	fake.recordInvocation("Suggest", []interface{}{arg1, arg2})
+	fake.suggestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) SuggestCallCount() int {
+	fake.suggestMutex.RLock()
+	defer fake.suggestMutex.RUnlock()
+	return len(fake.suggestArgsForCall)
+}
+
+func (fake *FakeTaskService) SuggestCalls(stub func(context.Context, internal.SuggestParams) ([]internal.Suggestion, error)) {
+	fake.suggestMutex.Lock()
+	defer fake.suggestMutex.Unlock()
+	fake.SuggestStub = stub
+}
+
+func (fake *FakeTaskService) SuggestArgsForCall(i int) (context.Context, internal.SuggestParams) {
+	fake.suggestMutex.RLock()
+	defer fake.suggestMutex.RUnlock()
+	argsForCall := fake.suggestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) SuggestReturns(result1 []internal.Suggestion, result2 error) {
+	fake.suggestMutex.Lock()
+	defer fake.suggestMutex.Unlock()
+	fake.SuggestStub = nil
+	fake.suggestReturns = struct {
+		result1 []internal.Suggestion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) SuggestReturnsOnCall(i int, result1 []internal.Suggestion, result2 error) {
+	fake.suggestMutex.Lock()
+	defer fake.suggestMutex.Unlock()
+	fake.SuggestStub = nil
+	if fake.suggestReturnsOnCall == nil {
+		fake.suggestReturnsOnCall = make(map[int]struct {
+			result1 []internal.Suggestion
+			result2 error
+		})
+	}
+	fake.suggestReturnsOnCall[i] = struct {
+		result1 []internal.Suggestion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) SuggestValues(arg1 context.Context, arg2 internal.Task) (internal.TaskSuggestions, error) {
+	fake.suggestValuesMutex.Lock()
+	ret, specificReturn := fake.suggestValuesReturnsOnCall[len(fake.suggestValuesArgsForCall)]
+	fake.suggestValuesArgsForCall = append(fake.suggestValuesArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.Task
+	}{arg1, arg2})
+	stub := fake.SuggestValuesStub
+	fakeReturns := fake.suggestValuesReturns
+	fake.recordInvocation("SuggestValues", []interface{}{arg1, arg2})
+	fake.suggestValuesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) SuggestValuesCallCount() int {
+	fake.suggestValuesMutex.RLock()
+	defer fake.suggestValuesMutex.RUnlock()
+	return len(fake.suggestValuesArgsForCall)
+}
+
+func (fake *FakeTaskService) SuggestValuesCalls(stub func(context.Context, internal.Task) (internal.TaskSuggestions, error)) {
+	fake.suggestValuesMutex.Lock()
+	defer fake.suggestValuesMutex.Unlock()
+	fake.SuggestValuesStub = stub
+}
+
+func (fake *FakeTaskService) SuggestValuesArgsForCall(i int) (context.Context, internal.Task) {
+	fake.suggestValuesMutex.RLock()
+	defer fake.suggestValuesMutex.RUnlock()
+	argsForCall := fake.suggestValuesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) SuggestValuesReturns(result1 internal.TaskSuggestions, result2 error) {
+	fake.suggestValuesMutex.Lock()
+	defer fake.suggestValuesMutex.Unlock()
+	fake.SuggestValuesStub = nil
+	fake.suggestValuesReturns = struct {
+		result1 internal.TaskSuggestions
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) SuggestValuesReturnsOnCall(i int, result1 internal.TaskSuggestions, result2 error) {
+	fake.suggestValuesMutex.Lock()
+	defer fake.suggestValuesMutex.Unlock()
+	fake.SuggestValuesStub = nil
+	if fake.suggestValuesReturnsOnCall == nil {
+		fake.suggestValuesReturnsOnCall = make(map[int]struct {
+			result1 internal.TaskSuggestions
+			result2 error
+		})
+	}
+	fake.suggestValuesReturnsOnCall[i] = struct {
+		result1 internal.TaskSuggestions
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskService) Task(arg1 context.Context, arg2 string) (internal.Task, error) {
 	fake.taskMutex.Lock()
 	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
@@ -341,7 +1234,72 @@ func (fake *FakeTaskService) TaskReturnsOnCall(i int, result1 internal.Task, res
 	}{result1, result2}
 }
 
-func (fake *FakeTaskService) Update(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Priority, arg5 internal.Dates, arg6 bool) error {
+func (fake *FakeTaskService) Trash(arg1 context.Context, arg2 internal.TrashParams) (internal.TrashResults, error) {
+	fake.trashMutex.Lock()
+	ret, specificReturn := fake.trashReturnsOnCall[len(fake.trashArgsForCall)]
+	fake.trashArgsForCall = append(fake.trashArgsForCall, struct {
+		arg1 context.Context
+		arg2 internal.TrashParams
+	}{arg1, arg2})
+	stub := fake.TrashStub
+	fakeReturns := fake.trashReturns
+	fake.recordInvocation("Trash", []interface{}{arg1, arg2})
+	fake.trashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) TrashCallCount() int {
+	fake.trashMutex.RLock()
+	defer fake.trashMutex.RUnlock()
+	return len(fake.trashArgsForCall)
+}
+
+func (fake *FakeTaskService) TrashCalls(stub func(context.Context, internal.TrashParams) (internal.TrashResults, error)) {
+	fake.trashMutex.Lock()
+	defer fake.trashMutex.Unlock()
+	fake.TrashStub = stub
+}
+
+func (fake *FakeTaskService) TrashArgsForCall(i int) (context.Context, internal.TrashParams) {
+	fake.trashMutex.RLock()
+	defer fake.trashMutex.RUnlock()
+	argsForCall := fake.trashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskService) TrashReturns(result1 internal.TrashResults, result2 error) {
+	fake.trashMutex.Lock()
+	defer fake.trashMutex.Unlock()
+	fake.TrashStub = nil
+	fake.trashReturns = struct {
+		result1 internal.TrashResults
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) TrashReturnsOnCall(i int, result1 internal.TrashResults, result2 error) {
+	fake.trashMutex.Lock()
+	defer fake.trashMutex.Unlock()
+	fake.TrashStub = nil
+	if fake.trashReturnsOnCall == nil {
+		fake.trashReturnsOnCall = make(map[int]struct {
+			result1 internal.TrashResults
+			result2 error
+		})
+	}
+	fake.trashReturnsOnCall[i] = struct {
+		result1 internal.TrashResults
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) Update(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Priority, arg5 internal.Dates, arg6 string, arg7 bool) error {
 	fake.updateMutex.Lock()
 	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
 	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
@@ -350,14 +1308,15 @@ func (fake *FakeTaskService) Update(arg1 context.Context, arg2 string, arg3 stri
 		arg3 string
 		arg4 internal.Priority
 		arg5 internal.Dates
-		arg6 bool
-	}{arg1, arg2, arg3, arg4, arg5, arg6})
+		arg6 string
+		arg7 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	stub := fake.UpdateStub
 	fakeReturns := fake.updateReturns
-	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("Update", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
 	fake.updateMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
 	}
 	if specificReturn {
 		return ret.result1
@@ -371,17 +1330,17 @@ func (fake *FakeTaskService) UpdateCallCount() int {
 	return len(fake.updateArgsForCall)
 }
 
-func (fake *FakeTaskService) UpdateCalls(stub func(context.Context, string, string, internal.Priority, internal.Dates, bool) error) {
+func (fake *FakeTaskService) UpdateCalls(stub func(context.Context, string, string, internal.Priority, internal.Dates, string, bool) error) {
 	fake.updateMutex.Lock()
 	defer fake.updateMutex.Unlock()
 	fake.UpdateStub = stub
 }
 
-func (fake *FakeTaskService) UpdateArgsForCall(i int) (context.Context, string, string, internal.Priority, internal.Dates, bool) {
+func (fake *FakeTaskService) UpdateArgsForCall(i int) (context.Context, string, string, internal.Priority, internal.Dates, string, bool) {
 	fake.updateMutex.RLock()
 	defer fake.updateMutex.RUnlock()
 	argsForCall := fake.updateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
 }
 
 func (fake *FakeTaskService) UpdateReturns(result1 error) {
@@ -407,19 +1366,115 @@ func (fake *FakeTaskService) UpdateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskService) Upsert(arg1 context.Context, arg2 string, arg3 string, arg4 internal.Priority, arg5 internal.Dates, arg6 string, arg7 bool) (bool, error) {
+	fake.upsertMutex.Lock()
+	ret, specificReturn := fake.upsertReturnsOnCall[len(fake.upsertArgsForCall)]
+	fake.upsertArgsForCall = append(fake.upsertArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 internal.Priority
+		arg5 internal.Dates
+		arg6 string
+		arg7 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.UpsertStub
+	fakeReturns := fake.upsertReturns
+	fake.recordInvocation("Upsert", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.upsertMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskService) UpsertCallCount() int {
+	fake.upsertMutex.RLock()
+	defer fake.upsertMutex.RUnlock()
+	return len(fake.upsertArgsForCall)
+}
+
+func (fake *FakeTaskService) UpsertCalls(stub func(context.Context, string, string, internal.Priority, internal.Dates, string, bool) (bool, error)) {
+	fake.upsertMutex.Lock()
+	defer fake.upsertMutex.Unlock()
+	fake.UpsertStub = stub
+}
+
+func (fake *FakeTaskService) UpsertArgsForCall(i int) (context.Context, string, string, internal.Priority, internal.Dates, string, bool) {
+	fake.upsertMutex.RLock()
+	defer fake.upsertMutex.RUnlock()
+	argsForCall := fake.upsertArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeTaskService) UpsertReturns(result1 bool, result2 error) {
+	fake.upsertMutex.Lock()
+	defer fake.upsertMutex.Unlock()
+	fake.UpsertStub = nil
+	fake.upsertReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskService) UpsertReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.upsertMutex.Lock()
+	defer fake.upsertMutex.Unlock()
+	fake.UpsertStub = nil
+	if fake.upsertReturnsOnCall == nil {
+		fake.upsertReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.upsertReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTaskService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.addDependencyMutex.RLock()
+	defer fake.addDependencyMutex.RUnlock()
+	fake.batchUpdateMutex.RLock()
+	defer fake.batchUpdateMutex.RUnlock()
 	fake.byMutex.RLock()
 	defer fake.byMutex.RUnlock()
+	fake.cloneMutex.RLock()
+	defer fake.cloneMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.dependenciesMutex.RLock()
+	defer fake.dependenciesMutex.RUnlock()
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	fake.removeDependencyMutex.RLock()
+	defer fake.removeDependencyMutex.RUnlock()
+	fake.reopenMutex.RLock()
+	defer fake.reopenMutex.RUnlock()
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	fake.suggestMutex.RLock()
+	defer fake.suggestMutex.RUnlock()
+	fake.suggestValuesMutex.RLock()
+	defer fake.suggestValuesMutex.RUnlock()
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
+	fake.trashMutex.RLock()
+	defer fake.trashMutex.RUnlock()
 	fake.updateMutex.RLock()
 	defer fake.updateMutex.RUnlock()
+	fake.upsertMutex.RLock()
+	defer fake.upsertMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
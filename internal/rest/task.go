@@ -2,78 +2,234 @@ package rest
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/MarioCarrion/todo-api/internal"
 )
 
-const uuidRegEx string = `[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{12}`
+const (
+	uuidRegEx string = `[0-9a-fA-F]{8}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{4}\-[0-9a-fA-F]{12}`
+	ulidRegEx string = `[0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{26}`
+
+	// idRegEx matches task ids, either UUIDs or ULIDs.
+	idRegEx = uuidRegEx + "|" + ulidRegEx
+)
+
+// errorCodeSearchDisabled is returned when the search engine is not reachable.
+const errorCodeSearchDisabled = "search_disabled"
+
+// defaultListSize is the number of records returned when listing tasks without explicitly indicating a size.
+const defaultListSize int64 = 10
+
+// defaultSuggestSize is the number of suggestions returned without explicitly indicating a size.
+const defaultSuggestSize int64 = 5
 
 //go:generate counterfeiter -generate
 
 //counterfeiter:generate -o resttesting/task_service.gen.go . TaskService
 
+//counterfeiter:generate -o resttesting/availability.gen.go . Availability
+
 // TaskService ...
 type TaskService interface {
+	BatchUpdate(ctx context.Context, patches internal.TaskPatches) (internal.BatchUpdateResults, error)
 	By(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error)
+	Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error)
+	SuggestValues(ctx context.Context, task internal.Task) (internal.TaskSuggestions, error)
+	Clone(ctx context.Context, id string) (internal.Task, error)
+	Complete(ctx context.Context, id string) (internal.Task, error)
+	Reopen(ctx context.Context, id string) (internal.Task, error)
+	AddDependency(ctx context.Context, dep internal.TaskDependency) error
+	RemoveDependency(ctx context.Context, dep internal.TaskDependency) error
+	Dependencies(ctx context.Context, id string) (internal.TaskDependencies, error)
 	Create(ctx context.Context, params internal.CreateParams) (internal.Task, error)
 	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
+	Restore(ctx context.Context, id string) (internal.Task, error)
 	Task(ctx context.Context, id string) (internal.Task, error)
-	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, isDone bool) error
+	Trash(ctx context.Context, params internal.TrashParams) (internal.TrashResults, error)
+	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error
+	Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error)
+}
+
+// Availability indicates whether a dependency is reachable.
+type Availability interface {
+	Available() bool
+}
+
+// Semantics defines the configurable behavior of the methods used for modifying tasks.
+type Semantics struct {
+	// DeleteMissingStatus is the status returned when deleting a task that does not exist: http.StatusNotFound,
+	// the default, or http.StatusNoContent.
+	DeleteMissingStatus int
+
+	// PutCreates indicates whether updating a task that does not exist creates it using the id in the path.
+	PutCreates bool
+}
+
+func (s Semantics) deleteMissingStatus() int {
+	if s.DeleteMissingStatus == http.StatusNoContent {
+		return http.StatusNoContent
+	}
+
+	return http.StatusNotFound
 }
 
 // TaskHandler ...
 type TaskHandler struct {
-	svc TaskService
+	svc             TaskService
+	searchAvailable Availability
+	semantics       Semantics
 }
 
 // NewTaskHandler ...
-func NewTaskHandler(svc TaskService) *TaskHandler {
+func NewTaskHandler(svc TaskService, search Availability, semantics Semantics) *TaskHandler {
 	return &TaskHandler{
-		svc: svc,
+		svc:             svc,
+		searchAvailable: search,
+		semantics:       semantics,
 	}
 }
 
 // Register connects the handlers to the router.
 func (t *TaskHandler) Register(r *mux.Router) {
 	r.HandleFunc("/tasks", t.create).Methods(http.MethodPost)
-	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", uuidRegEx), t.task).Methods(http.MethodGet)
-	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", uuidRegEx), t.update).Methods(http.MethodPut)
-	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", uuidRegEx), t.delete).Methods(http.MethodDelete)
-	r.HandleFunc("/search/tasks", t.search).Methods(http.MethodPost)
+	r.HandleFunc("/tasks", t.list).Methods(http.MethodGet)
+	r.HandleFunc("/tasks:batchUpdate", t.batchUpdate).Methods(http.MethodPost)
+	r.HandleFunc("/tasks/trash", t.trash).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", idRegEx), t.task).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", idRegEx), t.update).Methods(http.MethodPut)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", idRegEx), t.delete).Methods(http.MethodDelete)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}", idRegEx), t.options(r)).Methods(http.MethodOptions)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/clone", idRegEx), t.clone).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/restore", idRegEx), t.restore).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/complete", idRegEx), t.complete).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/reopen", idRegEx), t.reopen).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/dependencies", idRegEx), t.dependencies).Methods(http.MethodGet)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/dependencies", idRegEx), t.createDependency).Methods(http.MethodPost)
+	r.HandleFunc(fmt.Sprintf("/tasks/{id:%s}/dependencies/{blockerId:%s}", idRegEx, idRegEx), t.deleteDependency).
+		Methods(http.MethodDelete)
+	r.HandleFunc("/search/tasks", t.searchEnabled(t.search)).Methods(http.MethodPost)
+	r.HandleFunc("/search/tasks/suggest", t.searchEnabled(t.suggest)).Methods(http.MethodGet)
+}
+
+// searchEnabled keeps the search routes registered but disabled while the search engine is not reachable, requests
+// are accepted again as soon as connectivity is restored.
+func (t *TaskHandler) searchEnabled(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !t.searchAvailable.Available() {
+			renderResponse(r.Context(), w,
+				&ErrorResponse{
+					Error:     "search not available",
+					Code:      errorCodeSearchDisabled,
+					RequestID: internal.RequestIDFromContext(r.Context()),
+				},
+				http.StatusServiceUnavailable)
+
+			return
+		}
+
+		next(w, r)
+	}
 }
 
 // Task is an activity that needs to be completed within a period of time.
 //nolint: tagliatelle
 type Task struct {
-	ID          string   `json:"id"`
-	Description string   `json:"description"`
-	Priority    Priority `json:"priority"`
-	Dates       Dates    `json:"dates"`
-	IsDone      bool     `json:"is_done"`
+	ID          string     `json:"id"`
+	Description string     `json:"description"`
+	Priority    Priority   `json:"priority"`
+	Dates       Dates      `json:"dates"`
+	ProjectID   string     `json:"project_id,omitempty"`
+	IsDone      bool       `json:"is_done"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+
+	// Experimental fields, only included when the corresponding profile is requested.
+
+	IsOverdue  *bool `json:"is_overdue,omitempty"`
+	IsDueToday *bool `json:"is_due_today,omitempty"`
+	DueInDays  *int  `json:"due_in_days,omitempty"`
+
+	// Only included when requested using the "humanize" query parameter.
+
+	HumanDates *HumanDates `json:"human_dates,omitempty"`
+}
+
+func newTask(ctx context.Context, task internal.Task) Task {
+	res := Task{
+		ID:          task.ID,
+		Description: task.Description,
+		Priority:    NewPriority(task.Priority),
+		Dates:       newDates(ctx, task.Dates),
+		ProjectID:   task.ProjectID,
+		IsDone:      task.IsDone,
+		HumanDates:  newHumanDates(ctx, task.Dates.Start, task.Dates.Due, time.Now()),
+	}
+
+	if !task.CompletedAt.IsZero() {
+		completedAt := task.CompletedAt.UTC()
+		res.CompletedAt = &completedAt
+	}
+
+	if !task.CreatedAt.IsZero() {
+		createdAt := task.CreatedAt.UTC()
+		res.CreatedAt = &createdAt
+	}
+
+	if !task.UpdatedAt.IsZero() {
+		updatedAt := task.UpdatedAt.UTC()
+		res.UpdatedAt = &updatedAt
+	}
+
+	status := internal.NewDueStatus(task, currentTime(ctx))
+
+	if ProfileRequested(ctx, ProfileTaskOverdue) {
+		res.IsOverdue = &status.Overdue
+	}
+
+	if ProfileRequested(ctx, ProfileTaskDue) {
+		res.IsDueToday = &status.DueToday
+		res.DueInDays = status.DueInDays
+	}
+
+	return res
 }
 
 // CreateTasksRequest defines the request used for creating tasks.
+//nolint: tagliatelle
 type CreateTasksRequest struct {
 	Description string   `json:"description"`
 	Priority    Priority `json:"priority"`
 	Dates       Dates    `json:"dates"`
+	ProjectID   string   `json:"project_id,omitempty"`
 }
 
 // CreateTasksResponse defines the response returned back after creating tasks.
 type CreateTasksResponse struct {
-	Task Task `json:"task"`
+	Task        Task             `json:"task"`
+	Suggestions *TaskSuggestions `json:"suggestions,omitempty"`
+}
+
+// TaskSuggestions defines the values proposed for a new task based on the tasks with similar descriptions, those
+// are never applied to the task.
+type TaskSuggestions struct {
+	Priority *Priority  `json:"priority,omitempty"`
+	Due      *time.Time `json:"due,omitempty"`
 }
 
 func (t *TaskHandler) create(w http.ResponseWriter, r *http.Request) {
 	var req CreateTasksRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		renderErrorResponse(r.Context(), w, "invalid request",
-			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder"))
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
 
 		return
 	}
@@ -84,6 +240,7 @@ func (t *TaskHandler) create(w http.ResponseWriter, r *http.Request) {
 		Description: req.Description,
 		Priority:    req.Priority.Convert(),
 		Dates:       req.Dates.Convert(),
+		ProjectID:   req.ProjectID,
 	})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "create failed", err)
@@ -91,29 +248,247 @@ func (t *TaskHandler) create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	renderResponse(w,
+	renderResponse(r.Context(), w,
 		&CreateTasksResponse{
-			Task: Task{
-				ID:          task.ID,
-				Description: task.Description,
-				Priority:    NewPriority(task.Priority),
-				Dates:       NewDates(task.Dates),
-			},
+			Task:        newTask(r.Context(), task),
+			Suggestions: t.suggestions(r.Context(), task),
+		},
+		http.StatusCreated)
+}
+
+// suggestions returns the values proposed for the new task when requested, failing to propose them doesn't fail
+// creating the task so nil is returned instead.
+func (t *TaskHandler) suggestions(ctx context.Context, task internal.Task) *TaskSuggestions {
+	if !ProfileRequested(ctx, ProfileTaskSuggestions) || !t.searchAvailable.Available() {
+		return nil
+	}
+
+	suggestions, err := t.svc.SuggestValues(ctx, task)
+	if err != nil || suggestions.IsZero() {
+		return nil
+	}
+
+	res := TaskSuggestions{
+		Due: suggestions.Due,
+	}
+
+	if suggestions.Priority != nil {
+		priority := NewPriority(*suggestions.Priority)
+		res.Priority = &priority
+	}
+
+	return &res
+}
+
+func (t *TaskHandler) clone(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	task, err := t.svc.Clone(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "clone failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w,
+		&CreateTasksResponse{
+			Task: newTask(r.Context(), task),
 		},
 		http.StatusCreated)
 }
 
 func (t *TaskHandler) delete(w http.ResponseWriter, r *http.Request) {
-	// NOTE: Safe to ignore error, because it's always defined.
-	id, _ := mux.Vars(r)["id"] //nolint: gosimple
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
 
 	if err := t.svc.Delete(r.Context(), id); err != nil {
+		var ierr *internal.Error
+		if errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound &&
+			t.semantics.deleteMissingStatus() == http.StatusNoContent {
+			w.WriteHeader(http.StatusNoContent)
+
+			return
+		}
+
 		renderErrorResponse(r.Context(), w, "delete failed", err)
 
 		return
 	}
 
-	renderResponse(w, struct{}{}, http.StatusOK)
+	renderResponse(r.Context(), w, struct{}{}, http.StatusOK)
+}
+
+// ListTasksResponse defines the response returned back after listing tasks.
+//nolint: tagliatelle
+type ListTasksResponse struct {
+	Tasks          []Task `json:"tasks"`
+	NextCursor     string `json:"next_cursor,omitempty"`
+	Total          *int64 `json:"total,omitempty"`
+	TotalEstimated bool   `json:"total_estimated,omitempty"`
+}
+
+func (t *TaskHandler) list(w http.ResponseWriter, r *http.Request) {
+	size := defaultListSize
+
+	if val := r.URL.Query().Get("size"); val != "" {
+		res, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid size"))
+
+			return
+		}
+
+		size = res
+	}
+
+	var total bool
+
+	if val := r.URL.Query().Get("total"); val != "" {
+		res, err := strconv.ParseBool(val)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid total"))
+
+			return
+		}
+
+		total = res
+	}
+
+	due, err := newDueFilter(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	created, err := newTimeRange(r, "created")
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	updated, err := newTimeRange(r, "updated")
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	res, err := t.svc.List(r.Context(), internal.ListParams{
+		Cursor:    r.URL.Query().Get("cursor"),
+		Size:      size,
+		Sort:      internal.Sort(r.URL.Query().Get("sort")),
+		Total:     total,
+		Due:       newDueRange(r.Context(), due),
+		ProjectID: r.URL.Query().Get("project_id"),
+		Created:   created,
+		Updated:   updated,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "list failed", err)
+
+		return
+	}
+
+	tasks := make([]Task, len(res.Tasks))
+
+	for i, task := range res.Tasks {
+		tasks[i] = newTask(r.Context(), task)
+	}
+
+	resp := ListTasksResponse{
+		Tasks:      tasks,
+		NextCursor: res.NextCursor,
+	}
+
+	if total {
+		resp.Total = &res.Total
+		resp.TotalEstimated = res.TotalEstimated
+	}
+
+	setPaginationLinks(w, r, res.NextCursor)
+
+	renderResponse(r.Context(), w, &resp, http.StatusOK)
+}
+
+// newDueFilter returns the filter defined by the "overdue", "due_today" and "due_in_days" query parameters.
+func newDueFilter(r *http.Request) (internal.DueFilter, error) {
+	var res internal.DueFilter
+
+	for name, dst := range map[string]*bool{"overdue": &res.Overdue, "due_today": &res.Today} {
+		val := r.URL.Query().Get(name)
+		if val == "" {
+			continue
+		}
+
+		parsed, err := strconv.ParseBool(val)
+		if err != nil {
+			return internal.DueFilter{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid %s", name)
+		}
+
+		*dst = parsed
+	}
+
+	if val := r.URL.Query().Get("due_in_days"); val != "" {
+		days, err := strconv.Atoi(val)
+		if err != nil {
+			return internal.DueFilter{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid due_in_days")
+		}
+
+		res.InDays = &days
+	}
+
+	return res, nil
+}
+
+// newTimeRange returns the range defined by the "<prefix>_from" and "<prefix>_to" query parameters, RFC 3339 times;
+// nil is returned when neither is set.
+func newTimeRange(r *http.Request, prefix string) (*internal.TimeRange, error) {
+	var res internal.TimeRange
+
+	for name, dst := range map[string]*time.Time{prefix + "_from": &res.From, prefix + "_to": &res.To} {
+		val := r.URL.Query().Get(name)
+		if val == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, val)
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid %s", name)
+		}
+
+		*dst = parsed
+	}
+
+	if res.From.IsZero() && res.To.IsZero() {
+		return nil, nil
+	}
+
+	return &res, nil
+}
+
+// newDueRange returns the due dates selected by the filter in the time zone requested by the client, nil is returned
+// when not filtering.
+func newDueRange(ctx context.Context, filter internal.DueFilter) *internal.DueRange {
+	if filter.IsZero() {
+		return nil
+	}
+
+	res := filter.Range(currentTime(ctx))
+
+	return &res
 }
 
 // ReadTasksResponse defines the response returned back after searching one task.
@@ -122,8 +497,12 @@ type ReadTasksResponse struct {
 }
 
 func (t *TaskHandler) task(w http.ResponseWriter, r *http.Request) {
-	// NOTE: Safe to ignore error, because it's always defined.
-	id, _ := mux.Vars(r)["id"] //nolint: gosimple
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
 
 	task, err := t.svc.Task(r.Context(), id)
 	if err != nil {
@@ -132,17 +511,27 @@ func (t *TaskHandler) task(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	renderResponse(w,
-		&ReadTasksResponse{
-			Task: Task{
-				ID:          task.ID,
-				Description: task.Description,
-				Priority:    NewPriority(task.Priority),
-				Dates:       NewDates(task.Dates),
-				IsDone:      task.IsDone,
-			},
-		},
-		http.StatusOK)
+	res := &ReadTasksResponse{
+		Task: newTask(r.Context(), task),
+	}
+
+	etag := newRepresentationETag(r.Context(), task.Version, res)
+
+	// Values relative to the current time change without modifying the task, only the ETag is used for those.
+	updatedAt := task.UpdatedAt
+	if timeRelative(r.Context()) {
+		updatedAt = time.Time{}
+	}
+
+	setCacheHeaders(w, etag, updatedAt)
+
+	if notModified(r, etag, updatedAt) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, res, http.StatusOK)
 }
 
 // UpdateTasksRequest defines the request used for updating a task.
@@ -152,53 +541,212 @@ type UpdateTasksRequest struct {
 	IsDone      bool     `json:"is_done"`
 	Priority    Priority `json:"priority"`
 	Dates       Dates    `json:"dates"`
+	ProjectID   string   `json:"project_id,omitempty"`
 }
 
 func (t *TaskHandler) update(w http.ResponseWriter, r *http.Request) {
 	var req UpdateTasksRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		renderErrorResponse(r.Context(), w, "invalid request",
-			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder"))
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
 
 		return
 	}
 
 	defer r.Body.Close()
 
-	// NOTE: Safe to ignore error, because it's always defined.
-	id, _ := mux.Vars(r)["id"] //nolint: gosimple
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
 
-	err := t.svc.Update(r.Context(), id, req.Description, req.Priority.Convert(), req.Dates.Convert(), req.IsDone)
+		return
+	}
+
+	ctx := r.Context()
+
+	version, ok, err := parseIfMatch(r.Header.Get(IfMatchHeader))
 	if err != nil {
-		renderErrorResponse(r.Context(), w, "update failed", err)
+		renderErrorResponse(ctx, w, "invalid request", err)
+
+		return
+	}
+
+	// Merging requires the version the update is based on.
+	merge := ok && preferMerge(r)
+
+	if ok {
+		ctx = internal.NewContextWithExpectedVersion(ctx, version)
+	}
+
+	if merge {
+		ctx = internal.NewContextWithMerge(ctx)
+	}
+
+	if val := r.URL.Query().Get(ForceQueryParam); val != "" {
+		force, err := strconv.ParseBool(val)
+		if err != nil {
+			renderErrorResponse(ctx, w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid force"))
+
+			return
+		}
+
+		if force {
+			ctx = internal.NewContextWithIgnoreBlockers(ctx)
+		}
+	}
+
+	if t.semantics.PutCreates {
+		created, err := t.svc.Upsert(ctx, id, req.Description, req.Priority.Convert(), req.Dates.Convert(), req.ProjectID,
+			req.IsDone)
+		if err != nil {
+			renderErrorResponse(ctx, w, "update failed", err)
+
+			return
+		}
+
+		status := http.StatusOK
+		if created {
+			status = http.StatusCreated
+		}
+
+		if merge {
+			w.Header().Set(PreferenceAppliedHeader, PreferenceMerge)
+		}
+
+		renderResponse(r.Context(), w, &struct{}{}, status)
+
+		return
+	}
+
+	if err := t.svc.Update(ctx, id, req.Description, req.Priority.Convert(), req.Dates.Convert(), req.ProjectID,
+		req.IsDone); err != nil {
+		renderErrorResponse(ctx, w, "update failed", err)
 
 		return
 	}
 
-	renderResponse(w, &struct{}{}, http.StatusOK)
+	if merge {
+		w.Header().Set(PreferenceAppliedHeader, PreferenceMerge)
+	}
+
+	renderResponse(r.Context(), w, &struct{}{}, http.StatusOK)
+}
+
+// OptionsResponse describes the semantics of the methods supported by a resource.
+type OptionsResponse struct {
+	Methods map[string]MethodSemantics `json:"methods"`
+}
+
+// MethodSemantics describes the semantics of a method, this is, whether it's safe to retry it and what happens
+// when the resource does not exist.
+//nolint: tagliatelle
+type MethodSemantics struct {
+	Safe          bool `json:"safe"`
+	Idempotent    bool `json:"idempotent"`
+	MissingStatus int  `json:"missing_status"`
+	Creates       bool `json:"creates"`
+}
+
+// options describes the semantics of the methods, the Allow header is derived from the routes registered in router.
+func (t *TaskHandler) options(router *mux.Router) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		missingPut := http.StatusNotFound
+		if t.semantics.PutCreates {
+			missingPut = http.StatusCreated
+		}
+
+		w.Header().Set("Allow", strings.Join(allowedMethods(router, r), ", "))
+
+		renderResponse(r.Context(), w,
+			&OptionsResponse{
+				Methods: map[string]MethodSemantics{
+					http.MethodGet: {
+						Safe:          true,
+						Idempotent:    true,
+						MissingStatus: http.StatusNotFound,
+					},
+					http.MethodPut: {
+						Idempotent:    true,
+						MissingStatus: missingPut,
+						Creates:       t.semantics.PutCreates,
+					},
+					http.MethodDelete: {
+						Idempotent:    true,
+						MissingStatus: t.semantics.deleteMissingStatus(),
+					},
+				},
+			},
+			http.StatusOK)
+	}
 }
 
 // SearchTasksRequest defines the request used for searching tasks.
 //nolint: tagliatelle
 type SearchTasksRequest struct {
-	Description *string   `json:"description"`
-	Priority    *Priority `json:"priority"`
-	IsDone      *bool     `json:"is_done"`
-	From        int64     `json:"from"`
-	Size        int64     `json:"size"`
+	Description *string          `json:"description"`
+	Priority    *Priority        `json:"priority"`
+	IsDone      *bool            `json:"is_done"`
+	From        int64            `json:"from"`
+	Size        int64            `json:"size"`
+	Fuzziness   string           `json:"fuzziness,omitempty"`
+	Highlight   *SearchHighlight `json:"highlight,omitempty"`
+	Facets      bool             `json:"facets,omitempty"`
+	Overdue     bool             `json:"overdue,omitempty"`
+	DueToday    bool             `json:"due_today,omitempty"`
+	DueInDays   *int             `json:"due_in_days,omitempty"`
+	ProjectID   *string          `json:"project_id,omitempty"`
+}
+
+// SearchHighlight defines the options used for highlighting the matching descriptions.
+//nolint: tagliatelle
+type SearchHighlight struct {
+	FragmentSize int `json:"fragment_size,omitempty"`
+}
+
+// SearchFacets defines the number of matching tasks by priority and by status, keyed by the values used for
+// filtering by them.
+//nolint: tagliatelle
+type SearchFacets struct {
+	Priority map[Priority]int64 `json:"priority"`
+	IsDone   map[string]int64   `json:"is_done"`
+}
+
+func newSearchFacets(facets *internal.Facets) *SearchFacets {
+	if facets == nil {
+		return nil
+	}
+
+	res := SearchFacets{
+		Priority: make(map[Priority]int64, len(facets.Priority)),
+		IsDone: map[string]int64{
+			"true":  facets.Done,
+			"false": facets.Undone,
+		},
+	}
+
+	for priority, count := range facets.Priority {
+		res.Priority[NewPriority(priority)] += count
+	}
+
+	return &res
 }
 
-// SearchTasksResponse defines the response returned back after searching for any task.
+// SearchTasksResponse defines the response returned back after searching for any task. Highlights is only
+// included when requested, it contains the HTML-escaped fragments of the matching descriptions indexed by task id;
+// Facets as well.
+//nolint: tagliatelle
 type SearchTasksResponse struct {
-	Tasks []Task `json:"tasks"`
-	Total int64  `json:"total"`
+	Tasks      []Task              `json:"tasks"`
+	Total      int64               `json:"total"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+	Highlights map[string][]string `json:"highlights,omitempty"`
+	Facets     *SearchFacets       `json:"facets,omitempty"`
 }
 
 func (t *TaskHandler) search(w http.ResponseWriter, r *http.Request) {
 	var req SearchTasksRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		renderErrorResponse(r.Context(), w, "invalid request",
-			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "json decoder"))
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
 
 		return
 	}
@@ -212,12 +760,29 @@ func (t *TaskHandler) search(w http.ResponseWriter, r *http.Request) {
 		priority = &res
 	}
 
+	var highlight *internal.Highlight
+
+	if req.Highlight != nil {
+		highlight = &internal.Highlight{FragmentSize: req.Highlight.FragmentSize}
+	}
+
 	res, err := t.svc.By(r.Context(), internal.SearchParams{
 		Description: req.Description,
 		Priority:    priority,
 		IsDone:      req.IsDone,
 		From:        req.From,
 		Size:        req.Size,
+		Cursor:      r.URL.Query().Get("cursor"),
+		Sort:        internal.Sort(r.URL.Query().Get("sort")),
+		Fuzziness:   internal.Fuzziness(req.Fuzziness),
+		Highlight:   highlight,
+		Facets:      req.Facets,
+		ProjectID:   req.ProjectID,
+		Due: newDueRange(r.Context(), internal.DueFilter{
+			Overdue: req.Overdue,
+			Today:   req.DueToday,
+			InDays:  req.DueInDays,
+		}),
 	})
 	if err != nil {
 		renderErrorResponse(r.Context(), w, "search failed", err)
@@ -228,15 +793,76 @@ func (t *TaskHandler) search(w http.ResponseWriter, r *http.Request) {
 	tasks := make([]Task, len(res.Tasks))
 
 	for i, task := range res.Tasks {
-		tasks[i].ID = task.ID
-		tasks[i].Description = task.Description
-		tasks[i].Priority = NewPriority(task.Priority)
-		tasks[i].Dates = NewDates(task.Dates)
+		tasks[i] = newTask(r.Context(), task)
 	}
 
-	renderResponse(w,
+	setPaginationLinks(w, r, res.NextCursor)
+
+	renderResponse(r.Context(), w,
 		&SearchTasksResponse{
-			Tasks: tasks,
-			Total: res.Total,
+			Tasks:      tasks,
+			Total:      res.Total,
+			NextCursor: res.NextCursor,
+			Highlights: res.Highlights,
+			Facets:     newSearchFacets(res.Facets),
 		}, http.StatusOK)
 }
+
+// Suggestion is a task suggested while typing.
+type Suggestion struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// SuggestTasksResponse defines the response returned back after suggesting tasks.
+type SuggestTasksResponse struct {
+	Suggestions []Suggestion `json:"suggestions"`
+}
+
+func (t *TaskHandler) suggest(w http.ResponseWriter, r *http.Request) {
+	size := defaultSuggestSize
+
+	if val := r.URL.Query().Get("size"); val != "" {
+		res, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid size"))
+
+			return
+		}
+
+		size = res
+	}
+
+	res, err := t.svc.Suggest(r.Context(), internal.SuggestParams{
+		Prefix: r.URL.Query().Get("q"),
+		Size:   size,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "suggest failed", err)
+
+		return
+	}
+
+	suggestions := make([]Suggestion, len(res))
+
+	for i, suggestion := range res {
+		suggestions[i] = Suggestion{
+			ID:          suggestion.ID,
+			Description: suggestion.Description,
+		}
+	}
+
+	renderResponse(r.Context(), w, &SuggestTasksResponse{Suggestions: suggestions}, http.StatusOK)
+}
+
+// taskID returns the id in the route using the UUID text format, ULIDs are converted to it.
+func taskID(r *http.Request) (string, error) {
+	// NOTE: Safe to ignore missing values, because it's always defined.
+	id, err := internal.ParseID(mux.Vars(r)["id"])
+	if err != nil {
+		return "", internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "internal.ParseID")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,138 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// BatchUpdateTasksRequest defines the request used for updating multiple tasks at once.
+type BatchUpdateTasksRequest struct {
+	Patches []TaskPatch `json:"patches"`
+}
+
+// TaskPatch defines the fields changed in a task, missing fields are kept as they are.
+type TaskPatch struct {
+	ID     string          `json:"id"`
+	Fields TaskPatchFields `json:"fields"`
+}
+
+// TaskPatchFields defines the fields changed by a patch, an empty project_id removes the task from its project.
+//nolint: tagliatelle
+type TaskPatchFields struct {
+	Description *string   `json:"description,omitempty"`
+	Priority    *Priority `json:"priority,omitempty"`
+	Dates       *Dates    `json:"dates,omitempty"`
+	ProjectID   *string   `json:"project_id,omitempty"`
+	IsDone      *bool     `json:"is_done,omitempty"`
+}
+
+// Convert returns the domain type defining the patch.
+func (p TaskPatch) Convert() internal.TaskPatch {
+	res := internal.TaskPatch{
+		ID:          p.ID,
+		Description: p.Fields.Description,
+		ProjectID:   p.Fields.ProjectID,
+		IsDone:      p.Fields.IsDone,
+	}
+
+	if p.Fields.Priority != nil {
+		priority := p.Fields.Priority.Convert()
+		res.Priority = &priority
+	}
+
+	if p.Fields.Dates != nil {
+		dates := p.Fields.Dates.Convert()
+		res.Dates = &dates
+	}
+
+	return res
+}
+
+// BatchUpdateTasksResponse defines the response returned back after updating multiple tasks at once, Results are
+// sorted like the patches in the request. Applied indicates whether the patches were applied, either all or none
+// are.
+type BatchUpdateTasksResponse struct {
+	Applied bool                     `json:"applied"`
+	Results []BatchUpdateTasksResult `json:"results"`
+}
+
+// BatchUpdateTasksResult defines the result of applying a patch: Status is the one used when updating the task
+// alone, http.StatusFailedDependency when it was not applied because other patches failed.
+type BatchUpdateTasksResult struct {
+	ID     string         `json:"id"`
+	Status int            `json:"status"`
+	Task   *Task          `json:"task,omitempty"`
+	Error  *ErrorResponse `json:"error,omitempty"`
+}
+
+func (t *TaskHandler) batchUpdate(w http.ResponseWriter, r *http.Request) {
+	var req BatchUpdateTasksRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	defer r.Body.Close()
+
+	ctx := r.Context()
+
+	if val := r.URL.Query().Get(ForceQueryParam); val != "" {
+		force, err := strconv.ParseBool(val)
+		if err != nil {
+			renderErrorResponse(ctx, w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid force"))
+
+			return
+		}
+
+		if force {
+			ctx = internal.NewContextWithIgnoreBlockers(ctx)
+		}
+	}
+
+	patches := make(internal.TaskPatches, len(req.Patches))
+
+	for i, patch := range req.Patches {
+		patches[i] = patch.Convert()
+	}
+
+	res, err := t.svc.BatchUpdate(ctx, patches)
+	if err != nil {
+		renderErrorResponse(ctx, w, "update failed", err)
+
+		return
+	}
+
+	resp := BatchUpdateTasksResponse{
+		Applied: res.Applied,
+		Results: make([]BatchUpdateTasksResult, len(res.Results)),
+	}
+
+	for i, result := range res.Results {
+		item := BatchUpdateTasksResult{
+			ID:     patches[i].ID,
+			Status: http.StatusOK,
+		}
+
+		switch {
+		case result.Err != nil:
+			errResp, status := newErrorResponse(ctx, "update failed", result.Err)
+
+			item.Status = status
+			item.Error = &errResp
+		case !res.Applied:
+			item.Status = http.StatusFailedDependency
+			item.Error = &ErrorResponse{Error: "not applied"}
+		default:
+			task := newTask(ctx, result.Task)
+			item.Task = &task
+		}
+
+		resp.Results[i] = item
+	}
+
+	renderResponse(r.Context(), w, &resp, http.StatusOK)
+}
@@ -0,0 +1,168 @@
+package rest_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTasks_BatchUpdate(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	isDone := true
+
+	tests := []struct {
+		name            string
+		setup           func(*resttesting.FakeTaskService)
+		input           []byte
+		output          output
+		expectedPatches internal.TaskPatches
+	}{
+		{
+			"OK: 200 applied",
+			func(s *resttesting.FakeTaskService) {
+				s.BatchUpdateReturns(internal.BatchUpdateResults{
+					Applied: true,
+					Results: []internal.BatchUpdateResult{
+						{Task: internal.Task{ID: "1-2-3", Description: "done", Priority: internal.PriorityLow, IsDone: true}},
+					},
+				}, nil)
+			},
+			[]byte(`{"patches":[{"id":"1-2-3","fields":{"is_done":true}}]}`),
+			output{
+				http.StatusOK,
+				&rest.BatchUpdateTasksResponse{
+					Applied: true,
+					Results: []rest.BatchUpdateTasksResult{
+						{
+							ID:     "1-2-3",
+							Status: http.StatusOK,
+							Task:   &rest.Task{ID: "1-2-3", Description: "done", Priority: "low", IsDone: true},
+						},
+					},
+				},
+				&rest.BatchUpdateTasksResponse{},
+			},
+			internal.TaskPatches{{ID: "1-2-3", IsDone: &isDone}},
+		},
+		{
+			"OK: 200 not applied",
+			func(s *resttesting.FakeTaskService) {
+				s.BatchUpdateReturns(internal.BatchUpdateResults{
+					Results: []internal.BatchUpdateResult{
+						{},
+						{Err: internal.NewErrorf(internal.ErrorCodeNotFound, "not found")},
+						{Err: internal.NewErrorf(internal.ErrorCodeConflict, "blocked")},
+					},
+				}, nil)
+			},
+			[]byte(`{"patches":[{"id":"a","fields":{}},{"id":"b","fields":{}},{"id":"c","fields":{"is_done":true}}]}`),
+			output{
+				http.StatusOK,
+				&rest.BatchUpdateTasksResponse{
+					Results: []rest.BatchUpdateTasksResult{
+						{
+							ID:     "a",
+							Status: http.StatusFailedDependency,
+							Error:  &rest.ErrorResponse{Error: "not applied"},
+						},
+						{
+							ID:     "b",
+							Status: http.StatusNotFound,
+							Error:  &rest.ErrorResponse{Error: "update failed"},
+						},
+						{
+							ID:     "c",
+							Status: http.StatusConflict,
+							Error:  &rest.ErrorResponse{Error: "update failed"},
+						},
+					},
+				},
+				&rest.BatchUpdateTasksResponse{},
+			},
+			internal.TaskPatches{{ID: "a"}, {ID: "b"}, {ID: "c", IsDone: &isDone}},
+		},
+		{
+			"ERR: 400 json",
+			func(*resttesting.FakeTaskService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+			nil,
+		},
+		{
+			"ERR: 400 patches",
+			func(s *resttesting.FakeTaskService) {
+				s.BatchUpdateReturns(internal.BatchUpdateResults{},
+					internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid"))
+			},
+			[]byte(`{"patches":[]}`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "update failed",
+				},
+				&rest.ErrorResponse{},
+			},
+			internal.TaskPatches{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/tasks:batchUpdate", bytes.NewReader(tt.input)))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if tt.expectedPatches == nil {
+				if svc.BatchUpdateCallCount() != 0 {
+					t.Fatalf("expected no calls")
+				}
+
+				return
+			}
+
+			if _, patches := svc.BatchUpdateArgsForCall(0); !cmp.Equal(tt.expectedPatches, patches) {
+				t.Fatalf("expected arguments do not match: %s", cmp.Diff(tt.expectedPatches, patches))
+			}
+		})
+	}
+}
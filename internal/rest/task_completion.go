@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func (t *TaskHandler) complete(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	ctx := r.Context()
+
+	version, ok, err := parseIfMatch(r.Header.Get(IfMatchHeader))
+	if err != nil {
+		renderErrorResponse(ctx, w, "invalid request", err)
+
+		return
+	}
+
+	if ok {
+		ctx = internal.NewContextWithExpectedVersion(ctx, version)
+	}
+
+	if val := r.URL.Query().Get(ForceQueryParam); val != "" {
+		force, err := strconv.ParseBool(val)
+		if err != nil {
+			renderErrorResponse(ctx, w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid force"))
+
+			return
+		}
+
+		if force {
+			ctx = internal.NewContextWithIgnoreBlockers(ctx)
+		}
+	}
+
+	task, err := t.svc.Complete(ctx, id)
+	if err != nil {
+		renderErrorResponse(ctx, w, "complete failed", err)
+
+		return
+	}
+
+	if etag := newETag(task.Version); etag != "" {
+		w.Header().Set(ETagHeader, etag)
+	}
+
+	renderResponse(r.Context(), w,
+		&ReadTasksResponse{
+			Task: newTask(ctx, task),
+		},
+		http.StatusOK)
+}
+
+func (t *TaskHandler) reopen(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	ctx := r.Context()
+
+	version, ok, err := parseIfMatch(r.Header.Get(IfMatchHeader))
+	if err != nil {
+		renderErrorResponse(ctx, w, "invalid request", err)
+
+		return
+	}
+
+	if ok {
+		ctx = internal.NewContextWithExpectedVersion(ctx, version)
+	}
+
+	task, err := t.svc.Reopen(ctx, id)
+	if err != nil {
+		renderErrorResponse(ctx, w, "reopen failed", err)
+
+		return
+	}
+
+	if etag := newETag(task.Version); etag != "" {
+		w.Header().Set(ETagHeader, etag)
+	}
+
+	renderResponse(r.Context(), w,
+		&ReadTasksResponse{
+			Task: newTask(ctx, task),
+		},
+		http.StatusOK)
+}
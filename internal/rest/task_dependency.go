@@ -0,0 +1,116 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// ForceQueryParam is the query parameter used for completing tasks even when the tasks blocking them are not done.
+const ForceQueryParam = "force"
+
+// TaskDependenciesResponse defines the response returned back after reading the dependencies of a task.
+//nolint: tagliatelle
+type TaskDependenciesResponse struct {
+	BlockedBy []Task `json:"blocked_by"`
+	Blocks    []Task `json:"blocks"`
+}
+
+// CreateTaskDependenciesRequest defines the request used for indicating a task is blocked by another one.
+//nolint: tagliatelle
+type CreateTaskDependenciesRequest struct {
+	BlockedBy string `json:"blocked_by"`
+}
+
+func (t *TaskHandler) dependencies(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	deps, err := t.svc.Dependencies(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "find failed", err)
+
+		return
+	}
+
+	res := TaskDependenciesResponse{
+		BlockedBy: make([]Task, len(deps.BlockedBy)),
+		Blocks:    make([]Task, len(deps.Blocks)),
+	}
+
+	for i, task := range deps.BlockedBy {
+		res.BlockedBy[i] = newTask(r.Context(), task)
+	}
+
+	for i, task := range deps.Blocks {
+		res.Blocks[i] = newTask(r.Context(), task)
+	}
+
+	renderResponse(r.Context(), w, &res, http.StatusOK)
+}
+
+func (t *TaskHandler) createDependency(w http.ResponseWriter, r *http.Request) {
+	var req CreateTaskDependenciesRequest
+	if err := decodeRequest(w, r, &req); err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	defer r.Body.Close()
+
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	blocker, err := internal.ParseID(req.BlockedBy)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request",
+			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "internal.ParseID"))
+
+		return
+	}
+
+	if err := t.svc.AddDependency(r.Context(), internal.TaskDependency{BlockerID: blocker, BlockedID: id}); err != nil {
+		renderErrorResponse(r.Context(), w, "create failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, &struct{}{}, http.StatusCreated)
+}
+
+func (t *TaskHandler) deleteDependency(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	// NOTE: Safe to ignore missing values, because it's always defined.
+	blocker, err := internal.ParseID(mux.Vars(r)["blockerId"])
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request",
+			internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "internal.ParseID"))
+
+		return
+	}
+
+	if err := t.svc.RemoveDependency(r.Context(), internal.TaskDependency{BlockerID: blocker, BlockedID: id}); err != nil {
+		renderErrorResponse(r.Context(), w, "delete failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w, &struct{}{}, http.StatusOK)
+}
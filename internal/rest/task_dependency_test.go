@@ -0,0 +1,222 @@
+package rest_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTasks_Dependencies(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeTaskService{}
+	svc.DependenciesReturns(internal.TaskDependencies{
+		BlockedBy: []internal.Task{{ID: "1-2-3", Description: "blocker", Priority: internal.PriorityHigh}},
+		Blocks:    []internal.Task{},
+	}, nil)
+
+	rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+	res := doRequest(router,
+		httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/dependencies", nil))
+
+	assertResponse(t, res, test{
+		&rest.TaskDependenciesResponse{
+			BlockedBy: []rest.Task{{ID: "1-2-3", Description: "blocker", Priority: "high"}},
+			Blocks:    []rest.Task{},
+		},
+		&rest.TaskDependenciesResponse{},
+	})
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+
+	if _, id := svc.DependenciesArgsForCall(0); id != "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee" {
+		t.Fatalf("expected id do not match: %s", id)
+	}
+}
+
+func TestTasks_PostDependency(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		setup          func(*resttesting.FakeTaskService)
+		input          []byte
+		expectedStatus int
+		expectedDep    *internal.TaskDependency
+	}{
+		{
+			"OK: 201",
+			func(*resttesting.FakeTaskService) {},
+			[]byte(`{"blocked_by":"11111111-2222-3333-4444-555555555555"}`),
+			http.StatusCreated,
+			&internal.TaskDependency{
+				BlockerID: "11111111-2222-3333-4444-555555555555",
+				BlockedID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			},
+		},
+		{
+			"ERR: 400 json",
+			func(*resttesting.FakeTaskService) {},
+			[]byte(`{"invalid":"json`),
+			http.StatusBadRequest,
+			nil,
+		},
+		{
+			"ERR: 400 id",
+			func(*resttesting.FakeTaskService) {},
+			[]byte(`{"blocked_by":"x"}`),
+			http.StatusBadRequest,
+			nil,
+		},
+		{
+			"ERR: 409",
+			func(s *resttesting.FakeTaskService) {
+				s.AddDependencyReturns(internal.NewErrorf(internal.ErrorCodeConflict, "cycle"))
+			},
+			[]byte(`{"blocked_by":"11111111-2222-3333-4444-555555555555"}`),
+			http.StatusConflict,
+			&internal.TaskDependency{
+				BlockerID: "11111111-2222-3333-4444-555555555555",
+				BlockedID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/dependencies",
+					bytes.NewReader(tt.input)))
+			defer res.Body.Close()
+
+			//-
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if tt.expectedDep == nil {
+				if svc.AddDependencyCallCount() != 0 {
+					t.Fatalf("expected no calls")
+				}
+
+				return
+			}
+
+			if _, dep := svc.AddDependencyArgsForCall(0); !cmp.Equal(*tt.expectedDep, dep) {
+				t.Fatalf("expected arguments do not match: %s", cmp.Diff(*tt.expectedDep, dep))
+			}
+		})
+	}
+}
+
+func TestTasks_DeleteDependency(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeTaskService{}
+
+	rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+	res := doRequest(router, httptest.NewRequest(http.MethodDelete,
+		"/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/dependencies/11111111-2222-3333-4444-555555555555", nil))
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+
+	expected := internal.TaskDependency{
+		BlockerID: "11111111-2222-3333-4444-555555555555",
+		BlockedID: "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+	}
+
+	if _, dep := svc.RemoveDependencyArgsForCall(0); !cmp.Equal(expected, dep) {
+		t.Fatalf("expected arguments do not match: %s", cmp.Diff(expected, dep))
+	}
+}
+
+func TestTasks_UpdateForce(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		query          string
+		expectedStatus int
+		expectedIgnore bool
+	}{
+		{
+			"OK: default",
+			"",
+			http.StatusOK,
+			false,
+		},
+		{
+			"OK: force",
+			"?force=true",
+			http.StatusOK,
+			true,
+		},
+		{
+			"ERR: 400",
+			"?force=x",
+			http.StatusBadRequest,
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPut, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee"+tt.query,
+					bytes.NewReader([]byte(`{"description":"done","priority":"low","is_done":true}`))))
+			defer res.Body.Close()
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			ctx, _, _, _, _, _, _ := svc.UpdateArgsForCall(0)
+			if actual := internal.IgnoreBlockersFromContext(ctx); actual != tt.expectedIgnore {
+				t.Fatalf("expected ignore blockers %t, actual %t", tt.expectedIgnore, actual)
+			}
+		})
+	}
+}
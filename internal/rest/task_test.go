@@ -14,10 +14,112 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/breaker"
 	"github.com/MarioCarrion/todo-api/internal/rest"
 	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
 )
 
+func TestTasks_Clone(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeTaskService)
+		output output
+	}{
+		{
+			"OK: 201",
+			func(s *resttesting.FakeTaskService) {
+				s.CloneReturns(
+					internal.Task{
+						ID:          "x-y-z",
+						Description: "existing task",
+						Priority:    internal.PriorityHigh,
+					},
+					nil)
+			},
+			output{
+				http.StatusCreated,
+				&rest.CreateTasksResponse{
+					Task: rest.Task{
+						ID:          "x-y-z",
+						Description: "existing task",
+						Priority:    "high",
+					},
+				},
+				&rest.CreateTasksResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeTaskService) {
+				s.CloneReturns(internal.Task{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Error: "clone failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeTaskService) {
+				s.CloneReturns(internal.Task{},
+					errors.New("service error"))
+			},
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	//-
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/clone", nil))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if _, id := svc.CloneArgsForCall(0); id != "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee" {
+				t.Fatalf("expected id does not match, actual %s", id)
+			}
+		})
+	}
+}
+
 func TestTasks_Delete(t *testing.T) {
 	t.Parallel()
 
@@ -79,7 +181,7 @@ func TestTasks_Delete(t *testing.T) {
 			svc := &resttesting.FakeTaskService{}
 			tt.setup(svc)
 
-			rest.NewTaskHandler(svc).Register(router)
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
 
 			//-
 
@@ -186,7 +288,7 @@ func TestTasks_Post(t *testing.T) {
 			svc := &resttesting.FakeTaskService{}
 			tt.setup(svc)
 
-			rest.NewTaskHandler(svc).Register(router)
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
 
 			//-
 
@@ -204,78 +306,32 @@ func TestTasks_Post(t *testing.T) {
 	}
 }
 
-func TestTasks_Read(t *testing.T) {
+func TestTasks_PostSuggestions(t *testing.T) {
 	t.Parallel()
 
-	// XXX: Test "serviceArgs"
-
-	type output struct {
-		expectedStatus int
-		expected       interface{}
-		target         interface{}
-	}
+	due := time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC)
+	priority := internal.PriorityHigh
 
 	tests := []struct {
-		name   string
-		setup  func(*resttesting.FakeTaskService)
-		output output
+		name     string
+		profile  string
+		expected *rest.TaskSuggestions
 	}{
 		{
-			"OK: 200",
-			func(s *resttesting.FakeTaskService) {
-				s.TaskReturns(
-					internal.Task{
-						ID:          "a-b-c",
-						Description: "existing task",
-						IsDone:      true,
-					},
-					nil)
-			},
-			output{
-				http.StatusOK,
-				&rest.ReadTasksResponse{
-					Task: rest.Task{
-						ID:          "a-b-c",
-						Description: "existing task",
-						Priority:    "none",
-						IsDone:      true,
-					},
-				},
-				&rest.ReadTasksResponse{},
-			},
-		},
-		{
-			"OK: 200",
-			func(s *resttesting.FakeTaskService) {
-				s.TaskReturns(internal.Task{},
-					internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
-			},
-			output{
-				http.StatusNotFound,
-				&rest.ErrorResponse{
-					Error: "find failed",
-				},
-				&rest.ErrorResponse{},
+			"OK: requested",
+			string(rest.ProfileTaskSuggestions),
+			&rest.TaskSuggestions{
+				Priority: func() *rest.Priority { p := rest.Priority("high"); return &p }(),
+				Due:      &due,
 			},
 		},
 		{
-			"ERR: 500",
-			func(s *resttesting.FakeTaskService) {
-				s.TaskReturns(internal.Task{},
-					errors.New("service error"))
-			},
-			output{
-				http.StatusInternalServerError,
-				&rest.ErrorResponse{
-					Error: "internal error",
-				},
-				&rest.ErrorResponse{},
-			},
+			"OK: not requested",
+			"",
+			nil,
 		},
 	}
 
-	//-
-
 	for _, tt := range tests {
 		tt := tt
 
@@ -283,31 +339,43 @@ func TestTasks_Read(t *testing.T) {
 			t.Parallel()
 
 			router := mux.NewRouter()
+			router.Use(rest.Profiles)
+
 			svc := &resttesting.FakeTaskService{}
-			tt.setup(svc)
+			svc.CreateReturns(internal.Task{ID: "1-2-3", Description: "renew passport", Priority: internal.PriorityLow}, nil)
+			svc.SuggestValuesReturns(internal.TaskSuggestions{Priority: &priority, Due: &due}, nil)
 
-			rest.NewTaskHandler(svc).Register(router)
+			search := &resttesting.FakeAvailability{}
+			search.AvailableReturns(true)
 
-			//-
+			rest.NewTaskHandler(svc, search, rest.Semantics{}).Register(router)
 
-			res := doRequest(router,
-				httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+			req := httptest.NewRequest(http.MethodPost, "/tasks",
+				bytes.NewReader([]byte(`{"description":"renew passport","priority":"low"}`)))
+			req.Header.Set(rest.AcceptProfileHeader, tt.profile)
 
-			//-
+			res := doRequest(router, req)
 
-			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+			// Suggestions are never applied to the created task.
+			assertResponse(t, res, test{
+				&rest.CreateTasksResponse{
+					Task:        rest.Task{ID: "1-2-3", Description: "renew passport", Priority: "low"},
+					Suggestions: tt.expected,
+				},
+				&rest.CreateTasksResponse{},
+			})
 
-			if tt.output.expectedStatus != res.StatusCode {
-				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			if res.StatusCode != http.StatusCreated {
+				t.Fatalf("expected code %d, actual %d", http.StatusCreated, res.StatusCode)
 			}
 		})
 	}
 }
 
-func TestTasks_Update(t *testing.T) {
+func TestTasks_List(t *testing.T) {
 	t.Parallel()
 
-	// XXX: Test "serviceArgs"
+	total := int64(250000)
 
 	type output struct {
 		expectedStatus int
@@ -318,30 +386,69 @@ func TestTasks_Update(t *testing.T) {
 	tests := []struct {
 		name   string
 		setup  func(*resttesting.FakeTaskService)
-		input  []byte
+		target string
 		output output
 	}{
 		{
 			"OK: 200",
-			func(s *resttesting.FakeTaskService) {},
-			func() []byte {
-				b, _ := json.Marshal(&rest.UpdateTasksRequest{
-					Description: "update task",
-					Priority:    "low",
-				})
-
-				return b
-			}(),
+			func(s *resttesting.FakeTaskService) {
+				s.ListReturns(
+					internal.ListResults{
+						Tasks: []internal.Task{
+							{
+								ID:          "1-2-3",
+								Description: "listed task",
+								Priority:    internal.PriorityMedium,
+								IsDone:      true,
+							},
+						},
+						NextCursor: "next",
+					},
+					nil)
+			},
+			"/tasks?cursor=current&size=1",
 			output{
 				http.StatusOK,
-				&struct{}{},
-				&struct{}{},
+				&rest.ListTasksResponse{
+					Tasks: []rest.Task{
+						{
+							ID:          "1-2-3",
+							Description: "listed task",
+							Priority:    "medium",
+							IsDone:      true,
+						},
+					},
+					NextCursor: "next",
+				},
+				&rest.ListTasksResponse{},
+			},
+		},
+		{
+			"OK: 200 total",
+			func(s *resttesting.FakeTaskService) {
+				s.ListReturns(
+					internal.ListResults{
+						Tasks:          []internal.Task{},
+						Total:          250000,
+						TotalEstimated: true,
+					},
+					nil)
+			},
+			"/tasks?total=true",
+			output{
+				http.StatusOK,
+				&rest.ListTasksResponse{
+					Tasks:          []rest.Task{},
+					Total:          &total,
+					TotalEstimated: true,
+				},
+				&rest.ListTasksResponse{},
 			},
 		},
 		{
 			"ERR: 400",
 			func(*resttesting.FakeTaskService) {},
-			[]byte(`{"invalid":"json`),
+			"/tasks?size=x",
 			output{
 				http.StatusBadRequest,
 				&rest.ErrorResponse{
@@ -351,30 +458,48 @@ func TestTasks_Update(t *testing.T) {
 			},
 		},
 		{
-			"ERR: 404",
-			func(s *resttesting.FakeTaskService) {
-				s.UpdateReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			"ERR: 400 total",
+			func(*resttesting.FakeTaskService) {},
+			"/tasks?total=x",
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
 			},
-			func() []byte {
-				b, _ := json.Marshal(&rest.UpdateTasksRequest{
-					Description: "update task",
-					Priority:    "low",
-				})
-
-				return b
-			}(),
+		},
+		{
+			"ERR: 400 due_in_days",
+			func(*resttesting.FakeTaskService) {},
+			"/tasks?due_in_days=x",
 			output{
-				http.StatusNotFound,
-				&struct{}{},
-				&struct{}{},
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 400 updated_from",
+			func(*resttesting.FakeTaskService) {},
+			"/tasks?updated_from=yesterday",
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
 			},
 		},
 		{
 			"ERR: 500",
 			func(s *resttesting.FakeTaskService) {
-				s.UpdateReturns(errors.New("service error"))
+				s.ListReturns(internal.ListResults{},
+					errors.New("service error"))
 			},
-			[]byte(`{}`),
+			"/tasks",
 			output{
 				http.StatusInternalServerError,
 				&rest.ErrorResponse{
@@ -397,12 +522,11 @@ func TestTasks_Update(t *testing.T) {
 			svc := &resttesting.FakeTaskService{}
 			tt.setup(svc)
 
-			rest.NewTaskHandler(svc).Register(router)
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
 
 			//-
 
-			res := doRequest(router,
-				httptest.NewRequest(http.MethodPut, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", bytes.NewReader(tt.input)))
+			res := doRequest(router, httptest.NewRequest(http.MethodGet, tt.target, nil))
 
 			//-
 
@@ -415,6 +539,746 @@ func TestTasks_Update(t *testing.T) {
 	}
 }
 
+func TestTasks_ReadID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		target         string
+		expectedStatus int
+		expectedID     string
+	}{
+		{
+			"OK: UUID",
+			"/tasks/0186A6E4-4C4B-7C2A-9C8D-5B1E2F3A4B5C",
+			http.StatusOK,
+			"0186a6e4-4c4b-7c2a-9c8d-5b1e2f3a4b5c",
+		},
+		{
+			"OK: ULID",
+			"/tasks/01GTKE8K2B7VGD1EC8QF7H5V8P",
+			http.StatusOK,
+			"0186a6e4-4c4b-3ee0-d0b9-88bbcf12ed16",
+		},
+		{
+			"ERR: 400 ULID overflow",
+			"/tasks/81GTKE8K2B7VGD1EC8QF7H5V8P",
+			http.StatusBadRequest,
+			"",
+		},
+		{
+			"ERR: 404 route",
+			"/tasks/x",
+			http.StatusNotFound,
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			res := doRequest(router, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			defer res.Body.Close()
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if tt.expectedID == "" {
+				return
+			}
+
+			if _, actual := svc.TaskArgsForCall(0); actual != tt.expectedID {
+				t.Fatalf("expected id %s, actual %s", tt.expectedID, actual)
+			}
+		})
+	}
+}
+
+func TestTasks_Read(t *testing.T) {
+	t.Parallel()
+
+	// XXX: Test "serviceArgs"
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeTaskService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeTaskService) {
+				s.TaskReturns(
+					internal.Task{
+						ID:          "a-b-c",
+						Description: "existing task",
+						IsDone:      true,
+					},
+					nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadTasksResponse{
+					Task: rest.Task{
+						ID:          "a-b-c",
+						Description: "existing task",
+						Priority:    "none",
+						IsDone:      true,
+					},
+				},
+				&rest.ReadTasksResponse{},
+			},
+		},
+		{
+			"OK: 200",
+			func(s *resttesting.FakeTaskService) {
+				s.TaskReturns(internal.Task{},
+					internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Error: "find failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeTaskService) {
+				s.TaskReturns(internal.Task{},
+					errors.New("service error"))
+			},
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 500 dependency",
+			func(s *resttesting.FakeTaskService) {
+				s.TaskReturns(internal.Task{},
+					internal.WrapDependencyErrorf(errors.New("conn refused"), internal.DependencyPostgreSQL,
+						internal.ErrorCodeUnknown, "select task"))
+			},
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "find failed",
+					Code:  "dependency_postgresql",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 503 circuit open",
+			func(s *resttesting.FakeTaskService) {
+				s.TaskReturns(internal.Task{},
+					internal.WrapDependencyErrorf(breaker.ErrOpen, internal.DependencyPostgreSQL,
+						internal.ErrorCodeUnavailable, "postgresql not available"))
+			},
+			output{
+				http.StatusServiceUnavailable,
+				&rest.ErrorResponse{
+					Error: "find failed",
+					Code:  "circuit_open",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	//-
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodGet, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", nil))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestTasks_Update(t *testing.T) {
+	t.Parallel()
+
+	// XXX: Test "serviceArgs"
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeTaskService)
+		input  []byte
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeTaskService) {},
+			func() []byte {
+				b, _ := json.Marshal(&rest.UpdateTasksRequest{
+					Description: "update task",
+					Priority:    "low",
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusOK,
+				&struct{}{},
+				&struct{}{},
+			},
+		},
+		{
+			"ERR: 400",
+			func(*resttesting.FakeTaskService) {},
+			[]byte(`{"invalid":"json`),
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeTaskService) {
+				s.UpdateReturns(internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			func() []byte {
+				b, _ := json.Marshal(&rest.UpdateTasksRequest{
+					Description: "update task",
+					Priority:    "low",
+				})
+
+				return b
+			}(),
+			output{
+				http.StatusNotFound,
+				&struct{}{},
+				&struct{}{},
+			},
+		},
+		{
+			"ERR: 500",
+			func(s *resttesting.FakeTaskService) {
+				s.UpdateReturns(errors.New("service error"))
+			},
+			[]byte(`{}`),
+			output{
+				http.StatusInternalServerError,
+				&rest.ErrorResponse{
+					Error: "internal error",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	//-
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPut, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee", bytes.NewReader(tt.input)))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestTasks_Semantics(t *testing.T) {
+	t.Parallel()
+
+	const target = "/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a"
+
+	notFound := internal.NewErrorf(internal.ErrorCodeNotFound, "not found")
+
+	tests := []struct {
+		name           string
+		semantics      rest.Semantics
+		setup          func(*resttesting.FakeTaskService)
+		method         string
+		body           string
+		expectedStatus int
+	}{
+		{
+			"DELETE: missing 404",
+			rest.Semantics{},
+			func(s *resttesting.FakeTaskService) {
+				s.DeleteReturns(notFound)
+			},
+			http.MethodDelete,
+			"",
+			http.StatusNotFound,
+		},
+		{
+			"DELETE: missing 204",
+			rest.Semantics{DeleteMissingStatus: http.StatusNoContent},
+			func(s *resttesting.FakeTaskService) {
+				s.DeleteReturns(notFound)
+			},
+			http.MethodDelete,
+			"",
+			http.StatusNoContent,
+		},
+		{
+			"PUT: missing 404",
+			rest.Semantics{},
+			func(s *resttesting.FakeTaskService) {
+				s.UpdateReturns(notFound)
+			},
+			http.MethodPut,
+			`{"description":"task","priority":"low"}`,
+			http.StatusNotFound,
+		},
+		{
+			"PUT: created",
+			rest.Semantics{PutCreates: true},
+			func(s *resttesting.FakeTaskService) {
+				s.UpsertReturns(true, nil)
+			},
+			http.MethodPut,
+			`{"description":"task","priority":"low"}`,
+			http.StatusCreated,
+		},
+		{
+			"PUT: replaced",
+			rest.Semantics{PutCreates: true},
+			func(s *resttesting.FakeTaskService) {
+				s.UpsertReturns(false, nil)
+			},
+			http.MethodPut,
+			`{"description":"task","priority":"low"}`,
+			http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, tt.semantics).Register(router)
+
+			res := doRequest(router, httptest.NewRequest(tt.method, target, bytes.NewReader([]byte(tt.body))))
+			defer res.Body.Close()
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+
+	t.Run("OPTIONS", func(t *testing.T) {
+		t.Parallel()
+
+		router := mux.NewRouter()
+
+		rest.NewTaskHandler(&resttesting.FakeTaskService{},
+			&resttesting.FakeAvailability{},
+			rest.Semantics{DeleteMissingStatus: http.StatusNoContent, PutCreates: true}).Register(router)
+
+		res := doRequest(router, httptest.NewRequest(http.MethodOptions, target, nil))
+
+		assertResponse(t, res, test{
+			&rest.OptionsResponse{
+				Methods: map[string]rest.MethodSemantics{
+					http.MethodGet: {
+						Safe:          true,
+						Idempotent:    true,
+						MissingStatus: http.StatusNotFound,
+					},
+					http.MethodPut: {
+						Idempotent:    true,
+						MissingStatus: http.StatusCreated,
+						Creates:       true,
+					},
+					http.MethodDelete: {
+						Idempotent:    true,
+						MissingStatus: http.StatusNoContent,
+					},
+				},
+			},
+			&rest.OptionsResponse{},
+		})
+
+		if allow := res.Header.Get("Allow"); allow != "GET, PUT, DELETE, OPTIONS" {
+			t.Fatalf("expected Allow header, got %s", allow)
+		}
+	})
+}
+
+func TestTasks_Search(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(*resttesting.FakeTaskService)
+		available bool
+		output    output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeTaskService) {
+				s.ByReturns(
+					internal.SearchResults{
+						Tasks: []internal.Task{
+							{
+								ID:          "1-2-3",
+								Description: "searched task",
+								Priority:    internal.PriorityLow,
+							},
+						},
+						Total: 1,
+					},
+					nil)
+			},
+			true,
+			output{
+				http.StatusOK,
+				&rest.SearchTasksResponse{
+					Tasks: []rest.Task{
+						{
+							ID:          "1-2-3",
+							Description: "searched task",
+							Priority:    "low",
+						},
+					},
+					Total: 1,
+				},
+				&rest.SearchTasksResponse{},
+			},
+		},
+		{
+			"ERR: 503",
+			func(*resttesting.FakeTaskService) {},
+			false,
+			output{
+				http.StatusServiceUnavailable,
+				&rest.ErrorResponse{
+					Error: "search not available",
+					Code:  "search_disabled",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	//-
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			search := &resttesting.FakeAvailability{}
+			search.AvailableReturns(tt.available)
+
+			rest.NewTaskHandler(svc, search, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/search/tasks", bytes.NewReader([]byte(`{"description":"task"}`))))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+		})
+	}
+}
+
+func TestTasks_SearchHighlight(t *testing.T) {
+	t.Parallel()
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeTaskService{}
+	svc.ByReturns(
+		internal.SearchResults{
+			Tasks: []internal.Task{
+				{
+					ID:          "1-2-3",
+					Description: "searched task",
+					Priority:    internal.PriorityLow,
+				},
+			},
+			Total:      1,
+			Highlights: map[string][]string{"1-2-3": {"searched <em>task</em>"}},
+		},
+		nil)
+
+	search := &resttesting.FakeAvailability{}
+	search.AvailableReturns(true)
+
+	rest.NewTaskHandler(svc, search, rest.Semantics{}).Register(router)
+
+	res := doRequest(router,
+		httptest.NewRequest(http.MethodPost, "/search/tasks",
+			bytes.NewReader([]byte(`{"description":"tsak","fuzziness":"AUTO","highlight":{"fragment_size":50}}`))))
+
+	assertResponse(t, res, test{
+		&rest.SearchTasksResponse{
+			Tasks: []rest.Task{
+				{
+					ID:          "1-2-3",
+					Description: "searched task",
+					Priority:    "low",
+				},
+			},
+			Total:      1,
+			Highlights: map[string][]string{"1-2-3": {"searched <em>task</em>"}},
+		},
+		&rest.SearchTasksResponse{},
+	})
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+
+	_, args := svc.ByArgsForCall(0)
+
+	if args.Fuzziness != internal.FuzzinessAuto {
+		t.Fatalf("expected fuzziness %q, got %q", internal.FuzzinessAuto, args.Fuzziness)
+	}
+
+	if expected := (&internal.Highlight{FragmentSize: 50}); !cmp.Equal(expected, args.Highlight) {
+		t.Fatalf("expected highlight does not match: %s", cmp.Diff(expected, args.Highlight))
+	}
+}
+
+func TestTasks_SearchFacets(t *testing.T) {
+	t.Parallel()
+
+	facets := internal.NewFacets()
+	facets.Add(internal.PriorityHigh, true, 2)
+	facets.Add(internal.PriorityLow, false, 1)
+
+	router := mux.NewRouter()
+	svc := &resttesting.FakeTaskService{}
+	svc.ByReturns(internal.SearchResults{Tasks: []internal.Task{}, Total: 3, Facets: facets}, nil)
+
+	search := &resttesting.FakeAvailability{}
+	search.AvailableReturns(true)
+
+	rest.NewTaskHandler(svc, search, rest.Semantics{}).Register(router)
+
+	res := doRequest(router,
+		httptest.NewRequest(http.MethodPost, "/search/tasks",
+			bytes.NewReader([]byte(`{"description":"task","facets":true}`))))
+
+	assertResponse(t, res, test{
+		&rest.SearchTasksResponse{
+			Tasks: []rest.Task{},
+			Total: 3,
+			Facets: &rest.SearchFacets{
+				Priority: map[rest.Priority]int64{"none": 0, "low": 1, "medium": 0, "high": 2},
+				IsDone:   map[string]int64{"true": 2, "false": 1},
+			},
+		},
+		&rest.SearchTasksResponse{},
+	})
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+
+	if _, args := svc.ByArgsForCall(0); !args.Facets {
+		t.Fatalf("expected facets to be requested")
+	}
+}
+
+func TestTasks_Suggest(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name      string
+		target    string
+		setup     func(*resttesting.FakeTaskService)
+		available bool
+		output    output
+	}{
+		{
+			"OK: 200",
+			"/search/tasks/suggest?q=buy+mi",
+			func(s *resttesting.FakeTaskService) {
+				s.SuggestReturns(
+					[]internal.Suggestion{
+						{
+							ID:          "1-2-3",
+							Description: "buy milk",
+						},
+					},
+					nil)
+			},
+			true,
+			output{
+				http.StatusOK,
+				&rest.SuggestTasksResponse{
+					Suggestions: []rest.Suggestion{
+						{
+							ID:          "1-2-3",
+							Description: "buy milk",
+						},
+					},
+				},
+				&rest.SuggestTasksResponse{},
+			},
+		},
+		{
+			"ERR: 400",
+			"/search/tasks/suggest?q=buy&size=x",
+			func(*resttesting.FakeTaskService) {},
+			true,
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 503",
+			"/search/tasks/suggest?q=buy",
+			func(*resttesting.FakeTaskService) {},
+			false,
+			output{
+				http.StatusServiceUnavailable,
+				&rest.ErrorResponse{
+					Error: "search not available",
+					Code:  "search_disabled",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	//-
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			search := &resttesting.FakeAvailability{}
+			search.AvailableReturns(tt.available)
+
+			rest.NewTaskHandler(svc, search, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if tt.output.expectedStatus != http.StatusOK {
+				return
+			}
+
+			if _, params := svc.SuggestArgsForCall(0); params.Prefix != "buy mi" || params.Size != 5 {
+				t.Fatalf("unexpected params %#v", params)
+			}
+		})
+	}
+}
+
 type test struct {
 	expected interface{}
 	target   interface{}
@@ -0,0 +1,88 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TrashedTask is a deleted task kept in the trash until it's restored or purged.
+//nolint: tagliatelle
+type TrashedTask struct {
+	Task
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+// ListTrashResponse defines the response returned back after listing the deleted tasks.
+//nolint: tagliatelle
+type ListTrashResponse struct {
+	Tasks      []TrashedTask `json:"tasks"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
+
+func (t *TaskHandler) trash(w http.ResponseWriter, r *http.Request) {
+	size := defaultListSize
+
+	if val := r.URL.Query().Get("size"); val != "" {
+		res, err := strconv.ParseInt(val, 10, 64)
+		if err != nil {
+			renderErrorResponse(r.Context(), w, "invalid request",
+				internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid size"))
+
+			return
+		}
+
+		size = res
+	}
+
+	res, err := t.svc.Trash(r.Context(), internal.TrashParams{
+		Cursor: r.URL.Query().Get("cursor"),
+		Size:   size,
+	})
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "list failed", err)
+
+		return
+	}
+
+	tasks := make([]TrashedTask, len(res.Tasks))
+
+	for i, task := range res.Tasks {
+		tasks[i] = TrashedTask{
+			Task:      newTask(r.Context(), task.Task),
+			DeletedAt: task.DeletedAt,
+		}
+	}
+
+	setPaginationLinks(w, r, res.NextCursor)
+
+	renderResponse(r.Context(), w,
+		&ListTrashResponse{
+			Tasks:      tasks,
+			NextCursor: res.NextCursor,
+		}, http.StatusOK)
+}
+
+func (t *TaskHandler) restore(w http.ResponseWriter, r *http.Request) {
+	id, err := taskID(r)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "invalid request", err)
+
+		return
+	}
+
+	task, err := t.svc.Restore(r.Context(), id)
+	if err != nil {
+		renderErrorResponse(r.Context(), w, "restore failed", err)
+
+		return
+	}
+
+	renderResponse(r.Context(), w,
+		&ReadTasksResponse{
+			Task: newTask(r.Context(), task),
+		},
+		http.StatusOK)
+}
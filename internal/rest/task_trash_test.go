@@ -0,0 +1,219 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTasks_Trash(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2009, 11, 10, 23, 0, 0, 0, time.UTC)
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name           string
+		setup          func(*resttesting.FakeTaskService)
+		target         string
+		output         output
+		expectedParams *internal.TrashParams
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeTaskService) {
+				s.TrashReturns(internal.TrashResults{
+					Tasks: []internal.TrashedTask{
+						{
+							Task:      internal.Task{ID: "1-2-3", Description: "deleted", Priority: internal.PriorityHigh},
+							DeletedAt: deletedAt,
+						},
+					},
+					NextCursor: "abc",
+				}, nil)
+			},
+			"/tasks/trash?size=1&cursor=xyz",
+			output{
+				http.StatusOK,
+				&rest.ListTrashResponse{
+					Tasks: []rest.TrashedTask{
+						{
+							Task:      rest.Task{ID: "1-2-3", Description: "deleted", Priority: "high"},
+							DeletedAt: deletedAt,
+						},
+					},
+					NextCursor: "abc",
+				},
+				&rest.ListTrashResponse{},
+			},
+			&internal.TrashParams{Cursor: "xyz", Size: 1},
+		},
+		{
+			"ERR: 400 size",
+			func(*resttesting.FakeTaskService) {},
+			"/tasks/trash?size=x",
+			output{
+				http.StatusBadRequest,
+				&rest.ErrorResponse{
+					Error: "invalid request",
+				},
+				&rest.ErrorResponse{},
+			},
+			nil,
+		},
+		{
+			"ERR: 503",
+			func(s *resttesting.FakeTaskService) {
+				s.TrashReturns(internal.TrashResults{},
+					internal.NewErrorf(internal.ErrorCodeUnavailable, "trash not supported"))
+			},
+			"/tasks/trash",
+			output{
+				http.StatusServiceUnavailable,
+				&rest.ErrorResponse{
+					Error: "list failed",
+				},
+				&rest.ErrorResponse{},
+			},
+			&internal.TrashParams{Size: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router, httptest.NewRequest(http.MethodGet, tt.target, nil))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if tt.expectedParams == nil {
+				if svc.TrashCallCount() != 0 {
+					t.Fatalf("expected no calls")
+				}
+
+				return
+			}
+
+			if _, params := svc.TrashArgsForCall(0); !cmp.Equal(*tt.expectedParams, params) {
+				t.Fatalf("expected arguments do not match: %s", cmp.Diff(*tt.expectedParams, params))
+			}
+		})
+	}
+}
+
+func TestTasks_Restore(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}
+
+	tests := []struct {
+		name   string
+		setup  func(*resttesting.FakeTaskService)
+		output output
+	}{
+		{
+			"OK: 200",
+			func(s *resttesting.FakeTaskService) {
+				s.RestoreReturns(internal.Task{ID: "1-2-3", Description: "restored", Priority: internal.PriorityLow}, nil)
+			},
+			output{
+				http.StatusOK,
+				&rest.ReadTasksResponse{
+					Task: rest.Task{ID: "1-2-3", Description: "restored", Priority: "low"},
+				},
+				&rest.ReadTasksResponse{},
+			},
+		},
+		{
+			"ERR: 404",
+			func(s *resttesting.FakeTaskService) {
+				s.RestoreReturns(internal.Task{}, internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			output{
+				http.StatusNotFound,
+				&rest.ErrorResponse{
+					Error: "restore failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+		{
+			"ERR: 409",
+			func(s *resttesting.FakeTaskService) {
+				s.RestoreReturns(internal.Task{}, internal.NewErrorf(internal.ErrorCodeConflict, "task already exists"))
+			},
+			output{
+				http.StatusConflict,
+				&rest.ErrorResponse{
+					Error: "restore failed",
+				},
+				&rest.ErrorResponse{},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			//-
+
+			res := doRequest(router,
+				httptest.NewRequest(http.MethodPost, "/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee/restore", nil))
+
+			//-
+
+			assertResponse(t, res, test{tt.output.expected, tt.output.target})
+
+			if tt.output.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.output.expectedStatus, res.StatusCode)
+			}
+
+			if _, id := svc.RestoreArgsForCall(0); id != "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee" {
+				t.Fatalf("expected id do not match: %s", id)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TimeZoneHeader is the header used by clients for indicating the IANA time zone, for example "America/New_York",
+// used for rendering dates and for computing and filtering the tasks due today or in a number of days. The default
+// time zone is used when missing.
+const TimeZoneHeader = "Time-Zone"
+
+type timeZoneKey struct{}
+
+// TimeZone is a middleware that stores the time zone requested by clients in the request context, unknown time
+// zones are rejected. UTC is used by default.
+func TimeZone(next http.Handler) http.Handler {
+	return DefaultTimeZone(time.UTC)(next)
+}
+
+// DefaultTimeZone returns the TimeZone middleware using loc when clients don't request a time zone, the service
+// does not have users so this is the default of every client.
+func DefaultTimeZone(loc *time.Location) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Add("Vary", TimeZoneHeader)
+
+			loc := loc
+
+			if val := r.Header.Get(TimeZoneHeader); val != "" {
+				var err error
+
+				if loc, err = time.LoadLocation(val); err != nil {
+					renderErrorResponse(r.Context(), w, "invalid time zone",
+						internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "time.LoadLocation"))
+
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), timeZoneKey{}, loc)))
+		})
+	}
+}
+
+// currentTime returns the current time in the time zone requested by the client.
+func currentTime(ctx context.Context) time.Time {
+	loc, ok := ctx.Value(timeZoneKey{}).(*time.Location)
+	if !ok {
+		loc = time.UTC
+	}
+
+	return time.Now().In(loc)
+}
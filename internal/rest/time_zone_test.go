@@ -0,0 +1,240 @@
+package rest_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestTimeZone(t *testing.T) {
+	t.Parallel()
+
+	newBool := func(b bool) *bool {
+		return &b
+	}
+
+	newInt := func(i int) *int {
+		return &i
+	}
+
+	due := time.Now().AddDate(0, 0, 3).UTC()
+
+	tests := []struct {
+		name           string
+		timeZone       string
+		expectedStatus int
+		expected       interface{}
+		target         interface{}
+	}{
+		{
+			"OK: time zone",
+			"Pacific/Kiritimati",
+			http.StatusOK,
+			&rest.ReadTasksResponse{
+				Task: rest.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "due",
+					Priority:    "high",
+					Dates:       rest.Dates{Due: due},
+					IsDueToday:  newBool(false),
+					DueInDays:   newInt(3),
+				},
+			},
+			&rest.ReadTasksResponse{},
+		},
+		{
+			"OK: no time zone",
+			"",
+			http.StatusOK,
+			&rest.ReadTasksResponse{
+				Task: rest.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "due",
+					Priority:    "high",
+					Dates:       rest.Dates{Due: due},
+					IsDueToday:  newBool(false),
+					DueInDays:   newInt(3),
+				},
+			},
+			&rest.ReadTasksResponse{},
+		},
+		{
+			"ERR: 400",
+			"Mars/Olympus_Mons",
+			http.StatusBadRequest,
+			&rest.ErrorResponse{
+				Error: "invalid time zone",
+			},
+			&rest.ErrorResponse{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			router.Use(rest.Profiles, rest.TimeZone)
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(
+				internal.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "due",
+					Priority:    internal.PriorityHigh,
+					Dates:       internal.Dates{Due: due},
+				},
+				nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, "/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a", nil)
+			req.Header.Set(rest.AcceptProfileHeader, string(rest.ProfileTaskDue))
+
+			if tt.timeZone != "" {
+				req.Header.Set(rest.TimeZoneHeader, tt.timeZone)
+			}
+
+			res := doRequest(router, req)
+
+			if tt.expectedStatus != res.StatusCode {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			assertResponse(t, res, test{tt.expected, tt.target})
+		})
+	}
+}
+
+func TestTimeZone_List(t *testing.T) {
+	t.Parallel()
+
+	kiritimati, err := time.LoadLocation("Pacific/Kiritimati")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	router := mux.NewRouter()
+	router.Use(rest.TimeZone)
+
+	svc := &resttesting.FakeTaskService{}
+
+	rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+	req := httptest.NewRequest(http.MethodGet, "/tasks?due_today=true", nil)
+	req.Header.Set(rest.TimeZoneHeader, "Pacific/Kiritimati")
+
+	if res := doRequest(router, req); res.StatusCode != http.StatusOK {
+		t.Fatalf("expected code %d, actual %d", http.StatusOK, res.StatusCode)
+	}
+
+	_, params := svc.ListArgsForCall(0)
+	if params.Due == nil {
+		t.Fatalf("expected due filter")
+	}
+
+	// Days start at midnight in the requested time zone.
+	from := params.Due.From.In(kiritimati)
+	if from.Hour() != 0 || from.Minute() != 0 || params.Due.To.Sub(params.Due.From) != 24*time.Hour {
+		t.Fatalf("expected today in Pacific/Kiritimati, got %s - %s", params.Due.From, params.Due.To)
+	}
+}
+
+func TestTimeZone_Dates(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2021, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		timeZone string
+		dates    internal.Dates
+		expected string
+	}{
+		{
+			"OK: default time zone",
+			"",
+			internal.Dates{Due: due},
+			"2021-05-01T19:00:00+09:00",
+		},
+		{
+			"OK: requested time zone",
+			"America/New_York",
+			internal.Dates{Due: due},
+			"2021-05-01T06:00:00-04:00",
+		},
+		{
+			"OK: dates time zone",
+			"America/New_York",
+			internal.Dates{Due: due, TimeZone: "Europe/Paris"},
+			"2021-05-01T12:00:00+02:00",
+		},
+	}
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := mux.NewRouter()
+			router.Use(rest.DefaultTimeZone(tokyo))
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(
+				internal.Task{
+					ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+					Description: "due",
+					Dates:       tt.dates,
+				},
+				nil)
+
+			rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{}).Register(router)
+
+			req := httptest.NewRequest(http.MethodGet, "/tasks/a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a", nil)
+
+			if tt.timeZone != "" {
+				req.Header.Set(rest.TimeZoneHeader, tt.timeZone)
+			}
+
+			res := doRequest(router, req)
+			defer res.Body.Close()
+
+			var actual struct {
+				Task struct {
+					Dates struct {
+						Start string `json:"start"`
+						Due   string `json:"due"`
+					} `json:"dates"`
+				} `json:"task"`
+			}
+
+			if err := json.NewDecoder(res.Body).Decode(&actual); err != nil {
+				t.Fatalf("couldn't decode %s", err)
+			}
+
+			if actual.Task.Dates.Due != tt.expected {
+				t.Fatalf("expected due %s, actual %s", tt.expected, actual.Task.Dates.Due)
+			}
+
+			if actual.Task.Dates.Start != "0001-01-01T00:00:00Z" {
+				t.Fatalf("expected zero start, actual %s", actual.Task.Dates.Start)
+			}
+		})
+	}
+}
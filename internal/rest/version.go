@@ -0,0 +1,90 @@
+package rest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// DeprecationHeader indicates when the version of the API used by the request was deprecated, see RFC 9745.
+	DeprecationHeader = "Deprecation"
+
+	// SunsetHeader indicates when the version of the API used by the request stops being available, see RFC 8594.
+	SunsetHeader = "Sunset"
+
+	versionPrefix = "/api/"
+)
+
+// APIVersion defines a version of the API, its routes are mounted under "/api/<Name>" so breaking changes are
+// introduced in a new version registered side by side with the previous ones.
+type APIVersion struct {
+	Name        string    // "v1" for example, empty for the routes existing before versioning was introduced.
+	Deprecation time.Time // Zero when the version is not deprecated.
+	Sunset      time.Time // Zero when the version is not scheduled to be removed.
+	Successor   string    // Name of the version replacing this one, linked using the "successor-version" relation.
+}
+
+// Prefix returns the path prefix of the routes of the version.
+func (v APIVersion) Prefix() string {
+	if v.Name == "" {
+		return ""
+	}
+
+	return versionPrefix + v.Name
+}
+
+// RegisterVersion returns the router used for registering the routes of the version, the responses of deprecated
+// versions include the Deprecation and Sunset headers as well as the link to the same resource in the successor.
+func RegisterVersion(router *mux.Router, version APIVersion) *mux.Router {
+	var res *mux.Router
+
+	if prefix := version.Prefix(); prefix != "" {
+		res = router.PathPrefix(prefix).Subrouter()
+	} else {
+		res = router.NewRoute().Subrouter()
+	}
+
+	if !version.Deprecation.IsZero() {
+		res.Use(version.deprecate)
+	}
+
+	return res
+}
+
+func (v APIVersion) deprecate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(DeprecationHeader, "@"+strconv.FormatInt(v.Deprecation.Unix(), 10))
+
+		if !v.Sunset.IsZero() {
+			w.Header().Set(SunsetHeader, v.Sunset.UTC().Format(http.TimeFormat))
+		}
+
+		if v.Successor != "" {
+			path := versionPrefix + v.Successor + strings.TrimPrefix(r.URL.Path, v.Prefix())
+
+			w.Header().Add(LinkHeader, "<"+path+`>; rel="successor-version"`)
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// unversionedTemplate returns the path template without the version prefix, "/api/v1/tasks/{id}" is returned as
+// "/tasks/{id}".
+func unversionedTemplate(template string) string {
+	if !strings.HasPrefix(template, versionPrefix) {
+		return template
+	}
+
+	path := strings.TrimPrefix(template, versionPrefix)
+
+	if i := strings.Index(path, "/"); i > 0 {
+		return path[i:]
+	}
+
+	return template
+}
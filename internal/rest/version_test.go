@@ -0,0 +1,97 @@
+package rest_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestRegisterVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name                string
+		target              string
+		expectedStatus      int
+		expectedDeprecation string
+		expectedSunset      string
+		expectedLink        string
+	}{
+		{
+			"OK: current version",
+			"/api/v1/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			http.StatusOK,
+			"",
+			"",
+			"",
+		},
+		{
+			"OK: unversioned",
+			"/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			http.StatusOK,
+			"@1760659200",
+			"Fri, 30 Apr 2027 00:00:00 GMT",
+			`</api/v1/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee>; rel="successor-version"`,
+		},
+		{
+			"ERR: unknown version",
+			"/api/v2/tasks/aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			http.StatusNotFound,
+			"",
+			"",
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &resttesting.FakeTaskService{}
+			svc.TaskReturns(internal.Task{ID: "a-b-c", Description: "task"}, nil)
+
+			handler := rest.NewTaskHandler(svc, &resttesting.FakeAvailability{}, rest.Semantics{})
+
+			router := mux.NewRouter()
+
+			for _, version := range []rest.APIVersion{
+				{Name: "v1"},
+				{
+					Deprecation: time.Date(2025, time.October, 17, 0, 0, 0, 0, time.UTC),
+					Sunset:      time.Date(2027, time.April, 30, 0, 0, 0, 0, time.UTC),
+					Successor:   "v1",
+				},
+			} {
+				handler.Register(rest.RegisterVersion(router, version))
+			}
+
+			res := doRequest(router, httptest.NewRequest(http.MethodGet, tt.target, nil))
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.expectedStatus {
+				t.Fatalf("expected code %d, actual %d", tt.expectedStatus, res.StatusCode)
+			}
+
+			if actual := res.Header.Get(rest.DeprecationHeader); actual != tt.expectedDeprecation {
+				t.Fatalf("expected Deprecation %q, actual %q", tt.expectedDeprecation, actual)
+			}
+
+			if actual := res.Header.Get(rest.SunsetHeader); actual != tt.expectedSunset {
+				t.Fatalf("expected Sunset %q, actual %q", tt.expectedSunset, actual)
+			}
+
+			if actual := res.Header.Get(rest.LinkHeader); actual != tt.expectedLink {
+				t.Fatalf("expected Link %q, actual %q", tt.expectedLink, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// wsPingPeriod is how often connections are pinged, it must be shorter than wsPongWait.
+	wsPingPeriod = 30 * time.Second
+
+	// wsPongWait is the time connections are kept open without receiving anything from the client.
+	wsPongWait = 60 * time.Second
+
+	// wsWriteWait is the time available for writing a message.
+	wsWriteWait = 10 * time.Second
+
+	// wsCommandTimeout is the time available for running a command.
+	wsCommandTimeout = 5 * time.Second
+
+	// wsMaxMessageSize is the maximum size, in bytes, of the messages sent by clients.
+	wsMaxMessageSize = 4096
+
+	// wsSendBuffer is the number of messages queued for a connection, connections falling behind are closed.
+	wsSendBuffer = 64
+)
+
+const (
+	// WebSocketSubscribe subscribes to the changes of the tasks in TaskIDs, or to all of them when empty.
+	WebSocketSubscribe = "subscribe"
+
+	// WebSocketUnsubscribe unsubscribes from the changes of the tasks in TaskIDs, or from all of them when empty.
+	WebSocketUnsubscribe = "unsubscribe"
+
+	// WebSocketComplete marks the task in TaskID as done.
+	WebSocketComplete = "complete"
+
+	// WebSocketAck acknowledges the message indicated by Ref was handled.
+	WebSocketAck = "ack"
+
+	// WebSocketChange notifies a task in the subscriptions changed.
+	WebSocketChange = "change"
+
+	// WebSocketError indicates the message indicated by Ref, if any, failed.
+	WebSocketError = "error"
+)
+
+// TaskChanges defines the feed used for receiving the changes to tasks.
+type TaskChanges interface {
+	Subscribe(size int) (<-chan internal.TaskChange, func())
+}
+
+// WebSocketMessage defines the messages exchanged using the WebSocket API, Type indicates which fields are used.
+// Ref is set by clients for correlating the replies to their messages.
+//nolint: tagliatelle
+type WebSocketMessage struct {
+	Type    string      `json:"type"`
+	Ref     string      `json:"ref,omitempty"`
+	TaskIDs []string    `json:"task_ids,omitempty"`
+	TaskID  string      `json:"task_id,omitempty"`
+	Change  *TaskChange `json:"change,omitempty"`
+	Error   string      `json:"error,omitempty"`
+	Code    string      `json:"code,omitempty"`
+}
+
+// TaskChange indicates a task changed, clients read it again when they need its values.
+type TaskChange struct {
+	Kind    string `json:"kind"`
+	ID      string `json:"id"`
+	Version int64  `json:"version,omitempty"`
+}
+
+// WebSocketHandler serves the WebSocket API used by interactive clients for receiving the changes to tasks and
+// running lightweight commands.
+type WebSocketHandler struct {
+	svc      TaskService
+	changes  TaskChanges
+	upgrader websocket.Upgrader
+
+	mu    sync.Mutex
+	conns map[*websocket.Conn]struct{}
+}
+
+// NewWebSocketHandler instantiates the WebSocketHandler.
+func NewWebSocketHandler(svc TaskService, changes TaskChanges) *WebSocketHandler {
+	return &WebSocketHandler{
+		svc:     svc,
+		changes: changes,
+		conns:   make(map[*websocket.Conn]struct{}),
+	}
+}
+
+// Register connects the handlers to the router.
+func (h *WebSocketHandler) Register(r *mux.Router) {
+	r.HandleFunc("/ws", h.serve).Methods(http.MethodGet)
+}
+
+// Close closes the open connections indicating the server is going away, so clients connect to another instance.
+// It's meant to be called when shutting down the HTTP server, which doesn't track upgraded connections.
+func (h *WebSocketHandler) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "shutting down")
+
+	for conn := range h.conns {
+		_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteWait))
+		_ = conn.Close()
+	}
+}
+
+func (h *WebSocketHandler) serve(w http.ResponseWriter, r *http.Request) {
+	// The upgrader replies with an error when the handshake fails.
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+
+	h.mu.Lock()
+	h.conns[conn] = struct{}{}
+	h.mu.Unlock()
+
+	defer func() {
+		h.mu.Lock()
+		delete(h.conns, conn)
+		h.mu.Unlock()
+
+		conn.Close()
+	}()
+
+	changes, unsubscribe := h.changes.Subscribe(wsSendBuffer)
+	defer unsubscribe()
+
+	c := &wsConn{
+		conn:    conn,
+		send:    make(chan WebSocketMessage, wsSendBuffer),
+		done:    make(chan struct{}),
+		taskIDs: make(map[string]struct{}),
+	}
+
+	defer close(c.done)
+
+	go c.write()
+	go c.relay(changes)
+
+	c.read(r.Context(), h.svc)
+}
+
+// wsConn represents a client connection: read handles the messages sent by the client, write sends the queued
+// messages and pings the client, and relay queues the changes matching the subscriptions.
+type wsConn struct {
+	conn *websocket.Conn
+	send chan WebSocketMessage
+	done chan struct{}
+
+	mu      sync.Mutex
+	all     bool
+	taskIDs map[string]struct{}
+}
+
+func (c *wsConn) read(ctx context.Context, svc TaskService) {
+	c.conn.SetReadLimit(wsMaxMessageSize)
+
+	// The HTTP server may have set a deadline before upgrading the connection.
+	_ = c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+
+	for {
+		// Any error reading means the connection is not usable anymore, including the client closing it.
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+
+		_ = c.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+
+		reply := WebSocketMessage{Type: WebSocketError, Error: "invalid message", Code: "invalid_argument"}
+
+		var msg WebSocketMessage
+
+		if err := json.Unmarshal(data, &msg); err == nil {
+			reply = c.handle(ctx, svc, msg)
+		}
+
+		if !c.queue(reply) {
+			return
+		}
+	}
+}
+
+// handle runs the command received from the client and returns the reply.
+func (c *wsConn) handle(ctx context.Context, svc TaskService, msg WebSocketMessage) WebSocketMessage {
+	switch msg.Type {
+	case WebSocketSubscribe:
+		c.subscribe(msg.TaskIDs)
+	case WebSocketUnsubscribe:
+		c.unsubscribe(msg.TaskIDs)
+	case WebSocketComplete:
+		ctx, cancel := context.WithTimeout(ctx, wsCommandTimeout)
+		defer cancel()
+
+		if _, err := svc.Complete(ctx, msg.TaskID); err != nil {
+			return WebSocketMessage{Type: WebSocketError, Ref: msg.Ref, Error: "complete failed", Code: wsErrorCode(err)}
+		}
+	default:
+		return WebSocketMessage{Type: WebSocketError, Ref: msg.Ref, Error: "unknown message type", Code: "invalid_argument"}
+	}
+
+	return WebSocketMessage{Type: WebSocketAck, Ref: msg.Ref}
+}
+
+func (c *wsConn) subscribe(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(ids) == 0 {
+		c.all = true
+
+		return
+	}
+
+	for _, id := range ids {
+		c.taskIDs[id] = struct{}{}
+	}
+}
+
+func (c *wsConn) unsubscribe(ids []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(ids) == 0 {
+		c.all = false
+		c.taskIDs = make(map[string]struct{})
+
+		return
+	}
+
+	for _, id := range ids {
+		delete(c.taskIDs, id)
+	}
+}
+
+func (c *wsConn) subscribed(id string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, ok := c.taskIDs[id]
+
+	return c.all || ok
+}
+
+// queue adds the message to the ones waiting to be sent, when the client falls behind the connection is closed so
+// it connects again and reloads the tasks instead of missing changes.
+func (c *wsConn) queue(msg WebSocketMessage) bool {
+	select {
+	case c.send <- msg:
+		return true
+	default:
+		_ = c.conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too slow"),
+			time.Now().Add(wsWriteWait))
+		_ = c.conn.Close()
+
+		return false
+	}
+}
+
+func (c *wsConn) relay(changes <-chan internal.TaskChange) {
+	for {
+		select {
+		case <-c.done:
+			return
+		case change, ok := <-changes:
+			if !ok {
+				return
+			}
+
+			if !c.subscribed(change.ID) {
+				continue
+			}
+
+			if !c.queue(WebSocketMessage{
+				Type: WebSocketChange,
+				Change: &TaskChange{
+					Kind:    string(change.Kind),
+					ID:      change.ID,
+					Version: change.Version,
+				},
+			}) {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) write() {
+	ticker := time.NewTicker(wsPingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case msg := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+
+			if err := c.conn.WriteJSON(msg); err != nil {
+				_ = c.conn.Close()
+
+				return
+			}
+		case <-ticker.C:
+			if err := c.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait)); err != nil {
+				_ = c.conn.Close()
+
+				return
+			}
+		}
+	}
+}
+
+// wsErrorCode returns the code describing the error to clients.
+func wsErrorCode(err error) string {
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) {
+		return "internal"
+	}
+
+	switch ierr.Code() {
+	case internal.ErrorCodeNotFound:
+		return "not_found"
+	case internal.ErrorCodeInvalidArgument:
+		return "invalid_argument"
+	case internal.ErrorCodeConflict:
+		return "conflict"
+	case internal.ErrorCodeUnavailable:
+		return "unavailable"
+	case internal.ErrorCodeUnknown:
+		fallthrough
+	default:
+		return "internal"
+	}
+}
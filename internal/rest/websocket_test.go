@@ -0,0 +1,173 @@
+package rest_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/rest"
+	"github.com/MarioCarrion/todo-api/internal/rest/resttesting"
+)
+
+func TestWebSocketHandler_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	feed := internal.NewTaskChangeFeed()
+	conn := newWebSocket(t, &resttesting.FakeTaskService{}, feed)
+
+	for _, msg := range []rest.WebSocketMessage{
+		{Type: rest.WebSocketSubscribe, Ref: "1", TaskIDs: []string{"a", "b"}},
+		{Type: rest.WebSocketUnsubscribe, Ref: "2", TaskIDs: []string{"b"}},
+	} {
+		writeWebSocket(t, conn, msg)
+
+		assertWebSocket(t, conn, rest.WebSocketMessage{Type: rest.WebSocketAck, Ref: msg.Ref})
+	}
+
+	// Only the changes to the subscribed tasks are received.
+	feed.Publish(internal.TaskChange{Kind: internal.TaskChangeUpdated, ID: "b", Version: 2})
+	feed.Publish(internal.TaskChange{Kind: internal.TaskChangeUpdated, ID: "a", Version: 3})
+
+	assertWebSocket(t, conn, rest.WebSocketMessage{
+		Type:   rest.WebSocketChange,
+		Change: &rest.TaskChange{Kind: "updated", ID: "a", Version: 3},
+	})
+}
+
+func TestWebSocketHandler_Complete(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		setup    func(*resttesting.FakeTaskService)
+		expected rest.WebSocketMessage
+	}{
+		{
+			"OK",
+			func(s *resttesting.FakeTaskService) {
+				s.CompleteReturns(internal.Task{ID: "a", Description: "task", IsDone: true, Version: 5}, nil)
+			},
+			rest.WebSocketMessage{Type: rest.WebSocketAck, Ref: "1"},
+		},
+		{
+			"ERR: not found",
+			func(s *resttesting.FakeTaskService) {
+				s.CompleteReturns(internal.Task{}, internal.NewErrorf(internal.ErrorCodeNotFound, "not found"))
+			},
+			rest.WebSocketMessage{Type: rest.WebSocketError, Ref: "1", Error: "complete failed", Code: "not_found"},
+		},
+		{
+			"ERR: conflict",
+			func(s *resttesting.FakeTaskService) {
+				s.CompleteReturns(internal.Task{}, internal.NewErrorf(internal.ErrorCodeConflict, "blocked"))
+			},
+			rest.WebSocketMessage{Type: rest.WebSocketError, Ref: "1", Error: "complete failed", Code: "conflict"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := &resttesting.FakeTaskService{}
+			tt.setup(svc)
+
+			conn := newWebSocket(t, svc, internal.NewTaskChangeFeed())
+
+			writeWebSocket(t, conn, rest.WebSocketMessage{Type: rest.WebSocketComplete, Ref: "1", TaskID: "a"})
+
+			assertWebSocket(t, conn, tt.expected)
+
+			if svc.CompleteCallCount() != 1 {
+				t.Fatalf("expected Complete to be called once, got %d", svc.CompleteCallCount())
+			}
+
+			if _, id := svc.CompleteArgsForCall(0); id != "a" {
+				t.Fatalf("expected task a to be completed, got %s", id)
+			}
+
+			if svc.UpdateCallCount() != 0 {
+				t.Fatalf("expected Update not to be called, got %d", svc.UpdateCallCount())
+			}
+		})
+	}
+}
+
+func TestWebSocketHandler_Invalid(t *testing.T) {
+	t.Parallel()
+
+	conn := newWebSocket(t, &resttesting.FakeTaskService{}, internal.NewTaskChangeFeed())
+
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("{")); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	assertWebSocket(t, conn, rest.WebSocketMessage{Type: rest.WebSocketError, Error: "invalid message", Code: "invalid_argument"})
+
+	writeWebSocket(t, conn, rest.WebSocketMessage{Type: "delete", Ref: "1"})
+
+	assertWebSocket(t, conn, rest.WebSocketMessage{
+		Type:  rest.WebSocketError,
+		Ref:   "1",
+		Error: "unknown message type",
+		Code:  "invalid_argument",
+	})
+}
+
+func newWebSocket(t *testing.T, svc rest.TaskService, changes rest.TaskChanges) *websocket.Conn {
+	t.Helper()
+
+	router := mux.NewRouter()
+
+	handler := rest.NewWebSocketHandler(svc, changes)
+	handler.Register(router)
+
+	srv := httptest.NewServer(router)
+
+	conn, _, err := websocket.DefaultDialer.DialContext(context.Background(),
+		"ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	t.Cleanup(func() {
+		handler.Close()
+		conn.Close()
+		srv.Close()
+	})
+
+	return conn
+}
+
+func writeWebSocket(t *testing.T, conn *websocket.Conn, msg rest.WebSocketMessage) {
+	t.Helper()
+
+	if err := conn.WriteJSON(msg); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+}
+
+func assertWebSocket(t *testing.T, conn *websocket.Conn, expected rest.WebSocketMessage) {
+	t.Helper()
+
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	var actual rest.WebSocketMessage
+
+	if err := conn.ReadJSON(&actual); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
@@ -0,0 +1,67 @@
+// Package retention purges the deleted Tasks kept in the trash for longer than the retention period; purging is
+// idempotent so all the instances can run it at the same time.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TrashRepository defines the datastore keeping the deleted Tasks.
+type TrashRepository interface {
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+}
+
+// Purger permanently deletes the Tasks trashed for longer than the retention period.
+type Purger struct {
+	logger    *zap.Logger
+	repo      TrashRepository
+	retention time.Duration
+	interval  time.Duration
+}
+
+// NewPurger instantiates the Purger, Tasks trashed for longer than retention are purged every interval.
+func NewPurger(logger *zap.Logger, repo TrashRepository, retention, interval time.Duration) *Purger {
+	return &Purger{
+		logger:    logger,
+		repo:      repo,
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run purges the Tasks until ctx is canceled, failures are logged and retried in the next interval.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.Purge(ctx); err != nil {
+			p.logger.Warn("Couldn't purge trash", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes the Tasks trashed for longer than the retention period, it returns the number of purged Tasks.
+func (p *Purger) Purge(ctx context.Context) (int64, error) {
+	n, err := p.repo.Purge(ctx, time.Now().UTC().Add(-p.retention))
+	if err != nil {
+		return 0, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Purge")
+	}
+
+	if n > 0 {
+		p.logger.Info("Trash purged", zap.Int64("tasks", n))
+	}
+
+	return n, nil
+}
@@ -0,0 +1,67 @@
+package retention_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/retention"
+)
+
+func TestPurger(t *testing.T) {
+	t.Parallel()
+
+	repo := memory.NewTaskTrash()
+
+	expired := internal.TrashedTask{
+		Task:      internal.Task{ID: "44633fe3-b039-4fb3-a35f-a57fe3c906c7", Description: "expired"},
+		DeletedAt: time.Now().Add(-48 * time.Hour),
+	}
+
+	kept := internal.TrashedTask{
+		Task:      internal.Task{ID: "a2e6a4c8-06a1-4b38-8d0a-2f4ab5e3b2a1", Description: "kept"},
+		DeletedAt: time.Now().Add(-time.Hour),
+	}
+
+	for _, task := range []internal.TrashedTask{expired, kept} {
+		if err := repo.Save(context.Background(), task); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+	}
+
+	purger := retention.NewPurger(zap.NewNop(), repo, 24*time.Hour, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Purges once and returns because ctx is canceled.
+	purger.Run(ctx)
+
+	if _, err := repo.Find(context.Background(), expired.Task.ID); !isNotFound(err) {
+		t.Fatalf("expected purged task, got %v", err)
+	}
+
+	if _, err := repo.Find(context.Background(), kept.Task.ID); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	n, err := purger.Purge(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if n != 0 {
+		t.Fatalf("expected no purged tasks, got %d", n)
+	}
+}
+
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	return err != nil && errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound
+}
@@ -0,0 +1,88 @@
+// Package retry retries operations failing because of transient errors using capped exponential backoff with
+// jitter.
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+)
+
+//nolint: gochecknoglobals
+var (
+	retries = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/retry")).NewInt64Counter(
+		"retry.attempts",
+		metric.WithDescription("Number of times an operation was retried, labeled by operation"),
+	)
+
+	exhausted = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal/retry")).NewInt64Counter(
+		"retry.exhausted",
+		metric.WithDescription("Number of operations that failed after using all their attempts, labeled by operation"),
+	)
+)
+
+// Policy defines how an operation is retried.
+type Policy struct {
+	// Attempts is the maximum number of times the operation is called, including the first one.
+	Attempts int
+
+	// Backoff is the delay before the first retry, it's doubled after each retry up to MaxBackoff. The delay used is
+	// a random value between half and the whole backoff so concurrent callers don't retry at the same time.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+
+	// Retryable indicates whether err is transient, retrying won't help otherwise.
+	Retryable func(err error) bool
+}
+
+// Do calls fn until it succeeds, it fails with an error that is not retryable or the attempts are used; the last
+// error is returned. Retries stop when ctx is done or when its deadline would be exceeded while waiting.
+// operation identifies the operation in the metrics.
+func (p Policy) Do(ctx context.Context, operation string, fn func(ctx context.Context) error) error {
+	backoff := p.Backoff
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !p.Retryable(err) {
+			return err
+		}
+
+		if attempt >= p.Attempts {
+			exhausted.Add(ctx, 1, attribute.String("operation", operation))
+
+			return err
+		}
+
+		wait := jitter(backoff)
+
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		retries.Add(ctx, 1, attribute.String("operation", operation))
+
+		if backoff *= 2; backoff > p.MaxBackoff {
+			backoff = p.MaxBackoff
+		}
+	}
+}
+
+// jitter returns a random duration between half and the whole backoff.
+func jitter(backoff time.Duration) time.Duration {
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return backoff
+	}
+
+	return time.Duration(half + rand.Int63n(half+1)) //nolint: gosec
+}
@@ -0,0 +1,121 @@
+package retry_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal/retry"
+)
+
+var (
+	errTransient = errors.New("transient")
+	errPermanent = errors.New("permanent")
+)
+
+func TestPolicy_Do(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		err   error
+		calls int
+	}
+
+	tests := []struct {
+		name   string
+		ctx    func() (context.Context, context.CancelFunc)
+		errs   []error
+		output output
+	}{
+		{
+			"OK: first attempt",
+			background,
+			nil,
+			output{
+				calls: 1,
+			},
+		},
+		{
+			"OK: after transient errors",
+			background,
+			[]error{errTransient, errTransient},
+			output{
+				calls: 3,
+			},
+		},
+		{
+			"ERR: not retryable",
+			background,
+			[]error{errPermanent},
+			output{
+				err:   errPermanent,
+				calls: 1,
+			},
+		},
+		{
+			"ERR: attempts exhausted",
+			background,
+			[]error{errTransient, errTransient, errTransient, errTransient},
+			output{
+				err:   errTransient,
+				calls: 3,
+			},
+		},
+		{
+			"ERR: deadline exceeded while waiting",
+			func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Millisecond)
+			},
+			[]error{errTransient},
+			output{
+				err:   errTransient,
+				calls: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := retry.Policy{
+				Attempts:   3,
+				Backoff:    10 * time.Millisecond,
+				MaxBackoff: 15 * time.Millisecond,
+				Retryable: func(err error) bool {
+					return errors.Is(err, errTransient)
+				},
+			}
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			var calls int
+
+			err := policy.Do(ctx, "test", func(context.Context) error {
+				calls++
+
+				if calls <= len(tt.errs) {
+					return tt.errs[calls-1]
+				}
+
+				return nil
+			})
+
+			if !errors.Is(err, tt.output.err) {
+				t.Fatalf("expected %v error, got %v", tt.output.err, err)
+			}
+
+			if calls != tt.output.calls {
+				t.Fatalf("expected %d calls, got %d", tt.output.calls, calls)
+			}
+		})
+	}
+}
+
+func background() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
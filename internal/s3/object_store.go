@@ -0,0 +1,54 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// ObjectStore represents the bucket used for storing objects, keys are relative to prefix.
+type ObjectStore struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+// NewObjectStore instantiates the ObjectStore repository.
+func NewObjectStore(client *s3.Client, bucket, prefix string) *ObjectStore {
+	return &ObjectStore{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+// Put stores the object using the key, replacing the existing one.
+func (o *ObjectStore) Put(ctx context.Context, key string, contentType string, body []byte) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "ObjectStore.Put")
+	span.SetAttributes(
+		attribute.String("s3.bucket", o.bucket),
+		attribute.String("s3.key", key),
+	)
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencyS3)()
+
+	if _, err := o.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(o.bucket),
+		Key:           aws.String(path.Join(o.prefix, key)),
+		Body:          bytes.NewReader(body),
+		ContentLength: int64(len(body)),
+		ContentType:   aws.String(contentType),
+	}); err != nil {
+		return internal.WrapDependencyErrorf(err, internal.DependencyS3, internal.ErrorCodeUnknown, "client.PutObject")
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package internal
+
+import (
+	"html"
+	"strings"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Fuzziness defines the maximum number of edits, e.g. inserting, deleting or replacing a character, allowed for
+// a description term to match a word.
+type Fuzziness string
+
+const (
+	// FuzzinessNone indicates terms must match exactly.
+	FuzzinessNone Fuzziness = ""
+
+	// FuzzinessAuto allows no edits for terms up to 2 characters, one edit for terms up to 5 characters and two
+	// edits for longer terms.
+	FuzzinessAuto Fuzziness = "AUTO"
+)
+
+// Validate indicates whether the value is valid or not.
+func (f Fuzziness) Validate() error {
+	switch f {
+	case FuzzinessNone, FuzzinessAuto, "0", "1", "2":
+		return nil
+	}
+
+	return NewErrorf(ErrorCodeInvalidArgument, "must be AUTO, 0, 1 or 2")
+}
+
+// Fuzzy indicates whether terms may match words including edits.
+func (f Fuzziness) Fuzzy() bool {
+	return f != FuzzinessNone && f != "0"
+}
+
+// Distance returns the maximum number of edits allowed for term to match.
+func (f Fuzziness) Distance(term string) int {
+	switch f {
+	case "1":
+		return 1
+	case "2":
+		return 2
+	case FuzzinessAuto:
+		switch n := len([]rune(term)); {
+		case n <= 2:
+			return 0
+		case n <= 5:
+			return 1
+		default:
+			return 2
+		}
+	}
+
+	return 0
+}
+
+//-
+
+const (
+	// DefaultFragmentSize is the fragment size used when highlighting without indicating one.
+	DefaultFragmentSize = 100
+
+	// MaxFragmentSize is the maximum fragment size allowed when highlighting.
+	MaxFragmentSize = 1000
+
+	// MaxFragments is the maximum number of fragments returned for each highlighted description.
+	MaxFragments = 3
+)
+
+// Highlight defines the arguments used for highlighting the matching descriptions. FragmentSize is the approximate
+// number of characters of each fragment, when zero DefaultFragmentSize is used.
+type Highlight struct {
+	FragmentSize int
+}
+
+// Validate indicates whether the fields are valid or not.
+func (h Highlight) Validate() error {
+	if h.FragmentSize < 0 || h.FragmentSize > MaxFragmentSize {
+		return validation.Errors{
+			"fragment_size": NewErrorf(ErrorCodeInvalidArgument, "must be between 0 and %d", MaxFragmentSize),
+		}
+	}
+
+	return nil
+}
+
+// Size returns the fragment size to use.
+func (h Highlight) Size() int {
+	if h.FragmentSize == 0 {
+		return DefaultFragmentSize
+	}
+
+	return h.FragmentSize
+}
+
+// HighlightFragments returns up to MaxFragments fragments of text, of about size characters each, surrounding the
+// words indicated by match; those are wrapped in "<em>" tags and the rest of the text is HTML-escaped. It's meant
+// for datastores that don't highlight results.
+func HighlightFragments(text string, size int, match func(word string) bool) []string {
+	type word struct {
+		start, end int
+		match      bool
+	}
+
+	runes := []rune(text)
+
+	var words []word
+
+	for i := 0; i < len(runes); i++ {
+		if !isWordRune(runes[i]) {
+			continue
+		}
+
+		start := i
+
+		for i < len(runes) && isWordRune(runes[i]) {
+			i++
+		}
+
+		words = append(words, word{
+			start: start,
+			end:   i,
+			match: match(strings.ToLower(string(runes[start:i]))),
+		})
+	}
+
+	var (
+		res  []string
+		last int // Index of the first word not included in previous fragments.
+	)
+
+	for i := 0; i < len(words) && len(res) < MaxFragments; i++ {
+		if i < last || !words[i].match {
+			continue
+		}
+
+		// The fragment is centered on the matching word, expanding it to whole words.
+		from := i
+
+		for margin := (size - (words[i].end - words[i].start)) / 2; from > last; from-- {
+			if words[i].start-words[from-1].start > margin {
+				break
+			}
+		}
+
+		to := i
+
+		for to+1 < len(words) && words[to+1].end-words[from].start <= size {
+			to++
+		}
+
+		var b strings.Builder
+
+		for j := from; j <= to; j++ {
+			if j > from {
+				b.WriteString(html.EscapeString(string(runes[words[j-1].end:words[j].start])))
+			}
+
+			val := html.EscapeString(string(runes[words[j].start:words[j].end]))
+
+			if words[j].match {
+				val = "<em>" + val + "</em>"
+			}
+
+			b.WriteString(val)
+		}
+
+		res = append(res, b.String())
+		last = to + 1
+	}
+
+	return res
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+//-
+
+// Facets defines the number of tasks matching a search, regardless of pagination, by priority and by status; those
+// are meant for rendering filters. Tasks don't keep tags yet, so those are not counted.
+type Facets struct {
+	Priority map[Priority]int64
+	Done     int64
+	Undone   int64
+}
+
+// NewFacets instantiates Facets counting zero tasks for every priority.
+func NewFacets() *Facets {
+	return &Facets{
+		Priority: map[Priority]int64{
+			PriorityNone:   0,
+			PriorityLow:    0,
+			PriorityMedium: 0,
+			PriorityHigh:   0,
+		},
+	}
+}
+
+// Add counts n more tasks with the priority and status.
+func (f *Facets) Add(priority Priority, isDone bool, n int64) {
+	f.Priority[priority] += n
+
+	if isDone {
+		f.Done += n
+	} else {
+		f.Undone += n
+	}
+}
@@ -0,0 +1,134 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestFuzziness_Distance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  internal.Fuzziness
+		term   string
+		output int
+	}{
+		{
+			"OK: none",
+			internal.FuzzinessNone,
+			"groceries",
+			0,
+		},
+		{
+			"OK: fixed",
+			"1",
+			"groceries",
+			1,
+		},
+		{
+			"OK: AUTO short",
+			internal.FuzzinessAuto,
+			"to",
+			0,
+		},
+		{
+			"OK: AUTO medium",
+			internal.FuzzinessAuto,
+			"milk",
+			1,
+		},
+		{
+			"OK: AUTO long",
+			internal.FuzzinessAuto,
+			"groceries",
+			2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tt.input.Distance(tt.term); actual != tt.output {
+				t.Fatalf("expected %d, got %d", tt.output, actual)
+			}
+		})
+	}
+}
+
+func TestHighlightFragments(t *testing.T) {
+	t.Parallel()
+
+	match := func(word string) bool {
+		return word == "milk"
+	}
+
+	tests := []struct {
+		name   string
+		text   string
+		size   int
+		output []string
+	}{
+		{
+			"OK",
+			"Buy Milk & eggs",
+			100,
+			[]string{"Buy <em>Milk</em> &amp; eggs"},
+		},
+		{
+			"OK: fragments",
+			"milk first, then bread, butter, cheese, eggs and flour, finally milk again",
+			20,
+			[]string{"<em>milk</em> first, then", "finally <em>milk</em> again"},
+		},
+		{
+			"OK: no matches",
+			"buy eggs",
+			100,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := internal.HighlightFragments(tt.text, tt.size, match)
+			if !cmp.Equal(tt.output, actual) {
+				t.Fatalf("expected values do not match: %s", cmp.Diff(tt.output, actual))
+			}
+		})
+	}
+}
+
+func TestFacets_Add(t *testing.T) {
+	t.Parallel()
+
+	facets := internal.NewFacets()
+	facets.Add(internal.PriorityHigh, true, 2)
+	facets.Add(internal.PriorityHigh, false, 1)
+	facets.Add(internal.PriorityLow, false, 3)
+
+	expected := &internal.Facets{
+		Priority: map[internal.Priority]int64{
+			internal.PriorityNone:   0,
+			internal.PriorityLow:    3,
+			internal.PriorityMedium: 0,
+			internal.PriorityHigh:   3,
+		},
+		Done:   2,
+		Undone: 4,
+	}
+
+	if !cmp.Equal(expected, facets) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, facets))
+	}
+}
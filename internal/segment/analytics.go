@@ -0,0 +1,68 @@
+// Package segment implements the repository sending product analytics events using the Segment HTTP Tracking API,
+// services implementing the same API, like RudderStack, are supported as well.
+package segment
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Analytics represents the repository used for sending product analytics events.
+type Analytics struct {
+	client   *http.Client
+	endpoint string
+	writeKey string
+}
+
+// NewAnalytics instantiates the Analytics repository, endpoint is the base URL of the API, for example
+// "https://api.segment.io", and writeKey identifies the source receiving the events.
+func NewAnalytics(client *http.Client, endpoint, writeKey string) *Analytics {
+	return &Analytics{
+		client:   client,
+		endpoint: endpoint,
+		writeKey: writeKey,
+	}
+}
+
+// Track sends the event.
+func (a *Analytics) Track(ctx context.Context, event internal.AnalyticsEvent) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Analytics.Track")
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySegment)()
+
+	span.SetAttributes(attribute.String("analytics.event", event.Event))
+
+	var b bytes.Buffer
+
+	if err := json.NewEncoder(&b).Encode(event); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Encode")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/v1/track", &b)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "http.NewRequestWithContext")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(a.writeKey, "")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return internal.WrapDependencyErrorf(err, internal.DependencySegment, internal.ErrorCodeUnknown, "client.Do")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return internal.WrapDependencyErrorf(nil, internal.DependencySegment, internal.ErrorCodeUnknown,
+			"track %d", resp.StatusCode)
+	}
+
+	return nil
+}
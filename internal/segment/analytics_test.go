@@ -0,0 +1,76 @@
+package segment_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/segment"
+)
+
+func TestAnalytics_Track(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		status    int
+		withError bool
+	}{
+		{
+			"OK",
+			http.StatusOK,
+			false,
+		},
+		{
+			"ERR: status",
+			http.StatusBadRequest,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			event := internal.NewTaskCreatedEvent(internal.Task{Priority: internal.PriorityLow}, false)
+
+			var actual internal.AnalyticsEvent
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if key, _, _ := r.BasicAuth(); r.URL.Path != "/v1/track" || key != "write-key" {
+					w.WriteHeader(http.StatusUnauthorized)
+
+					return
+				}
+
+				if err := json.NewDecoder(r.Body).Decode(&actual); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+
+					return
+				}
+
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := segment.NewAnalytics(srv.Client(), srv.URL, "write-key").Track(context.Background(), event)
+			if (err != nil) != tt.withError {
+				t.Fatalf("expected error %t, got %v", tt.withError, err)
+			}
+
+			// Numbers are decoded as float64.
+			event.Properties["description_length"] = float64(0)
+
+			if !cmp.Equal(event, actual) {
+				t.Fatalf("expected event does not match: %s", cmp.Diff(event, actual))
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// IssueRepository defines the datastore listing the open Issues of a repository, GitHub.
+type IssueRepository interface {
+	ListIssues(ctx context.Context, repository string) ([]internal.Issue, error)
+}
+
+// IssueLinkRepository defines the datastore handling persisting IssueLink records.
+type IssueLinkRepository interface {
+	Claim(ctx context.Context, repository string, number int) (bool, error)
+	Release(ctx context.Context, repository string, number int) error
+	Save(ctx context.Context, link internal.IssueLink) error
+}
+
+// IssueImport defines the application service in charge of importing Issues as Tasks.
+type IssueImport struct {
+	issues          IssueRepository
+	links           IssueLinkRepository
+	tasks           *Task
+	repositories    []string
+	labelPriorities map[string]internal.Priority
+}
+
+// NewIssueImport instantiates the IssueImport service, only the Issues of repositories are imported. Tasks use the
+// highest priority in labelPriorities matching the labels of the Issue, medium when none matches.
+func NewIssueImport(issues IssueRepository, links IssueLinkRepository, tasks *Task, repositories []string,
+	labelPriorities map[string]internal.Priority) *IssueImport {
+	return &IssueImport{
+		issues:          issues,
+		links:           links,
+		tasks:           tasks,
+		repositories:    repositories,
+		labelPriorities: labelPriorities,
+	}
+}
+
+// Import creates a Task for each open Issue of the repository not imported yet. Each Issue is claimed before
+// creating its Task, so concurrent imports don't create duplicated Tasks; Issues whose Task was deleted are not
+// imported again.
+func (i *IssueImport) Import(ctx context.Context, repository string) (internal.IssueImport, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "IssueImport.Import")
+	defer span.End()
+
+	if err := internal.ValidateRepository(repository); err != nil {
+		return internal.IssueImport{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "ValidateRepository")
+	}
+
+	if !i.allowed(repository) {
+		return internal.IssueImport{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument,
+			"repository %q is not configured", repository)
+	}
+
+	issues, err := i.issues.ListIssues(ctx, repository)
+	if err != nil {
+		return internal.IssueImport{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "issues.ListIssues")
+	}
+
+	res := internal.IssueImport{Repository: repository}
+
+	for _, issue := range issues {
+		claimed, err := i.links.Claim(ctx, repository, issue.Number)
+		if err != nil {
+			return res, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.Claim")
+		}
+
+		if !claimed {
+			res.Skipped++
+
+			continue
+		}
+
+		if err := i.create(ctx, issue); err != nil {
+			return res, err
+		}
+
+		res.Imported++
+	}
+
+	return res, nil
+}
+
+// create creates the Task of the claimed Issue, the claim is released when it fails so it's imported again.
+func (i *IssueImport) create(ctx context.Context, issue internal.Issue) error {
+	task, err := i.tasks.Create(ctx, internal.CreateParams{
+		Description: issue.Title,
+		Priority:    i.priority(issue.Labels),
+		Dates:       internal.Dates{Due: issue.Due},
+	})
+	if err != nil {
+		_ = i.links.Release(ctx, issue.Repository, issue.Number)
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Create")
+	}
+
+	if err := i.links.Save(ctx, internal.IssueLink{
+		Repository: issue.Repository,
+		Number:     issue.Number,
+		TaskID:     task.ID,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "links.Save")
+	}
+
+	return nil
+}
+
+func (i *IssueImport) allowed(repository string) bool {
+	for _, r := range i.repositories {
+		if r == repository {
+			return true
+		}
+	}
+
+	return false
+}
+
+// priority returns the highest priority matching the labels.
+func (i *IssueImport) priority(labels []string) internal.Priority {
+	res := internal.PriorityMedium
+	matched := false
+
+	for _, label := range labels {
+		priority, ok := i.labelPriorities[label]
+		if !ok {
+			continue
+		}
+
+		if !matched || priority > res {
+			res = priority
+			matched = true
+		}
+	}
+
+	return res
+}
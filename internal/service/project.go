@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// projectTasksPageSize is the number of Tasks read at once when deleting a Project.
+const projectTasksPageSize = 100
+
+// ProjectRepository defines the datastore handling persisting Project records.
+type ProjectRepository interface {
+	Create(ctx context.Context, name string) (internal.Project, error)
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.Project, error)
+	List(ctx context.Context) ([]internal.Project, error)
+	Update(ctx context.Context, id string, name string) error
+}
+
+// Project defines the application service in charge of interacting with Projects.
+type Project struct {
+	repo  ProjectRepository
+	tasks *Task
+}
+
+// NewProject instantiates the Project service, the Tasks of deleted Projects are modified using tasks so the events
+// for them are published.
+func NewProject(repo ProjectRepository, tasks *Task) *Project {
+	return &Project{
+		repo:  repo,
+		tasks: tasks,
+	}
+}
+
+// Create stores a new record.
+func (p *Project) Create(ctx context.Context, name string) (internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Create")
+	defer span.End()
+
+	if err := (internal.Project{Name: name}).Validate(); err != nil {
+		return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "Validate")
+	}
+
+	project, err := p.repo.Create(ctx, name)
+	if err != nil {
+		return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Create")
+	}
+
+	return project, nil
+}
+
+// Delete removes an existing Project from the datastore, its Tasks are deleted or unassigned depending on strategy.
+// Tasks are modified before deleting the Project, so failed calls can be retried.
+func (p *Project) Delete(ctx context.Context, id string, strategy internal.ProjectDeleteStrategy) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Delete")
+	defer span.End()
+
+	if err := strategy.Validate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "strategy.Validate")
+	}
+
+	if _, err := p.repo.Find(ctx, id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	tasks, err := p.listTasks(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if err := p.deleteTask(ctx, id, task.ID, strategy); err != nil {
+			return err
+		}
+	}
+
+	if err := p.repo.Delete(ctx, id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Delete")
+	}
+
+	return nil
+}
+
+// Find gets an existing Project from the datastore.
+func (p *Project) Find(ctx context.Context, id string) (internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Find")
+	defer span.End()
+
+	project, err := p.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Project{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	return project, nil
+}
+
+// List returns all the Projects.
+func (p *Project) List(ctx context.Context) ([]internal.Project, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.List")
+	defer span.End()
+
+	projects, err := p.repo.List(ctx)
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.List")
+	}
+
+	return projects, nil
+}
+
+// Update updates an existing Project in the datastore.
+func (p *Project) Update(ctx context.Context, id string, name string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Project.Update")
+	defer span.End()
+
+	if err := (internal.Project{ID: id, Name: name}).Validate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "Validate")
+	}
+
+	if err := p.repo.Update(ctx, id, name); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Update")
+	}
+
+	return nil
+}
+
+// deleteTask deletes or unassigns the Task of the deleted Project, the stored values are read again because the
+// listed ones may be behind. Tasks deleted or assigned to other Projects in the meantime are skipped.
+func (p *Project) deleteTask(ctx context.Context, projectID, id string, strategy internal.ProjectDeleteStrategy) error {
+	if strategy == internal.ProjectDeleteCascade {
+		if err := p.tasks.Delete(ctx, id); err != nil && !isNotFound(err) {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Delete")
+		}
+
+		return nil
+	}
+
+	task, err := p.tasks.repo.Find(ctx, id)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	if task.ProjectID != projectID {
+		return nil
+	}
+
+	if err := p.tasks.Update(ctx, task.ID, task.Description, task.Priority, task.Dates, "", task.IsDone); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "tasks.Update")
+	}
+
+	return nil
+}
+
+// listTasks returns all the Tasks of the Project, those are read before modifying any of them because modified
+// Tasks don't belong to the Project anymore and would change the following pages.
+func (p *Project) listTasks(ctx context.Context, id string) ([]internal.Task, error) {
+	var (
+		res    []internal.Task
+		cursor string
+	)
+
+	for {
+		// Read directly, instead of using Task.List, because the limits of the clients don't apply.
+		page, err := p.tasks.read.List(ctx, internal.ListParams{
+			Cursor:    cursor,
+			Size:      projectTasksPageSize,
+			ProjectID: id,
+		})
+		if err != nil {
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "read.List")
+		}
+
+		res = append(res, page.Tasks...)
+
+		if cursor = page.NextCursor; cursor == "" {
+			return res, nil
+		}
+	}
+}
+
+func isNotFound(err error) bool {
+	var ierr *internal.Error
+
+	return errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeNotFound
+}
+
+func isInvalidArgument(err error) bool {
+	var ierr *internal.Error
+
+	return errors.As(err, &ierr) && ierr.Code() == internal.ErrorCodeInvalidArgument
+}
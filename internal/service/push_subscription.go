@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// PushSubscriptionRepository defines the datastore handling persisting PushSubscription records.
+type PushSubscriptionRepository interface {
+	Delete(ctx context.Context, id string) error
+	Save(ctx context.Context, sub internal.PushSubscription) error
+}
+
+// PushSubscription defines the application service in charge of interacting with PushSubscriptions.
+type PushSubscription struct {
+	repo PushSubscriptionRepository
+}
+
+// NewPushSubscription instantiates the PushSubscription service.
+func NewPushSubscription(repo PushSubscriptionRepository) *PushSubscription {
+	return &PushSubscription{
+		repo: repo,
+	}
+}
+
+// Register stores the subscription, replacing the existing one using the same endpoint.
+func (p *PushSubscription) Register(ctx context.Context, sub internal.PushSubscription) (internal.PushSubscription, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.Register")
+	defer span.End()
+
+	if err := sub.Validate(); err != nil {
+		return internal.PushSubscription{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "Validate")
+	}
+
+	sub.ID = internal.NewPushSubscriptionID(sub.Endpoint)
+
+	if err := p.repo.Save(ctx, sub); err != nil {
+		return internal.PushSubscription{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Save")
+	}
+
+	return sub, nil
+}
+
+// Unregister removes the subscription, no notifications are delivered to it anymore.
+func (p *PushSubscription) Unregister(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "PushSubscription.Unregister")
+	defer span.End()
+
+	if err := p.repo.Delete(ctx, id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Delete")
+	}
+
+	return nil
+}
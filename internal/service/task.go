@@ -2,13 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"time"
 
-	"github.com/mercari/go-circuitbreaker"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
 	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/breaker"
 )
 
 // TaskRepository defines the datastore handling persisting Task records.
@@ -16,12 +17,28 @@ type TaskRepository interface {
 	Create(ctx context.Context, dates internal.CreateParams) (internal.Task, error)
 	Delete(ctx context.Context, id string) error
 	Find(ctx context.Context, id string) (internal.Task, error)
-	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, isDone bool) error
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
+	Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error
+	Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error)
+}
+
+// TaskReadRepository defines the datastore used for reading Task records, it may be the same TaskRepository or
+// a read model projected from the task events.
+type TaskReadRepository interface {
+	Find(ctx context.Context, id string) (internal.Task, error)
+	List(ctx context.Context, params internal.ListParams) (internal.ListResults, error)
 }
 
 // TaskSearchRepository defines the datastore handling searching Task records.
 type TaskSearchRepository interface {
 	Search(ctx context.Context, args internal.SearchParams) (internal.SearchResults, error)
+	Suggest(ctx context.Context, params internal.SuggestParams) ([]internal.Suggestion, error)
+}
+
+// TaskVersionRepository defines the datastore used for reading previous versions of Task records, those are used
+// for resolving conflicting updates.
+type TaskVersionRepository interface {
+	FindVersion(ctx context.Context, id string, version int64) (internal.Task, error)
 }
 
 // TaskMessageBrokerRepository defines the datastore handling persisting Searchable Task records.
@@ -29,32 +46,98 @@ type TaskMessageBrokerRepository interface {
 	Created(ctx context.Context, task internal.Task) error
 	Deleted(ctx context.Context, id string) error
 	Updated(ctx context.Context, task internal.Task) error
+	Completed(ctx context.Context, task internal.Task) error
+	Reopened(ctx context.Context, task internal.Task) error
+}
+
+// AnalyticsRepository defines the sink receiving product analytics events.
+type AnalyticsRepository interface {
+	Track(ctx context.Context, event internal.AnalyticsEvent) error
 }
 
 // Task defines the application service in charge of interacting with Tasks.
 type Task struct {
 	repo      TaskRepository
+	read      TaskReadRepository
 	search    TaskSearchRepository
 	msgBroker TaskMessageBrokerRepository
-	cb        *circuitbreaker.CircuitBreaker
+	uow       UnitOfWork
+	limits    internal.QueryLimits
+	ids       internal.IDGenerator
+	versions  TaskVersionRepository
+	analytics AnalyticsRepository
+	projects  ProjectRepository
+	deps      TaskDependencyRepository
+	trash     TaskTrashRepository
+	flags     *internal.CompatFlagSet
+	cb        *breaker.Breaker
+}
+
+// TaskConfig defines the collaborators used by the Task service, only Logger, Repo, Read, Search and MessageBroker
+// are required.
+type TaskConfig struct {
+	Logger *zap.Logger
+
+	// Repo is used for modifying Tasks, Read for reading them and Search for searching them.
+	Repo   TaskRepository
+	Read   TaskReadRepository
+	Search TaskSearchRepository
+
+	MessageBroker TaskMessageBrokerRepository
+
+	// UnitOfWork is used for the calls that must be atomic, when nil those use Repo without a transaction.
+	UnitOfWork UnitOfWork
+
+	Limits internal.QueryLimits
+
+	// IDs generates the ids of new Tasks, when nil the datastore assigns them.
+	IDs internal.IDGenerator
+
+	// Versions are used for resolving conflicting updates, when nil those fail without details.
+	Versions TaskVersionRepository
+
+	// Analytics tracks product analytics events for the clients consenting to it, when not nil.
+	Analytics AnalyticsRepository
+
+	// Projects are the ones Tasks can be assigned to, when nil those are not validated; assigning them is only
+	// allowed by Flags, when nil no flag is enabled.
+	Projects ProjectRepository
+	Flags    *internal.CompatFlagSet
+
+	// Dependencies prevent completing Tasks blocked by unfinished Tasks, when nil dependencies are not supported.
+	Dependencies TaskDependencyRepository
+
+	// Trash keeps the deleted Tasks, when nil those are deleted permanently.
+	Trash TaskTrashRepository
 }
 
-// NewTask ...
-func NewTask(logger *zap.Logger, repo TaskRepository, search TaskSearchRepository, msgBroker TaskMessageBrokerRepository) *Task {
+// NewTask instantiates the Task service.
+func NewTask(conf TaskConfig) *Task {
+	uow := conf.UnitOfWork
+	if uow == nil {
+		uow = nonTransactionalUnitOfWork{repo: conf.Repo, projects: conf.Projects, deps: conf.Dependencies}
+	}
+
+	flags := conf.Flags
+	if flags == nil {
+		flags = internal.NewCompatFlagSet(internal.CompatFlags{})
+	}
+
 	return &Task{
-		repo:      repo,
-		search:    search,
-		msgBroker: msgBroker,
-		cb: circuitbreaker.New(
-			circuitbreaker.WithOpenTimeout(time.Minute*2),
-			circuitbreaker.WithTripFunc(circuitbreaker.NewTripFuncConsecutiveFailures(3)),
-			circuitbreaker.WithOnStateChangeHookFn(func(oldState, newState circuitbreaker.State) {
-				logger.Info("state changed",
-					zap.String("old", string(oldState)),
-					zap.String("new", string(newState)),
-				)
-			}),
-		),
+		repo:      conf.Repo,
+		read:      conf.Read,
+		search:    conf.Search,
+		msgBroker: conf.MessageBroker,
+		uow:       uow,
+		limits:    conf.Limits,
+		ids:       conf.IDs,
+		versions:  conf.Versions,
+		analytics: conf.Analytics,
+		projects:  conf.Projects,
+		deps:      conf.Dependencies,
+		trash:     conf.Trash,
+		flags:     flags,
+		cb:        breaker.New(conf.Logger, "search", internal.DependencyNone, time.Minute*2),
 	}
 }
 
@@ -63,22 +146,139 @@ func (t *Task) By(ctx context.Context, args internal.SearchParams) (_ internal.S
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.By")
 	defer span.End()
 
-	if !t.cb.Ready() {
-		return internal.SearchResults{}, internal.NewErrorf(internal.ErrorCodeUnknown, "service not available")
+	if err := args.Validate(); err != nil {
+		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "args.Validate")
+	}
+
+	if err := t.limits.ValidateSearch(args); err != nil {
+		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "limits.ValidateSearch")
 	}
 
-	defer func() {
-		err = t.cb.Done(ctx, err)
-	}()
+	start := time.Now()
 
-	res, err := t.search.Search(ctx, args)
+	var res internal.SearchResults
+
+	err = t.cb.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = t.search.Search(ctx, args)
+
+		return err //nolint: wrapcheck
+	})
 	if err != nil {
 		return internal.SearchResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "search")
 	}
 
+	t.track(ctx, internal.NewSearchPerformedEvent(args, res, time.Since(start)))
+
+	return res, nil
+}
+
+// Suggest returns the Tasks whose descriptions match the text typed so far.
+func (t *Task) Suggest(ctx context.Context, params internal.SuggestParams) (_ []internal.Suggestion, err error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Suggest")
+	defer span.End()
+
+	if err := params.Validate(); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
+
+	if err := t.limits.ValidateSuggest(params); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "limits.ValidateSuggest")
+	}
+
+	var res []internal.Suggestion
+
+	err = t.cb.Do(ctx, func(ctx context.Context) (err error) {
+		res, err = t.search.Suggest(ctx, params)
+
+		return err //nolint: wrapcheck
+	})
+	if err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "search.Suggest")
+	}
+
 	return res, nil
 }
 
+// suggestionSize is the number of similar Tasks searched for each keyword when proposing values.
+const suggestionSize = 10
+
+// SuggestValues proposes a priority and a due date for the Task using the Tasks with similar descriptions, the Task
+// itself is not changed.
+func (t *Task) SuggestValues(ctx context.Context, task internal.Task) (_ internal.TaskSuggestions, err error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.SuggestValues")
+	defer span.End()
+
+	keywords := internal.SuggestionKeywords(task.Description)
+	if len(keywords) == 0 {
+		return internal.TaskSuggestions{}, nil
+	}
+
+	var similar []internal.Task
+
+	err = t.cb.Do(ctx, func(ctx context.Context) error {
+		// Tasks matching more keywords are included more than once, so they weigh more.
+		for _, keyword := range keywords {
+			keyword := keyword
+
+			res, err := t.search.Search(ctx, internal.SearchParams{
+				Description: &keyword,
+				Size:        suggestionSize,
+			})
+			if err != nil {
+				return err //nolint: wrapcheck
+			}
+
+			similar = append(similar, res.Tasks...)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return internal.TaskSuggestions{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "search")
+	}
+
+	return internal.NewTaskSuggestions(task, similar, time.Now()), nil
+}
+
+// Clone stores a new record copying the description, priority, dates and project of an existing Task, the new Task
+// is not done.
+func (t *Task) Clone(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Clone")
+	defer span.End()
+
+	var task internal.Task
+
+	// The source is read from repo, instead of read, so recently modified Tasks are cloned as they are; both calls
+	// are part of the same unit of work.
+	if err := t.uow.Do(ctx, func(ctx context.Context, repos TxRepositories) error {
+		orig, err := repos.Task().Find(ctx, id)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+		}
+
+		if task, err = repos.Task().Create(ctx, internal.CreateParams{
+			ID:          t.newID(),
+			Description: orig.Description,
+			Priority:    orig.Priority,
+			Dates:       orig.Dates,
+			ProjectID:   orig.ProjectID,
+		}); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Create")
+		}
+
+		return nil
+	}); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "uow.Do")
+	}
+
+	// XXX: Transactions will be revisited in future episodes.
+	_ = t.msgBroker.Created(ctx, task) // XXX: Ignoring errors on purpose
+
+	t.track(ctx, internal.NewTaskCreatedEvent(task, true))
+
+	return task, nil
+}
+
 // Create stores a new record.
 func (t *Task) Create(ctx context.Context, params internal.CreateParams) (internal.Task, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Create")
@@ -88,6 +288,12 @@ func (t *Task) Create(ctx context.Context, params internal.CreateParams) (intern
 		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
 	}
 
+	if err := t.validateProject(ctx, t.projects, params.ProjectID); err != nil {
+		return internal.Task{}, err
+	}
+
+	params.ID = t.newID()
+
 	task, err := t.repo.Create(ctx, params)
 	if err != nil {
 		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Create")
@@ -96,14 +302,28 @@ func (t *Task) Create(ctx context.Context, params internal.CreateParams) (intern
 	// XXX: Transactions will be revisited in future episodes.
 	_ = t.msgBroker.Created(ctx, task) // XXX: Ignoring errors on purpose
 
+	t.track(ctx, internal.NewTaskCreatedEvent(task, false))
+
 	return task, nil
 }
 
-// Delete removes an existing Task from the datastore.
+// Delete removes an existing Task from the datastore, when supported it's moved to the trash so it can be restored
+// until it's purged.
 func (t *Task) Delete(ctx context.Context, id string) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Delete")
 	defer span.End()
 
+	if t.trash != nil {
+		if err := t.moveToTrash(ctx, id); err != nil {
+			return err
+		}
+
+		// XXX: Transactions will be revisited in future episodes.
+		_ = t.msgBroker.Deleted(ctx, id) // XXX: Ignoring errors on purpose
+
+		return nil
+	}
+
 	// XXX: We will revisit the number of received arguments in future episodes.
 	if err := t.repo.Delete(ctx, id); err != nil {
 		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "Delete")
@@ -115,13 +335,34 @@ func (t *Task) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// List returns a page of Tasks, the cursor in the results is used for requesting the next page.
+func (t *Task) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.List")
+	defer span.End()
+
+	if err := params.Validate(); err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
+
+	if err := t.limits.ValidateList(params); err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "limits.ValidateList")
+	}
+
+	res, err := t.read.List(ctx, params)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "read.List")
+	}
+
+	return res, nil
+}
+
 // Task gets an existing Task from the datastore.
 func (t *Task) Task(ctx context.Context, id string) (internal.Task, error) {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Task")
 	defer span.End()
 
 	// XXX: We will revisit the number of received arguments in future episodes.
-	task, err := t.repo.Find(ctx, id)
+	task, err := t.read.Find(ctx, id)
 	if err != nil {
 		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "Find")
 	}
@@ -129,15 +370,43 @@ func (t *Task) Task(ctx context.Context, id string) (internal.Task, error) {
 	return task, nil
 }
 
-// Update updates an existing Task in the datastore.
+// Update updates an existing Task in the datastore. When the Task changed since the version expected by ctx an
+// internal.TaskConflictError is returned, unless ctx requests merging the changes, see internal.NewContextWithMerge.
+// Tasks blocked by unfinished Tasks can't be completed, unless ctx requests it, see
+// internal.NewContextWithIgnoreBlockers.
 //nolint: lll
-func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, isDone bool) error {
+func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
 	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Update")
 	defer span.End()
 
+	if err := dates.Validate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "dates.Validate")
+	}
+
+	if err := t.validateProject(ctx, t.projects, projectID); err != nil {
+		return err
+	}
+
+	if isDone {
+		if err := t.validateBlockers(ctx, t.repo, t.deps, id, nil); err != nil {
+			return err
+		}
+	}
+
 	// XXX: We will revisit the number of received arguments in future episodes.
-	if err := t.repo.Update(ctx, id, description, priority, dates, isDone); err != nil {
-		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Update")
+	if err := t.repo.Update(ctx, id, description, priority, dates, projectID, isDone); err != nil {
+		yours := internal.Task{
+			ID:          id,
+			Description: description,
+			Priority:    priority,
+			Dates:       dates,
+			ProjectID:   projectID,
+			IsDone:      isDone,
+		}
+
+		if err := t.resolveConflict(ctx, err, yours); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Update")
+		}
 	}
 
 	{
@@ -151,3 +420,154 @@ func (t *Task) Update(ctx context.Context, id string, description string, priori
 
 	return nil
 }
+
+// Upsert creates a new Task using the received id or replaces the existing one, it indicates whether the Task was
+// created.
+//nolint: lll
+func (t *Task) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Upsert")
+	defer span.End()
+
+	params := internal.CreateParams{
+		Description: description,
+		Priority:    priority,
+		Dates:       dates,
+		ProjectID:   projectID,
+	}
+
+	if err := params.Validate(); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
+
+	if err := t.validateProject(ctx, t.projects, projectID); err != nil {
+		return false, err
+	}
+
+	if isDone {
+		if err := t.validateBlockers(ctx, t.repo, t.deps, id, nil); err != nil {
+			return false, err
+		}
+	}
+
+	task := internal.Task{
+		ID:          id,
+		Description: description,
+		Priority:    priority,
+		Dates:       dates,
+		ProjectID:   projectID,
+		IsDone:      isDone,
+	}
+
+	created, err := t.repo.Upsert(ctx, id, description, priority, dates, projectID, isDone)
+	if err != nil {
+		if err := t.resolveConflict(ctx, err, task); err != nil {
+			return false, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Upsert")
+		}
+	}
+
+	// The stored values include the merged changes, if any, and the times assigned when storing the Task.
+	if stored, err := t.repo.Find(ctx, id); err == nil {
+		task = stored
+	}
+
+	// XXX: Transactions will be revisited in future episodes.
+	if created {
+		_ = t.msgBroker.Created(ctx, task) // XXX: Ignoring errors on purpose
+	} else {
+		_ = t.msgBroker.Updated(ctx, task) // XXX: Ignoring errors on purpose
+	}
+
+	return created, nil
+}
+
+// resolveConflict returns err unless it's caused by a Task that changed since the version expected by ctx, in that
+// case the changes are merged, when requested, or an internal.TaskConflictError is returned. Conflicts are returned
+// as they are when the expected version is not available anymore.
+func (t *Task) resolveConflict(ctx context.Context, err error, yours internal.Task) error {
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeConflict || t.versions == nil {
+		return err
+	}
+
+	expected, ok := internal.ExpectedVersionFromContext(ctx)
+	if !ok {
+		return err
+	}
+
+	base, ferr := t.versions.FindVersion(ctx, yours.ID, expected)
+	if ferr != nil {
+		return err
+	}
+
+	theirs, ferr := t.repo.Find(ctx, yours.ID)
+	if ferr != nil {
+		return err
+	}
+
+	yours.Version = expected
+
+	conflict := internal.TaskConflict{
+		Base:   base,
+		Theirs: theirs,
+		Yours:  yours,
+	}
+
+	if merged, ok := conflict.Merge(); ok && internal.MergeFromContext(ctx) {
+		// The merged values are only stored when there were no other changes since reading the current version.
+		ctx = internal.NewContextWithExpectedVersion(ctx, theirs.Version)
+
+		if err := t.repo.Update(ctx, merged.ID, merged.Description, merged.Priority, merged.Dates, merged.ProjectID,
+			merged.IsDone); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Update")
+		}
+
+		return nil
+	}
+
+	return internal.WrapErrorf(&internal.TaskConflictError{Conflict: conflict}, internal.ErrorCodeConflict, "task changed")
+}
+
+// track sends the product analytics event when the client consents to it, errors are ignored because analytics
+// must never affect requests.
+func (t *Task) track(ctx context.Context, event internal.AnalyticsEvent) {
+	if t.analytics == nil || !internal.AnalyticsConsentFromContext(ctx) {
+		return
+	}
+
+	_ = t.analytics.Track(ctx, event)
+}
+
+// validateProject indicates whether the Project the Task is assigned to exists in projects and assigning Projects is
+// enabled, empty ids don't assign any.
+func (t *Task) validateProject(ctx context.Context, projects ProjectRepository, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	if !t.flags.Flags().WriteProjects {
+		return internal.NewErrorf(internal.ErrorCodeInvalidArgument, "projects are not enabled")
+	}
+
+	if projects == nil {
+		return nil
+	}
+
+	if _, err := projects.Find(ctx, id); err != nil {
+		if isNotFound(err) {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "project not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "projects.Find")
+	}
+
+	return nil
+}
+
+// newID returns the id of a new Task, it's empty when assigned by the datastore.
+func (t *Task) newID() string {
+	if t.ids == nil {
+		return ""
+	}
+
+	return t.ids.NewID()
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// errBatchFailed indicates at least one patch failed, the errors are included in the results.
+var errBatchFailed = errors.New("batch failed")
+
+// BatchUpdate applies the patches in a single unit of work, either all of them are applied or none; the results
+// include the error of each patch that failed. Datastores not supporting transactions validate all the patches
+// before applying them, so only failures saving them may leave some applied.
+func (t *Task) BatchUpdate(ctx context.Context, patches internal.TaskPatches) (internal.BatchUpdateResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.BatchUpdate")
+	defer span.End()
+
+	if err := patches.Validate(); err != nil {
+		return internal.BatchUpdateResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "patches.Validate")
+	}
+
+	completing := make(map[string]struct{})
+
+	for _, patch := range patches {
+		if patch.IsDone != nil && *patch.IsDone {
+			completing[patch.ID] = struct{}{}
+		}
+	}
+
+	res := internal.BatchUpdateResults{
+		Results: make([]internal.BatchUpdateResult, len(patches)),
+	}
+
+	// All the patches are validated before saving any of them.
+	if err := t.uow.Do(ctx, func(ctx context.Context, repos TxRepositories) error {
+		tasks := make([]internal.Task, len(patches))
+
+		var failed bool
+
+		for i, patch := range patches {
+			if tasks[i], res.Results[i].Err = t.patchTask(ctx, repos, patch, completing); res.Results[i].Err != nil {
+				failed = true
+			}
+		}
+
+		if failed {
+			return errBatchFailed
+		}
+
+		for i, task := range tasks {
+			if err := repos.Task().Update(ctx, task.ID, task.Description, task.Priority, task.Dates, task.ProjectID,
+				task.IsDone); err != nil {
+				res.Results[i].Err = internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Update")
+
+				return errBatchFailed
+			}
+		}
+
+		return nil
+	}); err != nil {
+		if errors.Is(err, errBatchFailed) {
+			return res, nil
+		}
+
+		return internal.BatchUpdateResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "uow.Do")
+	}
+
+	res.Applied = true
+
+	for i, patch := range patches {
+		// XXX: This will be improved when Kafka events are introduced in future episodes
+		task, err := t.repo.Find(ctx, patch.ID)
+		if err != nil {
+			continue
+		}
+
+		res.Results[i].Task = task
+
+		// XXX: Transactions will be revisited in future episodes.
+		_ = t.msgBroker.Updated(ctx, task) // XXX: Ignoring errors on purpose
+	}
+
+	return res, nil
+}
+
+// patchTask returns the Task matching the patch including the patched fields, those are validated like when
+// updating the Task. Projects and blockers are read using the repositories bound to the transaction, so they can't
+// change before the patches are saved.
+func (t *Task) patchTask(ctx context.Context, repos TxRepositories, patch internal.TaskPatch,
+	completing map[string]struct{}) (internal.Task, error) {
+	orig, err := repos.Task().Find(ctx, patch.ID)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	task := patch.Apply(orig)
+
+	if err := task.Validate(); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "task.Validate")
+	}
+
+	if task.ProjectID != orig.ProjectID {
+		var projects ProjectRepository
+		if t.projects != nil {
+			projects = repos.Project()
+		}
+
+		if err := t.validateProject(ctx, projects, task.ProjectID); err != nil {
+			return internal.Task{}, err
+		}
+	}
+
+	if task.IsDone && !orig.IsDone {
+		var deps TaskDependencyRepository
+		if t.deps != nil {
+			deps = repos.TaskDependency()
+		}
+
+		if err := t.validateBlockers(ctx, repos.Task(), deps, task.ID, completing); err != nil {
+			return internal.Task{}, err
+		}
+	}
+
+	return task, nil
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Complete marks an existing Task as done, the time it was completed is kept until it's reopened; completing a done
+// Task keeps it as it is. Tasks blocked by unfinished Tasks can't be completed, unless ctx requests it, see
+// internal.NewContextWithIgnoreBlockers.
+func (t *Task) Complete(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Complete")
+	defer span.End()
+
+	return t.setDone(ctx, id, true)
+}
+
+// Reopen marks an existing done Task as not done, reopening a Task that is not done keeps it as it is.
+func (t *Task) Reopen(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Reopen")
+	defer span.End()
+
+	return t.setDone(ctx, id, false)
+}
+
+// setDone updates the done state of the Task keeping the rest of its values, those must not change in the meantime:
+// when ctx does not expect a version already, the one found is expected. The dedicated event is published after
+// the one indicating the Task was updated.
+func (t *Task) setDone(ctx context.Context, id string, done bool) (internal.Task, error) {
+	task, err := t.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	if task.IsDone == done {
+		return task, nil
+	}
+
+	if done {
+		if err := t.validateBlockers(ctx, t.repo, t.deps, id, nil); err != nil {
+			return internal.Task{}, err
+		}
+	}
+
+	if _, ok := internal.ExpectedVersionFromContext(ctx); !ok && task.Version > 0 {
+		ctx = internal.NewContextWithExpectedVersion(ctx, task.Version)
+	}
+
+	yours := task
+	yours.IsDone = done
+
+	if err := t.repo.Update(ctx, id, task.Description, task.Priority, task.Dates, task.ProjectID, done); err != nil {
+		if err := t.resolveConflict(ctx, err, yours); err != nil {
+			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Update")
+		}
+	}
+
+	task, err = t.repo.Find(ctx, id)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	// XXX: Transactions will be revisited in future episodes.
+	_ = t.msgBroker.Updated(ctx, task) // XXX: Ignoring errors on purpose
+
+	if done {
+		_ = t.msgBroker.Completed(ctx, task) // XXX: Ignoring errors on purpose
+	} else {
+		_ = t.msgBroker.Reopened(ctx, task) // XXX: Ignoring errors on purpose
+	}
+
+	return task, nil
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskDependencyRepository defines the datastore handling persisting the dependencies between Task records.
+type TaskDependencyRepository interface {
+	Blocked(ctx context.Context, id string) ([]string, error)
+	Blockers(ctx context.Context, id string) ([]string, error)
+	Create(ctx context.Context, dep internal.TaskDependency) error
+	Delete(ctx context.Context, dep internal.TaskDependency) error
+}
+
+// AddDependency stores a new dependency between two existing Tasks, dependencies creating a cycle are rejected
+// because the Tasks involved would never be completed. Adding an existing dependency does nothing.
+func (t *Task) AddDependency(ctx context.Context, dep internal.TaskDependency) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.AddDependency")
+	defer span.End()
+
+	if err := dep.Validate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "dep.Validate")
+	}
+
+	if err := t.dependenciesAvailable(); err != nil {
+		return err
+	}
+
+	if _, err := t.repo.Find(ctx, dep.BlockedID); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	if _, err := t.repo.Find(ctx, dep.BlockerID); err != nil {
+		if isNotFound(err) {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "blocker not found")
+		}
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	// The new dependency creates a cycle when the blocked Task already blocks, directly or not, the blocker.
+	visited := map[string]struct{}{dep.BlockerID: {}}
+	pending := []string{dep.BlockerID}
+
+	for len(pending) > 0 {
+		id := pending[0]
+		pending = pending[1:]
+
+		blockers, err := t.deps.Blockers(ctx, id)
+		if err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deps.Blockers")
+		}
+
+		for _, blocker := range blockers {
+			if blocker == dep.BlockedID {
+				return internal.NewErrorf(internal.ErrorCodeConflict, "dependency creates a cycle")
+			}
+
+			if _, ok := visited[blocker]; !ok {
+				visited[blocker] = struct{}{}
+				pending = append(pending, blocker)
+			}
+		}
+	}
+
+	if err := t.deps.Create(ctx, dep); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deps.Create")
+	}
+
+	return nil
+}
+
+// RemoveDependency removes an existing dependency between two Tasks.
+func (t *Task) RemoveDependency(ctx context.Context, dep internal.TaskDependency) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.RemoveDependency")
+	defer span.End()
+
+	if err := dep.Validate(); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "dep.Validate")
+	}
+
+	if err := t.dependenciesAvailable(); err != nil {
+		return err
+	}
+
+	if err := t.deps.Delete(ctx, dep); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deps.Delete")
+	}
+
+	return nil
+}
+
+// Dependencies returns the Tasks blocking the Task and the ones blocked by it, deleted Tasks are not included.
+func (t *Task) Dependencies(ctx context.Context, id string) (internal.TaskDependencies, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Dependencies")
+	defer span.End()
+
+	if err := t.dependenciesAvailable(); err != nil {
+		return internal.TaskDependencies{}, err
+	}
+
+	if _, err := t.read.Find(ctx, id); err != nil {
+		return internal.TaskDependencies{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "read.Find")
+	}
+
+	blockers, err := t.deps.Blockers(ctx, id)
+	if err != nil {
+		return internal.TaskDependencies{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deps.Blockers")
+	}
+
+	blocked, err := t.deps.Blocked(ctx, id)
+	if err != nil {
+		return internal.TaskDependencies{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deps.Blocked")
+	}
+
+	var res internal.TaskDependencies
+
+	if res.BlockedBy, err = t.findTasks(ctx, blockers); err != nil {
+		return internal.TaskDependencies{}, err
+	}
+
+	if res.Blocks, err = t.findTasks(ctx, blocked); err != nil {
+		return internal.TaskDependencies{}, err
+	}
+
+	return res, nil
+}
+
+// validateBlockers indicates whether the Task can be completed, that is all the Tasks blocking it, according to
+// deps, are done or being completed along with it, as indicated by completing; Tasks are read using repo. Tasks
+// already done, and the ones completed regardless of their blockers as requested by ctx, are not validated.
+func (t *Task) validateBlockers(ctx context.Context, repo TaskRepository, deps TaskDependencyRepository, id string,
+	completing map[string]struct{}) error {
+	if deps == nil || internal.IgnoreBlockersFromContext(ctx) {
+		return nil
+	}
+
+	if task, err := repo.Find(ctx, id); err == nil && task.IsDone {
+		return nil
+	}
+
+	blockers, err := deps.Blockers(ctx, id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "deps.Blockers")
+	}
+
+	var unfinished int
+
+	for _, blocker := range blockers {
+		if _, ok := completing[blocker]; ok {
+			continue
+		}
+
+		task, err := repo.Find(ctx, blocker)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+		}
+
+		if !task.IsDone {
+			unfinished++
+		}
+	}
+
+	if unfinished > 0 {
+		return internal.NewErrorf(internal.ErrorCodeConflict, "task blocked by %d unfinished tasks", unfinished)
+	}
+
+	return nil
+}
+
+// findTasks returns the Tasks matching the ids, missing ones are skipped.
+func (t *Task) findTasks(ctx context.Context, ids []string) ([]internal.Task, error) {
+	res := []internal.Task{}
+
+	for _, id := range ids {
+		task, err := t.read.Find(ctx, id)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+
+			return nil, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "read.Find")
+		}
+
+		res = append(res, task)
+	}
+
+	return res, nil
+}
+
+func (t *Task) dependenciesAvailable() error {
+	if t.deps == nil {
+		return internal.NewErrorf(internal.ErrorCodeUnavailable, "dependencies not supported")
+	}
+
+	return nil
+}
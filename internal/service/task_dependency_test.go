@@ -0,0 +1,170 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/memory"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+func TestTask_AddDependency(t *testing.T) {
+	t.Parallel()
+
+	// Dependencies are indicated as [blocker, blocked] using the indexes of the tasks.
+	type dependency [2]int
+
+	tests := []struct {
+		name     string
+		existing []dependency
+		input    dependency
+		output   internal.ErrorCode
+		blockers []int // Blockers of the blocked task once added.
+	}{
+		{
+			"OK: independent tasks",
+			nil,
+			dependency{0, 1},
+			internal.ErrorCodeUnknown,
+			[]int{0},
+		},
+		{
+			"OK: diamond",
+			[]dependency{{0, 1}, {0, 2}, {1, 3}},
+			dependency{2, 3},
+			internal.ErrorCodeUnknown,
+			[]int{1, 2},
+		},
+		{
+			"OK: existing dependency",
+			[]dependency{{0, 1}, {1, 2}},
+			dependency{1, 2},
+			internal.ErrorCodeUnknown,
+			[]int{1},
+		},
+		{
+			"OK: transitive dependency",
+			[]dependency{{0, 1}, {1, 2}},
+			dependency{0, 2},
+			internal.ErrorCodeUnknown,
+			[]int{0, 1},
+		},
+		{
+			"ERR: self-loop",
+			nil,
+			dependency{0, 0},
+			internal.ErrorCodeInvalidArgument,
+			nil,
+		},
+		{
+			"ERR: direct cycle",
+			[]dependency{{0, 1}},
+			dependency{1, 0},
+			internal.ErrorCodeConflict,
+			nil,
+		},
+		{
+			"ERR: transitive cycle",
+			[]dependency{{0, 1}, {1, 2}, {2, 3}},
+			dependency{3, 0},
+			internal.ErrorCodeConflict,
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			store := memory.NewTask()
+			deps := memory.NewTaskDependency()
+
+			svc := service.NewTask(service.TaskConfig{
+				Logger:       zap.NewNop(),
+				Repo:         store,
+				Read:         store,
+				Search:       store,
+				Dependencies: deps,
+			})
+
+			ids := make([]string, 4)
+
+			for i := range ids {
+				task, err := store.Create(ctx, internal.CreateParams{
+					Description: "task",
+					Priority:    internal.PriorityLow,
+				})
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+
+				ids[i] = task.ID
+			}
+
+			newDependency := func(d dependency) internal.TaskDependency {
+				return internal.TaskDependency{BlockerID: ids[d[0]], BlockedID: ids[d[1]]}
+			}
+
+			for _, d := range tt.existing {
+				if err := svc.AddDependency(ctx, newDependency(d)); err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+			}
+
+			//-
+
+			err := svc.AddDependency(ctx, newDependency(tt.input))
+
+			//-
+
+			if tt.blockers == nil {
+				var ierr *internal.Error
+				if !errors.As(err, &ierr) || ierr.Code() != tt.output {
+					t.Fatalf("expected error code %d, got %v", tt.output, err)
+				}
+
+				// Rejected dependencies are not stored.
+				blockers, _ := deps.Blockers(ctx, ids[tt.input[1]])
+
+				for _, blocker := range blockers {
+					if blocker == ids[tt.input[0]] {
+						t.Fatalf("expected dependency not stored")
+					}
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected := make([]string, 0, len(tt.blockers))
+
+			for _, i := range tt.blockers {
+				expected = append(expected, ids[i])
+			}
+
+			sort.Strings(expected)
+
+			actual, err := deps.Blockers(ctx, ids[tt.input[1]])
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskTrashRepository defines the datastore handling persisting the deleted Task records until those are purged.
+type TaskTrashRepository interface {
+	Delete(ctx context.Context, id string) error
+	Find(ctx context.Context, id string) (internal.TrashedTask, error)
+	List(ctx context.Context, params internal.TrashParams) (internal.TrashResults, error)
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	Save(ctx context.Context, task internal.TrashedTask) error
+}
+
+// Trash returns a page of deleted Tasks, the most recently deleted first; the cursor in the results is used for
+// requesting the next page.
+func (t *Task) Trash(ctx context.Context, params internal.TrashParams) (internal.TrashResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Trash")
+	defer span.End()
+
+	if err := params.Validate(); err != nil {
+		return internal.TrashResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "params.Validate")
+	}
+
+	if err := t.limits.ValidateTrash(params); err != nil {
+		return internal.TrashResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "limits.ValidateTrash")
+	}
+
+	if err := t.trashAvailable(); err != nil {
+		return internal.TrashResults{}, err
+	}
+
+	res, err := t.trash.List(ctx, params)
+	if err != nil {
+		return internal.TrashResults{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "trash.List")
+	}
+
+	return res, nil
+}
+
+// Restore moves a deleted Task back from the trash, it's restored without Project when the one it was assigned to
+// can't be assigned anymore. Tasks created again using the same id since they were deleted are not replaced.
+func (t *Task) Restore(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Restore")
+	defer span.End()
+
+	if err := t.trashAvailable(); err != nil {
+		return internal.Task{}, err
+	}
+
+	trashed, err := t.trash.Find(ctx, id)
+	if err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "trash.Find")
+	}
+
+	if _, err := t.repo.Find(ctx, id); err == nil {
+		return internal.Task{}, internal.NewErrorf(internal.ErrorCodeConflict, "task already exists")
+	} else if !isNotFound(err) {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	task := trashed.Task
+
+	if err := t.validateProject(ctx, t.projects, task.ProjectID); err != nil {
+		if !isInvalidArgument(err) {
+			return internal.Task{}, err
+		}
+
+		task.ProjectID = ""
+	}
+
+	// Upserting, instead of creating, keeps the id and the completion; it's also supported by datastores keeping
+	// the history of deleted Tasks.
+	if _, err := t.repo.Upsert(ctx, task.ID, task.Description, task.Priority, task.Dates, task.ProjectID,
+		task.IsDone); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Upsert")
+	}
+
+	if err := t.trash.Delete(ctx, id); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "trash.Delete")
+	}
+
+	if stored, err := t.repo.Find(ctx, id); err == nil {
+		task = stored
+	}
+
+	// XXX: Transactions will be revisited in future episodes.
+	_ = t.msgBroker.Created(ctx, task) // XXX: Ignoring errors on purpose
+
+	return task, nil
+}
+
+// moveToTrash saves the Task in the trash and then deletes it, when deleting fails it's removed from the trash
+// so failed calls can be retried.
+func (t *Task) moveToTrash(ctx context.Context, id string) error {
+	task, err := t.repo.Find(ctx, id)
+	if err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Find")
+	}
+
+	// Times are truncated to the microsecond, the precision supported by all the datastores.
+	if err := t.trash.Save(ctx, internal.TrashedTask{
+		Task:      task,
+		DeletedAt: time.Now().UTC().Truncate(time.Microsecond),
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "trash.Save")
+	}
+
+	if err := t.repo.Delete(ctx, id); err != nil {
+		_ = t.trash.Delete(ctx, id) // XXX: Ignoring errors on purpose, those are purged eventually.
+
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "repo.Delete")
+	}
+
+	return nil
+}
+
+func (t *Task) trashAvailable() error {
+	if t.trash == nil {
+		return internal.NewErrorf(internal.ErrorCodeUnavailable, "trash not supported")
+	}
+
+	return nil
+}
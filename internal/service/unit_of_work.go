@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+)
+
+// UnitOfWork defines the datastore running multiple repository calls in a single transaction.
+type UnitOfWork interface {
+	// Do runs fn using repositories bound to a new transaction, the transaction is committed when fn returns no
+	// error and rolled back otherwise.
+	Do(ctx context.Context, fn func(ctx context.Context, repos TxRepositories) error) error
+}
+
+// TxRepositories defines the repositories bound to the transaction of a UnitOfWork, repositories used for other
+// records, like an outbox or an audit log, are added here so they are modified in the same transaction.
+type TxRepositories interface {
+	Task() TaskRepository
+	Project() ProjectRepository
+	TaskDependency() TaskDependencyRepository
+}
+
+// nonTransactionalUnitOfWork runs fn using the original repositories, it's used when the datastore does not
+// support transactions.
+type nonTransactionalUnitOfWork struct {
+	repo     TaskRepository
+	projects ProjectRepository
+	deps     TaskDependencyRepository
+}
+
+func (n nonTransactionalUnitOfWork) Do(ctx context.Context, fn func(context.Context, TxRepositories) error) error {
+	return fn(ctx, n)
+}
+
+func (n nonTransactionalUnitOfWork) Task() TaskRepository {
+	return n.repo
+}
+
+func (n nonTransactionalUnitOfWork) Project() ProjectRepository {
+	return n.projects
+}
+
+func (n nonTransactionalUnitOfWork) TaskDependency() TaskDependencyRepository {
+	return n.deps
+}
@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"encoding/base64"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// cursor defines the keyset used for paginating records, records are sorted by creation time and then by id or,
+// when sorting by urgency, by completion, then by urgency and then by id. At is the creation time, the urgency or,
+// when sorting by time, the requested time.
+type cursor struct {
+	Sort internal.Sort
+	Done bool
+	At   int64
+	ID   string
+}
+
+func decodeCursor(sort internal.Sort, val string) (cursor, error) {
+	if val == "" {
+		if sort.Descending() {
+			return cursor{Sort: sort, At: math.MaxInt64}, nil
+		}
+
+		return cursor{Sort: sort, At: math.MinInt64}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 4)
+	if len(split) != 4 || split[0] != string(sort) {
+		return cursor{}, internal.NewErrorf(internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	done, err := strconv.ParseBool(split[1])
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	at, err := strconv.ParseInt(split[2], 10, 64)
+	if err != nil {
+		return cursor{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return cursor{
+		Sort: sort,
+		Done: done,
+		At:   at,
+		ID:   split[3],
+	}, nil
+}
+
+func (c cursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join([]string{
+		string(c.Sort),
+		strconv.FormatBool(c.Done),
+		strconv.FormatInt(c.At, 10),
+		c.ID,
+	}, "|")))
+}
@@ -0,0 +1,47 @@
+-- Times are stored as microseconds since the Unix epoch, in UTC.
+CREATE TABLE IF NOT EXISTS tasks (
+  id           TEXT PRIMARY KEY,
+  description  TEXT NOT NULL,
+  priority     INTEGER NOT NULL DEFAULT 0 CHECK (priority BETWEEN 0 AND 3),
+  start_date   INTEGER,
+  due_date     INTEGER,
+  time_zone    TEXT NOT NULL DEFAULT '',
+  project_id   TEXT NOT NULL DEFAULT '',
+  done         INTEGER NOT NULL DEFAULT 0,
+  urgency_at   INTEGER NOT NULL,
+  completed_at INTEGER,
+  created_at   INTEGER NOT NULL,
+  updated_at   INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS tasks_created_at_id_idx ON tasks (created_at, id);
+
+CREATE INDEX IF NOT EXISTS tasks_urgency_idx ON tasks (done, urgency_at, id);
+
+CREATE TABLE IF NOT EXISTS projects (
+  id         TEXT PRIMARY KEY,
+  name       TEXT NOT NULL,
+  created_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS task_dependencies (
+  blocked_id TEXT NOT NULL,
+  blocker_id TEXT NOT NULL,
+  PRIMARY KEY (blocked_id, blocker_id)
+);
+
+CREATE INDEX IF NOT EXISTS task_dependencies_blocker_id_idx ON task_dependencies (blocker_id);
+
+CREATE TABLE IF NOT EXISTS tasks_trash (
+  id          TEXT PRIMARY KEY,
+  description TEXT NOT NULL,
+  priority    INTEGER NOT NULL DEFAULT 0,
+  start_date  INTEGER,
+  due_date    INTEGER,
+  time_zone   TEXT NOT NULL DEFAULT '',
+  project_id  TEXT NOT NULL DEFAULT '',
+  done        INTEGER NOT NULL DEFAULT 0,
+  deleted_at  INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS tasks_trash_deleted_at_id_idx ON tasks_trash (deleted_at, id);
@@ -0,0 +1,175 @@
+// Package sqlite implements the repositories using a SQLite database, it's meant for running the service without
+// any external dependency, PostgreSQL should be used otherwise.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	_ "embed" // Required for embedding the schema.
+	"math"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+//go:embed schema.sql
+var schema string
+
+// Migrate creates the tables and indexes that do not exist yet, and adds the columns missing in databases created
+// by previous versions.
+func Migrate(ctx context.Context, db *sql.DB) error {
+	if _, err := db.ExecContext(ctx, schema); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "db.ExecContext")
+	}
+
+	for _, column := range []struct {
+		name       string
+		definition string
+	}{
+		{"time_zone", `time_zone TEXT NOT NULL DEFAULT ''`},
+		{"project_id", `project_id TEXT NOT NULL DEFAULT ''`},
+		{"completed_at", `completed_at INTEGER`},
+		{"updated_at", `updated_at INTEGER`},
+	} {
+		var n int
+
+		if err := db.QueryRowContext(ctx,
+			`SELECT COUNT(*) FROM pragma_table_info('tasks') WHERE name = ?`, column.name).Scan(&n); err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "select table info")
+		}
+
+		if n > 0 {
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, `ALTER TABLE tasks ADD COLUMN `+column.definition); err != nil {
+			return wrapErrorf(err, internal.ErrorCodeUnknown, "add %s column", column.name)
+		}
+	}
+
+	// Tasks stored before updated_at was added were last updated when created, as far as it's known.
+	if _, err := db.ExecContext(ctx, `UPDATE tasks SET updated_at = created_at WHERE updated_at IS NULL`); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "update updated_at")
+	}
+
+	// Indexes on added columns are created once those exist.
+	if _, err := db.ExecContext(ctx, `CREATE INDEX IF NOT EXISTS tasks_project_id_idx ON tasks (project_id)`); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "create project_id index")
+	}
+
+	return nil
+}
+
+func newNullTime(t time.Time) sql.NullInt64 {
+	return sql.NullInt64{
+		Int64: t.UnixMicro(),
+		Valid: !t.IsZero(),
+	}
+}
+
+func newTime(v sql.NullInt64) time.Time {
+	if !v.Valid {
+		return time.Time{}
+	}
+
+	return time.UnixMicro(v.Int64).UTC()
+}
+
+// completedAtUpdate sets the completion time when the task is done for the first time, and clears it when it is not
+// done anymore; its arguments are whether the task is done and the current time.
+const completedAtUpdate = `CASE WHEN NOT ? THEN NULL WHEN done THEN completed_at ELSE ? END`
+
+func newCompletedAt(done bool, now time.Time) sql.NullInt64 {
+	if !done {
+		return sql.NullInt64{}
+	}
+
+	return newNullTime(now)
+}
+
+// completedAt returns the completion time of the task, which is only kept while the task is done.
+func completedAt(done bool, v sql.NullInt64) time.Time {
+	if !done {
+		return time.Time{}
+	}
+
+	return newTime(v)
+}
+
+// newUrgency returns the urgency of a task: the due date moved earlier depending on the priority, three days for
+// high and one day for medium; tasks without due date go last.
+func newUrgency(priority internal.Priority, due time.Time) int64 {
+	if due.IsZero() {
+		due = time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	switch priority {
+	case internal.PriorityHigh:
+		due = due.Add(-3 * 24 * time.Hour)
+	case internal.PriorityMedium:
+		due = due.Add(-24 * time.Hour)
+	}
+
+	return due.UnixMicro()
+}
+
+// wrapErrorf returns a wrapped error caused by SQLite, see internal.WrapDependencyErrorf.
+func wrapErrorf(orig error, code internal.ErrorCode, format string, a ...interface{}) error {
+	return internal.WrapDependencyErrorf(orig, internal.DependencySQLite, code, format, a...)
+}
+
+// dueCondition selects the tasks matching the arguments returned by newDueArgs.
+const dueCondition = `(NOT ? OR (NOT done AND due_date >= ? AND due_date < ?))`
+
+// newDueArgs returns the arguments of dueCondition, unbounded ends use dates no task is due at.
+func newDueArgs(r *internal.DueRange) []interface{} {
+	if r == nil {
+		return []interface{}{false, 0, 0}
+	}
+
+	var from int64 = math.MinInt64
+	if !r.From.IsZero() {
+		from = r.From.UnixMicro()
+	}
+
+	var to int64 = math.MaxInt64
+	if !r.To.IsZero() {
+		to = r.To.UnixMicro()
+	}
+
+	return []interface{}{true, from, to}
+}
+
+// createdCondition and updatedCondition select the tasks matching the arguments returned by newTimeRangeArgs.
+const (
+	createdCondition = `(NOT ? OR (created_at >= ? AND created_at < ?))`
+	updatedCondition = `(NOT ? OR (updated_at >= ? AND updated_at < ?))`
+)
+
+// newTimeRangeArgs returns the arguments of createdCondition and updatedCondition, unbounded ends use the extreme
+// times.
+func newTimeRangeArgs(r *internal.TimeRange) []interface{} {
+	if r == nil {
+		return []interface{}{false, 0, 0}
+	}
+
+	var from int64 = math.MinInt64
+	if !r.From.IsZero() {
+		from = r.From.UnixMicro()
+	}
+
+	var to int64 = math.MaxInt64
+	if !r.To.IsZero() {
+		to = r.To.UnixMicro()
+	}
+
+	return []interface{}{true, from, to}
+}
+
+// projectCondition selects the tasks matching the arguments returned by newProjectArgs.
+const projectCondition = `(? = '' OR project_id = ?)`
+
+// newProjectArgs returns the arguments of projectCondition, empty ids select all the tasks.
+func newProjectArgs(id string) []interface{} {
+	return []interface{}{id, id}
+}
@@ -0,0 +1,401 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// Task represents the repository used for interacting with Task records.
+type Task struct {
+	db *sql.DB
+}
+
+// NewTask instantiates the Task repository.
+func NewTask(db *sql.DB) *Task {
+	return &Task{
+		db: db,
+	}
+}
+
+// Create inserts a new task record.
+func (t *Task) Create(ctx context.Context, params internal.CreateParams) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Create")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	id := params.ID
+	if id == "" {
+		id = uuid.NewString()
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	if _, err := t.db.ExecContext(ctx,
+		`INSERT INTO tasks (id, description, priority, start_date, due_date, time_zone, project_id, urgency_at, created_at,
+		updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		id,
+		params.Description,
+		params.Priority,
+		newNullTime(params.Dates.Start),
+		newNullTime(params.Dates.Due),
+		params.Dates.TimeZone,
+		params.ProjectID,
+		newUrgency(params.Priority, params.Dates.Due),
+		now.UnixMicro(),
+		now.UnixMicro(),
+	); err != nil {
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "insert task")
+	}
+
+	return internal.Task{
+		ID:          id,
+		Description: params.Description,
+		Priority:    params.Priority,
+		Dates:       params.Dates,
+		ProjectID:   params.ProjectID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// Delete deletes the existing record matching the id.
+func (t *Task) Delete(ctx context.Context, id string) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Delete")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	res, err := t.db.ExecContext(ctx, `DELETE FROM tasks WHERE id = ?`, id)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete task")
+	}
+
+	return notFound(res)
+}
+
+// Find returns the requested task by searching its id.
+func (t *Task) Find(ctx context.Context, id string) (internal.Task, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Find")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	row := t.db.QueryRowContext(ctx,
+		`SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+		updated_at FROM tasks WHERE id = ?`, id)
+
+	var (
+		completed        sql.NullInt64
+		created, updated int64
+	)
+
+	task, err := scanTask(row, &completed, &created, &updated)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return internal.Task{}, internal.WrapErrorf(err, internal.ErrorCodeNotFound, "task not found")
+		}
+
+		return internal.Task{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select task")
+	}
+
+	task.CompletedAt = completedAt(task.IsDone, completed)
+	task.CreatedAt = time.UnixMicro(created).UTC()
+	task.UpdatedAt = time.UnixMicro(updated).UTC()
+
+	return task, nil
+}
+
+// Update updates the existing record with new values.
+// nolint: lll
+func (t *Task) Update(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Update")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	now := time.Now()
+
+	res, err := t.db.ExecContext(ctx,
+		`UPDATE tasks SET description = ?, priority = ?, start_date = ?, due_date = ?, time_zone = ?, project_id = ?, done = ?,
+		urgency_at = ?, completed_at = `+completedAtUpdate+`, updated_at = ?
+		WHERE id = ?`,
+		description,
+		priority,
+		newNullTime(dates.Start),
+		newNullTime(dates.Due),
+		dates.TimeZone,
+		projectID,
+		isDone,
+		newUrgency(priority, dates.Due),
+		isDone,
+		now.UnixMicro(),
+		now.UnixMicro(),
+		id,
+	)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "update task")
+	}
+
+	return notFound(res)
+}
+
+// Upsert inserts a new task record using the received id or replaces the existing one, it indicates whether the
+// record was inserted.
+// nolint: lll
+func (t *Task) Upsert(ctx context.Context, id string, description string, priority internal.Priority, dates internal.Dates, projectID string, isDone bool) (bool, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.Upsert")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return false, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "db.BeginTx")
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	now := time.Now()
+
+	res, err := tx.ExecContext(ctx,
+		`INSERT INTO tasks (id, description, priority, start_date, due_date, time_zone, project_id, done, urgency_at,
+		completed_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (id) DO NOTHING`,
+		id,
+		description,
+		priority,
+		newNullTime(dates.Start),
+		newNullTime(dates.Due),
+		dates.TimeZone,
+		projectID,
+		isDone,
+		newUrgency(priority, dates.Due),
+		newCompletedAt(isDone, now),
+		now.UnixMicro(),
+		now.UnixMicro(),
+	)
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "insert task")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n == 0 {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE tasks SET description = ?, priority = ?, start_date = ?, due_date = ?, time_zone = ?, project_id = ?, done = ?,
+			urgency_at = ?, completed_at = `+completedAtUpdate+`, updated_at = ?
+			WHERE id = ?`,
+			description,
+			priority,
+			newNullTime(dates.Start),
+			newNullTime(dates.Due),
+			dates.TimeZone,
+			projectID,
+			isDone,
+			newUrgency(priority, dates.Due),
+			isDone,
+			now.UnixMicro(),
+			now.UnixMicro(),
+			id,
+		); err != nil {
+			return false, wrapErrorf(err, internal.ErrorCodeUnknown, "update task")
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, wrapErrorf(err, internal.ErrorCodeUnknown, "tx.Commit")
+	}
+
+	return n == 1, nil
+}
+
+// List returns the tasks sorted by creation time, by urgency or by the requested time, the keyset used for
+// paginating the results is returned as an opaque cursor.
+func (t *Task) List(ctx context.Context, params internal.ListParams) (internal.ListResults, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "Task.List")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	sort := params.Sort
+	if sort != internal.SortUrgency && !sort.ByTime() {
+		sort = internal.SortDefault
+	}
+
+	after, err := decodeCursor(sort, params.Cursor)
+	if err != nil {
+		return internal.ListResults{}, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "decodeCursor")
+	}
+
+	filter := append(newDueArgs(params.Due), newProjectArgs(params.ProjectID)...)
+	filter = append(filter, newTimeRangeArgs(params.Created)...)
+	filter = append(filter, newTimeRangeArgs(params.Updated)...)
+
+	conditions := dueCondition + ` AND ` + projectCondition + ` AND ` + createdCondition + ` AND ` + updatedCondition
+
+	query := `SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+		updated_at, created_at
+		FROM tasks WHERE (created_at, id) > (?, ?) AND ` + conditions + ` ORDER BY created_at, id LIMIT ?`
+	args := append([]interface{}{after.At, after.ID}, filter...)
+
+	if sort == internal.SortUrgency {
+		query = `SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+			updated_at, urgency_at
+			FROM tasks WHERE (done, urgency_at, id) > (?, ?, ?) AND ` + conditions + ` ORDER BY done, urgency_at, id LIMIT ?`
+		args = append([]interface{}{after.Done, after.At, after.ID}, filter...)
+	}
+
+	if sort.ByTime() {
+		// The field is one of the known columns, it's safe to use it in the query.
+		field, op, dir := sort.Field(), ">", ""
+		if sort.Descending() {
+			op, dir = "<", " DESC"
+		}
+
+		query = `SELECT id, description, priority, start_date, due_date, time_zone, project_id, done, completed_at, created_at,
+			updated_at, ` + field + `
+			FROM tasks WHERE (` + field + `, id) ` + op + ` (?, ?) AND ` + conditions + ` ORDER BY ` + field + dir + `, id` + dir + `
+			LIMIT ?`
+		args = append([]interface{}{after.At, after.ID}, filter...)
+	}
+
+	// Counted before selecting the page because rows hold their connection until closed.
+	var total int64
+
+	if params.Total {
+		if err := t.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM tasks WHERE "+conditions,
+			filter...).Scan(&total); err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "count tasks")
+		}
+	}
+
+	// One extra record is requested to determine whether there is a next page.
+	rows, err := t.db.QueryContext(ctx, query, append(args, params.Size+1)...)
+	if err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "select tasks")
+	}
+
+	defer rows.Close()
+
+	var (
+		tasks []internal.Task
+		last  cursor
+		next  string
+	)
+
+	for rows.Next() {
+		if int64(len(tasks)) == params.Size {
+			next = last.String()
+
+			break
+		}
+
+		var (
+			completed            sql.NullInt64
+			created, updated, at int64
+		)
+
+		task, err := scanTask(rows, &completed, &created, &updated, &at)
+		if err != nil {
+			return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "scanTask")
+		}
+
+		task.CompletedAt = completedAt(task.IsDone, completed)
+		task.CreatedAt = time.UnixMicro(created).UTC()
+		task.UpdatedAt = time.UnixMicro(updated).UTC()
+
+		last = cursor{Sort: sort, Done: task.IsDone, At: at, ID: task.ID}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return internal.ListResults{}, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Err")
+	}
+
+	if tasks == nil {
+		tasks = []internal.Task{}
+	}
+
+	return internal.ListResults{
+		Tasks:      tasks,
+		NextCursor: next,
+		Total:      total,
+	}, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanTask scans the task columns followed by the extra ones.
+func scanTask(row scanner, extra ...interface{}) (internal.Task, error) {
+	var (
+		task       internal.Task
+		start, due sql.NullInt64
+	)
+
+	dest := append([]interface{}{
+		&task.ID, &task.Description, &task.Priority, &start, &due, &task.Dates.TimeZone, &task.ProjectID, &task.IsDone,
+	}, extra...)
+
+	if err := row.Scan(dest...); err != nil {
+		return internal.Task{}, err //nolint: wrapcheck
+	}
+
+	task.Dates.Start = newTime(start)
+	task.Dates.Due = newTime(due)
+
+	return task, nil
+}
+
+func notFound(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n == 0 {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "task not found")
+	}
+
+	return nil
+}
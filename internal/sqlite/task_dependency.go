@@ -0,0 +1,145 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+// TaskDependency represents the repository used for interacting with the dependencies between Task records.
+type TaskDependency struct {
+	db *sql.DB
+}
+
+// NewTaskDependency instantiates the TaskDependency repository.
+func NewTaskDependency(db *sql.DB) *TaskDependency {
+	return &TaskDependency{
+		db: db,
+	}
+}
+
+// Blocked returns the ids of the tasks blocked by the task matching the id, sorted by id.
+func (t *TaskDependency) Blocked(ctx context.Context, id string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Blocked")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	return t.selectIDs(ctx,
+		`SELECT blocked_id FROM task_dependencies WHERE blocker_id = ? ORDER BY blocked_id`, id)
+}
+
+// Blockers returns the ids of the tasks blocking the task matching the id, sorted by id.
+func (t *TaskDependency) Blockers(ctx context.Context, id string) ([]string, error) {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Blockers")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	return t.selectIDs(ctx,
+		`SELECT blocker_id FROM task_dependencies WHERE blocked_id = ? ORDER BY blocker_id`, id)
+}
+
+// Create inserts the dependency, existing ones are kept as they are.
+func (t *TaskDependency) Create(ctx context.Context, dep internal.TaskDependency) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Create")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	if err := validateDependencyIDs(dep); err != nil {
+		return err
+	}
+
+	if _, err := t.db.ExecContext(ctx,
+		`INSERT INTO task_dependencies (blocked_id, blocker_id) VALUES (?, ?) ON CONFLICT DO NOTHING`,
+		dep.BlockedID,
+		dep.BlockerID,
+	); err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "insert task dependency")
+	}
+
+	return nil
+}
+
+// Delete deletes the existing dependency.
+func (t *TaskDependency) Delete(ctx context.Context, dep internal.TaskDependency) error {
+	ctx, span := trace.SpanFromContext(ctx).Tracer().Start(ctx, "TaskDependency.Delete")
+	span.SetAttributes(attribute.String("db.system", "sqlite"))
+
+	defer span.End()
+	defer internal.TrackDependency(ctx, internal.DependencySQLite)()
+
+	if err := validateDependencyIDs(dep); err != nil {
+		return err
+	}
+
+	res, err := t.db.ExecContext(ctx,
+		`DELETE FROM task_dependencies WHERE blocked_id = ? AND blocker_id = ?`,
+		dep.BlockedID,
+		dep.BlockerID,
+	)
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "delete task dependency")
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return wrapErrorf(err, internal.ErrorCodeUnknown, "res.RowsAffected")
+	}
+
+	if n == 0 {
+		return internal.NewErrorf(internal.ErrorCodeNotFound, "dependency not found")
+	}
+
+	return nil
+}
+
+func (t *TaskDependency) selectIDs(ctx context.Context, query string, id string) ([]string, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+	}
+
+	rows, err := t.db.QueryContext(ctx, query, id)
+	if err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "select task dependencies")
+	}
+
+	defer rows.Close()
+
+	res := []string{}
+
+	for rows.Next() {
+		var val string
+
+		if err := rows.Scan(&val); err != nil {
+			return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Scan")
+		}
+
+		res = append(res, val)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, wrapErrorf(err, internal.ErrorCodeUnknown, "rows.Err")
+	}
+
+	return res, nil
+}
+
+func validateDependencyIDs(dep internal.TaskDependency) error {
+	for _, id := range []string{dep.BlockerID, dep.BlockedID} {
+		if _, err := uuid.Parse(id); err != nil {
+			return internal.WrapErrorf(err, internal.ErrorCodeInvalidArgument, "invalid uuid")
+		}
+	}
+
+	return nil
+}
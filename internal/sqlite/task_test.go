@@ -0,0 +1,243 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	_ "modernc.org/sqlite"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/service"
+	"github.com/MarioCarrion/todo-api/internal/sqlite"
+	"github.com/MarioCarrion/todo-api/internal/storetesting"
+)
+
+func TestTask_Conformance(t *testing.T) {
+	t.Parallel()
+
+	storetesting.TaskRepository(t, func(tb testing.TB) service.TaskRepository {
+		return sqlite.NewTask(newDB(tb))
+	})
+}
+
+func TestTask_Create(t *testing.T) {
+	t.Parallel()
+
+	store := sqlite.NewTask(newDB(t))
+
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	created, err := store.Create(context.Background(), internal.CreateParams{
+		Description: "test",
+		Priority:    internal.PriorityHigh,
+		Dates:       internal.Dates{Due: due},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	actual, err := store.Find(context.Background(), created.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(created, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(created, actual))
+	}
+}
+
+func TestTask_Update(t *testing.T) {
+	t.Parallel()
+
+	t.Run("OK", func(t *testing.T) {
+		t.Parallel()
+
+		store := sqlite.NewTask(newDB(t))
+
+		created, err := store.Create(context.Background(), internal.CreateParams{Description: "test"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		start := time.Now().UTC().Truncate(time.Microsecond)
+
+		if err := store.Update(context.Background(), created.ID, "changed", internal.PriorityLow,
+			internal.Dates{Start: start}, "", true); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err := store.Find(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := internal.Task{
+			ID:          created.ID,
+			Description: "changed",
+			Priority:    internal.PriorityLow,
+			Dates:       internal.Dates{Start: start},
+			IsDone:      true,
+			CompletedAt: actual.CompletedAt,
+			CreatedAt:   created.CreatedAt,
+			UpdatedAt:   actual.UpdatedAt,
+		}
+
+		if actual.CompletedAt.IsZero() {
+			t.Fatal("expected completion time, got none")
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("ERR: not found", func(t *testing.T) {
+		t.Parallel()
+
+		err := sqlite.NewTask(newDB(t)).Update(context.Background(), "44633fe3-b039-4fb3-a35f-a57fe3c906c7",
+			"changed", internal.PriorityNone, internal.Dates{}, "", false)
+
+		var ierr *internal.Error
+		if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+			t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+		}
+	})
+}
+
+func TestTask_Upsert(t *testing.T) {
+	t.Parallel()
+
+	store := sqlite.NewTask(newDB(t))
+
+	id := "44633fe3-b039-4fb3-a35f-a57fe3c906c7"
+
+	for _, expected := range []bool{true, false} {
+		inserted, err := store.Upsert(context.Background(), id, "upserted", internal.PriorityMedium, internal.Dates{}, "", false)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if inserted != expected {
+			t.Fatalf("expected inserted %t, got %t", expected, inserted)
+		}
+	}
+
+	_, err := store.Upsert(context.Background(), "x", "upserted", internal.PriorityMedium, internal.Dates{}, "", false)
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+		t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+	}
+}
+
+func TestTask_Delete(t *testing.T) {
+	t.Parallel()
+
+	store := sqlite.NewTask(newDB(t))
+
+	created, err := store.Create(context.Background(), internal.CreateParams{Description: "test"})
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if err := store.Delete(context.Background(), created.ID); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	var ierr *internal.Error
+
+	if err := store.Delete(context.Background(), created.ID); !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+		t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+	}
+
+	if _, err := store.Find(context.Background(), created.ID); !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeNotFound {
+		t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+	}
+}
+
+func TestTask_List(t *testing.T) {
+	t.Parallel()
+
+	store := sqlite.NewTask(newDB(t))
+
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	var tasks []internal.Task
+
+	for _, params := range []internal.CreateParams{
+		{Description: "no due date", Priority: internal.PriorityHigh},
+		{Description: "low", Priority: internal.PriorityLow, Dates: internal.Dates{Due: due}},
+		{Description: "high", Priority: internal.PriorityHigh, Dates: internal.Dates{Due: due}},
+	} {
+		task, err := store.Create(context.Background(), params)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	list := func(sort internal.Sort) []internal.Task {
+		var (
+			res    []internal.Task
+			cursor string
+		)
+
+		// Paginating one task at a time.
+		for {
+			page, err := store.List(context.Background(), internal.ListParams{Cursor: cursor, Size: 1, Sort: sort})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			res = append(res, page.Tasks...)
+
+			if cursor = page.NextCursor; cursor == "" {
+				return res
+			}
+		}
+	}
+
+	if actual := list(internal.SortDefault); !cmp.Equal(tasks, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(tasks, actual))
+	}
+
+	expected := []internal.Task{tasks[2], tasks[1], tasks[0]}
+
+	if actual := list(internal.SortUrgency); !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+
+	_, err := store.List(context.Background(), internal.ListParams{Cursor: "x", Size: 1})
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+		t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+	}
+}
+
+func newDB(tb testing.TB) *sql.DB {
+	tb.Helper()
+
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		tb.Fatalf("Couldn't open DB: %s", err)
+	}
+
+	// Each connection to ":memory:" is a different database.
+	db.SetMaxOpenConns(1)
+
+	tb.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	if err := sqlite.Migrate(context.Background(), db); err != nil {
+		tb.Fatalf("Couldn't migrate: %s", err)
+	}
+
+	return db
+}
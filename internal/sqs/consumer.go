@@ -0,0 +1,198 @@
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+const (
+	// waitTime is the maximum long polling time supported by SQS.
+	waitTime = 20 * time.Second
+
+	// maxVisibilityTimeout is the maximum delay used before retrying a message that failed.
+	maxVisibilityTimeout = 15 * time.Minute
+)
+
+// Event represents a task event received from the queue.
+type Event struct {
+	Type  string
+	Value internal.Task
+}
+
+// Handler processes events, messages are deleted from the queue only when it returns nil.
+type Handler func(ctx context.Context, evt Event) error
+
+// Consumer receives task events published to SNS and delivered to a SQS queue, it uses long polling and extends
+// the visibility timeout of the messages while those are being processed.
+type Consumer struct {
+	client            *sqs.Client
+	queueURL          string
+	visibilityTimeout time.Duration
+}
+
+// NewConsumer instantiates the Consumer, visibilityTimeout is how long messages are hidden from other consumers
+// after being received, it is extended while the message is being processed.
+func NewConsumer(client *sqs.Client, queueURL string, visibilityTimeout time.Duration) *Consumer {
+	return &Consumer{
+		client:            client,
+		queueURL:          queueURL,
+		visibilityTimeout: visibilityTimeout,
+	}
+}
+
+// Consume receives messages until ctx is canceled, messages are deleted after handler processes them successfully,
+// otherwise those become visible again after a delay that increases every time they are received.
+func (c *Consumer) Consume(ctx context.Context, handler Handler) error {
+	for {
+		res, err := c.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:            aws.String(c.queueURL),
+			MaxNumberOfMessages: 10,
+			WaitTimeSeconds:     int32(waitTime.Seconds()),
+			VisibilityTimeout:   int32(c.visibilityTimeout.Seconds()),
+			AttributeNames:      []types.QueueAttributeName{types.QueueAttributeNameAll},
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.ReceiveMessage")
+		}
+
+		for _, msg := range res.Messages {
+			if ctx.Err() != nil {
+				return nil // Not processed messages become visible again after the visibility timeout.
+			}
+
+			if err := c.process(ctx, msg, handler); err != nil {
+				return err
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (c *Consumer) process(ctx context.Context, msg types.Message, handler Handler) error {
+	evt, err := decodeEvent(ctx, aws.ToString(msg.Body))
+	if err != nil {
+		// Invalid messages are never going to be processed, those are retried until the redrive policy of the
+		// queue moves them to the dead-letter queue.
+		return c.retryLater(ctx, msg)
+	}
+
+	hctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go c.extendVisibility(hctx, msg)
+
+	if err := handler(hctx, evt); err != nil {
+		return c.retryLater(ctx, msg)
+	}
+
+	if _, err := c.client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      aws.String(c.queueURL),
+		ReceiptHandle: msg.ReceiptHandle,
+	}); err != nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.DeleteMessage")
+	}
+
+	return nil
+}
+
+// extendVisibility keeps the message hidden from other consumers while it's being processed.
+func (c *Consumer) extendVisibility(ctx context.Context, msg types.Message) {
+	ticker := time.NewTicker(c.visibilityTimeout / 2)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, _ = c.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+				QueueUrl:          aws.String(c.queueURL),
+				ReceiptHandle:     msg.ReceiptHandle,
+				VisibilityTimeout: int32(c.visibilityTimeout.Seconds()),
+			})
+		}
+	}
+}
+
+// retryLater makes the message visible again using exponential backoff depending on the number of times it was
+// received.
+func (c *Consumer) retryLater(ctx context.Context, msg types.Message) error {
+	count, _ := strconv.Atoi(msg.Attributes[string(types.MessageSystemAttributeNameApproximateReceiveCount)])
+	if count < 1 {
+		count = 1
+	}
+
+	delay := time.Duration(math.Min(math.Pow(2, float64(count-1)), maxVisibilityTimeout.Seconds())) * time.Second
+
+	if _, err := c.client.ChangeMessageVisibility(ctx, &sqs.ChangeMessageVisibilityInput{
+		QueueUrl:          aws.String(c.queueURL),
+		ReceiptHandle:     msg.ReceiptHandle,
+		VisibilityTimeout: int32(delay.Seconds()),
+	}); err != nil && ctx.Err() == nil {
+		return internal.WrapErrorf(err, internal.ErrorCodeUnknown, "client.ChangeMessageVisibility")
+	}
+
+	return nil
+}
+
+// notification is the envelope used by SNS when raw message delivery is not enabled.
+type notification struct {
+	TopicArn string
+	Message  string
+}
+
+// decodeEvent decodes the message body, supporting the SNS envelope and the CloudEvents one.
+func decodeEvent(ctx context.Context, body string) (Event, error) {
+	var n notification
+	if err := json.Unmarshal([]byte(body), &n); err == nil && n.TopicArn != "" {
+		body = n.Message
+	}
+
+	if cevt, err := internal.DecodeCloudEvent([]byte(body)); err == nil {
+		task, err := cevt.DecodeTask(ctx)
+		if err != nil {
+			return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "cevt.DecodeTask")
+		}
+
+		return Event{
+			Type:  cevt.Type,
+			Value: task,
+		}, nil
+	}
+
+	var evt struct {
+		Type    string
+		Version int
+		Value   json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(body), &evt); err != nil {
+		return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "json.Unmarshal")
+	}
+
+	task, err := internal.DecodeTaskEventData(ctx, evt.Version, evt.Value)
+	if err != nil {
+		return Event{}, internal.WrapErrorf(err, internal.ErrorCodeUnknown, "internal.DecodeTaskEventData")
+	}
+
+	return Event{
+		Type:  evt.Type,
+		Value: task,
+	}, nil
+}
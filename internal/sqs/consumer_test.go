@@ -0,0 +1,204 @@
+package sqs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
+	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/ory/dockertest/v3"
+	"github.com/ory/dockertest/v3/docker"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/sns"
+	"github.com/MarioCarrion/todo-api/internal/sqs"
+)
+
+func TestConsumer_Consume(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cloudEvents bool
+		failures    int
+	}{
+		{
+			"OK",
+			false,
+			0,
+		},
+		{
+			"OK: CloudEvents",
+			true,
+			0,
+		},
+		{
+			"OK: retried after failing",
+			false,
+			1,
+		},
+	}
+
+	cfg := newLocalstack(t)
+
+	for i, tt := range tests {
+		i, tt := i, tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			snsClient := awssns.NewFromConfig(cfg)
+			sqsClient := awssqs.NewFromConfig(cfg)
+
+			topicARN, queueURL := newSubscription(t, snsClient, sqsClient, fmt.Sprintf("tasks-%d", i))
+
+			task := internal.Task{
+				ID:          "a47ca5e1-a3d6-4a5a-8e1b-3d0b2a1f6e1a",
+				Description: "sqs",
+				Priority:    internal.PriorityHigh,
+			}
+
+			if err := sns.NewTask(snsClient, topicARN, tt.cloudEvents).Created(context.Background(), task); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+
+			var (
+				actual   sqs.Event
+				failures int
+			)
+
+			err := sqs.NewConsumer(sqsClient, queueURL, 10*time.Second).Consume(ctx, func(_ context.Context, evt sqs.Event) error {
+				if failures < tt.failures {
+					failures++
+
+					return errors.New("failed")
+				}
+
+				actual = evt
+
+				cancel()
+
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected := sqs.Event{
+				Type:  "tasks.event.created",
+				Value: task,
+			}
+
+			if !cmp.Equal(expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+			}
+		})
+	}
+}
+
+func newSubscription(tb testing.TB, snsClient *awssns.Client, sqsClient *awssqs.Client, name string) (string, string) {
+	tb.Helper()
+
+	ctx := context.Background()
+
+	topic, err := snsClient.CreateTopic(ctx, &awssns.CreateTopicInput{Name: aws.String(name)})
+	if err != nil {
+		tb.Fatalf("Couldn't create topic: %s", err)
+	}
+
+	queue, err := sqsClient.CreateQueue(ctx, &awssqs.CreateQueueInput{QueueName: aws.String(name)})
+	if err != nil {
+		tb.Fatalf("Couldn't create queue: %s", err)
+	}
+
+	attrs, err := sqsClient.GetQueueAttributes(ctx, &awssqs.GetQueueAttributesInput{
+		QueueUrl:       queue.QueueUrl,
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameQueueArn},
+	})
+	if err != nil {
+		tb.Fatalf("Couldn't get queue attributes: %s", err)
+	}
+
+	if _, err := snsClient.Subscribe(ctx, &awssns.SubscribeInput{
+		TopicArn: topic.TopicArn,
+		Protocol: aws.String("sqs"),
+		Endpoint: aws.String(attrs.Attributes[string(types.QueueAttributeNameQueueArn)]),
+	}); err != nil {
+		tb.Fatalf("Couldn't subscribe: %s", err)
+	}
+
+	return aws.ToString(topic.TopicArn), aws.ToString(queue.QueueUrl)
+}
+
+func newLocalstack(tb testing.TB) aws.Config {
+	tb.Helper()
+
+	pool, err := dockertest.NewPool("")
+	if err != nil {
+		tb.Fatalf("Couldn't connect to docker: %s", err)
+	}
+
+	pool.MaxWait = 60 * time.Second
+
+	resource, err := pool.RunWithOptions(&dockertest.RunOptions{
+		Repository: "localstack/localstack",
+		Tag:        "0.14.2",
+		Env: []string{
+			"SERVICES=sns,sqs",
+		},
+	}, func(config *docker.HostConfig) {
+		config.AutoRemove = true
+		config.RestartPolicy = docker.RestartPolicy{
+			Name: "no",
+		}
+	})
+	if err != nil {
+		tb.Fatalf("Couldn't start resource: %s", err)
+	}
+
+	_ = resource.Expire(120)
+
+	tb.Cleanup(func() {
+		if err := pool.Purge(resource); err != nil {
+			tb.Fatalf("Couldn't purge container: %v", err)
+		}
+	})
+
+	host := fmt.Sprintf("%s:4566", resource.Container.NetworkSettings.IPAddress)
+	if runtime.GOOS == "darwin" { // MacOS-specific
+		host = net.JoinHostPort(resource.GetBoundIP("4566/tcp"), resource.GetPort("4566/tcp"))
+	}
+
+	cfg := aws.Config{
+		Region:      "us-east-1",
+		Credentials: credentials.NewStaticCredentialsProvider("test", "test", ""),
+		EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(func(_, region string, _ ...interface{}) (aws.Endpoint, error) {
+			return aws.Endpoint{
+				URL:           "http://" + host,
+				SigningRegion: region,
+			}, nil
+		}),
+	}
+
+	if err := pool.Retry(func() error {
+		_, err := awssqs.NewFromConfig(cfg).ListQueues(context.Background(), &awssqs.ListQueuesInput{})
+
+		return err //nolint: wrapcheck
+	}); err != nil {
+		tb.Fatalf("Couldn't connect to localstack: %s", err)
+	}
+
+	return cfg
+}
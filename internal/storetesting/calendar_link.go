@@ -0,0 +1,155 @@
+package storetesting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/calendar"
+)
+
+// CalendarLinkRepository runs the tests every calendar.LinkRepository must pass. Those cover saving, finding and
+// deleting links, saving sync tokens and the errors returned for missing records and invalid ids.
+//nolint: funlen
+func CalendarLinkRepository(t *testing.T, newRepo func(tb testing.TB) calendar.LinkRepository) {
+	t.Helper()
+
+	newLink := func() internal.CalendarLink {
+		return internal.CalendarLink{
+			TaskID:      uuid.NewString(),
+			EventID:     "event" + uuid.NewString()[:8],
+			Fingerprint: "f1",
+		}
+	}
+
+	t.Run("SaveLink/FindLink: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		expected := newLink()
+
+		if err := repo.SaveLink(context.Background(), expected); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		find := func() {
+			t.Helper()
+
+			actual, err := repo.FindLinkByTask(context.Background(), expected.TaskID)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+			}
+
+			if actual, err = repo.FindLinkByEvent(context.Background(), expected.EventID); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+			}
+		}
+
+		find()
+
+		// Saving again replaces the existing record.
+		expected.Fingerprint = "f2"
+
+		if err := repo.SaveLink(context.Background(), expected); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		find()
+	})
+
+	t.Run("DeleteLink: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		link := newLink()
+
+		if err := repo.SaveLink(context.Background(), link); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.DeleteLink(context.Background(), link.TaskID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err := repo.FindLinkByTask(context.Background(), link.TaskID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		_, err = repo.FindLinkByEvent(context.Background(), link.EventID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("SaveSyncToken/SyncToken: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		calendarID := uuid.NewString() + "@group.calendar.google.com"
+
+		token := func(expected string) {
+			t.Helper()
+
+			actual, err := repo.SyncToken(context.Background(), calendarID)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if actual != expected {
+				t.Fatalf("expected token %q, got %q", expected, actual)
+			}
+		}
+
+		// Calendars not synchronized yet don't have a token.
+		token("")
+
+		for _, val := range []string{"token1", "token2"} {
+			if err := repo.SaveSyncToken(context.Background(), calendarID, val); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			token(val)
+		}
+	})
+
+	t.Run("Errors: not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		assertErrorCode(t, repo.DeleteLink(context.Background(), "44633fe3-b039-4fb3-a35f-a57fe3c906c7"),
+			internal.ErrorCodeNotFound)
+
+		_, err := repo.FindLinkByTask(context.Background(), "44633fe3-b039-4fb3-a35f-a57fe3c906c7")
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		_, err = repo.FindLinkByEvent(context.Background(), "missing")
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Errors: invalid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		assertErrorCode(t, repo.DeleteLink(context.Background(), "x"), internal.ErrorCodeInvalidArgument)
+		assertErrorCode(t, repo.SaveLink(context.Background(), internal.CalendarLink{TaskID: "x", EventID: "e"}),
+			internal.ErrorCodeInvalidArgument)
+		assertErrorCode(t, repo.SaveLink(context.Background(), internal.CalendarLink{TaskID: uuid.NewString()}),
+			internal.ErrorCodeInvalidArgument)
+
+		_, err := repo.FindLinkByTask(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+}
@@ -0,0 +1,133 @@
+package storetesting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/github"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// IssueLinkStore defines the datastore keeping the links, used by the service importing Issues and by the closer
+// closing them.
+type IssueLinkStore interface {
+	service.IssueLinkRepository
+	github.LinkRepository
+}
+
+// IssueLinkRepository runs the tests every IssueLinkStore must pass. Those cover claiming, releasing, saving and
+// finding links, and the errors returned for missing records and invalid ids; repositories are random so records
+// saved by other tests sharing the datastore are ignored.
+//nolint: funlen
+func IssueLinkRepository(t *testing.T, newRepo func(tb testing.TB) IssueLinkStore) {
+	t.Helper()
+
+	newRepository := func() string {
+		return "octo/" + uuid.NewString()
+	}
+
+	t.Run("Claim/Release: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		repository := newRepository()
+
+		claim := func(expected bool) {
+			t.Helper()
+
+			actual, err := repo.Claim(context.Background(), repository, 1)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if actual != expected {
+				t.Fatalf("expected claimed %t, got %t", expected, actual)
+			}
+		}
+
+		claim(true)
+		claim(false)
+
+		// Other issues of the same repository are claimed independently.
+		if claimed, err := repo.Claim(context.Background(), repository, 2); err != nil || !claimed {
+			t.Fatalf("expected issue claimed, got %t, %v", claimed, err)
+		}
+
+		if err := repo.Release(context.Background(), repository, 1); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		claim(true)
+	})
+
+	t.Run("Save/FindByTask: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		expected := internal.IssueLink{
+			Repository: newRepository(),
+			Number:     7,
+			TaskID:     uuid.NewString(),
+		}
+
+		if _, err := repo.Claim(context.Background(), expected.Repository, expected.Number); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		find := func() {
+			t.Helper()
+
+			if err := repo.Save(context.Background(), expected); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual, err := repo.FindByTask(context.Background(), expected.TaskID)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+			}
+		}
+
+		find()
+
+		// Saving again replaces the existing record.
+		expected.Closed = true
+
+		find()
+
+		// Saved issues are not claimed again.
+		if claimed, err := repo.Claim(context.Background(), expected.Repository, expected.Number); err != nil || claimed {
+			t.Fatalf("expected issue not claimed, got %t, %v", claimed, err)
+		}
+	})
+
+	t.Run("Errors: not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		_, err := repo.FindByTask(context.Background(), "44633fe3-b039-4fb3-a35f-a57fe3c906c7")
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Errors: invalid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		assertErrorCode(t, repo.Save(context.Background(), internal.IssueLink{Repository: newRepository(), TaskID: "x"}),
+			internal.ErrorCodeInvalidArgument)
+
+		_, err := repo.FindByTask(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+}
@@ -0,0 +1,150 @@
+package storetesting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// ProjectRepository runs the tests every service.ProjectRepository must pass. Those cover creating, finding,
+// updating, deleting and listing records and the errors returned for missing records and invalid ids; records
+// created by other tests sharing the datastore are ignored when listing.
+//nolint: funlen
+func ProjectRepository(t *testing.T, newRepo func(tb testing.TB) service.ProjectRepository) {
+	t.Helper()
+
+	const missingID = "44633fe3-b039-4fb3-a35f-a57fe3c906c7"
+
+	t.Run("Create/Find: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), "created")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if created.ID == "" || created.Name != "created" {
+			t.Fatalf("expected values do not match: %+v", created)
+		}
+
+		assertFindProject(t, repo, created)
+	})
+
+	t.Run("Update: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), "created")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.Update(context.Background(), created.ID, "updated"); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		assertFindProject(t, repo, internal.Project{ID: created.ID, Name: "updated"})
+	})
+
+	t.Run("Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), "deleted")
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.Delete(context.Background(), created.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err = repo.Find(context.Background(), created.ID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("List: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		var expected []internal.Project
+
+		for _, name := range []string{"first", "second", "third"} {
+			created, err := repo.Create(context.Background(), name)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			expected = append(expected, created)
+		}
+
+		res, err := repo.List(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		ids := map[string]struct{}{}
+
+		for _, project := range expected {
+			ids[project.ID] = struct{}{}
+		}
+
+		var actual []internal.Project
+
+		for _, project := range res {
+			if _, ok := ids[project.ID]; ok {
+				actual = append(actual, project)
+			}
+		}
+
+		if !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("Errors: not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		_, err := repo.Find(context.Background(), missingID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		assertErrorCode(t, repo.Update(context.Background(), missingID, "updated"), internal.ErrorCodeNotFound)
+		assertErrorCode(t, repo.Delete(context.Background(), missingID), internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Errors: invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		_, err := repo.Find(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+		assertErrorCode(t, repo.Update(context.Background(), "x", "updated"), internal.ErrorCodeInvalidArgument)
+		assertErrorCode(t, repo.Delete(context.Background(), "x"), internal.ErrorCodeInvalidArgument)
+	})
+}
+
+func assertFindProject(t *testing.T, repo service.ProjectRepository, expected internal.Project) {
+	t.Helper()
+
+	actual, err := repo.Find(context.Background(), expected.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
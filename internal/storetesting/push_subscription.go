@@ -0,0 +1,168 @@
+package storetesting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/push"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// PushSubscriptionStore defines the datastore keeping the subscriptions, used by the service registering them and
+// by the reminders delivered to them.
+type PushSubscriptionStore interface {
+	service.PushSubscriptionRepository
+	push.SubscriptionRepository
+}
+
+// PushSubscriptionRepository runs the tests every PushSubscriptionStore must pass. Those cover saving, listing and
+// deleting records, claiming reminders and the errors returned for missing records and invalid ids; records saved
+// by other tests sharing the datastore are ignored when listing.
+//nolint: funlen
+func PushSubscriptionRepository(t *testing.T, newRepo func(tb testing.TB) PushSubscriptionStore) {
+	t.Helper()
+
+	newSub := func() internal.PushSubscription {
+		endpoint := "https://push.example.com/" + uuid.NewString()
+
+		return internal.PushSubscription{
+			ID:       internal.NewPushSubscriptionID(endpoint),
+			Endpoint: endpoint,
+			P256dh:   "BNcRdreALRFXTkOOUHK1EtK2wtaz5Ry4YfYCA_0QTpQtUbVlUls0VJXg7A8u-Ts1XbjhazAkj7I99e8QcYP7DkM",
+			Auth:     "tBHItJI5svbpez7KI4CCXg",
+		}
+	}
+
+	listed := func(t *testing.T, repo PushSubscriptionStore, ids map[string]struct{}) []internal.PushSubscription {
+		t.Helper()
+
+		res, err := repo.List(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		var actual []internal.PushSubscription
+
+		for _, sub := range res {
+			if _, ok := ids[sub.ID]; ok {
+				actual = append(actual, sub)
+			}
+		}
+
+		return actual
+	}
+
+	t.Run("Save/List: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		expected := []internal.PushSubscription{newSub(), newSub()}
+
+		if expected[1].ID < expected[0].ID {
+			expected[0], expected[1] = expected[1], expected[0]
+		}
+
+		ids := map[string]struct{}{}
+
+		for _, i := range []int{1, 0} {
+			if err := repo.Save(context.Background(), expected[i]); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			ids[expected[i].ID] = struct{}{}
+		}
+
+		if actual := listed(t, repo, ids); !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+
+		// Saving again replaces the existing record.
+		expected[0].P256dh = "BOr4zVfkPvAHz4AtWFkjnY5fUwZWYbKYH0MZqdaRqxOJuq_wL69z08LbzT2WgzHvvJ8zUy3RyE6oVdPEpNmvCl8"
+		expected[0].Auth = "Zx1ZNtDsGwQeNVkP0NO8sA"
+
+		if err := repo.Save(context.Background(), expected[0]); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actual := listed(t, repo, ids); !cmp.Equal(expected, actual) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+		}
+	})
+
+	t.Run("Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		sub := newSub()
+
+		if err := repo.Save(context.Background(), sub); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.Delete(context.Background(), sub.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actual := listed(t, repo, map[string]struct{}{sub.ID: {}}); len(actual) != 0 {
+			t.Fatalf("expected no subscriptions, got %v", actual)
+		}
+	})
+
+	t.Run("ClaimReminder: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		taskID := uuid.NewString()
+		due := time.Now().UTC().Truncate(time.Microsecond)
+
+		claim := func(due time.Time, expected bool) {
+			t.Helper()
+
+			claimed, err := repo.ClaimReminder(context.Background(), taskID, due)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if claimed != expected {
+				t.Fatalf("expected claimed %t, got %t", expected, claimed)
+			}
+		}
+
+		claim(due, true)
+		claim(due, false)
+
+		// Changing the due date is reminded again.
+		claim(due.Add(time.Hour), true)
+		claim(due.Add(time.Hour), false)
+	})
+
+	t.Run("Errors: not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		assertErrorCode(t, repo.Delete(context.Background(), "44633fe3-b039-4fb3-a35f-a57fe3c906c7"),
+			internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Errors: invalid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		assertErrorCode(t, repo.Delete(context.Background(), "x"), internal.ErrorCodeInvalidArgument)
+		assertErrorCode(t, repo.Save(context.Background(), internal.PushSubscription{ID: "x"}),
+			internal.ErrorCodeInvalidArgument)
+
+		_, err := repo.ClaimReminder(context.Background(), "x", time.Now())
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+}
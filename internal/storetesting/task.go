@@ -0,0 +1,742 @@
+// Package storetesting defines the conformance tests shared by the repositories persisting Task records.
+package storetesting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// ignoreGenerated ignores the values set by the datastores: Task.Version, not all datastores support it and those
+// that do are covered by their own tests, and the times covered by "Update: OK completed at" and "Update: OK
+// timestamps".
+//nolint: gochecknoglobals
+var ignoreGenerated = cmpopts.IgnoreFields(internal.Task{}, "Version", "CompletedAt", "CreatedAt", "UpdatedAt")
+
+// TaskRepository runs the tests every service.TaskRepository must pass, newRepo must return a repository without
+// records. Those cover creating, finding, updating, upserting, deleting and listing records, the errors returned
+// for missing records and invalid ids, paginating and concurrent access.
+//nolint: funlen, gocognit, cyclop, maintidx
+func TaskRepository(t *testing.T, newRepo func(tb testing.TB) service.TaskRepository) {
+	t.Helper()
+
+	// Times are truncated to the microsecond, the precision supported by all the datastores.
+	due := time.Now().Add(48 * time.Hour).UTC().Truncate(time.Microsecond)
+
+	const (
+		missingID = "44633fe3-b039-4fb3-a35f-a57fe3c906c7"
+		projectID = "0f8fad5b-d9cb-469f-a165-70867728950e"
+	)
+
+	t.Run("Create/Find: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), internal.CreateParams{
+			Description: "created",
+			Priority:    internal.PriorityHigh,
+			Dates:       internal.Dates{Start: due.Add(-time.Hour), Due: due, TimeZone: "America/New_York"},
+			ProjectID:   projectID,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		assertFind(t, repo, created)
+	})
+
+	t.Run("Create/Find: OK id", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		id := internal.UUIDv7Generator{}.NewID()
+
+		created, err := repo.Create(context.Background(), internal.CreateParams{
+			ID:          id,
+			Description: "created with id",
+			Priority:    internal.PriorityLow,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if created.ID != id {
+			t.Fatalf("expected id %s, got %s", id, created.ID)
+		}
+
+		assertFind(t, repo, created)
+	})
+
+	t.Run("Find: ERR", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		_, err := repo.Find(context.Background(), missingID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		_, err = repo.Find(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+
+	t.Run("Update: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), internal.CreateParams{Description: "created"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		expected := internal.Task{
+			ID:          created.ID,
+			Description: "updated",
+			Priority:    internal.PriorityLow,
+			Dates:       internal.Dates{Due: due},
+			ProjectID:   projectID,
+			IsDone:      true,
+		}
+
+		// Updating using the same values is not an error.
+		for i := 0; i < 2; i++ {
+			if err := repo.Update(context.Background(), created.ID, expected.Description, expected.Priority,
+				expected.Dates, expected.ProjectID, expected.IsDone); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		assertFind(t, repo, expected)
+	})
+
+	t.Run("Update: OK completed at", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), internal.CreateParams{Description: "created"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !created.CompletedAt.IsZero() {
+			t.Fatalf("expected no completion time, got %s", created.CompletedAt)
+		}
+
+		update := func(done bool) internal.Task {
+			t.Helper()
+
+			if err := repo.Update(context.Background(), created.ID, created.Description, created.Priority,
+				created.Dates, created.ProjectID, done); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			task, err := repo.Find(context.Background(), created.ID)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			return task
+		}
+
+		completed := update(true)
+		if completed.CompletedAt.IsZero() {
+			t.Fatal("expected completion time, got none")
+		}
+
+		// Updating a done Task keeps the time it was completed.
+		time.Sleep(time.Millisecond)
+
+		if actual := update(true); !actual.CompletedAt.Equal(completed.CompletedAt) {
+			t.Fatalf("expected completion time %s, got %s", completed.CompletedAt, actual.CompletedAt)
+		}
+
+		if actual := update(false); !actual.CompletedAt.IsZero() {
+			t.Fatalf("expected no completion time, got %s", actual.CompletedAt)
+		}
+	})
+
+	t.Run("Update: OK timestamps", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), internal.CreateParams{Description: "created"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if created.CreatedAt.IsZero() || !created.UpdatedAt.Equal(created.CreatedAt) {
+			t.Fatalf("expected creation and update times to match, got %s and %s", created.CreatedAt, created.UpdatedAt)
+		}
+
+		time.Sleep(time.Millisecond)
+
+		if err := repo.Update(context.Background(), created.ID, "updated", created.Priority, created.Dates,
+			created.ProjectID, created.IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		actual, err := repo.Find(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if !actual.CreatedAt.Equal(created.CreatedAt) {
+			t.Fatalf("expected creation time %s, got %s", created.CreatedAt, actual.CreatedAt)
+		}
+
+		if !actual.UpdatedAt.After(created.UpdatedAt) {
+			t.Fatalf("expected update time after %s, got %s", created.UpdatedAt, actual.UpdatedAt)
+		}
+	})
+
+	t.Run("Update: ERR", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		err := repo.Update(context.Background(), missingID, "updated", internal.PriorityNone, internal.Dates{}, "", false)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Upsert: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		expected := internal.Task{
+			ID:          missingID,
+			Description: "upserted",
+			Priority:    internal.PriorityMedium,
+			Dates:       internal.Dates{Due: due},
+		}
+
+		for _, inserted := range []bool{true, false, false} {
+			actual, err := repo.Upsert(context.Background(), expected.ID, expected.Description, expected.Priority,
+				expected.Dates, expected.ProjectID, expected.IsDone)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if inserted != actual {
+				t.Fatalf("expected inserted %t, got %t", inserted, actual)
+			}
+
+			expected.IsDone = true
+		}
+
+		assertFind(t, repo, expected)
+	})
+
+	t.Run("Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		created, err := repo.Create(context.Background(), internal.CreateParams{Description: "created"})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.Delete(context.Background(), created.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err = repo.Find(context.Background(), created.ID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		err = repo.Delete(context.Background(), created.ID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("List: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		var tasks []internal.Task
+
+		for _, params := range []internal.CreateParams{
+			{Description: "no due date", Priority: internal.PriorityHigh},
+			{Description: "low", Priority: internal.PriorityLow, Dates: internal.Dates{Due: due}},
+			{Description: "high", Priority: internal.PriorityHigh, Dates: internal.Dates{Due: due}},
+			{Description: "medium", Priority: internal.PriorityMedium, Dates: internal.Dates{Due: due}},
+		} {
+			task, err := repo.Create(context.Background(), params)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			tasks = append(tasks, task)
+
+			// Creation times must be different to make the order deterministic.
+			time.Sleep(time.Millisecond)
+		}
+
+		// Done tasks go last when sorting by urgency.
+		tasks[2].IsDone = true
+
+		if err := repo.Update(context.Background(), tasks[2].ID, tasks[2].Description, tasks[2].Priority,
+			tasks[2].Dates, tasks[2].ProjectID, tasks[2].IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if actual := list(t, repo, internal.SortDefault); !cmp.Equal(tasks, actual, ignoreGenerated) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(tasks, actual, ignoreGenerated))
+		}
+
+		expected := []internal.Task{tasks[3], tasks[1], tasks[0], tasks[2]}
+
+		if actual := list(t, repo, internal.SortUrgency); !cmp.Equal(expected, actual, ignoreGenerated) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual, ignoreGenerated))
+		}
+	})
+
+	t.Run("List: ERR cursor", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newRepo(t).List(context.Background(), internal.ListParams{Cursor: "x", Size: 1})
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+
+	t.Run("List: OK due", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		overdue := time.Now().Add(-time.Hour).UTC().Truncate(time.Microsecond)
+
+		var tasks []internal.Task
+
+		for _, params := range []internal.CreateParams{
+			{Description: "no due date"},
+			{Description: "overdue", Dates: internal.Dates{Due: overdue}},
+			{Description: "due", Dates: internal.Dates{Due: due}},
+			{Description: "done", Dates: internal.Dates{Due: overdue}},
+		} {
+			task, err := repo.Create(context.Background(), params)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			tasks = append(tasks, task)
+
+			time.Sleep(time.Millisecond)
+		}
+
+		tasks[3].IsDone = true
+
+		if err := repo.Update(context.Background(), tasks[3].ID, tasks[3].Description, tasks[3].Priority,
+			tasks[3].Dates, tasks[3].ProjectID, tasks[3].IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		now := time.Now()
+
+		for _, tt := range []struct {
+			due      internal.DueRange
+			expected []internal.Task
+		}{
+			{internal.DueRange{To: now}, []internal.Task{tasks[1]}},
+			{internal.DueRange{From: now}, []internal.Task{tasks[2]}},
+			{internal.DueRange{From: now, To: due}, nil},
+		} {
+			for _, sort := range []internal.Sort{internal.SortDefault, internal.SortUrgency} {
+				due := tt.due
+
+				page, err := repo.List(context.Background(), internal.ListParams{Size: 10, Sort: sort, Total: true, Due: &due})
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+
+				if !cmp.Equal(tt.expected, page.Tasks, ignoreGenerated, cmpopts.EquateEmpty()) {
+					t.Fatalf("%s: expected result does not match: %s", sort, cmp.Diff(tt.expected, page.Tasks, ignoreGenerated))
+				}
+
+				if page.Total != int64(len(tt.expected)) {
+					t.Fatalf("%s: expected total %d, got %d", sort, len(tt.expected), page.Total)
+				}
+			}
+		}
+	})
+
+	t.Run("List: OK created and updated", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		create := func(description string) internal.Task {
+			t.Helper()
+
+			task, err := repo.Create(context.Background(), internal.CreateParams{Description: description})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			return task
+		}
+
+		before := create("before")
+		updated := create("updated")
+
+		time.Sleep(10 * time.Millisecond)
+
+		mid := time.Now()
+
+		time.Sleep(10 * time.Millisecond)
+
+		after := create("after")
+
+		if err := repo.Update(context.Background(), updated.ID, "changed", updated.Priority, updated.Dates,
+			updated.ProjectID, updated.IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		updated.Description = "changed"
+
+		for _, tt := range []struct {
+			name     string
+			created  *internal.TimeRange
+			updated  *internal.TimeRange
+			expected []internal.Task
+		}{
+			{"created from", &internal.TimeRange{From: mid}, nil, []internal.Task{after}},
+			{"created to", &internal.TimeRange{To: mid}, nil, []internal.Task{before, updated}},
+			{"updated from", nil, &internal.TimeRange{From: mid}, []internal.Task{updated, after}},
+			{"both", &internal.TimeRange{To: mid}, &internal.TimeRange{From: mid}, []internal.Task{updated}},
+		} {
+			for _, sort := range []internal.Sort{internal.SortDefault, internal.SortUrgency} {
+				page, err := repo.List(context.Background(), internal.ListParams{
+					Size:    10,
+					Sort:    sort,
+					Total:   true,
+					Created: tt.created,
+					Updated: tt.updated,
+				})
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+
+				// Tasks without due date and priority are equally urgent, those are sorted by id.
+				byID := cmpopts.SortSlices(func(a, b internal.Task) bool { return a.ID < b.ID })
+
+				if !cmp.Equal(tt.expected, page.Tasks, ignoreGenerated, byID, cmpopts.EquateEmpty()) {
+					t.Fatalf("%s %s: expected result does not match: %s", tt.name, sort,
+						cmp.Diff(tt.expected, page.Tasks, ignoreGenerated, byID))
+				}
+
+				if page.Total != int64(len(tt.expected)) {
+					t.Fatalf("%s %s: expected total %d, got %d", tt.name, sort, len(tt.expected), page.Total)
+				}
+			}
+		}
+	})
+
+	t.Run("List: OK sorted by time", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		var tasks []internal.Task
+
+		for _, description := range []string{"first", "second", "third"} {
+			task, err := repo.Create(context.Background(), internal.CreateParams{Description: description})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			tasks = append(tasks, task)
+
+			// Creation and update times must be different to make the order deterministic.
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		// The first task becomes the most recently updated one.
+		tasks[0].Description = "first updated"
+
+		if err := repo.Update(context.Background(), tasks[0].ID, tasks[0].Description, tasks[0].Priority,
+			tasks[0].Dates, tasks[0].ProjectID, tasks[0].IsDone); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		for sort, expected := range map[internal.Sort][]internal.Task{
+			internal.SortCreated:     {tasks[0], tasks[1], tasks[2]},
+			internal.SortCreatedDesc: {tasks[2], tasks[1], tasks[0]},
+			internal.SortUpdated:     {tasks[1], tasks[2], tasks[0]},
+			internal.SortUpdatedDesc: {tasks[0], tasks[2], tasks[1]},
+		} {
+			if actual := list(t, repo, sort); !cmp.Equal(expected, actual, ignoreGenerated) {
+				t.Fatalf("%s: expected result does not match: %s", sort, cmp.Diff(expected, actual, ignoreGenerated))
+			}
+		}
+
+		// Cursors are only valid for the sort used for requesting them.
+		page, err := repo.List(context.Background(), internal.ListParams{Size: 1, Sort: internal.SortCreated})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err = repo.List(context.Background(), internal.ListParams{Cursor: page.NextCursor, Size: 1, Sort: internal.SortUpdated})
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+
+	t.Run("List: OK project", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		var tasks []internal.Task
+
+		for _, params := range []internal.CreateParams{
+			{Description: "no project"},
+			{Description: "project", ProjectID: projectID},
+			{Description: "other project", ProjectID: missingID},
+			{Description: "project urgent", Priority: internal.PriorityHigh, Dates: internal.Dates{Due: due}, ProjectID: projectID},
+		} {
+			task, err := repo.Create(context.Background(), params)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			tasks = append(tasks, task)
+
+			time.Sleep(time.Millisecond)
+		}
+
+		for sort, expected := range map[internal.Sort][]internal.Task{
+			internal.SortDefault: {tasks[1], tasks[3]},
+			internal.SortUrgency: {tasks[3], tasks[1]},
+		} {
+			page, err := repo.List(context.Background(), internal.ListParams{Size: 10, Sort: sort, Total: true, ProjectID: projectID})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			if !cmp.Equal(expected, page.Tasks, ignoreGenerated) {
+				t.Fatalf("%s: expected result does not match: %s", sort, cmp.Diff(expected, page.Tasks, ignoreGenerated))
+			}
+
+			if page.Total != int64(len(expected)) {
+				t.Fatalf("%s: expected total %d, got %d", sort, len(expected), page.Total)
+			}
+		}
+	})
+
+	t.Run("List: OK pagination", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		page, err := repo.List(context.Background(), internal.ListParams{Size: 2})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if len(page.Tasks) != 0 || page.NextCursor != "" {
+			t.Fatalf("expected no tasks nor cursor, got %#v", page)
+		}
+
+		var tasks []internal.Task
+
+		for i := 0; i < 5; i++ {
+			task, err := repo.Create(context.Background(), internal.CreateParams{Description: fmt.Sprintf("task %d", i)})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			tasks = append(tasks, task)
+
+			time.Sleep(time.Millisecond)
+		}
+
+		page, err = repo.List(context.Background(), internal.ListParams{Size: 2, Total: true})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if page.Total != int64(len(tasks)) || page.TotalEstimated {
+			t.Fatalf("expected exact total %d, got %d (estimated %t)", len(tasks), page.Total, page.TotalEstimated)
+		}
+
+		for _, size := range []int64{2, 5, 10} {
+			var (
+				actual []internal.Task
+				pages  int
+				cursor string
+			)
+
+			for {
+				page, err := repo.List(context.Background(), internal.ListParams{Cursor: cursor, Size: size})
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+
+				if int64(len(page.Tasks)) > size {
+					t.Fatalf("expected at most %d tasks, got %d", size, len(page.Tasks))
+				}
+
+				actual = append(actual, page.Tasks...)
+				pages++
+
+				if cursor = page.NextCursor; cursor == "" {
+					break
+				}
+			}
+
+			if !cmp.Equal(tasks, actual, ignoreGenerated) {
+				t.Fatalf("size %d: expected result does not match: %s", size, cmp.Diff(tasks, actual, ignoreGenerated))
+			}
+
+			// The last page may be empty when the number of tasks is a multiple of the size.
+			if expected := (len(tasks) + int(size) - 1) / int(size); pages != expected && pages != expected+1 {
+				t.Fatalf("size %d: expected %d pages, got %d", size, expected, pages)
+			}
+		}
+	})
+
+	t.Run("ERR: invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		err := repo.Delete(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+		err = repo.Update(context.Background(), "x", "updated", internal.PriorityNone, internal.Dates{}, "", false)
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+		_, err = repo.Upsert(context.Background(), "x", "upserted", internal.PriorityNone, internal.Dates{}, "", false)
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+
+	t.Run("Concurrency: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		const workers = 10
+
+		var (
+			wg       sync.WaitGroup
+			mutex    sync.Mutex
+			created  []internal.Task
+			inserted int
+			errs     []error
+		)
+
+		record := func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			errs = append(errs, err)
+		}
+
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+
+			go func(i int) {
+				defer wg.Done()
+
+				task, err := repo.Create(context.Background(), internal.CreateParams{Description: fmt.Sprintf("task %d", i)})
+				if err != nil {
+					record(err)
+
+					return
+				}
+
+				// Upserting the same record concurrently inserts it once.
+				ok, err := repo.Upsert(context.Background(), missingID, fmt.Sprintf("upserted %d", i),
+					internal.PriorityNone, internal.Dates{}, "", false)
+				if err != nil {
+					record(err)
+
+					return
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				created = append(created, task)
+
+				if ok {
+					inserted++
+				}
+			}(i)
+		}
+
+		wg.Wait()
+
+		if len(errs) > 0 {
+			t.Fatalf("expected no errors, got %v", errs)
+		}
+
+		if inserted != 1 {
+			t.Fatalf("expected upserted task to be inserted once, got %d", inserted)
+		}
+
+		for _, task := range created {
+			assertFind(t, repo, task)
+		}
+
+		if actual := list(t, repo, internal.SortDefault); len(actual) != workers+1 {
+			t.Fatalf("expected %d tasks, got %d", workers+1, len(actual))
+		}
+	})
+}
+
+// list returns all the tasks paginating one task at a time.
+func list(t *testing.T, repo service.TaskRepository, sort internal.Sort) []internal.Task {
+	t.Helper()
+
+	var (
+		res    []internal.Task
+		cursor string
+	)
+
+	for {
+		page, err := repo.List(context.Background(), internal.ListParams{Cursor: cursor, Size: 1, Sort: sort})
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		res = append(res, page.Tasks...)
+
+		if cursor = page.NextCursor; cursor == "" {
+			return res
+		}
+	}
+}
+
+func assertFind(t *testing.T, repo service.TaskRepository, expected internal.Task) {
+	t.Helper()
+
+	actual, err := repo.Find(context.Background(), expected.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(expected, actual, ignoreGenerated) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual, ignoreGenerated))
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, code internal.ErrorCode) {
+	t.Helper()
+
+	var ierr *internal.Error
+	if !errors.As(err, &ierr) || ierr.Code() != code {
+		t.Fatalf("expected %T error with code %d, got %T : %v", ierr, code, err, err)
+	}
+}
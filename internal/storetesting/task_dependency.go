@@ -0,0 +1,106 @@
+package storetesting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// TaskDependencyRepository runs the tests every service.TaskDependencyRepository must pass. Those cover creating,
+// listing and deleting dependencies and the errors returned for missing dependencies and invalid ids; every test
+// uses new ids so datastores can be shared with other tests.
+func TaskDependencyRepository(t *testing.T, newRepo func(tb testing.TB) service.TaskDependencyRepository) {
+	t.Helper()
+
+	t.Run("Create/Blockers/Blocked: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		blocked := uuid.NewString()
+		blockers := []string{uuid.NewString(), uuid.NewString()}
+
+		for _, blocker := range blockers {
+			dep := internal.TaskDependency{BlockerID: blocker, BlockedID: blocked}
+
+			// Creating existing dependencies does nothing.
+			for i := 0; i < 2; i++ {
+				if err := repo.Create(context.Background(), dep); err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+			}
+		}
+
+		if blockers[0] > blockers[1] {
+			blockers[0], blockers[1] = blockers[1], blockers[0]
+		}
+
+		assertIDs(t, blockers, func() ([]string, error) { return repo.Blockers(context.Background(), blocked) })
+		assertIDs(t, []string{blocked}, func() ([]string, error) { return repo.Blocked(context.Background(), blockers[0]) })
+		assertIDs(t, []string{}, func() ([]string, error) { return repo.Blockers(context.Background(), blockers[0]) })
+	})
+
+	t.Run("Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		dep := internal.TaskDependency{BlockerID: uuid.NewString(), BlockedID: uuid.NewString()}
+
+		if err := repo.Create(context.Background(), dep); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.Delete(context.Background(), dep); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		assertIDs(t, []string{}, func() ([]string, error) { return repo.Blockers(context.Background(), dep.BlockedID) })
+		assertIDs(t, []string{}, func() ([]string, error) { return repo.Blocked(context.Background(), dep.BlockerID) })
+	})
+
+	t.Run("Errors: not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		dep := internal.TaskDependency{BlockerID: uuid.NewString(), BlockedID: uuid.NewString()}
+
+		assertErrorCode(t, repo.Delete(context.Background(), dep), internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Errors: invalid id", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		dep := internal.TaskDependency{BlockerID: "x", BlockedID: uuid.NewString()}
+
+		assertErrorCode(t, repo.Create(context.Background(), dep), internal.ErrorCodeInvalidArgument)
+		assertErrorCode(t, repo.Delete(context.Background(), dep), internal.ErrorCodeInvalidArgument)
+
+		_, err := repo.Blockers(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+		_, err = repo.Blocked(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+}
+
+func assertIDs(t *testing.T, expected []string, list func() ([]string, error)) {
+	t.Helper()
+
+	actual, err := list()
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(expected, actual) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual))
+	}
+}
@@ -0,0 +1,97 @@
+package storetesting
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// TaskSearchRepository runs the tests every service.TaskSearchRepository must pass, newRepo must return the
+// repository used for creating the records searched by the returned search repository, without records. Those
+// cover the tasks matched by the search values, results are compared ignoring their order because relevance is
+// calculated differently by every search engine.
+//nolint: funlen
+func TaskSearchRepository(t *testing.T,
+	newRepo func(tb testing.TB) (service.TaskRepository, service.TaskSearchRepository)) {
+	t.Helper()
+
+	ptrString := func(s string) *string { return &s }
+	ptrPriority := func(p internal.Priority) *internal.Priority { return &p }
+
+	tests := []struct {
+		name     string
+		input    internal.SearchParams
+		expected []string
+	}{
+		{
+			"Search: OK every term",
+			internal.SearchParams{Description: ptrString("buy milk")},
+			[]string{"buy milk", "buy milk and bread"},
+		},
+		{
+			"Search: OK ignoring case",
+			internal.SearchParams{Description: ptrString("MILK")},
+			[]string{"buy milk", "buy milk and bread", "Milk the cow"},
+		},
+		{
+			"Search: OK every value",
+			internal.SearchParams{Description: ptrString("milk"), Priority: ptrPriority(internal.PriorityHigh)},
+			[]string{"buy milk"},
+		},
+		{
+			"Search: OK no matches",
+			internal.SearchParams{Description: ptrString("milk dog")},
+			[]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo, search := newRepo(t)
+
+			for _, params := range []internal.CreateParams{
+				{Description: "buy milk and bread", Priority: internal.PriorityLow},
+				{Description: "buy milk", Priority: internal.PriorityHigh},
+				{Description: "buy bread", Priority: internal.PriorityHigh},
+				{Description: "walk the dog", Priority: internal.PriorityHigh},
+				{Description: "Milk the cow", Priority: internal.PriorityMedium},
+			} {
+				if _, err := repo.Create(context.Background(), params); err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+			}
+
+			tt.input.Size = 10
+
+			res, err := search.Search(context.Background(), tt.input)
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			actual := make([]string, len(res.Tasks))
+			for i, task := range res.Tasks {
+				actual[i] = task.Description
+			}
+
+			sort.Strings(actual)
+			sort.Strings(tt.expected)
+
+			if !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected results do not match: %s", cmp.Diff(tt.expected, actual))
+			}
+
+			if res.Total != int64(len(tt.expected)) {
+				t.Fatalf("expected %d total, got %d", len(tt.expected), res.Total)
+			}
+		})
+	}
+}
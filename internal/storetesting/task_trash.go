@@ -0,0 +1,210 @@
+package storetesting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+
+	"github.com/MarioCarrion/todo-api/internal"
+	"github.com/MarioCarrion/todo-api/internal/service"
+)
+
+// TaskTrashRepository runs the tests every service.TaskTrashRepository must pass. Those cover saving, finding,
+// deleting, listing and purging trashed records and the errors returned for missing records and invalid ids;
+// records saved by other tests sharing the datastore are ignored when listing.
+//nolint: funlen
+func TaskTrashRepository(t *testing.T, newRepo func(tb testing.TB) service.TaskTrashRepository) {
+	t.Helper()
+
+	// Times are truncated to the microsecond, the precision supported by all the datastores.
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	newTrashed := func(deletedAt time.Time) internal.TrashedTask {
+		return internal.TrashedTask{
+			Task: internal.Task{
+				ID:          uuid.NewString(),
+				Description: "trashed",
+				Priority:    internal.PriorityMedium,
+				Dates:       internal.Dates{Start: now.Add(-time.Hour), Due: now, TimeZone: "America/New_York"},
+				ProjectID:   "0f8fad5b-d9cb-469f-a165-70867728950e",
+				IsDone:      true,
+			},
+			DeletedAt: deletedAt,
+		}
+	}
+
+	t.Run("Save/Find: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		trashed := newTrashed(now)
+
+		if err := repo.Save(context.Background(), trashed); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		assertFindTrashed(t, repo, trashed)
+
+		// Saving again replaces the existing record.
+		trashed.Task.Description = "trashed again"
+		trashed.Task.ProjectID = ""
+		trashed.DeletedAt = now.Add(time.Minute)
+
+		if err := repo.Save(context.Background(), trashed); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		assertFindTrashed(t, repo, trashed)
+	})
+
+	t.Run("Delete: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		trashed := newTrashed(now)
+
+		if err := repo.Save(context.Background(), trashed); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if err := repo.Delete(context.Background(), trashed.Task.ID); err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		_, err := repo.Find(context.Background(), trashed.Task.ID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+	})
+
+	t.Run("List: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		// Saved out of order, and two of them deleted at the same time so those are sorted by id.
+		expected := []internal.TrashedTask{
+			newTrashed(now.Add(-time.Minute)),
+			newTrashed(now.Add(-2 * time.Minute)),
+			newTrashed(now.Add(-2 * time.Minute)),
+			newTrashed(now.Add(-3 * time.Minute)),
+		}
+
+		if expected[1].Task.ID < expected[2].Task.ID {
+			expected[1], expected[2] = expected[2], expected[1]
+		}
+
+		for _, i := range []int{2, 0, 3, 1} {
+			if err := repo.Save(context.Background(), expected[i]); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		ids := map[string]struct{}{}
+
+		for _, trashed := range expected {
+			ids[trashed.Task.ID] = struct{}{}
+		}
+
+		var (
+			actual []internal.TrashedTask
+			cursor string
+		)
+
+		for {
+			res, err := repo.List(context.Background(), internal.TrashParams{Cursor: cursor, Size: 2})
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			for _, trashed := range res.Tasks {
+				if _, ok := ids[trashed.Task.ID]; ok {
+					actual = append(actual, trashed)
+				}
+			}
+
+			if cursor = res.NextCursor; cursor == "" {
+				break
+			}
+		}
+
+		if !cmp.Equal(expected, actual, ignoreGenerated) {
+			t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual, ignoreGenerated))
+		}
+	})
+
+	t.Run("Purge: OK", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		// Only this test uses deletion times that old, so other tests sharing the datastore are not affected.
+		purged := newTrashed(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC))
+		kept := newTrashed(time.Date(2002, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		for _, trashed := range []internal.TrashedTask{purged, kept} {
+			if err := repo.Save(context.Background(), trashed); err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+		}
+
+		n, err := repo.Purge(context.Background(), time.Date(2001, 6, 1, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("expected no error, got %s", err)
+		}
+
+		if n < 1 {
+			t.Fatalf("expected purged tasks, got %d", n)
+		}
+
+		_, err = repo.Find(context.Background(), purged.Task.ID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		assertFindTrashed(t, repo, kept)
+	})
+
+	t.Run("Errors: not found", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		const missingID = "44633fe3-b039-4fb3-a35f-a57fe3c906c7"
+
+		_, err := repo.Find(context.Background(), missingID)
+		assertErrorCode(t, err, internal.ErrorCodeNotFound)
+
+		assertErrorCode(t, repo.Delete(context.Background(), missingID), internal.ErrorCodeNotFound)
+	})
+
+	t.Run("Errors: invalid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepo(t)
+
+		_, err := repo.Find(context.Background(), "x")
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+
+		assertErrorCode(t, repo.Delete(context.Background(), "x"), internal.ErrorCodeInvalidArgument)
+		assertErrorCode(t, repo.Save(context.Background(), internal.TrashedTask{Task: internal.Task{ID: "x"}}),
+			internal.ErrorCodeInvalidArgument)
+
+		_, err = repo.List(context.Background(), internal.TrashParams{Cursor: "!", Size: 1})
+		assertErrorCode(t, err, internal.ErrorCodeInvalidArgument)
+	})
+}
+
+func assertFindTrashed(t *testing.T, repo service.TaskTrashRepository, expected internal.TrashedTask) {
+	t.Helper()
+
+	actual, err := repo.Find(context.Background(), expected.Task.ID)
+	if err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	if !cmp.Equal(expected, actual, ignoreGenerated) {
+		t.Fatalf("expected result does not match: %s", cmp.Diff(expected, actual, ignoreGenerated))
+	}
+}
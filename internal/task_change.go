@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"sync"
+)
+
+const (
+	// TaskChangeCreated indicates the Task was created.
+	TaskChangeCreated TaskChangeKind = "created"
+
+	// TaskChangeUpdated indicates the Task was updated.
+	TaskChangeUpdated TaskChangeKind = "updated"
+
+	// TaskChangeDeleted indicates the Task was deleted.
+	TaskChangeDeleted TaskChangeKind = "deleted"
+)
+
+// TaskChangeKind indicates how a Task changed.
+type TaskChangeKind string
+
+// TaskChange indicates a Task was modified, subscribers read the Task again when they need its values.
+type TaskChange struct {
+	Kind    TaskChangeKind
+	ID      string
+	Version int64
+}
+
+// TaskChangeFeed fans out the changes to tasks to the subscribers in the same process, like the clients connected
+// for receiving near real-time updates.
+type TaskChangeFeed struct {
+	mu          sync.Mutex
+	seq         uint64
+	subscribers map[uint64]chan TaskChange
+}
+
+// NewTaskChangeFeed instantiates the TaskChangeFeed.
+func NewTaskChangeFeed() *TaskChangeFeed {
+	return &TaskChangeFeed{
+		subscribers: make(map[uint64]chan TaskChange),
+	}
+}
+
+// Subscribe returns the channel receiving the published changes, buffering up to size changes, and the function
+// for unsubscribing which closes that channel. Changes are dropped when the buffer is full, so a slow subscriber
+// does not block the others.
+func (f *TaskChangeFeed) Subscribe(size int) (<-chan TaskChange, func()) {
+	changes := make(chan TaskChange, size)
+
+	f.mu.Lock()
+	f.seq++
+	id := f.seq
+	f.subscribers[id] = changes
+	f.mu.Unlock()
+
+	var once sync.Once
+
+	return changes, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			delete(f.subscribers, id)
+			f.mu.Unlock()
+
+			close(changes)
+		})
+	}
+}
+
+// Publish sends the change to the current subscribers, it returns the number of subscribers that dropped it.
+func (f *TaskChangeFeed) Publish(change TaskChange) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var dropped int
+
+	for _, changes := range f.subscribers {
+		select {
+		case changes <- change:
+		default:
+			dropped++
+		}
+	}
+
+	return dropped
+}
@@ -0,0 +1,55 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestTaskChangeFeed(t *testing.T) {
+	t.Parallel()
+
+	feed := internal.NewTaskChangeFeed()
+
+	fast, unsubscribeFast := feed.Subscribe(2)
+	defer unsubscribeFast()
+
+	slow, unsubscribeSlow := feed.Subscribe(1)
+
+	created := internal.TaskChange{Kind: internal.TaskChangeCreated, ID: "1-2-3", Version: 1}
+	updated := internal.TaskChange{Kind: internal.TaskChangeUpdated, ID: "1-2-3", Version: 2}
+
+	if dropped := feed.Publish(created); dropped != 0 {
+		t.Fatalf("expected no dropped changes, got %d", dropped)
+	}
+
+	// The buffer of the slow subscriber is full, it does not block the other ones.
+	if dropped := feed.Publish(updated); dropped != 1 {
+		t.Fatalf("expected 1 dropped change, got %d", dropped)
+	}
+
+	for _, expected := range []internal.TaskChange{created, updated} {
+		if actual := <-fast; actual != expected {
+			t.Fatalf("expected %#v, got %#v", expected, actual)
+		}
+	}
+
+	if actual := <-slow; actual != created {
+		t.Fatalf("expected %#v, got %#v", created, actual)
+	}
+
+	unsubscribeSlow()
+	unsubscribeSlow()
+
+	if _, ok := <-slow; ok {
+		t.Fatalf("expected closed channel")
+	}
+
+	if dropped := feed.Publish(created); dropped != 0 {
+		t.Fatalf("expected no dropped changes, got %d", dropped)
+	}
+
+	if actual := <-fast; actual != created {
+		t.Fatalf("expected %#v, got %#v", created, actual)
+	}
+}
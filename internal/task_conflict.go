@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"fmt"
+)
+
+// TaskConflict defines the versions of a Task involved in a conflicting update: Base is the version the update
+// was based on, Theirs is the current version, including the changes made by others since Base, and Yours
+// includes the values of the rejected update.
+type TaskConflict struct {
+	Base   Task
+	Theirs Task
+	Yours  Task
+}
+
+// taskField defines a field of a Task that can be changed independently when merging.
+type taskField struct {
+	name  string
+	equal func(a, b Task) bool
+	set   func(dst *Task, src Task)
+}
+
+//nolint: gochecknoglobals
+var taskFields = []taskField{
+	{
+		name:  "description",
+		equal: func(a, b Task) bool { return a.Description == b.Description },
+		set:   func(dst *Task, src Task) { dst.Description = src.Description },
+	},
+	{
+		name:  "priority",
+		equal: func(a, b Task) bool { return a.Priority == b.Priority },
+		set:   func(dst *Task, src Task) { dst.Priority = src.Priority },
+	},
+	{
+		name:  "dates.start",
+		equal: func(a, b Task) bool { return a.Dates.Start.Equal(b.Dates.Start) },
+		set:   func(dst *Task, src Task) { dst.Dates.Start = src.Dates.Start },
+	},
+	{
+		name:  "dates.due",
+		equal: func(a, b Task) bool { return a.Dates.Due.Equal(b.Dates.Due) },
+		set:   func(dst *Task, src Task) { dst.Dates.Due = src.Dates.Due },
+	},
+	{
+		name:  "dates.time_zone",
+		equal: func(a, b Task) bool { return a.Dates.TimeZone == b.Dates.TimeZone },
+		set:   func(dst *Task, src Task) { dst.Dates.TimeZone = src.Dates.TimeZone },
+	},
+	{
+		name:  "project_id",
+		equal: func(a, b Task) bool { return a.ProjectID == b.ProjectID },
+		set:   func(dst *Task, src Task) { dst.ProjectID = src.ProjectID },
+	},
+	{
+		name:  "is_done",
+		equal: func(a, b Task) bool { return a.IsDone == b.IsDone },
+		set:   func(dst *Task, src Task) { dst.IsDone = src.IsDone },
+	},
+}
+
+// Conflicts returns the names of the fields changed by both Theirs and Yours using different values.
+func (c TaskConflict) Conflicts() []string {
+	res := []string{}
+
+	for _, field := range taskFields {
+		if !field.equal(c.Base, c.Theirs) && !field.equal(c.Base, c.Yours) && !field.equal(c.Theirs, c.Yours) {
+			res = append(res, field.name)
+		}
+	}
+
+	return res
+}
+
+// Merge returns Theirs including the fields changed by Yours, it indicates whether merging was possible: it isn't
+// when there are conflicts.
+func (c TaskConflict) Merge() (Task, bool) {
+	if len(c.Conflicts()) > 0 {
+		return Task{}, false
+	}
+
+	res := c.Theirs
+
+	for _, field := range taskFields {
+		if !field.equal(c.Base, c.Yours) {
+			field.set(&res, c.Yours)
+		}
+	}
+
+	return res, true
+}
+
+// TaskConflictError indicates a Task was not updated because it changed since the expected version, it includes
+// the versions involved for resolving the conflict.
+type TaskConflictError struct {
+	Conflict TaskConflict
+}
+
+// Error returns the message.
+func (e *TaskConflictError) Error() string {
+	return fmt.Sprintf("task changed since version %d, current version is %d",
+		e.Conflict.Base.Version, e.Conflict.Theirs.Version)
+}
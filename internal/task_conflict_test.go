@@ -0,0 +1,116 @@
+package internal_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestTaskConflict_Merge(t *testing.T) {
+	t.Parallel()
+
+	base := internal.Task{
+		ID:          "1-2-3",
+		Description: "buy milk",
+		Priority:    internal.PriorityLow,
+		Version:     1,
+	}
+
+	tests := []struct {
+		name      string
+		theirs    func(internal.Task) internal.Task
+		yours     func(internal.Task) internal.Task
+		conflicts []string
+		merged    *internal.Task
+	}{
+		{
+			"OK: different fields",
+			func(task internal.Task) internal.Task {
+				task.Priority = internal.PriorityHigh
+				task.Version = 2
+
+				return task
+			},
+			func(task internal.Task) internal.Task {
+				task.Description = "buy bread"
+
+				return task
+			},
+			[]string{},
+			&internal.Task{
+				ID:          "1-2-3",
+				Description: "buy bread",
+				Priority:    internal.PriorityHigh,
+				Version:     2,
+			},
+		},
+		{
+			"OK: same values",
+			func(task internal.Task) internal.Task {
+				task.IsDone = true
+				task.Version = 2
+
+				return task
+			},
+			func(task internal.Task) internal.Task {
+				task.IsDone = true
+
+				return task
+			},
+			[]string{},
+			&internal.Task{
+				ID:          "1-2-3",
+				Description: "buy milk",
+				Priority:    internal.PriorityLow,
+				IsDone:      true,
+				Version:     2,
+			},
+		},
+		{
+			"ERR: same field",
+			func(task internal.Task) internal.Task {
+				task.Description = "buy eggs"
+				task.Priority = internal.PriorityHigh
+
+				return task
+			},
+			func(task internal.Task) internal.Task {
+				task.Description = "buy bread"
+				task.Priority = internal.PriorityHigh
+
+				return task
+			},
+			[]string{"description"},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conflict := internal.TaskConflict{
+				Base:   base,
+				Theirs: tt.theirs(base),
+				Yours:  tt.yours(base),
+			}
+
+			if actual := conflict.Conflicts(); !cmp.Equal(tt.conflicts, actual) {
+				t.Fatalf("expected conflicts do not match: %s", cmp.Diff(tt.conflicts, actual))
+			}
+
+			merged, ok := conflict.Merge()
+			if ok != (tt.merged != nil) {
+				t.Fatalf("expected merged %t, got %t", tt.merged != nil, ok)
+			}
+
+			if ok && !cmp.Equal(*tt.merged, merged) {
+				t.Fatalf("expected merged task does not match: %s", cmp.Diff(*tt.merged, merged))
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// TaskDependency indicates the Task BlockerID blocks the Task BlockedID: the latter can't be completed until the
+// former is done.
+type TaskDependency struct {
+	BlockerID string
+	BlockedID string
+}
+
+// Validate indicates whether the fields are valid or not.
+func (d TaskDependency) Validate() error {
+	if err := validation.ValidateStruct(&d,
+		validation.Field(&d.BlockerID, validation.Required),
+		validation.Field(&d.BlockedID, validation.Required),
+	); err != nil {
+		return WrapErrorf(err, ErrorCodeInvalidArgument, "invalid values")
+	}
+
+	if d.BlockerID == d.BlockedID {
+		return NewErrorf(ErrorCodeInvalidArgument, "tasks can't block themselves")
+	}
+
+	return nil
+}
+
+// TaskDependencies defines the Tasks related to a Task: BlockedBy are the ones blocking it and Blocks the ones it
+// blocks.
+type TaskDependencies struct {
+	BlockedBy []Task
+	Blocks    []Task
+}
+
+type ignoreBlockersKey struct{}
+
+// NewContextWithIgnoreBlockers returns a new context indicating that Tasks are completed even when the Tasks blocking
+// them are not done yet.
+func NewContextWithIgnoreBlockers(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ignoreBlockersKey{}, true)
+}
+
+// IgnoreBlockersFromContext indicates whether ctx requests completing Tasks regardless of their blockers.
+func IgnoreBlockersFromContext(ctx context.Context) bool {
+	ignore, _ := ctx.Value(ignoreBlockersKey{}).(bool)
+
+	return ignore
+}
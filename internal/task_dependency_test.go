@@ -0,0 +1,70 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestTaskDependency_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.TaskDependency
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.TaskDependency{BlockerID: "a", BlockedID: "b"},
+			false,
+		},
+		{
+			"ERR: missing blocker",
+			internal.TaskDependency{BlockedID: "b"},
+			true,
+		},
+		{
+			"ERR: missing blocked",
+			internal.TaskDependency{BlockerID: "a"},
+			true,
+		},
+		{
+			"ERR: self",
+			internal.TaskDependency{BlockerID: "a", BlockedID: "a"},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.input.Validate()
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %v", tt.withErr, err)
+			}
+
+			var ierr *internal.Error
+			if err != nil && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument) {
+				t.Fatalf("expected invalid argument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestIgnoreBlockersFromContext(t *testing.T) {
+	t.Parallel()
+
+	if internal.IgnoreBlockersFromContext(context.Background()) {
+		t.Fatalf("expected false")
+	}
+
+	if !internal.IgnoreBlockersFromContext(internal.NewContextWithIgnoreBlockers(context.Background())) {
+		t.Fatalf("expected true")
+	}
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/global"
+)
+
+// TaskEventVersion is the version of the data of the task events published by this service, it must be increased
+// when fields are added or their meaning changes. Events published before versioning was introduced use version
+// zero.
+const TaskEventVersion = 5
+
+//nolint: gochecknoglobals
+var (
+	unrecognizedTaskEvents = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal")).NewInt64Counter(
+		"events.unrecognized_version",
+		metric.WithDescription("Number of task events using a version newer than the one supported, labeled by version"),
+	)
+
+	unrecognizedTaskEventTypes = metric.Must(global.Meter("github.com/MarioCarrion/todo-api/internal")).NewInt64Counter(
+		"events.unrecognized_type",
+		metric.WithDescription("Number of task events using a type unknown to this version, labeled by type"),
+	)
+)
+
+// SkipUnrecognizedTaskEvent counts the task event using a type unknown to this version in the
+// "events.unrecognized_type" metric. Those events are skipped instead of failing, because newer versions may
+// publish them while both versions run alongside each other during rolling deploys.
+func SkipUnrecognizedTaskEvent(ctx context.Context, eventType string) {
+	unrecognizedTaskEventTypes.Add(ctx, 1, attribute.String("type", eventType))
+}
+
+// DecodeTaskEventData decodes the data of a task event published using version: unknown fields are ignored and
+// missing fields, or all of them when b is empty, use the defaults of that version. Events using a version newer
+// than TaskEventVersion are decoded using the defaults of TaskEventVersion, those are counted in the
+// "events.unrecognized_version" metric so consumers can be upgraded.
+func DecodeTaskEventData(ctx context.Context, version int, b []byte) (Task, error) {
+	if version > TaskEventVersion {
+		unrecognizedTaskEvents.Add(ctx, 1, attribute.Int("version", version))
+
+		version = TaskEventVersion
+	}
+
+	// Fields missing in b keep the default values.
+	res := taskEventDefaults(version)
+
+	if len(b) == 0 {
+		return res, nil
+	}
+
+	if err := json.Unmarshal(b, &res); err != nil {
+		return Task{}, WrapErrorf(err, ErrorCodeInvalidArgument, "json.Unmarshal")
+	}
+
+	return res, nil
+}
+
+// taskEventDefaults returns the values used for the fields missing in the data of task events, per version.
+func taskEventDefaults(version int) Task {
+	switch version {
+	case 0, 1, 2, 3, 4, 5:
+		// Versions zero and one use the same fields, version two adds "Version", version three "ProjectID",
+		// version four "CompletedAt" and version five "CreatedAt" and "UpdatedAt"; all of them are optional.
+		return Task{
+			Priority: PriorityNone,
+		}
+	}
+
+	return Task{}
+}
+
+// TaskEventRecord is an event appended to the stream of a task when tasks are event sourced, Data is the JSON
+// encoded state: "created" events include all the fields while "updated" events only include the changed ones.
+type TaskEventRecord struct {
+	TaskID    string
+	Version   int64
+	Type      string
+	Data      []byte
+	CreatedAt time.Time
+}
@@ -0,0 +1,153 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestDecodeTaskEventData(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		expected internal.Task
+		withErr  bool
+	}
+
+	tests := []struct {
+		name    string
+		version int
+		input   string
+		output  output
+	}{
+		{
+			"OK",
+			internal.TaskEventVersion,
+			`{"ID":"1-2-3","Description":"event","Priority":3,"IsDone":true}`,
+			output{
+				expected: internal.Task{
+					ID:          "1-2-3",
+					Description: "event",
+					Priority:    internal.PriorityHigh,
+					IsDone:      true,
+				},
+			},
+		},
+		{
+			"OK: version 1, without task version",
+			1,
+			`{"ID":"1-2-3","Description":"event","Priority":1}`,
+			output{
+				expected: internal.Task{
+					ID:          "1-2-3",
+					Description: "event",
+					Priority:    internal.PriorityLow,
+				},
+			},
+		},
+		{
+			"OK: task version",
+			internal.TaskEventVersion,
+			`{"ID":"1-2-3","Description":"event","Version":4}`,
+			output{
+				expected: internal.Task{
+					ID:          "1-2-3",
+					Description: "event",
+					Version:     4,
+				},
+			},
+		},
+		{
+			"OK: project",
+			internal.TaskEventVersion,
+			`{"ID":"1-2-3","Description":"event","ProjectID":"4-5-6"}`,
+			output{
+				expected: internal.Task{
+					ID:          "1-2-3",
+					Description: "event",
+					ProjectID:   "4-5-6",
+				},
+			},
+		},
+		{
+			"OK: version 2, without project",
+			2,
+			`{"ID":"1-2-3","Description":"event","Version":4}`,
+			output{
+				expected: internal.Task{
+					ID:          "1-2-3",
+					Description: "event",
+					Version:     4,
+				},
+			},
+		},
+		{
+			"OK: unversioned, missing fields",
+			0,
+			`{"ID":"1-2-3"}`,
+			output{
+				expected: internal.Task{
+					ID:       "1-2-3",
+					Priority: internal.PriorityNone,
+				},
+			},
+		},
+		{
+			"OK: newer version, unknown fields",
+			internal.TaskEventVersion + 1,
+			`{"ID":"1-2-3","Description":"event","Labels":["new"],"Dates":{"Reminder":"2021-01-01T00:00:00Z"}}`,
+			output{
+				expected: internal.Task{
+					ID:          "1-2-3",
+					Description: "event",
+				},
+			},
+		},
+		{
+			"OK: empty",
+			internal.TaskEventVersion,
+			``,
+			output{
+				expected: internal.Task{},
+			},
+		},
+		{
+			"ERR: invalid JSON",
+			internal.TaskEventVersion,
+			`{"ID":`,
+			output{
+				withErr: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := internal.DecodeTaskEventData(context.Background(), tt.version, []byte(tt.input))
+			if (err != nil) != tt.output.withErr {
+				t.Fatalf("expected error %t, got %s", tt.output.withErr, err)
+			}
+
+			if err != nil {
+				var ierr *internal.Error
+				if !errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument {
+					t.Fatalf("expected %T error, got %T : %v", ierr, err, err)
+				}
+
+				return
+			}
+
+			if !cmp.Equal(tt.output.expected, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output.expected, actual))
+			}
+		})
+	}
+}
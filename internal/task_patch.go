@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// MaxBatchSize is the maximum number of patches applied in a single batch update.
+const MaxBatchSize = 100
+
+// TaskPatch defines the fields changed in the Task matching ID, nil fields are kept as they are.
+type TaskPatch struct {
+	ID          string
+	Description *string
+	Priority    *Priority
+	Dates       *Dates
+	ProjectID   *string
+	IsDone      *bool
+}
+
+// Apply returns the task including the patched fields.
+func (p TaskPatch) Apply(task Task) Task {
+	if p.Description != nil {
+		task.Description = *p.Description
+	}
+
+	if p.Priority != nil {
+		task.Priority = *p.Priority
+	}
+
+	if p.Dates != nil {
+		task.Dates = *p.Dates
+	}
+
+	if p.ProjectID != nil {
+		task.ProjectID = *p.ProjectID
+	}
+
+	if p.IsDone != nil {
+		task.IsDone = *p.IsDone
+	}
+
+	return task
+}
+
+// TaskPatches defines the patches applied in a single batch update, each Task is patched at most once.
+type TaskPatches []TaskPatch
+
+// Validate indicates whether the patches are valid or not, the values of each field are validated once applied.
+func (p TaskPatches) Validate() error {
+	if len(p) == 0 || len(p) > MaxBatchSize {
+		return NewErrorf(ErrorCodeInvalidArgument, "must include between 1 and %d patches", MaxBatchSize)
+	}
+
+	errs := validation.Errors{}
+	seen := make(map[string]struct{}, len(p))
+
+	for i, patch := range p {
+		if patch.ID == "" {
+			errs[fmt.Sprintf("%d.id", i)] = NewErrorf(ErrorCodeInvalidArgument, "cannot be blank")
+
+			continue
+		}
+
+		if _, ok := seen[patch.ID]; ok {
+			errs[fmt.Sprintf("%d.id", i)] = NewErrorf(ErrorCodeInvalidArgument, "must be unique")
+		}
+
+		seen[patch.ID] = struct{}{}
+	}
+
+	if err := errs.Filter(); err != nil {
+		return WrapErrorf(err, ErrorCodeInvalidArgument, "invalid values")
+	}
+
+	return nil
+}
+
+// BatchUpdateResult defines the result of applying a patch: the patched Task or the error preventing it.
+type BatchUpdateResult struct {
+	Task Task
+	Err  error
+}
+
+// BatchUpdateResults defines the results of a batch update, indexed like the patches. Patches are applied all
+// together: when Applied is false none of them was, the ones without error failed because of the others.
+type BatchUpdateResults struct {
+	Applied bool
+	Results []BatchUpdateResult
+}
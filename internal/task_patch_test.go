@@ -0,0 +1,120 @@
+package internal_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestTaskPatch_Apply(t *testing.T) {
+	t.Parallel()
+
+	description := "updated"
+	priority := internal.PriorityHigh
+	projectID := ""
+	isDone := true
+
+	task := internal.Task{
+		ID:          "a",
+		Description: "original",
+		Priority:    internal.PriorityLow,
+		ProjectID:   "p",
+	}
+
+	tests := []struct {
+		name     string
+		input    internal.TaskPatch
+		expected internal.Task
+	}{
+		{
+			"OK: no fields",
+			internal.TaskPatch{ID: "a"},
+			task,
+		},
+		{
+			"OK: all fields",
+			internal.TaskPatch{
+				ID:          "a",
+				Description: &description,
+				Priority:    &priority,
+				ProjectID:   &projectID,
+				IsDone:      &isDone,
+			},
+			internal.Task{
+				ID:          "a",
+				Description: "updated",
+				Priority:    internal.PriorityHigh,
+				IsDone:      true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tt.input.Apply(task); !cmp.Equal(tt.expected, actual) {
+				t.Fatalf("expected results don't match: %s", cmp.Diff(tt.expected, actual))
+			}
+		})
+	}
+}
+
+func TestTaskPatches_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   internal.TaskPatches
+		withErr bool
+	}{
+		{
+			"OK",
+			internal.TaskPatches{{ID: "a"}, {ID: "b"}},
+			false,
+		},
+		{
+			"ERR: empty",
+			internal.TaskPatches{},
+			true,
+		},
+		{
+			"ERR: too many",
+			make(internal.TaskPatches, internal.MaxBatchSize+1),
+			true,
+		},
+		{
+			"ERR: missing id",
+			internal.TaskPatches{{ID: "a"}, {}},
+			true,
+		},
+		{
+			"ERR: duplicated id",
+			internal.TaskPatches{{ID: "a"}, {ID: "a"}},
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.input.Validate()
+			if (err != nil) != tt.withErr {
+				t.Fatalf("expected error %t, got %v", tt.withErr, err)
+			}
+
+			var ierr *internal.Error
+			if err != nil && (!errors.As(err, &ierr) || ierr.Code() != internal.ErrorCodeInvalidArgument) {
+				t.Fatalf("expected invalid argument, got %v", err)
+			}
+		})
+	}
+}
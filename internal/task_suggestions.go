@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// MaxSuggestionKeywords is the maximum number of description words used for finding similar tasks.
+	MaxSuggestionKeywords = 3
+
+	// minSimilarTasks is the minimum number of similar tasks needed for proposing a value.
+	minSimilarTasks = 2
+)
+
+//nolint: gochecknoglobals
+var stopWords = map[string]struct{}{
+	"about": {}, "after": {}, "and": {}, "are": {}, "before": {}, "but": {}, "for": {}, "from": {}, "into": {},
+	"not": {}, "off": {}, "out": {}, "over": {}, "that": {}, "the": {}, "then": {}, "this": {}, "with": {},
+}
+
+// TaskSuggestions defines the values proposed for a new Task based on the tasks with similar descriptions, nil
+// when there is nothing to propose.
+type TaskSuggestions struct {
+	Priority *Priority
+	Due      *time.Time
+}
+
+// IsZero indicates whether nothing is proposed.
+func (s TaskSuggestions) IsZero() bool {
+	return s.Priority == nil && s.Due == nil
+}
+
+// SuggestionKeywords returns the words of the description used for finding similar tasks, the longest ones first
+// and up to MaxSuggestionKeywords; short and common words are not used.
+func SuggestionKeywords(description string) []string {
+	seen := make(map[string]struct{})
+
+	var res []string
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(description), func(r rune) bool { return !isWordRune(r) }) {
+		if _, ok := stopWords[word]; ok || utf8.RuneCountInString(word) < 3 {
+			continue
+		}
+
+		if _, ok := seen[word]; ok {
+			continue
+		}
+
+		seen[word] = struct{}{}
+
+		res = append(res, word)
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return utf8.RuneCountInString(res[i]) > utf8.RuneCountInString(res[j])
+	})
+
+	if len(res) > MaxSuggestionKeywords {
+		res = res[:MaxSuggestionKeywords]
+	}
+
+	return res
+}
+
+// NewTaskSuggestions proposes values for the task using the similar ones, tasks sharing more keywords are expected
+// to be included more than once so they weigh more. The priority is the most common one when it differs from the
+// task's, the due date is only proposed when the task has none: it's as far from the start date, or from now, as
+// the median time given to the similar tasks between their start and due dates.
+func NewTaskSuggestions(task Task, similar []Task, now time.Time) TaskSuggestions {
+	var (
+		res       TaskSuggestions
+		votes     = make(map[Priority]int)
+		voted     int
+		durations []time.Duration
+	)
+
+	for _, other := range similar {
+		if other.ID == task.ID {
+			continue
+		}
+
+		if other.Priority != PriorityNone {
+			votes[other.Priority]++
+			voted++
+		}
+
+		if !other.Dates.Start.IsZero() && other.Dates.Due.After(other.Dates.Start) {
+			durations = append(durations, other.Dates.Due.Sub(other.Dates.Start))
+		}
+	}
+
+	if voted >= minSimilarTasks {
+		var (
+			best  Priority
+			count int
+		)
+
+		// Ties are resolved using the higher priority.
+		for _, priority := range []Priority{PriorityHigh, PriorityMedium, PriorityLow} {
+			if votes[priority] > count {
+				best, count = priority, votes[priority]
+			}
+		}
+
+		if best != task.Priority {
+			res.Priority = &best
+		}
+	}
+
+	if len(durations) >= minSimilarTasks && task.Dates.Due.IsZero() {
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		start := task.Dates.Start
+		if start.IsZero() {
+			start = now
+		}
+
+		due := start.Add(durations[len(durations)/2]).UTC().Truncate(time.Minute)
+		res.Due = &due
+	}
+
+	return res
+}
@@ -0,0 +1,134 @@
+package internal_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/MarioCarrion/todo-api/internal"
+)
+
+func TestSuggestionKeywords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		output []string
+	}{
+		{
+			"OK: longest first",
+			"Renew the car insurance",
+			[]string{"insurance", "renew", "car"},
+		},
+		{
+			"OK: limited",
+			"Call plumber about kitchen sink leaking",
+			[]string{"plumber", "kitchen", "leaking"},
+		},
+		{
+			"OK: repeated and short words",
+			"Go to the gym, gym!",
+			[]string{"gym"},
+		},
+		{
+			"OK: none",
+			"do it",
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := internal.SuggestionKeywords(tt.input); !cmp.Equal(tt.output, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output, actual))
+			}
+		})
+	}
+}
+
+func TestNewTaskSuggestions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	similar := func(priority internal.Priority, days int) internal.Task {
+		return internal.Task{
+			ID:       "similar",
+			Priority: priority,
+			Dates:    internal.Dates{Start: start, Due: start.AddDate(0, 0, days)},
+		}
+	}
+
+	priority := func(p internal.Priority) *internal.Priority { return &p }
+	due := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name    string
+		task    internal.Task
+		similar []internal.Task
+		output  internal.TaskSuggestions
+	}{
+		{
+			"OK: priority and due date",
+			internal.Task{ID: "new", Priority: internal.PriorityLow},
+			[]internal.Task{
+				similar(internal.PriorityHigh, 1),
+				similar(internal.PriorityHigh, 3),
+				similar(internal.PriorityLow, 7),
+			},
+			internal.TaskSuggestions{
+				Priority: priority(internal.PriorityHigh),
+				Due:      due(now.AddDate(0, 0, 3)),
+			},
+		},
+		{
+			"OK: due date from start",
+			internal.Task{ID: "new", Priority: internal.PriorityHigh, Dates: internal.Dates{Start: now.AddDate(0, 0, 1)}},
+			[]internal.Task{
+				similar(internal.PriorityHigh, 2),
+				similar(internal.PriorityHigh, 2),
+			},
+			internal.TaskSuggestions{
+				Due: due(now.AddDate(0, 0, 3)),
+			},
+		},
+		{
+			"OK: explicit due date",
+			internal.Task{ID: "new", Priority: internal.PriorityHigh, Dates: internal.Dates{Due: now}},
+			[]internal.Task{
+				similar(internal.PriorityHigh, 2),
+				similar(internal.PriorityHigh, 2),
+			},
+			internal.TaskSuggestions{},
+		},
+		{
+			"OK: not enough similar tasks",
+			internal.Task{ID: "new", Priority: internal.PriorityLow},
+			[]internal.Task{
+				similar(internal.PriorityHigh, 2),
+				{ID: "new", Priority: internal.PriorityHigh},
+			},
+			internal.TaskSuggestions{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := internal.NewTaskSuggestions(tt.task, tt.similar, now)
+			if !cmp.Equal(tt.output, actual) {
+				t.Fatalf("expected result does not match: %s", cmp.Diff(tt.output, actual))
+			}
+		})
+	}
+}
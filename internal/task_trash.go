@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// TrashedTask is a deleted Task, deleted Tasks are kept in the trash so they can be restored until those are purged.
+type TrashedTask struct {
+	Task      Task
+	DeletedAt time.Time
+}
+
+// TrashParams defines the arguments used for listing the trashed Tasks, the most recently deleted first. Cursor is
+// an opaque value returned by a previous call, when empty the first page is returned.
+type TrashParams struct {
+	Cursor string
+	Size   int64
+}
+
+// Validate indicates whether the fields are valid or not.
+func (p TrashParams) Validate() error {
+	errs := validation.Errors{}
+
+	if p.Size <= 0 {
+		errs["size"] = NewErrorf(ErrorCodeInvalidArgument, "must be greater than zero")
+	}
+
+	return errs.Filter()
+}
+
+// TrashResults defines the collection of trashed Tasks that were listed. NextCursor is empty when there are no more
+// records to list.
+type TrashResults struct {
+	Tasks      []TrashedTask
+	NextCursor string
+}
+
+// TrashCursor defines the keyset used for paginating the trashed Tasks, those are sorted by deletion time and then
+// by id, both descending. The cursor of the first page sorts after every trashed Task.
+type TrashCursor struct {
+	DeletedAt time.Time
+	ID        string
+}
+
+// DecodeTrashCursor returns the cursor encoded in val, the one of the first page when empty.
+func DecodeTrashCursor(val string) (TrashCursor, error) {
+	if val == "" {
+		return TrashCursor{DeletedAt: time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(val)
+	if err != nil {
+		return TrashCursor{}, WrapErrorf(err, ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	split := strings.SplitN(string(b), "|", 2)
+	if len(split) != 2 || split[1] == "" {
+		return TrashCursor{}, NewErrorf(ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	at, err := strconv.ParseInt(split[0], 10, 64)
+	if err != nil {
+		return TrashCursor{}, WrapErrorf(err, ErrorCodeInvalidArgument, "invalid cursor")
+	}
+
+	return TrashCursor{
+		DeletedAt: time.UnixMicro(at).UTC(),
+		ID:        split[1],
+	}, nil
+}
+
+// After indicates whether the trashed Task sorts after the cursor, that is it belongs to the following pages.
+func (c TrashCursor) After(task TrashedTask) bool {
+	if !task.DeletedAt.Equal(c.DeletedAt) {
+		return task.DeletedAt.Before(c.DeletedAt)
+	}
+
+	return task.Task.ID < c.ID
+}
+
+func (c TrashCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.DeletedAt.UnixMicro(), 10) + "|" + c.ID))
+}
+
+// NewTrashCursor returns the cursor pointing at the trashed Task.
+func NewTrashCursor(task TrashedTask) TrashCursor {
+	return TrashCursor{
+		DeletedAt: task.DeletedAt,
+		ID:        task.Task.ID,
+	}
+}
@@ -37,10 +37,13 @@ func (p Priority) Validate() error {
 // Category is human readable value meant to be used to organize your tasks. Category values are unique.
 type Category string
 
-// Dates indicates a point in time where a task starts or completes, dates are not enforced on Tasks.
+// Dates indicates a point in time where a task starts or completes, dates are not enforced on Tasks. TimeZone is the
+// IANA time zone the dates are meant to be rendered in, for example "America/New_York", when empty the time zone of
+// whoever reads them is used.
 type Dates struct {
-	Start time.Time
-	Due   time.Time
+	Start    time.Time
+	Due      time.Time
+	TimeZone string
 }
 
 // Validate ...
@@ -49,9 +52,29 @@ func (d Dates) Validate() error {
 		return NewErrorf(ErrorCodeInvalidArgument, "start dates should be before end date")
 	}
 
+	if d.TimeZone != "" {
+		if _, err := time.LoadLocation(d.TimeZone); err != nil {
+			return WrapErrorf(err, ErrorCodeInvalidArgument, "unknown time zone")
+		}
+	}
+
 	return nil
 }
 
+// Location returns the time zone of the dates, nil when TimeZone is empty or unknown.
+func (d Dates) Location() *time.Location {
+	if d.TimeZone == "" {
+		return nil
+	}
+
+	loc, err := time.LoadLocation(d.TimeZone)
+	if err != nil {
+		return nil
+	}
+
+	return loc
+}
+
 // Task is an activity that needs to be completed within a period of time.
 type Task struct {
 	IsDone      bool
@@ -59,8 +82,13 @@ type Task struct {
 	ID          string
 	Description string
 	Dates       Dates
+	ProjectID   string    // Empty when the Task does not belong to a Project.
+	CompletedAt time.Time // Zero when the Task is not done, or when it was completed before those were kept.
+	CreatedAt   time.Time // Zero when the datastore does not keep it.
+	UpdatedAt   time.Time // Time of the last change, equal to CreatedAt until then; zero like CreatedAt.
 	SubTasks    []Task
 	Categories  []Category
+	Version     int64 // Increased every time the Task is modified, zero when the datastore does not support it.
 }
 
 // Validate ...
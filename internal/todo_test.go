@@ -92,6 +92,14 @@ func TestDates_Validate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"OK: TimeZone",
+			internal.Dates{
+				Due:      time.Now(),
+				TimeZone: "America/New_York",
+			},
+			false,
+		},
 		{
 			"ERR: Start > Due",
 			internal.Dates{
@@ -100,6 +108,14 @@ func TestDates_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ERR: TimeZone",
+			internal.Dates{
+				Due:      time.Now(),
+				TimeZone: "Mars/Olympus_Mons",
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,215 @@
+// Package client implements a client for the ToDo API, it wraps the client generated from the OpenAPI 3
+// specification adding typed methods, retries of transient failures and errors using the codes of the service.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/internal/retry"
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+// Client calls the ToDo API.
+type Client struct {
+	oa3   *openapi3.Client
+	retry RetryOptions
+}
+
+// RetryOptions defines how transient failures are retried: requests are sent up to Attempts times waiting Backoff
+// before the first retry, it's doubled after each retry up to MaxBackoff. One attempt means requests are not
+// retried.
+type RetryOptions struct {
+	Attempts   int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// Option configures the client.
+type Option func(*options)
+
+type options struct {
+	httpClient *http.Client
+	retry      RetryOptions
+}
+
+// WithHTTPClient defines the HTTP client used for sending requests, http.DefaultClient is used by default.
+func WithHTTPClient(client *http.Client) Option {
+	return func(o *options) {
+		o.httpClient = client
+	}
+}
+
+// WithRetry defines how transient failures are retried.
+func WithRetry(retry RetryOptions) Option {
+	return func(o *options) {
+		o.retry = retry
+	}
+}
+
+// New instantiates the client, baseURL includes the version of the API, "http://127.0.0.1:9234/api/v1" for
+// example. By default requests are retried up to 4 times waiting between 200 milliseconds and 2 seconds.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	o := options{
+		httpClient: http.DefaultClient,
+		retry: RetryOptions{
+			Attempts:   4,
+			Backoff:    200 * time.Millisecond,
+			MaxBackoff: 2 * time.Second,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	oa3, err := openapi3.NewClient(baseURL, openapi3.WithHTTPClient(o.httpClient))
+	if err != nil {
+		return nil, wrapErrorf(err, ErrorCodeInvalidArgument, "openapi3.NewClient")
+	}
+
+	return &Client{
+		oa3:   oa3,
+		retry: o.retry,
+	}, nil
+}
+
+// ResponseError is the error returned by the API, it's wrapped by an Error using the code matching the status so
+// callers can use both.
+type ResponseError struct {
+	StatusCode int
+	Code       string // Code returned by the API, like "dependency_postgresql", when included.
+	Message    string
+	RequestID  string
+}
+
+// Error returns the message and status.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("%s (status %d, request %q)", e.Message, e.StatusCode, e.RequestID)
+}
+
+// sendFunc sends a request using the generated client.
+type sendFunc func(ctx context.Context) (*http.Response, error)
+
+// do sends the request until it succeeds or fails with an error that is not transient, it fails when the status
+// is not one of expected. The body of the response is decoded into dst, when not nil, and its headers returned.
+//
+// Failures sending the request, or 502 and 504 responses, are only retried for idempotent requests because the
+// request may have been processed; 429 and 503 responses are always retried, those requests were rejected.
+func (c *Client) do(ctx context.Context, operation string, idempotent bool, send sendFunc, dst interface{},
+	expected ...int) (http.Header, error) {
+	var (
+		header    http.Header
+		retryable bool
+	)
+
+	policy := retry.Policy{
+		Attempts:   c.retry.Attempts,
+		Backoff:    c.retry.Backoff,
+		MaxBackoff: c.retry.MaxBackoff,
+		Retryable:  func(error) bool { return retryable },
+	}
+
+	err := policy.Do(ctx, "client."+operation, func(ctx context.Context) error {
+		retryable = false
+
+		res, err := send(ctx)
+		if err != nil {
+			retryable = idempotent && ctx.Err() == nil
+
+			return wrapErrorf(err, ErrorCodeUnavailable, "send")
+		}
+
+		defer res.Body.Close()
+
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			retryable = idempotent && ctx.Err() == nil
+
+			return wrapErrorf(err, ErrorCodeUnavailable, "io.ReadAll")
+		}
+
+		if !contains(expected, res.StatusCode) {
+			switch res.StatusCode {
+			case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+				retryable = true
+			case http.StatusBadGateway, http.StatusGatewayTimeout:
+				retryable = idempotent
+			}
+
+			return newResponseError(res.StatusCode, body)
+		}
+
+		header = res.Header
+
+		if dst == nil || len(body) == 0 {
+			return nil
+		}
+
+		if err := json.Unmarshal(body, dst); err != nil {
+			return wrapErrorf(err, ErrorCodeUnknown, "json.Unmarshal")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, wrapErrorf(err, ErrorCodeUnknown, "%s", operation)
+	}
+
+	return header, nil
+}
+
+// newResponseError returns the error matching the response, its code is derived from the status.
+func newResponseError(status int, body []byte) error {
+	rerr := ResponseError{
+		StatusCode: status,
+		Message:    http.StatusText(status),
+	}
+
+	var res openapi3.ErrorResponse
+
+	if err := json.Unmarshal(body, &res); err == nil {
+		if res.Error != nil {
+			rerr.Message = *res.Error
+		}
+
+		if res.Code != nil {
+			rerr.Code = *res.Code
+		}
+
+		if res.RequestId != nil {
+			rerr.RequestID = *res.RequestId
+		}
+	}
+
+	var code ErrorCode
+
+	switch status {
+	case http.StatusNotFound:
+		code = ErrorCodeNotFound
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		code = ErrorCodeInvalidArgument
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		code = ErrorCodeConflict
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		code = ErrorCodeUnavailable
+	default:
+		code = ErrorCodeUnknown
+	}
+
+	return wrapErrorf(&rerr, code, "unexpected response")
+}
+
+func contains(statuses []int, status int) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+
+	return false
+}
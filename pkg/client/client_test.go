@@ -0,0 +1,203 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/MarioCarrion/todo-api/pkg/client"
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+func TestClient_ReadTask(t *testing.T) {
+	t.Parallel()
+
+	type output struct {
+		description string
+		etag        string
+		code        client.ErrorCode
+		apiCode     string
+		withErr     bool
+	}
+
+	tests := []struct {
+		name     string
+		statuses []int
+		output   output
+		requests int32
+	}{
+		{
+			"OK",
+			[]int{http.StatusOK},
+			output{
+				description: "renew passport",
+				etag:        `"3"`,
+			},
+			1,
+		},
+		{
+			"OK: retried",
+			[]int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			output{
+				description: "renew passport",
+				etag:        `"3"`,
+			},
+			3,
+		},
+		{
+			"ERR: not found",
+			[]int{http.StatusNotFound},
+			output{
+				code:    client.ErrorCodeNotFound,
+				withErr: true,
+			},
+			1,
+		},
+		{
+			"ERR: attempts used",
+			[]int{http.StatusServiceUnavailable, http.StatusServiceUnavailable},
+			output{
+				code:    client.ErrorCodeUnavailable,
+				apiCode: "dependency_postgresql",
+				withErr: true,
+			},
+			2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/api/v1/tasks/a-b-c" {
+					t.Errorf("expected path, got %s", r.URL.Path)
+				}
+
+				status := tt.statuses[atomic.AddInt32(&requests, 1)-1]
+
+				w.Header().Set("Content-Type", "application/json")
+
+				if status != http.StatusOK {
+					w.WriteHeader(status)
+					_, _ = w.Write([]byte(`{"error":"failed","code":"dependency_postgresql","request_id":"r-1"}`))
+
+					return
+				}
+
+				w.Header().Set("ETag", `"3"`)
+				_, _ = w.Write([]byte(`{"task":{"id":"a-b-c","description":"renew passport"}}`))
+			}))
+			defer srv.Close()
+
+			c, err := client.New(srv.URL+"/api/v1", client.WithRetry(client.RetryOptions{
+				Attempts:   len(tt.statuses),
+				Backoff:    time.Millisecond,
+				MaxBackoff: time.Millisecond,
+			}))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			task, etag, err := c.ReadTask(context.Background(), "a-b-c")
+			if (err != nil) != tt.output.withErr {
+				t.Fatalf("expected error %t, got %s", tt.output.withErr, err)
+			}
+
+			if actual := atomic.LoadInt32(&requests); actual != tt.requests {
+				t.Fatalf("expected %d requests, got %d", tt.requests, actual)
+			}
+
+			if err != nil {
+				var cerr *client.Error
+				if !errors.As(err, &cerr) || cerr.Code() != tt.output.code {
+					t.Fatalf("expected code %d, got %s", tt.output.code, err)
+				}
+
+				var rerr *client.ResponseError
+				if !errors.As(err, &rerr) || rerr.RequestID != "r-1" {
+					t.Fatalf("expected response error, got %s", err)
+				}
+
+				if tt.output.apiCode != "" && rerr.Code != tt.output.apiCode {
+					t.Fatalf("expected API code %q, got %q", tt.output.apiCode, rerr.Code)
+				}
+
+				return
+			}
+
+			if task.Description == nil || *task.Description != tt.output.description {
+				t.Fatalf("expected description %q, got %v", tt.output.description, task.Description)
+			}
+
+			if etag != tt.output.etag {
+				t.Fatalf("expected etag %q, got %q", tt.output.etag, etag)
+			}
+		})
+	}
+}
+
+func TestClient_CreateTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		status   int
+		requests int32
+	}{
+		{
+			"ERR: rejected is retried",
+			http.StatusTooManyRequests,
+			3,
+		},
+		{
+			"ERR: bad gateway is not retried",
+			http.StatusBadGateway,
+			1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			c, err := client.New(srv.URL, client.WithRetry(client.RetryOptions{
+				Attempts:   3,
+				Backoff:    time.Millisecond,
+				MaxBackoff: time.Millisecond,
+			}))
+			if err != nil {
+				t.Fatalf("expected no error, got %s", err)
+			}
+
+			description := "renew passport"
+
+			if _, err := c.CreateTask(context.Background(), openapi3.CreateTasksRequest{Description: &description}); err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			if actual := atomic.LoadInt32(&requests); actual != tt.requests {
+				t.Fatalf("expected %d requests, got %d", tt.requests, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error is the error returned by the client, it includes a code for determining what triggered it; when the API
+// responded with an error it wraps a ResponseError including the details.
+type Error struct {
+	orig error
+	msg  string
+	code ErrorCode
+}
+
+// ErrorCode defines the supported error codes.
+type ErrorCode uint
+
+const (
+	// ErrorCodeUnknown indicates an unexpected failure.
+	ErrorCodeUnknown ErrorCode = iota
+
+	// ErrorCodeNotFound indicates the resource does not exist, 404 responses.
+	ErrorCodeNotFound
+
+	// ErrorCodeInvalidArgument indicates the request is not valid, 400, 413 and 422 responses.
+	ErrorCodeInvalidArgument
+
+	// ErrorCodeUnavailable indicates a transient failure: the request could not be sent, or 429, 503 and 504
+	// responses; those are retried, see WithRetry.
+	ErrorCodeUnavailable
+
+	// ErrorCodeConflict indicates the resource changed since it was read, 409 and 412 responses.
+	ErrorCodeConflict
+)
+
+// wrapErrorf returns a wrapped error. When code is ErrorCodeUnknown and the wrapped error is an Error, the code of
+// the wrapped error is kept.
+func wrapErrorf(orig error, code ErrorCode, format string, a ...interface{}) error {
+	var cerr *Error
+	if code == ErrorCodeUnknown && errors.As(orig, &cerr) {
+		code = cerr.code
+	}
+
+	return &Error{
+		code: code,
+		orig: orig,
+		msg:  fmt.Sprintf(format, a...),
+	}
+}
+
+// Error returns the message, when wrapping errors the wrapped error is returned.
+func (e *Error) Error() string {
+	if e.orig != nil {
+		return fmt.Sprintf("%s: %v", e.msg, e.orig)
+	}
+
+	return e.msg
+}
+
+// Unwrap returns the wrapped error, if any.
+func (e *Error) Unwrap() error {
+	return e.orig
+}
+
+// Code returns the code representing this error.
+func (e *Error) Code() ErrorCode {
+	return e.code
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+// CreateProject creates a project, it's only retried when the API rejected the request.
+func (c *Client) CreateProject(ctx context.Context, name string) (openapi3.Project, error) {
+	var res openapi3.ProjectResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.CreateProject(ctx, openapi3.CreateProjectJSONRequestBody{Name: &name}) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "CreateProject", false, send, &res, http.StatusCreated); err != nil {
+		return openapi3.Project{}, err
+	}
+
+	if res.Project == nil {
+		return openapi3.Project{}, nil
+	}
+
+	return *res.Project, nil
+}
+
+// ReadProject returns the project.
+func (c *Client) ReadProject(ctx context.Context, id string) (openapi3.Project, error) {
+	var res openapi3.ProjectResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.ReadProject(ctx, id) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "ReadProject", true, send, &res, http.StatusOK); err != nil {
+		return openapi3.Project{}, err
+	}
+
+	if res.Project == nil {
+		return openapi3.Project{}, nil
+	}
+
+	return *res.Project, nil
+}
+
+// ListProjects returns all the projects.
+func (c *Client) ListProjects(ctx context.Context) ([]openapi3.Project, error) {
+	var res openapi3.ListProjectsResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.ListProject(ctx) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "ListProjects", true, send, &res, http.StatusOK); err != nil {
+		return nil, err
+	}
+
+	if res.Projects == nil {
+		return nil, nil
+	}
+
+	return *res.Projects, nil
+}
+
+// DeleteProject deletes the project, its tasks are kept or deleted depending on strategy.
+func (c *Client) DeleteProject(ctx context.Context, id string, strategy openapi3.DeleteProjectParamsStrategy) error {
+	params := openapi3.DeleteProjectParams{Strategy: &strategy}
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.DeleteProject(ctx, id, &params) //nolint: wrapcheck
+	}
+
+	_, err := c.do(ctx, "DeleteProject", true, send, nil, http.StatusOK)
+
+	return err
+}
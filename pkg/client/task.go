@@ -0,0 +1,139 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/MarioCarrion/todo-api/pkg/openapi3"
+)
+
+// CreateTask creates a task, it's only retried when the API rejected the request.
+func (c *Client) CreateTask(ctx context.Context, req openapi3.CreateTasksRequest) (openapi3.Task, error) {
+	var res openapi3.CreateTasksResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.CreateTask(ctx, openapi3.CreateTaskJSONRequestBody(req)) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "CreateTask", false, send, &res, http.StatusCreated); err != nil {
+		return openapi3.Task{}, err
+	}
+
+	return taskOrEmpty(res.Task), nil
+}
+
+// ReadTask returns the task and its ETag, the latter is used for updating it only when it did not change.
+func (c *Client) ReadTask(ctx context.Context, id string) (openapi3.Task, string, error) {
+	var res openapi3.ReadTasksResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.ReadTask(ctx, id, &openapi3.ReadTaskParams{}) //nolint: wrapcheck
+	}
+
+	header, err := c.do(ctx, "ReadTask", true, send, &res, http.StatusOK)
+	if err != nil {
+		return openapi3.Task{}, "", err
+	}
+
+	return taskOrEmpty(res.Task), header.Get("ETag"), nil
+}
+
+// UpdateTask updates the task, when etag is not empty it's only updated when it still matches.
+func (c *Client) UpdateTask(ctx context.Context, id, etag string, req openapi3.UpdateTasksRequest) error {
+	var params openapi3.UpdateTaskParams
+	if etag != "" {
+		params.IfMatch = &etag
+	}
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.UpdateTask(ctx, id, &params, openapi3.UpdateTaskJSONRequestBody(req)) //nolint: wrapcheck
+	}
+
+	_, err := c.do(ctx, "UpdateTask", true, send, nil, http.StatusOK, http.StatusCreated)
+
+	return err
+}
+
+// DeleteTask deletes the task.
+func (c *Client) DeleteTask(ctx context.Context, id string) error {
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.DeleteTask(ctx, id) //nolint: wrapcheck
+	}
+
+	_, err := c.do(ctx, "DeleteTask", true, send, nil, http.StatusOK, http.StatusNoContent)
+
+	return err
+}
+
+// CompleteTask marks the task as done, it returns the updated task.
+func (c *Client) CompleteTask(ctx context.Context, id string) (openapi3.Task, error) {
+	var res openapi3.ReadTasksResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.CompleteTask(ctx, id, &openapi3.CompleteTaskParams{}) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "CompleteTask", true, send, &res, http.StatusOK); err != nil {
+		return openapi3.Task{}, err
+	}
+
+	return taskOrEmpty(res.Task), nil
+}
+
+// ReopenTask marks the task as not done, it returns the updated task.
+func (c *Client) ReopenTask(ctx context.Context, id string) (openapi3.Task, error) {
+	var res openapi3.ReadTasksResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.ReopenTask(ctx, id, &openapi3.ReopenTaskParams{}) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "ReopenTask", true, send, &res, http.StatusOK); err != nil {
+		return openapi3.Task{}, err
+	}
+
+	return taskOrEmpty(res.Task), nil
+}
+
+// ListTasks returns a page of tasks, the cursor of the next one is included when there are more results.
+func (c *Client) ListTasks(ctx context.Context, params openapi3.ListTaskParams) (openapi3.ListTasksResponse, error) {
+	var res openapi3.ListTasksResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		return c.oa3.ListTask(ctx, &params) //nolint: wrapcheck
+	}
+
+	if _, err := c.do(ctx, "ListTasks", true, send, &res, http.StatusOK); err != nil {
+		return openapi3.ListTasksResponse{}, err
+	}
+
+	return res, nil
+}
+
+// SearchTasks returns the indexed tasks matching the request.
+func (c *Client) SearchTasks(ctx context.Context,
+	req openapi3.SearchTasksRequest) (openapi3.SearchTasksResponse, error) {
+	var res openapi3.SearchTasksResponse
+
+	send := func(ctx context.Context) (*http.Response, error) {
+		body := openapi3.SearchTaskJSONRequestBody(req)
+
+		return c.oa3.SearchTask(ctx, &openapi3.SearchTaskParams{}, body) //nolint: wrapcheck
+	}
+
+	// Searching doesn't modify tasks, so it's safe to retry even though it uses POST.
+	if _, err := c.do(ctx, "SearchTasks", true, send, &res, http.StatusOK); err != nil {
+		return openapi3.SearchTasksResponse{}, err
+	}
+
+	return res, nil
+}
+
+// taskOrEmpty returns the task included in responses, the API always includes it on success.
+func taskOrEmpty(task *openapi3.Task) openapi3.Task {
+	if task == nil {
+		return openapi3.Task{}
+	}
+
+	return *task
+}